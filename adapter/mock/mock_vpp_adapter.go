@@ -90,7 +90,7 @@ func (m *defaultReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	// field[1] m.Retval
 	m.Retval = int32(buf.DecodeUint32())
-	return nil
+	return buf.Err()
 }
 
 // MessageDTO is a structure used for propagating information to ReplyHandlers.
@@ -282,11 +282,12 @@ func (a *VppAdapter) SendMsg(clientID uint32, data []byte) error {
 			reqMsgName := a.msgIDsToName[msgID]
 			a.access.Unlock()
 
+			// the handler gets its own copy, since the caller may reuse the data
 			reply, msgID, finished := replyHandler(MessageDTO{
 				MsgID:    msgID,
 				MsgName:  reqMsgName,
 				ClientID: clientID,
-				Data:     data,
+				Data:     append([]byte(nil), data...),
 			})
 			if finished {
 				a.callback(msgID, reply)
@@ -0,0 +1,41 @@
+//  Copyright (c) 2023 Cisco and/or its affiliates.
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at:
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+// Package replay provides persistence of the API trace records and a VPP adapter
+// replaying a recorded session, which allows running tests against a conversation
+// captured from a real VPP without VPP.
+//
+// The records captured by the API trace can be saved into a file in one of the
+// supported formats:
+//
+//	conn.Trace().Enable(true)
+//	// ... program VPP
+//	err := replay.SaveFile("session.trace", replay.FormatBinary, conn.Trace().GetRecords())
+//
+// The binary format stores each message as a binary frame along with its name,
+// CRC, timestamp and context. The JSON lines format stores the same data as one
+// JSON object per line, which is easier to inspect and edit.
+//
+// The adapter answers each request with the replies recorded for the request
+// with the same name in the same order:
+//
+//	records, err := replay.LoadFile("session.trace")
+//	if err != nil {
+//		// handle error!
+//	}
+//	conn, err := core.Connect(replay.NewVppAdapter(records))
+//
+// The adapter does not answer health check probes unless they were recorded,
+// so it is supposed to be used with core.Connect rather than core.AsyncConnect.
+package replay
@@ -0,0 +1,385 @@
+//  Copyright (c) 2023 Cisco and/or its affiliates.
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at:
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package replay
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"time"
+
+	"go.fd.io/govpp/api"
+	"go.fd.io/govpp/codec"
+)
+
+// Format is a format of the trace file.
+type Format int
+
+const (
+	// FormatBinary stores the records as length-prefixed binary records.
+	FormatBinary Format = iota
+	// FormatJSONLines stores the records as JSON objects, one per line.
+	FormatJSONLines
+)
+
+func (f Format) String() string {
+	switch f {
+	case FormatBinary:
+		return "binary"
+	case FormatJSONLines:
+		return "jsonl"
+	}
+	return fmt.Sprintf("Format(%d)", int(f))
+}
+
+// Version is the version of the trace file format written by this package.
+const Version = 1
+
+// binaryMagic starts the binary trace file, followed by the version (uint16).
+var binaryMagic = []byte("GOVPPTRC")
+
+// jsonFormatName identifies the JSON lines trace file in its header line.
+const jsonFormatName = "govpp-trace"
+
+const flagReceived = 1 << 0
+
+// maxRecordSize limits the size of the binary record read from the file to avoid
+// huge allocations for corrupted files.
+const maxRecordSize = 1 << 24
+
+// ErrInvalidFormat is returned when the trace file format is not recognized.
+var ErrInvalidFormat = errors.New("invalid trace file format")
+
+// Writer writes the trace records in the given format.
+type Writer struct {
+	w      *bufio.Writer
+	format Format
+}
+
+// NewWriter writes the file header into w and returns a writer for the records.
+// Flush must be called after the last record.
+func NewWriter(w io.Writer, format Format) (*Writer, error) {
+	tw := &Writer{w: bufio.NewWriter(w), format: format}
+	var err error
+	switch format {
+	case FormatBinary:
+		header := make([]byte, len(binaryMagic)+2)
+		copy(header, binaryMagic)
+		binary.BigEndian.PutUint16(header[len(binaryMagic):], Version)
+		_, err = tw.w.Write(header)
+	case FormatJSONLines:
+		err = json.NewEncoder(tw.w).Encode(jsonHeader{Format: jsonFormatName, Version: Version})
+	default:
+		return nil, fmt.Errorf("%w: %v", ErrInvalidFormat, format)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return tw, nil
+}
+
+// Write writes a single record.
+func (tw *Writer) Write(record *api.Record) error {
+	frame, err := encodeFrame(record)
+	if err != nil {
+		return err
+	}
+	switch tw.format {
+	case FormatJSONLines:
+		return json.NewEncoder(tw.w).Encode(jsonRecord{
+			Timestamp: record.Timestamp,
+			Received:  record.IsReceived,
+			Channel:   record.ChannelID,
+			Context:   record.Context,
+			SeqNum:    record.SeqNum,
+			Latency:   record.Latency,
+			MsgName:   record.Message.GetMessageName(),
+			MsgCrc:    record.Message.GetCrcString(),
+			Frame:     frame,
+			Message:   record.Message,
+		})
+	default:
+		return tw.writeBinary(record, frame)
+	}
+}
+
+// Flush writes any buffered data to the underlying writer.
+func (tw *Writer) Flush() error {
+	return tw.w.Flush()
+}
+
+// binary record:
+//
+//	length    uint32 (length of the rest of the record)
+//	flags     uint8
+//	channel   uint16
+//	context   uint32
+//	seq_num   uint16
+//	timestamp int64 (unix nanoseconds)
+//	latency   int64 (nanoseconds)
+//	name      uint8 length + bytes
+//	crc       uint8 length + bytes
+//	frame     uint32 length + bytes
+func (tw *Writer) writeBinary(record *api.Record, frame []byte) error {
+	name, crc := record.Message.GetMessageName(), record.Message.GetCrcString()
+	if len(name) > 255 || len(crc) > 255 {
+		return fmt.Errorf("message name or CRC too long: %s_%s", name, crc)
+	}
+	size := 1 + 2 + 4 + 2 + 8 + 8 + 1 + len(name) + 1 + len(crc) + 4 + len(frame)
+	b := make([]byte, 4+size)
+	binary.BigEndian.PutUint32(b[0:4], uint32(size))
+	if record.IsReceived {
+		b[4] |= flagReceived
+	}
+	binary.BigEndian.PutUint16(b[5:7], record.ChannelID)
+	binary.BigEndian.PutUint32(b[7:11], record.Context)
+	binary.BigEndian.PutUint16(b[11:13], record.SeqNum)
+	binary.BigEndian.PutUint64(b[13:21], uint64(record.Timestamp.UnixNano()))
+	binary.BigEndian.PutUint64(b[21:29], uint64(record.Latency))
+	off := 29
+	b[off] = uint8(len(name))
+	off += 1 + copy(b[off+1:], name)
+	b[off] = uint8(len(crc))
+	off += 1 + copy(b[off+1:], crc)
+	binary.BigEndian.PutUint32(b[off:off+4], uint32(len(frame)))
+	copy(b[off+4:], frame)
+	_, err := tw.w.Write(b)
+	return err
+}
+
+type jsonHeader struct {
+	Format  string `json:"format"`
+	Version int    `json:"version"`
+}
+
+type jsonRecord struct {
+	Timestamp time.Time     `json:"timestamp"`
+	Received  bool          `json:"received"`
+	Channel   uint16        `json:"channel"`
+	Context   uint32        `json:"context"`
+	SeqNum    uint16        `json:"seq_num"`
+	Latency   time.Duration `json:"latency,omitempty"`
+	MsgName   string        `json:"msg_name"`
+	MsgCrc    string        `json:"msg_crc"`
+	Frame     []byte        `json:"frame"`
+	// Message is informative only, the message is decoded from the frame.
+	Message api.Message `json:"message,omitempty"`
+}
+
+// WriteRecords writes the file header and all the records into w.
+func WriteRecords(w io.Writer, format Format, records []*api.Record) error {
+	tw, err := NewWriter(w, format)
+	if err != nil {
+		return err
+	}
+	for _, record := range records {
+		if err := tw.Write(record); err != nil {
+			return err
+		}
+	}
+	return tw.Flush()
+}
+
+// SaveFile writes the records into a new file at path.
+func SaveFile(path string, format Format, records []*api.Record) error {
+	f, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+	if err := WriteRecords(f, format, records); err != nil {
+		_ = f.Close()
+		return err
+	}
+	return f.Close()
+}
+
+// ReadRecords reads all the records from r. The format is detected from the file header.
+func ReadRecords(r io.Reader) ([]*api.Record, error) {
+	br := bufio.NewReader(r)
+	head, err := br.Peek(len(binaryMagic))
+	if err != nil && !errors.Is(err, io.EOF) {
+		return nil, err
+	}
+	if bytes.Equal(head, binaryMagic) {
+		return readBinary(br)
+	}
+	return readJSONLines(br)
+}
+
+// LoadFile reads all the records from the file at path.
+func LoadFile(path string) ([]*api.Record, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	return ReadRecords(f)
+}
+
+func readBinary(r *bufio.Reader) ([]*api.Record, error) {
+	header := make([]byte, len(binaryMagic)+2)
+	if _, err := io.ReadFull(r, header); err != nil {
+		return nil, err
+	}
+	if version := binary.BigEndian.Uint16(header[len(binaryMagic):]); version != Version {
+		return nil, fmt.Errorf("%w: unsupported version %d", ErrInvalidFormat, version)
+	}
+
+	var records []*api.Record
+	for {
+		var size [4]byte
+		if _, err := io.ReadFull(r, size[:]); errors.Is(err, io.EOF) {
+			return records, nil
+		} else if err != nil {
+			return nil, err
+		}
+		recordSize := binary.BigEndian.Uint32(size[:])
+		if recordSize > maxRecordSize {
+			return nil, fmt.Errorf("%w: record #%d too large (%d bytes)", ErrInvalidFormat, len(records), recordSize)
+		}
+		b := make([]byte, recordSize)
+		if _, err := io.ReadFull(r, b); err != nil {
+			return nil, err
+		}
+		record, err := decodeBinaryRecord(b)
+		if err != nil {
+			return nil, fmt.Errorf("record #%d: %w", len(records), err)
+		}
+		records = append(records, record)
+	}
+}
+
+func decodeBinaryRecord(b []byte) (*api.Record, error) {
+	const fixedSize = 1 + 2 + 4 + 2 + 8 + 8
+	if len(b) < fixedSize+1 {
+		return nil, fmt.Errorf("%w: record too short", ErrInvalidFormat)
+	}
+	record := &api.Record{
+		IsReceived: b[0]&flagReceived != 0,
+		ChannelID:  binary.BigEndian.Uint16(b[1:3]),
+		Context:    binary.BigEndian.Uint32(b[3:7]),
+		SeqNum:     binary.BigEndian.Uint16(b[7:9]),
+		Timestamp:  time.Unix(0, int64(binary.BigEndian.Uint64(b[9:17]))),
+		Latency:    time.Duration(binary.BigEndian.Uint64(b[17:25])),
+	}
+	b = b[fixedSize:]
+
+	readString := func() (string, bool) {
+		if len(b) < 1 || len(b) < 1+int(b[0]) {
+			return "", false
+		}
+		s := string(b[1 : 1+b[0]])
+		b = b[1+b[0]:]
+		return s, true
+	}
+	name, ok := readString()
+	if !ok {
+		return nil, fmt.Errorf("%w: invalid message name", ErrInvalidFormat)
+	}
+	crc, ok := readString()
+	if !ok || len(b) < 4 {
+		return nil, fmt.Errorf("%w: invalid message CRC", ErrInvalidFormat)
+	}
+	frameLen := binary.BigEndian.Uint32(b[:4])
+	if uint32(len(b)-4) < frameLen {
+		return nil, fmt.Errorf("%w: invalid frame length", ErrInvalidFormat)
+	}
+	msg, err := decodeFrame(name, crc, b[4:4+frameLen])
+	if err != nil {
+		return nil, err
+	}
+	record.Message = msg
+	return record, nil
+}
+
+func readJSONLines(r *bufio.Reader) ([]*api.Record, error) {
+	dec := json.NewDecoder(r)
+	var header jsonHeader
+	if err := dec.Decode(&header); err != nil || header.Format != jsonFormatName {
+		return nil, ErrInvalidFormat
+	}
+	if header.Version != Version {
+		return nil, fmt.Errorf("%w: unsupported version %d", ErrInvalidFormat, header.Version)
+	}
+
+	var records []*api.Record
+	for {
+		var rec struct {
+			jsonRecord
+			Message json.RawMessage `json:"message,omitempty"`
+		}
+		if err := dec.Decode(&rec); errors.Is(err, io.EOF) {
+			return records, nil
+		} else if err != nil {
+			return nil, fmt.Errorf("record #%d: %w", len(records), err)
+		}
+		msg, err := decodeFrame(rec.MsgName, rec.MsgCrc, rec.Frame)
+		if err != nil {
+			return nil, fmt.Errorf("record #%d: %w", len(records), err)
+		}
+		records = append(records, &api.Record{
+			Message:    msg,
+			Timestamp:  rec.Timestamp,
+			IsReceived: rec.Received,
+			ChannelID:  rec.Channel,
+			Context:    rec.Context,
+			SeqNum:     rec.SeqNum,
+			Latency:    rec.Latency,
+		})
+	}
+}
+
+// encodeFrame encodes the message into a binary frame. The message ID is zero,
+// since it is only valid for the recorded session.
+func encodeFrame(record *api.Record) ([]byte, error) {
+	data, err := codec.DefaultCodec.EncodeMsg(record.Message, 0)
+	if err != nil {
+		return nil, fmt.Errorf("encoding %s failed: %w", record.Message.GetMessageName(), err)
+	}
+	setContext(data, record.Message, record.Context)
+	return data, nil
+}
+
+// decodeFrame decodes the frame into a new instance of the registered message.
+func decodeFrame(name, crc string, frame []byte) (api.Message, error) {
+	msgType, err := api.LookupMessage(name, crc)
+	if err != nil {
+		return nil, err
+	}
+	msg := api.NewMessage(msgType)
+	if err := codec.DefaultCodec.DecodeMsg(frame, msg); err != nil {
+		return nil, fmt.Errorf("decoding %s failed: %w", name, err)
+	}
+	return msg, nil
+}
+
+// setContext sets the context in the frame header of the message.
+func setContext(data []byte, msg api.Message, context uint32) {
+	switch msg.GetMessageType() {
+	case api.ReplyMessage:
+		if len(data) >= 6 {
+			binary.BigEndian.PutUint32(data[2:6], context)
+		}
+	case api.RequestMessage:
+		if len(data) >= 10 {
+			binary.BigEndian.PutUint32(data[6:10], context)
+		}
+	}
+}
@@ -0,0 +1,206 @@
+//  Copyright (c) 2023 Cisco and/or its affiliates.
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at:
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package replay
+
+import (
+	"encoding/binary"
+	"fmt"
+	"sort"
+	"sync"
+
+	"go.fd.io/govpp/adapter"
+	"go.fd.io/govpp/api"
+	"go.fd.io/govpp/codec"
+)
+
+// UnexpectedRequestError is returned by SendMsg if there is no recorded
+// exchange left for the request.
+type UnexpectedRequestError struct {
+	MsgName string
+	MsgCrc  string
+}
+
+func (e *UnexpectedRequestError) Error() string {
+	return fmt.Sprintf("no recorded reply left for request %s_%s", e.MsgName, e.MsgCrc)
+}
+
+// exchange is a recorded request with the messages received after it.
+type exchange struct {
+	request  *api.Record
+	received []*api.Record // replies and events in the recorded order
+}
+
+// VppAdapter is a VPP adapter replaying a recorded session.
+type VppAdapter struct {
+	callback adapter.MsgCallback
+
+	mu        sync.Mutex
+	msgIDs    map[string]uint16 // message IDs indexed by name + CRC
+	msgNames  map[uint16]string // message name + CRC indexed by ID
+	exchanges map[string][]*exchange
+	initial   []*api.Record // messages received before the first request, sent with it
+}
+
+// NewVppAdapter returns an adapter replaying the recorded session. Each request
+// is answered by the messages received in the recorded session after the
+// recorded request with the same name, CRC and multipart flag, in the order
+// the requests were recorded. The replies are identified by the request context.
+// Events received before the first request are sent before the replies to the
+// first request, the other events are sent after the replies to the request
+// preceding them.
+func NewVppAdapter(records []*api.Record) *VppAdapter {
+	a := &VppAdapter{
+		msgIDs:    make(map[string]uint16),
+		msgNames:  make(map[uint16]string),
+		exchanges: make(map[string][]*exchange),
+	}
+
+	sorted := append([]*api.Record(nil), records...)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return sorted[i].Timestamp.Before(sorted[j].Timestamp)
+	})
+
+	var (
+		last      *exchange
+		byContext = make(map[uint32]*exchange) // last request sent with the context
+	)
+	for _, record := range sorted {
+		if !record.IsReceived {
+			ex := &exchange{request: record}
+			key := exchangeKey(record.Message, record.Context)
+			a.exchanges[key] = append(a.exchanges[key], ex)
+			byContext[record.Context] = ex
+			last = ex
+			continue
+		}
+		ex := last
+		if record.Context != 0 && record.Message.GetMessageType() == api.ReplyMessage {
+			if reqEx, ok := byContext[record.Context]; ok {
+				ex = reqEx
+			}
+		}
+		if ex == nil {
+			a.initial = append(a.initial, record)
+		} else {
+			ex.received = append(ex.received, record)
+		}
+	}
+	return a
+}
+
+// exchangeKey identifies the request of the recorded exchange.
+func exchangeKey(msg api.Message, context uint32) string {
+	multipart := (context>>16)&0x1 != 0
+	return fmt.Sprintf("%s_%s/%t", msg.GetMessageName(), msg.GetCrcString(), multipart)
+}
+
+// Connect emulates connecting to VPP.
+func (a *VppAdapter) Connect() error {
+	return nil
+}
+
+// Disconnect emulates disconnecting from VPP.
+func (a *VppAdapter) Disconnect() error {
+	return nil
+}
+
+// GetMsgID returns a message ID for the given message name and CRC. The IDs are
+// assigned sequentially, since the recorded IDs are only valid for the recorded session.
+func (a *VppAdapter) GetMsgID(msgName string, msgCrc string) (uint16, error) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	return a.getMsgID(msgName + "_" + msgCrc), nil
+}
+
+func (a *VppAdapter) getMsgID(nameCrc string) uint16 {
+	if msgID, ok := a.msgIDs[nameCrc]; ok {
+		return msgID
+	}
+	msgID := uint16(len(a.msgIDs) + 1)
+	a.msgIDs[nameCrc] = msgID
+	a.msgNames[msgID] = nameCrc
+	return msgID
+}
+
+// SendMsg answers the request with the messages recorded for it.
+func (a *VppAdapter) SendMsg(context uint32, data []byte) error {
+	if len(data) < 2 {
+		return fmt.Errorf("message too short")
+	}
+	msgID := binary.BigEndian.Uint16(data[0:2])
+
+	a.mu.Lock()
+	nameCrc, ok := a.msgNames[msgID]
+	if !ok {
+		a.mu.Unlock()
+		return fmt.Errorf("unknown message ID: %d", msgID)
+	}
+	msgType, err := api.LookupMessage(api.SplitNameCrc(nameCrc))
+	if err != nil {
+		a.mu.Unlock()
+		return err
+	}
+	key := exchangeKey(msgType, context)
+	queue := a.exchanges[key]
+	if len(queue) == 0 {
+		a.mu.Unlock()
+		return &UnexpectedRequestError{MsgName: msgType.GetMessageName(), MsgCrc: msgType.GetCrcString()}
+	}
+	ex := queue[0]
+	a.exchanges[key] = queue[1:]
+	initial := a.initial
+	a.initial = nil
+	a.mu.Unlock()
+
+	if err := a.send(initial, context); err != nil {
+		return err
+	}
+	return a.send(ex.received, context)
+}
+
+// send encodes the recorded messages and passes them to the callback. The replies
+// get the context of the replayed request.
+func (a *VppAdapter) send(records []*api.Record, context uint32) error {
+	for _, record := range records {
+		msg := record.Message
+		a.mu.Lock()
+		msgID := a.getMsgID(msg.GetMessageName() + "_" + msg.GetCrcString())
+		a.mu.Unlock()
+
+		data, err := codec.DefaultCodec.EncodeMsg(msg, msgID)
+		if err != nil {
+			return fmt.Errorf("encoding recorded %s failed: %w", msg.GetMessageName(), err)
+		}
+		msgContext := record.Context
+		if msgContext != 0 && msg.GetMessageType() == api.ReplyMessage {
+			msgContext = context
+		}
+		setContext(data, msg, msgContext)
+		if a.callback != nil {
+			a.callback(msgID, data)
+		}
+	}
+	return nil
+}
+
+// SetMsgCallback sets a callback function that will be called by the adapter whenever a message comes from VPP.
+func (a *VppAdapter) SetMsgCallback(cb adapter.MsgCallback) {
+	a.callback = cb
+}
+
+// WaitReady returns always nil.
+func (a *VppAdapter) WaitReady() error {
+	return nil
+}
@@ -0,0 +1,106 @@
+package replay_test
+
+import (
+	"bytes"
+	"path/filepath"
+	"testing"
+
+	. "github.com/onsi/gomega"
+
+	"go.fd.io/govpp/adapter"
+	"go.fd.io/govpp/adapter/mock"
+	"go.fd.io/govpp/adapter/replay"
+	"go.fd.io/govpp/api"
+	interfaces "go.fd.io/govpp/binapi/interface"
+	"go.fd.io/govpp/binapi/memclnt"
+	"go.fd.io/govpp/core"
+)
+
+// runSession creates a loopback and dumps interfaces via the connection.
+func runSession(vppAdapter adapter.VppAPI) (*interfaces.CreateLoopbackReply, []*interfaces.SwInterfaceDetails, *core.Connection) {
+	conn, err := core.Connect(vppAdapter)
+	Expect(err).ShouldNot(HaveOccurred())
+	conn.Trace().Enable(true)
+
+	ch, err := conn.NewAPIChannel()
+	Expect(err).ShouldNot(HaveOccurred())
+	defer ch.Close()
+
+	reply := &interfaces.CreateLoopbackReply{}
+	err = ch.SendRequest(&interfaces.CreateLoopback{MacAddress: [6]uint8{1, 2, 3, 4, 5, 6}}).ReceiveReply(reply)
+	Expect(err).ShouldNot(HaveOccurred())
+
+	var list []*interfaces.SwInterfaceDetails
+	reqCtx := ch.SendMultiRequest(&interfaces.SwInterfaceDump{})
+	for {
+		details := &interfaces.SwInterfaceDetails{}
+		stop, err := reqCtx.ReceiveReply(details)
+		Expect(err).ShouldNot(HaveOccurred())
+		if stop {
+			break
+		}
+		list = append(list, details)
+	}
+	return reply, list, conn
+}
+
+func TestReplay(t *testing.T) {
+	RegisterTestingT(t)
+
+	mockVpp := mock.NewVppAdapter()
+	mockVpp.MockReply(&interfaces.CreateLoopbackReply{SwIfIndex: 5})
+	mockVpp.MockReply(
+		&interfaces.SwInterfaceDetails{SwIfIndex: 0, InterfaceName: "local0"},
+		&interfaces.SwInterfaceDetails{SwIfIndex: 5, InterfaceName: "loop0"},
+		&memclnt.ControlPingReply{},
+	)
+	recordedReply, recordedList, conn := runSession(mockVpp)
+	records := conn.Trace().GetRecords()
+	conn.Disconnect()
+	Expect(recordedList).To(HaveLen(2))
+
+	for _, format := range []replay.Format{replay.FormatBinary, replay.FormatJSONLines} {
+		path := filepath.Join(t.TempDir(), "session.trace")
+		Expect(replay.SaveFile(path, format, records)).To(Succeed())
+		loaded, err := replay.LoadFile(path)
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(loaded).To(HaveLen(len(records)))
+		for i, record := range loaded {
+			Expect(record.Message).To(BeEquivalentTo(records[i].Message))
+			Expect(record.Context).To(Equal(records[i].Context))
+			Expect(record.IsReceived).To(Equal(records[i].IsReceived))
+			Expect(record.Timestamp.Equal(records[i].Timestamp)).To(BeTrue())
+		}
+
+		reply, list, conn := runSession(replay.NewVppAdapter(loaded))
+		Expect(reply).To(Equal(recordedReply))
+		Expect(list).To(Equal(recordedList))
+
+		// the recorded session does not contain more requests
+		ch, err := conn.NewAPIChannel()
+		Expect(err).ShouldNot(HaveOccurred())
+		err = ch.SendRequest(&interfaces.CreateLoopback{}).ReceiveReply(&interfaces.CreateLoopbackReply{})
+		Expect(err).To(MatchError(ContainSubstring("no recorded reply left for request create_loopback")))
+		ch.Close()
+		conn.Disconnect()
+	}
+}
+
+func TestReadRecordsInvalid(t *testing.T) {
+	RegisterTestingT(t)
+
+	_, err := replay.ReadRecords(bytes.NewBufferString("not a trace"))
+	Expect(err).To(MatchError(replay.ErrInvalidFormat))
+
+	var buf bytes.Buffer
+	Expect(replay.WriteRecords(&buf, replay.FormatBinary, []*api.Record{
+		{Message: &memclnt.ControlPing{}, Context: 1},
+	})).To(Succeed())
+	_, err = replay.ReadRecords(bytes.NewReader(buf.Bytes()[:buf.Len()-1]))
+	Expect(err).To(HaveOccurred())
+
+	// record length far beyond any message is rejected before allocating it
+	header := append([]byte("GOVPPTRC"), 0, 1)
+	_, err = replay.ReadRecords(bytes.NewReader(append(header, 0xff, 0xff, 0xff, 0xff)))
+	Expect(err).To(MatchError(ContainSubstring("too large")))
+}
@@ -0,0 +1,45 @@
+//  Copyright (c) 2023 Cisco and/or its affiliates.
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at:
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+// Package simulator provides a VPP adapter simulating VPP in the process,
+// which allows running end-to-end tests of the applications using GoVPP without
+// VPP. Unlike the mock adapter, which answers with the replies prepared by the test,
+// the simulator keeps the state modified by the requests and answers based on it.
+//
+// The simulator handles the following messages by default:
+//
+//   - control_ping, show_version
+//   - create_loopback, create_loopback_instance, delete_loopback
+//   - sw_interface_set_flags, sw_interface_dump
+//   - sw_interface_add_del_address, ip_address_dump
+//   - ip_table_add_del, ip_route_add_del, ip_route_dump
+//   - want_interface_events, with sw_interface_event sent on interface
+//     state change and deletion
+//
+// The requests are validated the way VPP does it, e.g. a request with an unknown
+// interface index is answered with the INVALID_SW_IF_INDEX retval. Note that
+// sw_interface_dump lists all interfaces only when its sw_if_index is ~0.
+//
+//	sim := simulator.NewVppAdapter()
+//	conn, err := core.Connect(sim)
+//
+// Other messages can be simulated by registering a handler, which can also
+// replace the built-in one:
+//
+//	sim.RegisterHandler(&vxlan.VxlanAddDelTunnel{}, func(req api.Message) []api.Message {
+//		return []api.Message{&vxlan.VxlanAddDelTunnelReply{SwIfIndex: 10}}
+//	})
+//
+// The events not triggered by any request can be sent by SendEvent.
+package simulator
@@ -0,0 +1,181 @@
+//  Copyright (c) 2023 Cisco and/or its affiliates.
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at:
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package simulator
+
+import (
+	"encoding/binary"
+	"fmt"
+	"reflect"
+	"sync"
+
+	"go.fd.io/govpp/adapter"
+	"go.fd.io/govpp/api"
+	"go.fd.io/govpp/codec"
+)
+
+// Handler handles the decoded request and returns the messages sent back to
+// the client in the given order. The reply messages get the context of the
+// request, the event messages are sent as they are.
+//
+// The requests are handled one at a time, so the handler does not need to
+// synchronize access to the state shared only with other handlers. It must
+// not call SendEvent, the events are supposed to be returned instead.
+type Handler func(req api.Message) []api.Message
+
+// handlerEntry is a registered handler with the request type it decodes.
+type handlerEntry struct {
+	msgType reflect.Type
+	handler Handler
+}
+
+// VppAdapter is a VPP adapter simulating VPP in the process. It keeps the
+// state modified by the requests and answers the requests based on that state.
+type VppAdapter struct {
+	callback adapter.MsgCallback
+
+	mu       sync.Mutex
+	msgIDs   map[string]uint16 // message IDs indexed by name + CRC
+	msgNames map[uint16]string // message name + CRC indexed by ID
+	handlers map[string]handlerEntry
+
+	sendMu sync.Mutex // serializes handling of requests and sending of events
+	state  *vppState
+}
+
+// NewVppAdapter returns a simulator with a fresh state containing only
+// the local0 interface and the default IPv4 and IPv6 tables. The handlers
+// of the built-in message families are registered.
+func NewVppAdapter() *VppAdapter {
+	a := &VppAdapter{
+		msgIDs:   make(map[string]uint16),
+		msgNames: make(map[uint16]string),
+		handlers: make(map[string]handlerEntry),
+		state:    newVppState(),
+	}
+	a.state.registerHandlers(a)
+	return a
+}
+
+// RegisterHandler registers the handler for the request message. The request
+// is decoded into a new message of the same type as msg. An already registered
+// handler for the message, including the built-in one, is replaced.
+func (a *VppAdapter) RegisterHandler(msg api.Message, handler Handler) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	a.handlers[msg.GetMessageName()+"_"+msg.GetCrcString()] = handlerEntry{
+		msgType: reflect.TypeOf(msg).Elem(),
+		handler: handler,
+	}
+}
+
+// SendEvent sends the event messages to the client, e.g. to simulate
+// an asynchronous change of the link state.
+func (a *VppAdapter) SendEvent(events ...api.Message) error {
+	a.sendMu.Lock()
+	defer a.sendMu.Unlock()
+	return a.send(events, 0)
+}
+
+// Connect emulates connecting to VPP.
+func (a *VppAdapter) Connect() error {
+	return nil
+}
+
+// Disconnect emulates disconnecting from VPP. The state is kept.
+func (a *VppAdapter) Disconnect() error {
+	return nil
+}
+
+// GetMsgID returns a message ID for the given message name and CRC.
+// The IDs are assigned sequentially.
+func (a *VppAdapter) GetMsgID(msgName string, msgCrc string) (uint16, error) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	return a.getMsgID(msgName + "_" + msgCrc), nil
+}
+
+func (a *VppAdapter) getMsgID(nameCrc string) uint16 {
+	if msgID, ok := a.msgIDs[nameCrc]; ok {
+		return msgID
+	}
+	msgID := uint16(len(a.msgIDs) + 1)
+	a.msgIDs[nameCrc] = msgID
+	a.msgNames[msgID] = nameCrc
+	return msgID
+}
+
+// SendMsg decodes the request, passes it to the registered handler and sends
+// the returned messages to the client. An UnknownMsgError is returned if there
+// is no handler registered for the request.
+func (a *VppAdapter) SendMsg(context uint32, data []byte) error {
+	if len(data) < 2 {
+		return fmt.Errorf("message too short")
+	}
+	msgID := binary.BigEndian.Uint16(data[0:2])
+
+	a.mu.Lock()
+	nameCrc, ok := a.msgNames[msgID]
+	if !ok {
+		a.mu.Unlock()
+		return fmt.Errorf("unknown message ID: %d", msgID)
+	}
+	entry, ok := a.handlers[nameCrc]
+	a.mu.Unlock()
+	if !ok {
+		name, crc := api.SplitNameCrc(nameCrc)
+		return &adapter.UnknownMsgError{MsgName: name, MsgCrc: crc}
+	}
+
+	req := reflect.New(entry.msgType).Interface().(api.Message)
+	if err := codec.DefaultCodec.DecodeMsg(data, req); err != nil {
+		return fmt.Errorf("decoding %s failed: %w", req.GetMessageName(), err)
+	}
+
+	a.sendMu.Lock()
+	defer a.sendMu.Unlock()
+	return a.send(entry.handler(req), context)
+}
+
+// send encodes the messages and passes them to the callback. The replies get
+// the given context.
+func (a *VppAdapter) send(msgs []api.Message, context uint32) error {
+	for _, msg := range msgs {
+		a.mu.Lock()
+		msgID := a.getMsgID(msg.GetMessageName() + "_" + msg.GetCrcString())
+		a.mu.Unlock()
+
+		data, err := codec.DefaultCodec.EncodeMsg(msg, msgID)
+		if err != nil {
+			return fmt.Errorf("encoding %s failed: %w", msg.GetMessageName(), err)
+		}
+		if msg.GetMessageType() == api.ReplyMessage && len(data) >= 6 {
+			binary.BigEndian.PutUint32(data[2:6], context)
+		}
+		if a.callback != nil {
+			a.callback(msgID, data)
+		}
+	}
+	return nil
+}
+
+// SetMsgCallback sets a callback function that will be called by the adapter whenever a message comes from VPP.
+func (a *VppAdapter) SetMsgCallback(cb adapter.MsgCallback) {
+	a.callback = cb
+}
+
+// WaitReady returns always nil.
+func (a *VppAdapter) WaitReady() error {
+	return nil
+}
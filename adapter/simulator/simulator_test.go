@@ -0,0 +1,186 @@
+package simulator_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+
+	"go.fd.io/govpp/adapter/simulator"
+	"go.fd.io/govpp/api"
+	"go.fd.io/govpp/binapi/fib_types"
+	interfaces "go.fd.io/govpp/binapi/interface"
+	"go.fd.io/govpp/binapi/interface_types"
+	"go.fd.io/govpp/binapi/ip"
+	"go.fd.io/govpp/binapi/ip_types"
+	"go.fd.io/govpp/binapi/vpe"
+	"go.fd.io/govpp/core"
+)
+
+func connect(t *testing.T, sim *simulator.VppAdapter) (*core.Connection, api.Channel) {
+	RegisterTestingT(t)
+	conn, err := core.Connect(sim)
+	Expect(err).ShouldNot(HaveOccurred())
+	ch, err := conn.NewAPIChannel()
+	Expect(err).ShouldNot(HaveOccurred())
+	t.Cleanup(func() {
+		ch.Close()
+		conn.Disconnect()
+	})
+	return conn, ch
+}
+
+func dumpInterfaces(ch api.Channel) []*interfaces.SwInterfaceDetails {
+	var list []*interfaces.SwInterfaceDetails
+	reqCtx := ch.SendMultiRequest(&interfaces.SwInterfaceDump{SwIfIndex: ^interface_types.InterfaceIndex(0)})
+	for {
+		details := &interfaces.SwInterfaceDetails{}
+		stop, err := reqCtx.ReceiveReply(details)
+		Expect(err).ShouldNot(HaveOccurred())
+		if stop {
+			return list
+		}
+		list = append(list, details)
+	}
+}
+
+func TestInterfaces(t *testing.T) {
+	_, ch := connect(t, simulator.NewVppAdapter())
+
+	version := &vpe.ShowVersionReply{}
+	Expect(ch.SendRequest(&vpe.ShowVersion{}).ReceiveReply(version)).To(Succeed())
+	Expect(version.Version).To(Equal(simulator.Version))
+
+	created := &interfaces.CreateLoopbackReply{}
+	Expect(ch.SendRequest(&interfaces.CreateLoopback{}).ReceiveReply(created)).To(Succeed())
+	Expect(created.SwIfIndex).To(BeEquivalentTo(1))
+
+	err := ch.SendRequest(&interfaces.SwInterfaceSetFlags{
+		SwIfIndex: created.SwIfIndex,
+		Flags:     interface_types.IF_STATUS_API_FLAG_ADMIN_UP,
+	}).ReceiveReply(&interfaces.SwInterfaceSetFlagsReply{})
+	Expect(err).ShouldNot(HaveOccurred())
+
+	prefix, err := ip_types.ParseAddressWithPrefix("10.10.0.1/24")
+	Expect(err).ShouldNot(HaveOccurred())
+	err = ch.SendRequest(&interfaces.SwInterfaceAddDelAddress{
+		SwIfIndex: created.SwIfIndex,
+		IsAdd:     true,
+		Prefix:    prefix,
+	}).ReceiveReply(&interfaces.SwInterfaceAddDelAddressReply{})
+	Expect(err).ShouldNot(HaveOccurred())
+
+	list := dumpInterfaces(ch)
+	Expect(list).To(HaveLen(2))
+	Expect(list[0].InterfaceName).To(Equal("local0"))
+	Expect(list[1].InterfaceName).To(Equal("loop0"))
+	Expect(list[1].Flags).To(Equal(interface_types.IF_STATUS_API_FLAG_ADMIN_UP | interface_types.IF_STATUS_API_FLAG_LINK_UP))
+
+	addrCtx := ch.SendMultiRequest(&ip.IPAddressDump{SwIfIndex: created.SwIfIndex})
+	addr := &ip.IPAddressDetails{}
+	stop, err := addrCtx.ReceiveReply(addr)
+	Expect(err).ShouldNot(HaveOccurred())
+	Expect(stop).To(BeFalse())
+	Expect(addr.Prefix.String()).To(Equal("10.10.0.1/24"))
+	stop, err = addrCtx.ReceiveReply(&ip.IPAddressDetails{})
+	Expect(err).ShouldNot(HaveOccurred())
+	Expect(stop).To(BeTrue())
+
+	err = ch.SendRequest(&interfaces.DeleteLoopback{SwIfIndex: created.SwIfIndex}).ReceiveReply(&interfaces.DeleteLoopbackReply{})
+	Expect(err).ShouldNot(HaveOccurred())
+	err = ch.SendRequest(&interfaces.DeleteLoopback{SwIfIndex: created.SwIfIndex}).ReceiveReply(&interfaces.DeleteLoopbackReply{})
+	Expect(err).To(MatchError(api.INVALID_SW_IF_INDEX))
+	Expect(dumpInterfaces(ch)).To(HaveLen(1))
+}
+
+func TestRoutes(t *testing.T) {
+	_, ch := connect(t, simulator.NewVppAdapter())
+
+	prefix, err := ip_types.ParsePrefix("192.168.0.0/16")
+	Expect(err).ShouldNot(HaveOccurred())
+	route := ip.IPRoute{
+		Prefix: prefix,
+		NPaths: 1,
+		Paths:  []fib_types.FibPath{{SwIfIndex: 0, Proto: fib_types.FIB_API_PATH_NH_PROTO_IP4}},
+	}
+	err = ch.SendRequest(&ip.IPRouteAddDel{IsAdd: true, Route: route}).ReceiveReply(&ip.IPRouteAddDelReply{})
+	Expect(err).ShouldNot(HaveOccurred())
+
+	route.TableID = 10
+	err = ch.SendRequest(&ip.IPRouteAddDel{IsAdd: true, Route: route}).ReceiveReply(&ip.IPRouteAddDelReply{})
+	Expect(err).To(MatchError(api.NO_SUCH_FIB))
+
+	var routes []*ip.IPRouteDetails
+	reqCtx := ch.SendMultiRequest(&ip.IPRouteDump{})
+	for {
+		details := &ip.IPRouteDetails{}
+		stop, err := reqCtx.ReceiveReply(details)
+		Expect(err).ShouldNot(HaveOccurred())
+		if stop {
+			break
+		}
+		routes = append(routes, details)
+	}
+	Expect(routes).To(HaveLen(1))
+	Expect(routes[0].Route.Prefix.String()).To(Equal("192.168.0.0/16"))
+	Expect(routes[0].Route.Paths).To(HaveLen(1))
+
+	route.TableID = 0
+	err = ch.SendRequest(&ip.IPRouteAddDel{Route: route}).ReceiveReply(&ip.IPRouteAddDelReply{})
+	Expect(err).ShouldNot(HaveOccurred())
+	err = ch.SendRequest(&ip.IPRouteAddDel{Route: route}).ReceiveReply(&ip.IPRouteAddDelReply{})
+	Expect(err).To(MatchError(api.NO_SUCH_ENTRY))
+}
+
+func TestInterfaceEvents(t *testing.T) {
+	conn, ch := connect(t, simulator.NewVppAdapter())
+
+	watcher, err := conn.WatchEvent(context.Background(), &interfaces.SwInterfaceEvent{})
+	Expect(err).ShouldNot(HaveOccurred())
+	defer watcher.Close()
+
+	err = ch.SendRequest(&interfaces.WantInterfaceEvents{EnableDisable: 1, PID: 42}).ReceiveReply(&interfaces.WantInterfaceEventsReply{})
+	Expect(err).ShouldNot(HaveOccurred())
+
+	created := &interfaces.CreateLoopbackReply{}
+	Expect(ch.SendRequest(&interfaces.CreateLoopback{}).ReceiveReply(created)).To(Succeed())
+	err = ch.SendRequest(&interfaces.SwInterfaceSetFlags{
+		SwIfIndex: created.SwIfIndex,
+		Flags:     interface_types.IF_STATUS_API_FLAG_ADMIN_UP,
+	}).ReceiveReply(&interfaces.SwInterfaceSetFlagsReply{})
+	Expect(err).ShouldNot(HaveOccurred())
+	err = ch.SendRequest(&interfaces.DeleteLoopback{SwIfIndex: created.SwIfIndex}).ReceiveReply(&interfaces.DeleteLoopbackReply{})
+	Expect(err).ShouldNot(HaveOccurred())
+
+	var events []*interfaces.SwInterfaceEvent
+	for len(events) < 2 {
+		select {
+		case msg := <-watcher.Events():
+			events = append(events, msg.(*interfaces.SwInterfaceEvent))
+		case <-time.After(time.Second):
+			t.Fatalf("expected 2 events, got %d", len(events))
+		}
+	}
+	Expect(events[0]).To(Equal(&interfaces.SwInterfaceEvent{
+		PID:       42,
+		SwIfIndex: created.SwIfIndex,
+		Flags:     interface_types.IF_STATUS_API_FLAG_ADMIN_UP | interface_types.IF_STATUS_API_FLAG_LINK_UP,
+	}))
+	Expect(events[1].Deleted).To(BeTrue())
+}
+
+func TestRegisterHandler(t *testing.T) {
+	sim := simulator.NewVppAdapter()
+	sim.RegisterHandler(&vpe.ShowVersion{}, func(api.Message) []api.Message {
+		return []api.Message{&vpe.ShowVersionReply{Version: "23.10"}}
+	})
+	_, ch := connect(t, sim)
+
+	version := &vpe.ShowVersionReply{}
+	Expect(ch.SendRequest(&vpe.ShowVersion{}).ReceiveReply(version)).To(Succeed())
+	Expect(version.Version).To(Equal("23.10"))
+
+	err := ch.SendRequest(&interfaces.SwInterfaceSetMtu{}).ReceiveReply(&interfaces.SwInterfaceSetMtuReply{})
+	Expect(err).To(HaveOccurred())
+}
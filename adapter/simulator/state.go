@@ -0,0 +1,394 @@
+//  Copyright (c) 2023 Cisco and/or its affiliates.
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at:
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package simulator
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"go.fd.io/govpp/api"
+	"go.fd.io/govpp/binapi/ethernet_types"
+	"go.fd.io/govpp/binapi/fib_types"
+	interfaces "go.fd.io/govpp/binapi/interface"
+	"go.fd.io/govpp/binapi/interface_types"
+	"go.fd.io/govpp/binapi/ip"
+	"go.fd.io/govpp/binapi/ip_types"
+	"go.fd.io/govpp/binapi/memclnt"
+	"go.fd.io/govpp/binapi/vpe"
+)
+
+const (
+	// Version is the version reported by the simulator in show_version reply.
+	Version = "simulator"
+
+	defaultMtu = 9000
+)
+
+// simInterface is an interface of the simulated VPP.
+type simInterface struct {
+	index     interface_types.InterfaceIndex
+	name      string
+	devType   string
+	instance  uint32
+	mac       ethernet_types.MacAddress
+	flags     interface_types.IfStatusFlags
+	addresses []ip_types.AddressWithPrefix
+}
+
+// tableKey identifies the IP table.
+type tableKey struct {
+	id    uint32
+	isIP6 bool
+}
+
+// vppState is the state of the simulated VPP modified by the built-in handlers.
+type vppState struct {
+	interfaces   map[interface_types.InterfaceIndex]*simInterface
+	nextIfIndex  interface_types.InterfaceIndex
+	loopbacks    map[uint32]struct{} // used loopback instances
+	tables       map[tableKey]string // table names
+	routes       map[tableKey]map[string]*ip.IPRoute
+	nextStatsIdx uint32
+
+	interfaceEvents bool
+	interfaceEvPID  uint32
+}
+
+func newVppState() *vppState {
+	s := &vppState{
+		interfaces: make(map[interface_types.InterfaceIndex]*simInterface),
+		loopbacks:  make(map[uint32]struct{}),
+		tables: map[tableKey]string{
+			{id: 0, isIP6: false}: "ipv4-VRF:0",
+			{id: 0, isIP6: true}:  "ipv6-VRF:0",
+		},
+		routes: make(map[tableKey]map[string]*ip.IPRoute),
+	}
+	s.interfaces[0] = &simInterface{index: 0, name: "local0", devType: "local"}
+	s.nextIfIndex = 1
+	return s
+}
+
+func (s *vppState) registerHandlers(a *VppAdapter) {
+	a.RegisterHandler(&memclnt.ControlPing{}, s.controlPing)
+	a.RegisterHandler(&vpe.ShowVersion{}, s.showVersion)
+	a.RegisterHandler(&interfaces.CreateLoopback{}, s.createLoopback)
+	a.RegisterHandler(&interfaces.CreateLoopbackInstance{}, s.createLoopbackInstance)
+	a.RegisterHandler(&interfaces.DeleteLoopback{}, s.deleteLoopback)
+	a.RegisterHandler(&interfaces.SwInterfaceSetFlags{}, s.swInterfaceSetFlags)
+	a.RegisterHandler(&interfaces.SwInterfaceDump{}, s.swInterfaceDump)
+	a.RegisterHandler(&interfaces.SwInterfaceAddDelAddress{}, s.swInterfaceAddDelAddress)
+	a.RegisterHandler(&interfaces.WantInterfaceEvents{}, s.wantInterfaceEvents)
+	a.RegisterHandler(&ip.IPAddressDump{}, s.ipAddressDump)
+	a.RegisterHandler(&ip.IPTableAddDel{}, s.ipTableAddDel)
+	a.RegisterHandler(&ip.IPRouteAddDel{}, s.ipRouteAddDel)
+	a.RegisterHandler(&ip.IPRouteDump{}, s.ipRouteDump)
+}
+
+func (s *vppState) controlPing(api.Message) []api.Message {
+	return []api.Message{&memclnt.ControlPingReply{}}
+}
+
+func (s *vppState) showVersion(api.Message) []api.Message {
+	return []api.Message{&vpe.ShowVersionReply{
+		Program: "vpe",
+		Version: Version,
+	}}
+}
+
+func (s *vppState) createLoopback(msg api.Message) []api.Message {
+	req := msg.(*interfaces.CreateLoopback)
+	ifIdx := s.addLoopback(s.freeLoopbackInstance(), req.MacAddress)
+	return []api.Message{&interfaces.CreateLoopbackReply{SwIfIndex: ifIdx}}
+}
+
+func (s *vppState) createLoopbackInstance(msg api.Message) []api.Message {
+	req := msg.(*interfaces.CreateLoopbackInstance)
+	instance := s.freeLoopbackInstance()
+	if req.IsSpecified {
+		if _, used := s.loopbacks[req.UserInstance]; used {
+			return []api.Message{&interfaces.CreateLoopbackInstanceReply{
+				Retval:    int32(api.INSTANCE_IN_USE),
+				SwIfIndex: ^interface_types.InterfaceIndex(0),
+			}}
+		}
+		instance = req.UserInstance
+	}
+	ifIdx := s.addLoopback(instance, req.MacAddress)
+	return []api.Message{&interfaces.CreateLoopbackInstanceReply{SwIfIndex: ifIdx}}
+}
+
+func (s *vppState) freeLoopbackInstance() uint32 {
+	var instance uint32
+	for {
+		if _, used := s.loopbacks[instance]; !used {
+			return instance
+		}
+		instance++
+	}
+}
+
+func (s *vppState) addLoopback(instance uint32, mac ethernet_types.MacAddress) interface_types.InterfaceIndex {
+	if mac == (ethernet_types.MacAddress{}) {
+		// VPP generates the address from the instance
+		mac = ethernet_types.MacAddress{0xde, 0xad, 0, 0, byte(instance >> 8), byte(instance)}
+	}
+	iface := &simInterface{
+		index:    s.nextIfIndex,
+		name:     fmt.Sprintf("loop%d", instance),
+		devType:  "Loopback",
+		instance: instance,
+		mac:      mac,
+	}
+	s.nextIfIndex++
+	s.interfaces[iface.index] = iface
+	s.loopbacks[instance] = struct{}{}
+	return iface.index
+}
+
+func (s *vppState) deleteLoopback(msg api.Message) []api.Message {
+	req := msg.(*interfaces.DeleteLoopback)
+	iface, ok := s.interfaces[req.SwIfIndex]
+	if !ok || iface.devType != "Loopback" {
+		return []api.Message{&interfaces.DeleteLoopbackReply{Retval: int32(api.INVALID_SW_IF_INDEX)}}
+	}
+	delete(s.interfaces, iface.index)
+	delete(s.loopbacks, iface.instance)
+	replies := []api.Message{&interfaces.DeleteLoopbackReply{}}
+	if s.interfaceEvents {
+		replies = append(replies, &interfaces.SwInterfaceEvent{
+			PID:       s.interfaceEvPID,
+			SwIfIndex: iface.index,
+			Deleted:   true,
+		})
+	}
+	return replies
+}
+
+func (s *vppState) swInterfaceSetFlags(msg api.Message) []api.Message {
+	req := msg.(*interfaces.SwInterfaceSetFlags)
+	iface, ok := s.interfaces[req.SwIfIndex]
+	if !ok {
+		return []api.Message{&interfaces.SwInterfaceSetFlagsReply{Retval: int32(api.INVALID_SW_IF_INDEX)}}
+	}
+	flags := req.Flags & interface_types.IF_STATUS_API_FLAG_ADMIN_UP
+	if flags != 0 && iface.devType == "Loopback" {
+		// the link of the loopback follows its admin state
+		flags |= interface_types.IF_STATUS_API_FLAG_LINK_UP
+	}
+	replies := []api.Message{&interfaces.SwInterfaceSetFlagsReply{}}
+	if flags != iface.flags {
+		iface.flags = flags
+		if s.interfaceEvents {
+			replies = append(replies, &interfaces.SwInterfaceEvent{
+				PID:       s.interfaceEvPID,
+				SwIfIndex: iface.index,
+				Flags:     flags,
+			})
+		}
+	}
+	return replies
+}
+
+func (s *vppState) swInterfaceDump(msg api.Message) []api.Message {
+	req := msg.(*interfaces.SwInterfaceDump)
+	var details []api.Message
+	for _, iface := range s.sortedInterfaces() {
+		if req.SwIfIndex != ^interface_types.InterfaceIndex(0) && req.SwIfIndex != iface.index {
+			continue
+		}
+		if req.NameFilterValid && !strings.Contains(iface.name, req.NameFilter) {
+			continue
+		}
+		details = append(details, &interfaces.SwInterfaceDetails{
+			SwIfIndex:        iface.index,
+			SupSwIfIndex:     uint32(iface.index),
+			L2Address:        iface.mac,
+			Flags:            iface.flags,
+			Type:             interface_types.IF_API_TYPE_HARDWARE,
+			LinkMtu:          defaultMtu,
+			Mtu:              []uint32{defaultMtu, 0, 0, 0},
+			InterfaceName:    iface.name,
+			InterfaceDevType: iface.devType,
+		})
+	}
+	return details
+}
+
+func (s *vppState) sortedInterfaces() []*simInterface {
+	list := make([]*simInterface, 0, len(s.interfaces))
+	for _, iface := range s.interfaces {
+		list = append(list, iface)
+	}
+	sort.Slice(list, func(i, j int) bool {
+		return list[i].index < list[j].index
+	})
+	return list
+}
+
+func (s *vppState) swInterfaceAddDelAddress(msg api.Message) []api.Message {
+	req := msg.(*interfaces.SwInterfaceAddDelAddress)
+	iface, ok := s.interfaces[req.SwIfIndex]
+	if !ok {
+		return []api.Message{&interfaces.SwInterfaceAddDelAddressReply{Retval: int32(api.INVALID_SW_IF_INDEX)}}
+	}
+	if req.DelAll {
+		iface.addresses = nil
+		return []api.Message{&interfaces.SwInterfaceAddDelAddressReply{}}
+	}
+	for i, addr := range iface.addresses {
+		if addr != req.Prefix {
+			continue
+		}
+		if !req.IsAdd {
+			iface.addresses = append(iface.addresses[:i], iface.addresses[i+1:]...)
+		}
+		return []api.Message{&interfaces.SwInterfaceAddDelAddressReply{}}
+	}
+	if !req.IsAdd {
+		return []api.Message{&interfaces.SwInterfaceAddDelAddressReply{Retval: int32(api.ADDRESS_NOT_FOUND_FOR_INTERFACE)}}
+	}
+	for _, other := range s.interfaces {
+		for _, addr := range other.addresses {
+			if addr.Address == req.Prefix.Address {
+				return []api.Message{&interfaces.SwInterfaceAddDelAddressReply{Retval: int32(api.ADDRESS_IN_USE)}}
+			}
+		}
+	}
+	iface.addresses = append(iface.addresses, req.Prefix)
+	return []api.Message{&interfaces.SwInterfaceAddDelAddressReply{}}
+}
+
+func (s *vppState) wantInterfaceEvents(msg api.Message) []api.Message {
+	req := msg.(*interfaces.WantInterfaceEvents)
+	s.interfaceEvents = req.EnableDisable != 0
+	s.interfaceEvPID = req.PID
+	return []api.Message{&interfaces.WantInterfaceEventsReply{}}
+}
+
+func (s *vppState) ipAddressDump(msg api.Message) []api.Message {
+	req := msg.(*ip.IPAddressDump)
+	iface, ok := s.interfaces[req.SwIfIndex]
+	if !ok {
+		return nil
+	}
+	var details []api.Message
+	for _, addr := range iface.addresses {
+		if (addr.Address.Af == ip_types.ADDRESS_IP6) != req.IsIPv6 {
+			continue
+		}
+		details = append(details, &ip.IPAddressDetails{
+			SwIfIndex: iface.index,
+			Prefix:    addr,
+		})
+	}
+	return details
+}
+
+func (s *vppState) ipTableAddDel(msg api.Message) []api.Message {
+	req := msg.(*ip.IPTableAddDel)
+	key := tableKey{id: req.Table.TableID, isIP6: req.Table.IsIP6}
+	if key.id == 0 {
+		// the default tables always exist
+		return []api.Message{&ip.IPTableAddDelReply{}}
+	}
+	if req.IsAdd {
+		s.tables[key] = req.Table.Name
+	} else {
+		delete(s.tables, key)
+		delete(s.routes, key)
+	}
+	return []api.Message{&ip.IPTableAddDelReply{}}
+}
+
+func (s *vppState) ipRouteAddDel(msg api.Message) []api.Message {
+	req := msg.(*ip.IPRouteAddDel)
+	key := tableKey{id: req.Route.TableID, isIP6: req.Route.Prefix.Address.Af == ip_types.ADDRESS_IP6}
+	if _, ok := s.tables[key]; !ok {
+		return []api.Message{&ip.IPRouteAddDelReply{Retval: int32(api.NO_SUCH_FIB)}}
+	}
+	prefix := req.Route.Prefix.String()
+	route, exists := s.routes[key][prefix]
+
+	if !req.IsAdd {
+		if !exists {
+			return []api.Message{&ip.IPRouteAddDelReply{Retval: int32(api.NO_SUCH_ENTRY)}}
+		}
+		if req.IsMultipath {
+			route.Paths = removePaths(route.Paths, req.Route.Paths)
+			route.NPaths = uint8(len(route.Paths))
+		}
+		if !req.IsMultipath || len(route.Paths) == 0 {
+			delete(s.routes[key], prefix)
+		}
+		return []api.Message{&ip.IPRouteAddDelReply{StatsIndex: route.StatsIndex}}
+	}
+
+	if !exists {
+		route = &ip.IPRoute{
+			TableID:    req.Route.TableID,
+			StatsIndex: s.nextStatsIdx,
+			Prefix:     req.Route.Prefix,
+		}
+		s.nextStatsIdx++
+		if s.routes[key] == nil {
+			s.routes[key] = make(map[string]*ip.IPRoute)
+		}
+		s.routes[key][prefix] = route
+	}
+	if req.IsMultipath {
+		route.Paths = append(removePaths(route.Paths, req.Route.Paths), req.Route.Paths...)
+	} else {
+		route.Paths = append([]fib_types.FibPath(nil), req.Route.Paths...)
+	}
+	route.NPaths = uint8(len(route.Paths))
+	return []api.Message{&ip.IPRouteAddDelReply{StatsIndex: route.StatsIndex}}
+}
+
+func (s *vppState) ipRouteDump(msg api.Message) []api.Message {
+	req := msg.(*ip.IPRouteDump)
+	routes := s.routes[tableKey{id: req.Table.TableID, isIP6: req.Table.IsIP6}]
+	prefixes := make([]string, 0, len(routes))
+	for prefix := range routes {
+		prefixes = append(prefixes, prefix)
+	}
+	sort.Strings(prefixes)
+	details := make([]api.Message, 0, len(prefixes))
+	for _, prefix := range prefixes {
+		route := *routes[prefix]
+		route.Paths = append([]fib_types.FibPath(nil), route.Paths...)
+		details = append(details, &ip.IPRouteDetails{Route: route})
+	}
+	return details
+}
+
+// removePaths returns the paths without the removed ones.
+func removePaths(paths, removed []fib_types.FibPath) []fib_types.FibPath {
+	var kept []fib_types.FibPath
+	for _, path := range paths {
+		found := false
+		for _, r := range removed {
+			if path == r {
+				found = true
+				break
+			}
+		}
+		if !found {
+			kept = append(kept, path)
+		}
+	}
+	return kept
+}
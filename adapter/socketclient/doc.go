@@ -33,4 +33,18 @@
 //	socksvr {
 //		socket-name /run/vpp/api.sock
 //	}
+//
+// # Remote connection
+//
+// VPP in another container or host can be reached by exposing its API socket
+// with the vpp-forwarder command and using tcp:// or tls:// target, which speaks
+// the same framing as the unix domain socket:
+//
+//	client := socketclient.NewVppClient("tls://vpp-host:7879")
+//	tlsConfig, err := socketclient.NewTLSConfig("ca.crt", "client.crt", "client.key")
+//	if err != nil {
+//		// handle error!
+//	}
+//	client.SetTLSConfig(tlsConfig)
+//	conn, err := core.Connect(client)
 package socketclient
@@ -0,0 +1,109 @@
+//  Copyright (c) 2023 Cisco and/or its affiliates.
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at:
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package socketclient
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"net"
+	"os"
+	"strings"
+)
+
+const (
+	networkUnix = "unix"
+	networkTCP  = "tcp"
+	networkTLS  = "tls"
+)
+
+// parseTarget splits the target into the network and address. The target
+// without scheme is a path of the unix socket.
+func parseTarget(target string) (network, address string) {
+	for _, network := range []string{networkUnix, networkTCP, networkTLS} {
+		if strings.HasPrefix(target, network+"://") {
+			return network, strings.TrimPrefix(target, network+"://")
+		}
+	}
+	return networkUnix, target
+}
+
+// SetTLSConfig sets the TLS configuration used for tls:// targets, e.g.
+// with the client certificate. If not set, the default configuration
+// verifying the server with the system root CAs is used.
+func (c *Client) SetTLSConfig(config *tls.Config) {
+	c.tlsConfig = config
+}
+
+// NewTLSConfig returns the TLS configuration for connecting to the remote
+// target. The server certificate is verified with the CA certificate from caFile
+// if set, otherwise with the system root CAs. The client certificate is loaded
+// from certFile and keyFile if set.
+func NewTLSConfig(caFile, certFile, keyFile string) (*tls.Config, error) {
+	config := &tls.Config{
+		MinVersion: tls.VersionTLS12,
+	}
+	if caFile != "" {
+		caCert, err := os.ReadFile(caFile)
+		if err != nil {
+			return nil, fmt.Errorf("reading CA certificate failed: %w", err)
+		}
+		config.RootCAs = x509.NewCertPool()
+		if !config.RootCAs.AppendCertsFromPEM(caCert) {
+			return nil, fmt.Errorf("no CA certificate found in %s", caFile)
+		}
+	}
+	if certFile != "" || keyFile != "" {
+		cert, err := tls.LoadX509KeyPair(certFile, keyFile)
+		if err != nil {
+			return nil, fmt.Errorf("loading client certificate failed: %w", err)
+		}
+		config.Certificates = []tls.Certificate{cert}
+	}
+	return config, nil
+}
+
+func (c *Client) isRemote() bool {
+	return c.network != networkUnix
+}
+
+// connectRemote connects to the remote target speaking the same framing as the VPP API socket.
+func (c *Client) connectRemote() error {
+	log.Debugf("Connecting to: %s://%s", c.network, c.address)
+
+	dialer := &net.Dialer{Timeout: c.connectTimeout}
+	var (
+		conn net.Conn
+		err  error
+	)
+	if c.network == networkTLS {
+		config := c.tlsConfig
+		if config == nil {
+			config = &tls.Config{MinVersion: tls.VersionTLS12}
+		}
+		conn, err = tls.DialWithDialer(dialer, "tcp", c.address, config)
+	} else {
+		conn, err = dialer.Dial("tcp", c.address)
+	}
+	if err != nil {
+		log.Debugf("Connecting to %s://%s failed: %s", c.network, c.address, err)
+		return err
+	}
+
+	c.setConn(conn)
+	log.Debugf("Connected to %s://%s (local addr: %v)", c.network, c.address, conn.LocalAddr())
+
+	return nil
+}
@@ -0,0 +1,174 @@
+package socketclient
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/binary"
+	"io"
+	"math/big"
+	"net"
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+
+	"go.fd.io/govpp/api"
+	"go.fd.io/govpp/binapi/memclnt"
+	"go.fd.io/govpp/codec"
+)
+
+func TestParseTarget(t *testing.T) {
+	RegisterTestingT(t)
+
+	for target, expected := range map[string][2]string{
+		"/run/vpp/api.sock":        {networkUnix, "/run/vpp/api.sock"},
+		"unix:///run/vpp/api.sock": {networkUnix, "/run/vpp/api.sock"},
+		"tcp://10.0.0.1:7879":      {networkTCP, "10.0.0.1:7879"},
+		"tls://vpp:7879":           {networkTLS, "vpp:7879"},
+	} {
+		network, address := parseTarget(target)
+		Expect([2]string{network, address}).To(Equal(expected), target)
+	}
+}
+
+// serveFakeVPP answers the socket client registration and control pings
+// on the accepted connections.
+func serveFakeVPP(listener net.Listener) {
+	for {
+		conn, err := listener.Accept()
+		if err != nil {
+			return
+		}
+		go func() {
+			defer conn.Close()
+			header := make([]byte, 16)
+			for {
+				if _, err := io.ReadFull(conn, header); err != nil {
+					return
+				}
+				data := make([]byte, binary.BigEndian.Uint32(header[8:12]))
+				if _, err := io.ReadFull(conn, data); err != nil {
+					return
+				}
+				var reply api.Message
+				var replyID uint16
+				switch binary.BigEndian.Uint16(data[0:2]) {
+				case sockCreateMsgId:
+					reply, replyID = &memclnt.SockclntCreateReply{
+						Index: 1,
+						Count: 2,
+						MessageTable: []memclnt.MessageTableEntry{
+							{Index: 100, Name: "control_ping_51077d14"},
+							{Index: 101, Name: "control_ping_reply_f6b0b8ca"},
+						},
+					}, 16
+				case 100:
+					reply, replyID = &memclnt.ControlPingReply{VpePID: 42}, 101
+				default:
+					continue
+				}
+				msg, _ := codec.DefaultCodec.EncodeMsg(reply, replyID)
+				copy(msg[2:6], data[6:10]) // context
+				binary.BigEndian.PutUint32(header[8:12], uint32(len(msg)))
+				if _, err := conn.Write(append(header, msg...)); err != nil {
+					return
+				}
+			}
+		}()
+	}
+}
+
+func pingRemote(client *Client) {
+	received := make(chan []byte, 1)
+	client.SetMsgCallback(func(msgID uint16, data []byte) {
+		received <- append([]byte(nil), data...)
+	})
+	Expect(client.Connect()).To(Succeed())
+	defer func() { Expect(client.Disconnect()).To(Succeed()) }()
+
+	msgID, err := client.GetMsgID("control_ping", "51077d14")
+	Expect(err).ShouldNot(HaveOccurred())
+	msg, err := codec.DefaultCodec.EncodeMsg(&memclnt.ControlPing{}, msgID)
+	Expect(err).ShouldNot(HaveOccurred())
+	Expect(client.SendMsg(7, msg)).To(Succeed())
+
+	var data []byte
+	Eventually(received, time.Second).Should(Receive(&data))
+	reply := &memclnt.ControlPingReply{}
+	Expect(codec.DefaultCodec.DecodeMsg(data, reply)).To(Succeed())
+	Expect(reply.VpePID).To(BeEquivalentTo(42))
+	Expect(binary.BigEndian.Uint32(data[2:6])).To(BeEquivalentTo(7))
+}
+
+func TestConnectTCP(t *testing.T) {
+	RegisterTestingT(t)
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	Expect(err).ShouldNot(HaveOccurred())
+	defer listener.Close()
+	go serveFakeVPP(listener)
+
+	client := NewVppClient("tcp://" + listener.Addr().String())
+	Expect(client.WaitReady()).To(Succeed())
+	pingRemote(client)
+}
+
+func TestConnectTLS(t *testing.T) {
+	RegisterTestingT(t)
+
+	ca, caKey := newCertificate(nil, nil, true)
+	serverCert, serverKey := newCertificate(ca, caKey, false)
+	clientCert, clientKey := newCertificate(ca, caKey, false)
+	roots := x509.NewCertPool()
+	roots.AddCert(ca)
+
+	listener, err := tls.Listen("tcp", "127.0.0.1:0", &tls.Config{
+		Certificates: []tls.Certificate{{Certificate: [][]byte{serverCert.Raw}, PrivateKey: serverKey}},
+		ClientCAs:    roots,
+		ClientAuth:   tls.RequireAndVerifyClientCert,
+	})
+	Expect(err).ShouldNot(HaveOccurred())
+	defer listener.Close()
+	go serveFakeVPP(listener)
+
+	client := NewVppClient("tls://" + listener.Addr().String())
+	client.SetTLSConfig(&tls.Config{
+		RootCAs:      roots,
+		ServerName:   "localhost",
+		Certificates: []tls.Certificate{{Certificate: [][]byte{clientCert.Raw}, PrivateKey: clientKey}},
+	})
+	pingRemote(client)
+
+	// the server requires the client certificate
+	client = NewVppClient("tls://" + listener.Addr().String())
+	client.SetTLSConfig(&tls.Config{RootCAs: roots, ServerName: "localhost"})
+	Expect(client.Connect()).ToNot(Succeed())
+}
+
+func newCertificate(parent *x509.Certificate, parentKey *ecdsa.PrivateKey, isCA bool) (*x509.Certificate, *ecdsa.PrivateKey) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	Expect(err).ShouldNot(HaveOccurred())
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(time.Now().UnixNano()),
+		Subject:               pkix.Name{CommonName: "localhost"},
+		DNSNames:              []string{"localhost"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		KeyUsage:              x509.KeyUsageDigitalSignature | x509.KeyUsageCertSign,
+		ExtKeyUsage:           []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth, x509.ExtKeyUsageClientAuth},
+		BasicConstraintsValid: true,
+		IsCA:                  isCA,
+	}
+	if parent == nil {
+		parent, parentKey = template, key
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, parent, &key.PublicKey, parentKey)
+	Expect(err).ShouldNot(HaveOccurred())
+	cert, err := x509.ParseCertificate(der)
+	Expect(err).ShouldNot(HaveOccurred())
+	return cert, key
+}
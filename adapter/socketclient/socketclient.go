@@ -16,6 +16,7 @@ package socketclient
 
 import (
 	"bufio"
+	"crypto/tls"
 	"encoding/binary"
 	"errors"
 	"fmt"
@@ -75,12 +76,14 @@ func init() {
 }
 
 type Client struct {
-	socketPath string
 	clientName string
 
-	conn   *net.UnixConn
+	network   string // unix, tcp or tls
+	address   string // socket path or remote host:port
+	tlsConfig *tls.Config
+
+	conn   net.Conn
 	reader *bufio.Reader
-	writer *bufio.Writer
 
 	connectTimeout    time.Duration
 	disconnectTimeout time.Duration
@@ -89,7 +92,11 @@ type Client struct {
 	clientIndex  uint32
 	msgTable     map[string]uint16
 	sockDelMsgId uint16
-	writeMu      sync.Mutex
+
+	writeMu     sync.Mutex
+	writeHeader [16]byte
+	writeVec    [2][]byte   // header and message written by a single write
+	writeBufs   net.Buffers // consumed by the write
 
 	headerPool *sync.Pool
 
@@ -99,12 +106,17 @@ type Client struct {
 
 // NewVppClient returns a new Client using socket.
 // If socket is empty string DefaultSocketName is used.
+//
+// The socket can also be a remote target in form tcp://host:port or
+// tls://host:port, e.g. VPP API socket exposed by the vpp-forwarder command.
 func NewVppClient(socket string) *Client {
 	if socket == "" {
 		socket = DefaultSocketName
 	}
+	network, address := parseTarget(socket)
 	return &Client{
-		socketPath:        socket,
+		network:           network,
+		address:           address,
 		clientName:        DefaultClientName,
 		connectTimeout:    DefaultConnectTimeout,
 		disconnectTimeout: DefaultDisconnectTimeout,
@@ -140,9 +152,12 @@ func (c *Client) SetMsgCallback(cb adapter.MsgCallback) {
 }
 
 // WaitReady checks if the socket file exists and if it does not exist waits for
-// it for the duration defined by MaxWaitReady.
+// it for the duration defined by MaxWaitReady. For remote targets it returns nil.
 func (c *Client) WaitReady() error {
-	socketDir, _ := filepath.Split(c.socketPath)
+	if c.isRemote() {
+		return nil
+	}
+	socketDir, _ := filepath.Split(c.address)
 	dirChain := strings.Split(filepath.ToSlash(filepath.Clean(socketDir)), "/")
 
 	dir := "/"
@@ -155,14 +170,14 @@ func (c *Client) WaitReady() error {
 	}
 
 	// check if socket already exists
-	if _, err := os.Stat(c.socketPath); err == nil {
+	if _, err := os.Stat(c.address); err == nil {
 		return nil // socket exists, we are ready
 	} else if !errors.Is(err, fs.ErrNotExist) {
 		log.Debugf("error is: %+v", err)
 		return err // some other error occurred
 	}
 
-	log.Debugf("waiting for file: %v", c.socketPath)
+	log.Debugf("waiting for file: %v", c.address)
 
 	// socket does not exist, watch for it
 	watcher, err := fsnotify.NewWatcher()
@@ -176,7 +191,7 @@ func (c *Client) WaitReady() error {
 	}()
 
 	// start directory watcher
-	d := filepath.Dir(c.socketPath)
+	d := filepath.Dir(c.address)
 	if err := watcher.Add(d); err != nil {
 		log.Debugf("watcher add(%v) error: %v", d, err)
 		return err
@@ -187,7 +202,7 @@ func (c *Client) WaitReady() error {
 		select {
 		case <-timeout.C:
 			log.Debugf("watcher timeout after: %v", MaxWaitReady)
-			return fmt.Errorf("timeout waiting (%s) for socket file: %s", MaxWaitReady, c.socketPath)
+			return fmt.Errorf("timeout waiting (%s) for socket file: %s", MaxWaitReady, c.address)
 
 		case e := <-watcher.Errors:
 			log.Debugf("watcher error: %+v", e)
@@ -195,7 +210,7 @@ func (c *Client) WaitReady() error {
 
 		case ev := <-watcher.Events:
 			log.Debugf("watcher event: %+v", ev)
-			if ev.Name == c.socketPath && (ev.Op&fsnotify.Create) == fsnotify.Create {
+			if ev.Name == c.address && (ev.Op&fsnotify.Create) == fsnotify.Create {
 				// socket created, we are ready
 				return nil
 			}
@@ -254,15 +269,21 @@ func waitForDir(dir string) error {
 }
 
 func (c *Client) Connect() error {
-	// check if socket exists
-	if _, err := os.Stat(c.socketPath); os.IsNotExist(err) {
-		return fmt.Errorf("VPP API socket file %s does not exist", c.socketPath)
-	} else if err != nil {
-		return fmt.Errorf("VPP API socket error: %v", err)
-	}
+	if c.isRemote() {
+		if err := c.connectRemote(); err != nil {
+			return err
+		}
+	} else {
+		// check if socket exists
+		if _, err := os.Stat(c.address); os.IsNotExist(err) {
+			return fmt.Errorf("VPP API socket file %s does not exist", c.address)
+		} else if err != nil {
+			return fmt.Errorf("VPP API socket error: %v", err)
+		}
 
-	if err := c.connect(c.socketPath); err != nil {
-		return err
+		if err := c.connect(c.address); err != nil {
+			return err
+		}
 	}
 
 	if err := c.open(); err != nil {
@@ -285,8 +306,15 @@ func (c *Client) Disconnect() error {
 
 	close(c.quit)
 
-	if err := c.conn.CloseRead(); err != nil {
-		log.Debugf("closing readMsg failed: %v", err)
+	// TLS connection cannot be closed only for reading
+	if conn, ok := c.conn.(interface{ CloseRead() error }); ok {
+		if err := conn.CloseRead(); err != nil {
+			log.Debugf("closing readMsg failed: %v", err)
+		}
+	} else {
+		err := c.disconnect()
+		c.wg.Wait()
+		return err
 	}
 
 	// wait for readerLoop to return
@@ -306,7 +334,7 @@ const defaultBufferSize = 4096
 func (c *Client) connect(sockAddr string) error {
 	addr := &net.UnixAddr{Name: sockAddr, Net: "unix"}
 
-	log.Debugf("Connecting to: %v", c.socketPath)
+	log.Debugf("Connecting to: %v", c.address)
 
 	conn, err := net.DialUnix("unix", nil, addr)
 	if err != nil {
@@ -322,15 +350,17 @@ func (c *Client) connect(sockAddr string) error {
 		}
 	}
 
-	c.conn = conn
+	c.setConn(conn)
 	log.Debugf("Connected to socket (local addr: %v)", c.conn.LocalAddr().(*net.UnixAddr))
 
-	c.reader = bufio.NewReaderSize(c.conn, defaultBufferSize)
-	c.writer = bufio.NewWriterSize(c.conn, defaultBufferSize)
-
 	return nil
 }
 
+func (c *Client) setConn(conn net.Conn) {
+	c.conn = conn
+	c.reader = bufio.NewReaderSize(c.conn, defaultBufferSize)
+}
+
 func (c *Client) disconnect() error {
 	log.Debugf("Closing socket")
 	if err := c.conn.Close(); err != nil {
@@ -427,6 +457,12 @@ func (c *Client) SendMsg(context uint32, data []byte) error {
 	return nil
 }
 
+// SendMsgBorrowed sends the message like SendMsg, the data is written
+// to the connection before it returns and it is not retained.
+func (c *Client) SendMsgBorrowed(context uint32, data []byte) error {
+	return c.SendMsg(context, data)
+}
+
 // setMsgRequestHeader sets client index and context in the message request header
 //
 // Message request has following structure:
@@ -447,60 +483,24 @@ func (c *Client) writeMsg(msg []byte) error {
 	c.writeMu.Lock()
 	defer c.writeMu.Unlock()
 
-	header, ok := c.headerPool.Get().(*[]byte)
-	if !ok {
-		return fmt.Errorf("failed to get header from pool")
-	}
-	err := writeMsgHeader(c.writer, *header, len(msg))
-	if err != nil {
-		return err
-	}
-	c.headerPool.Put(header)
-
-	if err := writeMsgData(c.writer, msg, c.writer.Size()); err != nil {
-		return err
-	}
-
-	if err := c.writer.Flush(); err != nil {
-		return err
+	header := c.writeHeader[:]
+	binary.BigEndian.PutUint32(header[8:12], uint32(len(msg)))
+	if debug {
+		log.Debugf(" - header (%d): % 0X", len(header), header)
 	}
 
-	log.Debugf(" -- writeMsg done")
-
-	return nil
-}
-
-func writeMsgHeader(w io.Writer, header []byte, dataLen int) error {
-	binary.BigEndian.PutUint32(header[8:12], uint32(dataLen))
-
-	n, err := w.Write(header)
+	// the message is written directly from the buffer without copying
+	// (using vectored write if supported by the connection)
+	c.writeVec = [2][]byte{header, msg}
+	c.writeBufs = c.writeVec[:]
+	_, err := c.writeBufs.WriteTo(c.conn)
+	c.writeVec[1] = nil // the message must not be retained
 	if err != nil {
 		return err
 	}
-	if debug {
-		log.Debugf(" - header sent (%d/%d): % 0X", n, len(header), header)
-	}
 
-	return nil
-}
+	log.Debugf(" -- writeMsg done")
 
-func writeMsgData(w io.Writer, msg []byte, writerSize int) error {
-	for i := 0; i <= len(msg)/writerSize; i++ {
-		x := i*writerSize + writerSize
-		if x > len(msg) {
-			x = len(msg)
-		}
-		if debug {
-			log.Debugf(" - x=%v i=%v len=%v mod=%v", x, i, len(msg), len(msg)/writerSize)
-		}
-		n, err := w.Write(msg[i*writerSize : x])
-		if err != nil {
-			return err
-		}
-		if debug {
-			log.Debugf(" - data sent x=%d (%d/%d): % 0X", x, n, len(msg), msg)
-		}
-	}
 	return nil
 }
 
@@ -651,7 +651,7 @@ func readMsgData(r io.Reader, buf []byte, dataLen int) ([]byte, error) {
 }
 
 func isClosedError(err error) bool {
-	if errors.Is(err, io.EOF) {
+	if errors.Is(err, io.EOF) || errors.Is(err, net.ErrClosed) {
 		return true
 	}
 	return strings.HasSuffix(err.Error(), "use of closed network connection")
@@ -84,6 +84,12 @@ type StatEntry struct {
 	Type    StatType
 	Data    Stat
 	Symlink bool
+
+	// SymlinkTarget and SymlinkItem are set only for the symlinks. They are
+	// the index of the stat entry the symlink points to and the index of the
+	// item in the vectors of that entry (e.g. the interface index).
+	SymlinkTarget uint32
+	SymlinkItem   uint32
 }
 
 // Counter represents simple counter with single value, which is usually packet count.
@@ -93,6 +93,11 @@ type statSegment interface {
 	// UpdateEntryData accepts pointer to a directory segment with data, and stat
 	// segment to update
 	UpdateEntryData(segment dirSegment, s *adapter.Stat) error
+
+	// GetSymlinkIndexes accepts pointer to a directory segment of the symlink and
+	// returns the index of the directory entry it points to and the index of the
+	// item in the entry data. The ok is false if the symlinks are not supported.
+	GetSymlinkIndexes(segment dirSegment) (entryIndex, itemIndex uint32, ok bool)
 }
 
 // vecHeader represents a vector header
@@ -514,7 +514,7 @@ func (sc *StatsClient) getStatEntriesOnIndex(vector dirVector, indexes ...uint32
 		if d != nil {
 			t = d.Type()
 		}
-		entries = append(entries, adapter.StatEntry{
+		entry := adapter.StatEntry{
 			StatIdentifier: adapter.StatIdentifier{
 				Index: index,
 				Name:  dirName,
@@ -522,7 +522,11 @@ func (sc *StatsClient) getStatEntriesOnIndex(vector dirVector, indexes ...uint32
 			Type:    t,
 			Data:    d,
 			Symlink: dirType == adapter.Symlink,
-		})
+		}
+		if entry.Symlink {
+			entry.SymlinkTarget, entry.SymlinkItem, _ = sc.GetSymlinkIndexes(dirPtr)
+		}
+		entries = append(entries, entry)
 	}
 	return entries, nil
 }
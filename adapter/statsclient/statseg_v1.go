@@ -216,6 +216,11 @@ func (ss *statSegmentV1) CopyEntryData(segment dirSegment, _ uint32) adapter.Sta
 	return nil
 }
 
+func (ss *statSegmentV1) GetSymlinkIndexes(dirSegment) (entryIndex, itemIndex uint32, ok bool) {
+	debugf("Symlinks are not supported for stats v1")
+	return 0, 0, false
+}
+
 func (ss *statSegmentV1) UpdateEntryData(segment dirSegment, stat *adapter.Stat) error {
 	dirEntry := (*statSegDirectoryEntryV1)(segment)
 	switch (*stat).(type) {
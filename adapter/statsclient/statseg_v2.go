@@ -253,6 +253,15 @@ func (ss *statSegmentV2) CopyEntryData(segment dirSegment, index uint32) adapter
 	return nil
 }
 
+func (ss *statSegmentV2) GetSymlinkIndexes(segment dirSegment) (entryIndex, itemIndex uint32, ok bool) {
+	dirEntry := (*statSegDirectoryEntryV2)(segment)
+	if getStatType(dirEntry.directoryType, ss.getErrorVector() != nil) != adapter.Symlink {
+		return 0, 0, false
+	}
+	entryIndex, itemIndex = ss.getSymlinkIndexes(dirEntry)
+	return entryIndex, itemIndex, true
+}
+
 func (ss *statSegmentV2) UpdateEntryData(segment dirSegment, stat *adapter.Stat) error {
 	dirEntry := (*statSegDirectoryEntryV2)(segment)
 	switch (*stat).(type) {
@@ -0,0 +1,144 @@
+//  Copyright (c) 2023 Cisco and/or its affiliates.
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at:
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package statsfile
+
+import (
+	"fmt"
+	"regexp"
+
+	"go.fd.io/govpp/adapter"
+)
+
+// implements StatsAPI
+var _ adapter.StatsAPI = (*StatsAdapter)(nil)
+
+// StatsAdapter is a stats adapter serving the stats of a snapshot. The stat
+// data is shared by all returned entries and must not be modified.
+type StatsAdapter struct {
+	snapshot *Snapshot
+	byIndex  map[uint32]adapter.StatEntry
+}
+
+// NewStatsAdapter returns an adapter serving the stats of the snapshot.
+func NewStatsAdapter(snapshot *Snapshot) *StatsAdapter {
+	a := &StatsAdapter{
+		snapshot: snapshot,
+		byIndex:  make(map[uint32]adapter.StatEntry, len(snapshot.Entries)),
+	}
+	for _, entry := range snapshot.Entries {
+		a.byIndex[entry.Index] = entry
+	}
+	return a
+}
+
+// Connect does nothing, the snapshot is always available.
+func (a *StatsAdapter) Connect() error {
+	return nil
+}
+
+// Disconnect does nothing.
+func (a *StatsAdapter) Disconnect() error {
+	return nil
+}
+
+// ListStats lists indexed names for stats of the snapshot matching patterns.
+func (a *StatsAdapter) ListStats(patterns ...string) ([]adapter.StatIdentifier, error) {
+	entries, err := a.matchEntries(patterns...)
+	if err != nil {
+		return nil, err
+	}
+	identifiers := make([]adapter.StatIdentifier, len(entries))
+	for i, entry := range entries {
+		identifiers[i] = entry.StatIdentifier
+	}
+	return identifiers, nil
+}
+
+// DumpStats returns the stat entries of the snapshot matching patterns.
+func (a *StatsAdapter) DumpStats(patterns ...string) ([]adapter.StatEntry, error) {
+	return a.matchEntries(patterns...)
+}
+
+// PrepareDir returns the stat dir with the entries of the snapshot matching patterns.
+func (a *StatsAdapter) PrepareDir(patterns ...string) (*adapter.StatDir, error) {
+	entries, err := a.matchEntries(patterns...)
+	if err != nil {
+		return nil, err
+	}
+	return &adapter.StatDir{
+		Epoch:   a.snapshot.Epoch,
+		Entries: entries,
+	}, nil
+}
+
+// PrepareDirOnIndex returns the stat dir with the entries of the snapshot on indexes.
+func (a *StatsAdapter) PrepareDirOnIndex(indexes ...uint32) (*adapter.StatDir, error) {
+	entries := make([]adapter.StatEntry, 0, len(indexes))
+	for _, index := range indexes {
+		entry, ok := a.byIndex[index]
+		if !ok {
+			return nil, fmt.Errorf("stat entry index %d not found in snapshot", index)
+		}
+		entries = append(entries, entry)
+	}
+	return &adapter.StatDir{
+		Epoch:   a.snapshot.Epoch,
+		Entries: entries,
+	}, nil
+}
+
+// UpdateDir sets the values of the dir entries from the snapshot.
+func (a *StatsAdapter) UpdateDir(dir *adapter.StatDir) error {
+	if dir.Epoch != a.snapshot.Epoch {
+		return adapter.ErrStatsDirStale
+	}
+	for i := range dir.Entries {
+		entry, ok := a.byIndex[dir.Entries[i].Index]
+		if !ok {
+			return adapter.ErrStatsDirStale
+		}
+		dir.Entries[i].Data = entry.Data
+	}
+	return nil
+}
+
+// matchEntries returns the entries with names matching any of the regex
+// patterns, or all entries if no pattern is given.
+func (a *StatsAdapter) matchEntries(patterns ...string) ([]adapter.StatEntry, error) {
+	if len(patterns) == 0 {
+		return append([]adapter.StatEntry(nil), a.snapshot.Entries...), nil
+	}
+	regexes := make([]*regexp.Regexp, len(patterns))
+	for i, pattern := range patterns {
+		r, err := regexp.Compile(pattern)
+		if err != nil {
+			return nil, fmt.Errorf("compiling regexp failed: %v", err)
+		}
+		regexes[i] = r
+	}
+	var entries []adapter.StatEntry
+	for _, entry := range a.snapshot.Entries {
+		if len(entry.Name) == 0 {
+			continue
+		}
+		for _, r := range regexes {
+			if r.Match(entry.Name) {
+				entries = append(entries, entry)
+				break
+			}
+		}
+	}
+	return entries, nil
+}
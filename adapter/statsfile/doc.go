@@ -0,0 +1,47 @@
+//  Copyright (c) 2023 Cisco and/or its affiliates.
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at:
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+// Package statsfile provides snapshots of the VPP stats segment persisted into
+// files and a stats adapter backed by a snapshot, which allows inspecting
+// the stats captured from a real VPP without VPP.
+//
+// A snapshot of all the stats (or the stats matching the patterns) is captured
+// using any stats adapter and saved into a file:
+//
+//	client := statsclient.NewStatsClient("")
+//	if err := client.Connect(); err != nil {
+//		// handle error!
+//	}
+//	snapshot, err := statsfile.Capture(client)
+//	if err != nil {
+//		// handle error!
+//	}
+//	err = statsfile.SaveFile("stats.snapshot", snapshot)
+//
+// The file stores the epoch and the entries of the stat directory with their
+// values, encoded as variable-length integers and compressed by gzip.
+//
+// The adapter serves the stats of the loaded snapshot, so it can be used by
+// core.StatsConnection, the exporters or in tests in place of the hand-built
+// entries of the mock adapter:
+//
+//	snapshot, err := statsfile.LoadFile("stats.snapshot")
+//	if err != nil {
+//		// handle error!
+//	}
+//	statsConn, err := core.ConnectStats(statsfile.NewStatsAdapter(snapshot))
+//
+// The values of the stats never change, the stat directories prepared by the
+// adapter are updated with the same values.
+package statsfile
@@ -0,0 +1,417 @@
+//  Copyright (c) 2023 Cisco and/or its affiliates.
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at:
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package statsfile
+
+import (
+	"bufio"
+	"bytes"
+	"compress/gzip"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"os"
+	"time"
+
+	"go.fd.io/govpp/adapter"
+)
+
+// Version is the version of the snapshot file format written by this package.
+const Version = 1
+
+// fileMagic starts the snapshot file, followed by the version (uint16)
+// and the gzip compressed snapshot.
+var fileMagic = []byte("GOVPPSTS")
+
+// maxLength limits the lengths read from the file to avoid huge allocations
+// for corrupted files.
+const maxLength = 1 << 24
+
+// ErrInvalidFormat is returned when the snapshot file format is not recognized.
+var ErrInvalidFormat = errors.New("invalid stats snapshot format")
+
+// Snapshot is the stat directory captured at the given time.
+type Snapshot struct {
+	Timestamp time.Time
+	Epoch     int64
+	Entries   []adapter.StatEntry
+}
+
+// Capture prepares the stat directory for the stats matching any of
+// the patterns (all stats if none given) and returns it as a snapshot.
+func Capture(stats adapter.StatsAPI, patterns ...string) (*Snapshot, error) {
+	dir, err := stats.PrepareDir(patterns...)
+	if err != nil {
+		return nil, err
+	}
+	return &Snapshot{
+		Timestamp: time.Now(),
+		Epoch:     dir.Epoch,
+		Entries:   dir.Entries,
+	}, nil
+}
+
+// kinds of the stat data
+const (
+	kindNone uint8 = iota
+	kindScalar
+	kindError
+	kindSimpleCounter
+	kindCombinedCounter
+	kindName
+	kindEmpty
+)
+
+const flagSymlink = 1 << 0
+
+// WriteSnapshot writes the snapshot into w.
+//
+// snapshot (gzip compressed):
+//
+//	timestamp varint (unix nanoseconds)
+//	epoch     varint
+//	entries   uvarint count + entries
+//
+// entry:
+//
+//	index uvarint
+//	name  uvarint length + bytes
+//	type  uvarint length + bytes
+//	flags uint8
+//	link  uvarint target index + uvarint item index, only for symlinks
+//	kind  uint8
+//	data  depends on the kind, the counters are encoded as uvarints
+func WriteSnapshot(w io.Writer, snapshot *Snapshot) error {
+	header := make([]byte, len(fileMagic)+2)
+	copy(header, fileMagic)
+	binary.BigEndian.PutUint16(header[len(fileMagic):], Version)
+	if _, err := w.Write(header); err != nil {
+		return err
+	}
+
+	zw := gzip.NewWriter(w)
+	sw := &snapshotWriter{w: bufio.NewWriter(zw)}
+	sw.varint(snapshot.Timestamp.UnixNano())
+	sw.varint(snapshot.Epoch)
+	sw.uvarint(uint64(len(snapshot.Entries)))
+	for _, entry := range snapshot.Entries {
+		if err := sw.entry(entry); err != nil {
+			return err
+		}
+	}
+	if sw.err != nil {
+		return sw.err
+	}
+	if err := sw.w.Flush(); err != nil {
+		return err
+	}
+	return zw.Close()
+}
+
+// SaveFile writes the snapshot into a new file at path.
+func SaveFile(path string, snapshot *Snapshot) error {
+	f, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+	if err := WriteSnapshot(f, snapshot); err != nil {
+		_ = f.Close()
+		return err
+	}
+	return f.Close()
+}
+
+// ReadSnapshot reads the snapshot from r.
+func ReadSnapshot(r io.Reader) (*Snapshot, error) {
+	header := make([]byte, len(fileMagic)+2)
+	if _, err := io.ReadFull(r, header); err != nil || !bytes.Equal(header[:len(fileMagic)], fileMagic) {
+		return nil, ErrInvalidFormat
+	}
+	if version := binary.BigEndian.Uint16(header[len(fileMagic):]); version != Version {
+		return nil, fmt.Errorf("%w: unsupported version %d", ErrInvalidFormat, version)
+	}
+
+	zr, err := gzip.NewReader(r)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidFormat, err)
+	}
+	defer zr.Close()
+	sr := &snapshotReader{r: bufio.NewReader(zr)}
+
+	snapshot := &Snapshot{
+		Timestamp: time.Unix(0, sr.varint()),
+		Epoch:     sr.varint(),
+	}
+	n := sr.length()
+	for i := 0; i < n && sr.err == nil; i++ {
+		entry := sr.entry()
+		if sr.err != nil {
+			return nil, fmt.Errorf("entry #%d: %w", i, sr.err)
+		}
+		snapshot.Entries = append(snapshot.Entries, entry)
+	}
+	if sr.err != nil {
+		return nil, sr.err
+	}
+	// reading until the end verifies the gzip checksum
+	if _, err := sr.r.ReadByte(); err != io.EOF {
+		if err == nil {
+			return nil, fmt.Errorf("%w: unexpected data after snapshot", ErrInvalidFormat)
+		}
+		return nil, fmt.Errorf("%w: %v", ErrInvalidFormat, err)
+	}
+	return snapshot, nil
+}
+
+// LoadFile reads the snapshot from the file at path.
+func LoadFile(path string) (*Snapshot, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	return ReadSnapshot(f)
+}
+
+// snapshotWriter keeps the first write error, so the encoding is not
+// interrupted by error checks.
+type snapshotWriter struct {
+	w   *bufio.Writer
+	buf [binary.MaxVarintLen64]byte
+	err error
+}
+
+func (sw *snapshotWriter) write(b []byte) {
+	if sw.err == nil {
+		_, sw.err = sw.w.Write(b)
+	}
+}
+
+func (sw *snapshotWriter) uvarint(v uint64) {
+	sw.write(sw.buf[:binary.PutUvarint(sw.buf[:], v)])
+}
+
+func (sw *snapshotWriter) varint(v int64) {
+	sw.write(sw.buf[:binary.PutVarint(sw.buf[:], v)])
+}
+
+func (sw *snapshotWriter) byte(v uint8) {
+	sw.write([]byte{v})
+}
+
+func (sw *snapshotWriter) bytes(b []byte) {
+	sw.uvarint(uint64(len(b)))
+	sw.write(b)
+}
+
+func (sw *snapshotWriter) entry(entry adapter.StatEntry) error {
+	sw.uvarint(uint64(entry.Index))
+	sw.bytes(entry.Name)
+	sw.bytes([]byte(entry.Type))
+	var flags uint8
+	if entry.Symlink {
+		flags |= flagSymlink
+	}
+	sw.byte(flags)
+	if entry.Symlink {
+		sw.uvarint(uint64(entry.SymlinkTarget))
+		sw.uvarint(uint64(entry.SymlinkItem))
+	}
+
+	switch data := entry.Data.(type) {
+	case nil:
+		sw.byte(kindNone)
+	case adapter.ScalarStat:
+		sw.byte(kindScalar)
+		binary.BigEndian.PutUint64(sw.buf[:8], math.Float64bits(float64(data)))
+		sw.write(sw.buf[:8])
+	case adapter.ErrorStat:
+		sw.byte(kindError)
+		sw.uvarint(uint64(len(data)))
+		for _, v := range data {
+			sw.uvarint(uint64(v))
+		}
+	case adapter.SimpleCounterStat:
+		sw.byte(kindSimpleCounter)
+		sw.uvarint(uint64(len(data)))
+		for _, thread := range data {
+			sw.uvarint(uint64(len(thread)))
+			for _, v := range thread {
+				sw.uvarint(uint64(v))
+			}
+		}
+	case adapter.CombinedCounterStat:
+		sw.byte(kindCombinedCounter)
+		sw.uvarint(uint64(len(data)))
+		for _, thread := range data {
+			sw.uvarint(uint64(len(thread)))
+			for _, v := range thread {
+				sw.uvarint(v[0])
+				sw.uvarint(v[1])
+			}
+		}
+	case adapter.NameStat:
+		sw.byte(kindName)
+		sw.uvarint(uint64(len(data)))
+		for _, name := range data {
+			// zero length for nil names (deleted objects)
+			if name == nil {
+				sw.uvarint(0)
+				continue
+			}
+			sw.uvarint(uint64(len(name)) + 1)
+			sw.write(name)
+		}
+	case adapter.EmptyStat:
+		sw.byte(kindEmpty)
+		sw.bytes([]byte(data))
+	default:
+		return fmt.Errorf("unsupported stat data %T of entry %s", entry.Data, entry.Name)
+	}
+	return sw.err
+}
+
+// snapshotReader keeps the first read error, the values read after it are zero.
+type snapshotReader struct {
+	r   *bufio.Reader
+	err error
+}
+
+func (sr *snapshotReader) fail(err error) {
+	if sr.err != nil {
+		return
+	}
+	if errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF) {
+		err = fmt.Errorf("%w: unexpected end of snapshot", ErrInvalidFormat)
+	}
+	sr.err = err
+}
+
+func (sr *snapshotReader) uvarint() uint64 {
+	if sr.err != nil {
+		return 0
+	}
+	v, err := binary.ReadUvarint(sr.r)
+	if err != nil {
+		sr.fail(err)
+	}
+	return v
+}
+
+func (sr *snapshotReader) varint() int64 {
+	if sr.err != nil {
+		return 0
+	}
+	v, err := binary.ReadVarint(sr.r)
+	if err != nil {
+		sr.fail(err)
+	}
+	return v
+}
+
+func (sr *snapshotReader) byte() uint8 {
+	if sr.err != nil {
+		return 0
+	}
+	v, err := sr.r.ReadByte()
+	if err != nil {
+		sr.fail(err)
+	}
+	return v
+}
+
+func (sr *snapshotReader) length() int {
+	n := sr.uvarint()
+	if n > maxLength {
+		sr.fail(fmt.Errorf("%w: length %d too large", ErrInvalidFormat, n))
+		return 0
+	}
+	return int(n)
+}
+
+func (sr *snapshotReader) read(n int) []byte {
+	if sr.err != nil {
+		return nil
+	}
+	b := make([]byte, n)
+	if _, err := io.ReadFull(sr.r, b); err != nil {
+		sr.fail(err)
+		return nil
+	}
+	return b
+}
+
+func (sr *snapshotReader) bytes() []byte {
+	return sr.read(sr.length())
+}
+
+func (sr *snapshotReader) entry() adapter.StatEntry {
+	var entry adapter.StatEntry
+	entry.Index = uint32(sr.uvarint())
+	entry.Name = sr.bytes()
+	entry.Type = adapter.StatType(sr.bytes())
+	entry.Symlink = sr.byte()&flagSymlink != 0
+	if entry.Symlink {
+		entry.SymlinkTarget = uint32(sr.uvarint())
+		entry.SymlinkItem = uint32(sr.uvarint())
+	}
+
+	switch kind := sr.byte(); kind {
+	case kindNone:
+	case kindScalar:
+		if b := sr.read(8); b != nil {
+			entry.Data = adapter.ScalarStat(math.Float64frombits(binary.BigEndian.Uint64(b)))
+		}
+	case kindError:
+		data := make(adapter.ErrorStat, sr.length())
+		for i := range data {
+			data[i] = adapter.Counter(sr.uvarint())
+		}
+		entry.Data = data
+	case kindSimpleCounter:
+		data := make(adapter.SimpleCounterStat, sr.length())
+		for i := range data {
+			data[i] = make([]adapter.Counter, sr.length())
+			for j := range data[i] {
+				data[i][j] = adapter.Counter(sr.uvarint())
+			}
+		}
+		entry.Data = data
+	case kindCombinedCounter:
+		data := make(adapter.CombinedCounterStat, sr.length())
+		for i := range data {
+			data[i] = make([]adapter.CombinedCounter, sr.length())
+			for j := range data[i] {
+				data[i][j] = adapter.CombinedCounter{sr.uvarint(), sr.uvarint()}
+			}
+		}
+		entry.Data = data
+	case kindName:
+		data := make(adapter.NameStat, sr.length())
+		for i := range data {
+			n := sr.length()
+			if n > 0 {
+				data[i] = sr.read(n - 1)
+			}
+		}
+		entry.Data = data
+	case kindEmpty:
+		entry.Data = adapter.EmptyStat(sr.bytes())
+	default:
+		sr.fail(fmt.Errorf("%w: unknown stat data kind %d", ErrInvalidFormat, kind))
+	}
+	return entry
+}
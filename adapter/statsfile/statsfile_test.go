@@ -0,0 +1,142 @@
+package statsfile_test
+
+import (
+	"bytes"
+	"errors"
+	"path/filepath"
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+
+	"go.fd.io/govpp/adapter"
+	"go.fd.io/govpp/adapter/mock"
+	"go.fd.io/govpp/adapter/statsfile"
+	"go.fd.io/govpp/api"
+	"go.fd.io/govpp/core"
+)
+
+var testEntries = []adapter.StatEntry{
+	{
+		StatIdentifier: adapter.StatIdentifier{Index: 1, Name: []byte(core.SystemStats_VectorRate)},
+		Type:           adapter.ScalarIndex,
+		Data:           adapter.ScalarStat(1.5),
+	},
+	{
+		StatIdentifier: adapter.StatIdentifier{Index: 2, Name: []byte(core.InterfaceStats_Names)},
+		Type:           adapter.NameVector,
+		Data:           adapter.NameStat{adapter.Name("local0"), nil, adapter.Name("loop0")},
+	},
+	{
+		StatIdentifier: adapter.StatIdentifier{Index: 3, Name: []byte(core.InterfaceStats_Drops)},
+		Type:           adapter.SimpleCounterVector,
+		Data:           adapter.SimpleCounterStat{{0, 1, 2}, {0, 300, 1 << 40}},
+	},
+	{
+		StatIdentifier: adapter.StatIdentifier{Index: 4, Name: []byte(core.InterfaceStats_Rx)},
+		Type:           adapter.CombinedCounterVector,
+		Data:           adapter.CombinedCounterStat{{{0, 0}, {1, 64}, {10, 1000}}},
+	},
+	{
+		StatIdentifier: adapter.StatIdentifier{Index: 5, Name: []byte("/interfaces/loop0/rx")},
+		Type:           adapter.CombinedCounterVector,
+		Data:           adapter.CombinedCounterStat{{{10, 1000}}},
+		Symlink:        true,
+		SymlinkTarget:  4,
+		SymlinkItem:    2,
+	},
+	{
+		StatIdentifier: adapter.StatIdentifier{Index: 6, Name: []byte("/err/ip4-input/no error")},
+		Type:           adapter.ErrorIndex,
+		Data:           adapter.ErrorStat{5, 7},
+	},
+	{
+		StatIdentifier: adapter.StatIdentifier{Index: 7, Name: []byte("/removed")},
+		Type:           adapter.Empty,
+		Data:           adapter.EmptyStat(""),
+	},
+}
+
+func TestCapture(t *testing.T) {
+	RegisterTestingT(t)
+
+	stats := mock.NewStatsAdapter()
+	stats.MockDir(&adapter.StatDir{Epoch: 3, Entries: testEntries})
+
+	snapshot, err := statsfile.Capture(stats)
+	Expect(err).ShouldNot(HaveOccurred())
+	Expect(snapshot.Epoch).To(BeEquivalentTo(3))
+	Expect(snapshot.Entries).To(Equal(testEntries))
+	Expect(snapshot.Timestamp).NotTo(BeZero())
+}
+
+func TestSnapshotFile(t *testing.T) {
+	RegisterTestingT(t)
+
+	snapshot := &statsfile.Snapshot{
+		Timestamp: time.Unix(1700000000, 123),
+		Epoch:     42,
+		Entries:   testEntries,
+	}
+	path := filepath.Join(t.TempDir(), "stats.snapshot")
+	Expect(statsfile.SaveFile(path, snapshot)).To(Succeed())
+
+	loaded, err := statsfile.LoadFile(path)
+	Expect(err).ShouldNot(HaveOccurred())
+	Expect(loaded.Timestamp.Equal(snapshot.Timestamp)).To(BeTrue())
+	Expect(loaded.Epoch).To(Equal(snapshot.Epoch))
+	Expect(loaded.Entries).To(Equal(snapshot.Entries))
+}
+
+func TestReadInvalidSnapshot(t *testing.T) {
+	RegisterTestingT(t)
+
+	_, err := statsfile.ReadSnapshot(bytes.NewReader([]byte("not a snapshot")))
+	Expect(errors.Is(err, statsfile.ErrInvalidFormat)).To(BeTrue())
+
+	var buf bytes.Buffer
+	Expect(statsfile.WriteSnapshot(&buf, &statsfile.Snapshot{Entries: testEntries})).To(Succeed())
+	_, err = statsfile.ReadSnapshot(bytes.NewReader(buf.Bytes()[:buf.Len()-10]))
+	Expect(err).To(HaveOccurred())
+}
+
+func TestStatsAdapter(t *testing.T) {
+	RegisterTestingT(t)
+
+	stats := statsfile.NewStatsAdapter(&statsfile.Snapshot{Epoch: 1, Entries: testEntries})
+
+	list, err := stats.ListStats("^/if/")
+	Expect(err).ShouldNot(HaveOccurred())
+	Expect(list).To(Equal([]adapter.StatIdentifier{
+		{Index: 2, Name: []byte(core.InterfaceStats_Names)},
+		{Index: 3, Name: []byte(core.InterfaceStats_Drops)},
+		{Index: 4, Name: []byte(core.InterfaceStats_Rx)},
+	}))
+
+	dir, err := stats.PrepareDirOnIndex(6)
+	Expect(err).ShouldNot(HaveOccurred())
+	Expect(dir.Entries).To(Equal(testEntries[5:6]))
+	Expect(stats.UpdateDir(dir)).To(Succeed())
+	Expect(dir.Entries).To(Equal(testEntries[5:6]))
+
+	_, err = stats.PrepareDirOnIndex(100)
+	Expect(err).To(HaveOccurred())
+
+	dir.Epoch = 2
+	Expect(stats.UpdateDir(dir)).To(MatchError(adapter.ErrStatsDirStale))
+}
+
+func TestStatsConnection(t *testing.T) {
+	RegisterTestingT(t)
+
+	statsConn, err := core.ConnectStats(statsfile.NewStatsAdapter(&statsfile.Snapshot{Entries: testEntries}))
+	Expect(err).ShouldNot(HaveOccurred())
+	defer statsConn.Disconnect()
+
+	ifaceStats := new(api.InterfaceStats)
+	Expect(statsConn.GetInterfaceStats(ifaceStats)).To(Succeed())
+	Expect(ifaceStats.Interfaces).To(HaveLen(3))
+	Expect(ifaceStats.Interfaces[2].InterfaceName).To(Equal("loop0"))
+	Expect(ifaceStats.Interfaces[2].Drops).To(BeEquivalentTo(2 + 1<<40))
+	Expect(ifaceStats.Interfaces[2].Rx).To(Equal(api.InterfaceCounterCombined{Packets: 10, Bytes: 1000}))
+}
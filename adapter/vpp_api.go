@@ -54,6 +54,15 @@ type VppAPI interface {
 	WaitReady() error
 }
 
+// BorrowingMsgSender is an optional interface of VppAPI implemented by the
+// adapters which do not retain the sent data. The caller may reuse the buffer
+// passed to SendMsgBorrowed once it returns, e.g. return it to codec buffer pool.
+type BorrowingMsgSender interface {
+	// SendMsgBorrowed sends a binary-encoded message to VPP like SendMsg,
+	// but the data must not be retained after SendMsgBorrowed returns.
+	SendMsgBorrowed(context uint32, data []byte) error
+}
+
 // UnknownMsgError is the error type usually returned by GetMsgID
 // method of VppAPI. It describes the name and CRC for the unknown message.
 type UnknownMsgError struct {
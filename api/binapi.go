@@ -15,8 +15,12 @@
 package api
 
 import (
+	"fmt"
 	"path"
 	"reflect"
+	"sort"
+	"strings"
+	"sync"
 )
 
 // GoVppAPIPackageIsVersionX is referenced from generated binapi files
@@ -52,6 +56,21 @@ type Message interface {
 	GetMessageType() MessageType
 }
 
+// MessageFactory is implemented by the messages that can not be created as
+// a zero value of their type, e.g. messages defined by a schema at runtime.
+type MessageFactory interface {
+	// NewMessage returns a new empty message of the same definition.
+	NewMessage() Message
+}
+
+// NewMessage returns a new empty message of the same type as msg.
+func NewMessage(msg Message) Message {
+	if factory, ok := msg.(MessageFactory); ok {
+		return factory.NewMessage()
+	}
+	return reflect.New(reflect.TypeOf(msg).Elem()).Interface().(Message)
+}
+
 // DataType is an interface that is implemented by all VPP Binary API data types by the binapi_generator.
 type DataType interface {
 	// GetTypeName returns the original VPP name of the data type, as defined in the VPP API.
@@ -59,27 +78,160 @@ type DataType interface {
 }
 
 var (
+	registryMu             sync.Mutex
 	registeredMessages     = make(map[string]map[string]Message)
 	registeredMessageTypes = make(map[string]map[reflect.Type]string)
+
+	// copies of the registry returned to the callers, built on first use
+	registeredMessagesCopy     map[string]map[string]Message
+	registeredMessageTypesCopy map[string]map[reflect.Type]string
+	lookupIndex                map[string][]Message // registered messages by name
 )
 
-// RegisterMessage is called from generated code to register message.
+// RegisterMessage is called from generated code to register message. It can
+// be also called at runtime, e.g. for the messages defined by a schema loaded
+// at runtime, which implement MessageFactory. Their Go type is shared, so they
+// are registered only by name and CRC, not in the registered message types.
 func RegisterMessage(x Message, name string) {
-	binapiPath := path.Dir(reflect.TypeOf(x).Elem().PkgPath())
+	registryMu.Lock()
+	defer registryMu.Unlock()
+	registeredMessagesCopy, registeredMessageTypesCopy, lookupIndex = nil, nil, nil
+
+	binapiPath := GetMessagePath(x)
 	if _, ok := registeredMessages[binapiPath]; !ok {
 		registeredMessages[binapiPath] = make(map[string]Message)
 		registeredMessageTypes[binapiPath] = make(map[reflect.Type]string)
 	}
 	registeredMessages[binapiPath][x.GetMessageName()+"_"+x.GetCrcString()] = x
-	registeredMessageTypes[binapiPath][reflect.TypeOf(x)] = name
+	if _, ok := x.(MessageFactory); !ok {
+		registeredMessageTypes[binapiPath][reflect.TypeOf(x)] = name
+	}
 }
 
-// GetRegisteredMessages returns list of all registered messages.
+// GetMessagePath returns the binapi path under which the message is registered.
+// It is the parent of the package path of generated messages, e.g. "go.fd.io/govpp/binapi",
+// and the package path of the messages implementing MessageFactory.
+func GetMessagePath(msg Message) string {
+	pkgPath := reflect.TypeOf(msg).Elem().PkgPath()
+	if _, ok := msg.(MessageFactory); ok {
+		return pkgPath
+	}
+	return path.Dir(pkgPath)
+}
+
+// GetRegisteredMessages returns list of all registered messages. The returned
+// maps must not be modified.
 func GetRegisteredMessages() map[string]map[string]Message {
-	return registeredMessages
+	registryMu.Lock()
+	defer registryMu.Unlock()
+	if registeredMessagesCopy == nil {
+		registeredMessagesCopy = make(map[string]map[string]Message, len(registeredMessages))
+		for p, msgs := range registeredMessages {
+			registeredMessagesCopy[p] = make(map[string]Message, len(msgs))
+			for nameCrc, msg := range msgs {
+				registeredMessagesCopy[p][nameCrc] = msg
+			}
+		}
+	}
+	return registeredMessagesCopy
 }
 
-// GetRegisteredMessageTypes returns list of all registered message types.
+// GetRegisteredMessageTypes returns list of all registered message types. The
+// returned maps must not be modified.
 func GetRegisteredMessageTypes() map[string]map[reflect.Type]string {
-	return registeredMessageTypes
+	registryMu.Lock()
+	defer registryMu.Unlock()
+	if registeredMessageTypesCopy == nil {
+		registeredMessageTypesCopy = make(map[string]map[reflect.Type]string, len(registeredMessageTypes))
+		for p, types := range registeredMessageTypes {
+			registeredMessageTypesCopy[p] = make(map[reflect.Type]string, len(types))
+			for t, name := range types {
+				registeredMessageTypesCopy[p][t] = name
+			}
+		}
+	}
+	return registeredMessageTypesCopy
+}
+
+// LookupMessage finds the registered message with the given name and CRC.
+// Without the CRC, the name must match messages with a single CRC. If the
+// message is registered in multiple binapi paths, the generated message is
+// preferred over the messages defined at runtime, then the message from the
+// first path in sorted order.
+func LookupMessage(name, crc string) (Message, error) {
+	registryMu.Lock()
+	if lookupIndex == nil {
+		lookupIndex = buildLookupIndex()
+	}
+	msgs := lookupIndex[name]
+	registryMu.Unlock()
+
+	var found Message
+	for _, msg := range msgs {
+		if crc != "" && !strings.EqualFold(msg.GetCrcString(), crc) {
+			continue
+		}
+		if found == nil {
+			found = msg
+		} else if found.GetCrcString() != msg.GetCrcString() {
+			return nil, fmt.Errorf("message %s is ambiguous without CRC", name)
+		}
+	}
+	if found == nil {
+		if crc == "" {
+			return nil, fmt.Errorf("unknown message: %s", name)
+		}
+		return nil, fmt.Errorf("unknown message: %s_%s", name, crc)
+	}
+	return found, nil
+}
+
+// SplitNameCrc splits the message name and CRC joined by underscore, e.g.
+// "control_ping_51077d14" as used in the message tables.
+func SplitNameCrc(nameCrc string) (name, crc string) {
+	i := strings.LastIndexByte(nameCrc, '_')
+	if i < 0 {
+		return nameCrc, ""
+	}
+	return nameCrc[:i], nameCrc[i+1:]
+}
+
+// generatedMessage is implemented by the messages generated with marshalling methods.
+type generatedMessage interface {
+	Marshal([]byte) ([]byte, error)
+	Unmarshal([]byte) error
+}
+
+// buildLookupIndex indexes the registered messages by name in the order
+// they are preferred by LookupMessage.
+func buildLookupIndex() map[string][]Message {
+	paths := make([]string, 0, len(registeredMessages))
+	for p := range registeredMessages {
+		paths = append(paths, p)
+	}
+	sort.Strings(paths)
+
+	var generated, others []Message
+	for _, p := range paths {
+		nameCrcs := make([]string, 0, len(registeredMessages[p]))
+		for nameCrc := range registeredMessages[p] {
+			nameCrcs = append(nameCrcs, nameCrc)
+		}
+		sort.Strings(nameCrcs)
+		for _, nameCrc := range nameCrcs {
+			msg := registeredMessages[p][nameCrc]
+			_, factory := msg.(MessageFactory)
+			if _, ok := msg.(generatedMessage); ok && !factory {
+				generated = append(generated, msg)
+			} else {
+				others = append(others, msg)
+			}
+		}
+	}
+
+	index := make(map[string][]Message)
+	for _, msg := range append(generated, others...) {
+		index[msg.GetMessageName()] = append(index[msg.GetMessageName()], msg)
+	}
+	return index
 }
@@ -0,0 +1,57 @@
+package api
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+)
+
+type lookupTestMsg struct{}
+
+func (*lookupTestMsg) GetMessageName() string      { return "lookup_test" }
+func (*lookupTestMsg) GetCrcString() string        { return "0a0b0c0d" }
+func (*lookupTestMsg) GetMessageType() MessageType { return RequestMessage }
+
+type lookupTestMsgV2 struct{}
+
+func (*lookupTestMsgV2) GetMessageName() string      { return "lookup_test" }
+func (*lookupTestMsgV2) GetCrcString() string        { return "01020304" }
+func (*lookupTestMsgV2) GetMessageType() MessageType { return RequestMessage }
+
+func TestLookupMessage(t *testing.T) {
+	RegisterTestingT(t)
+
+	_, err := LookupMessage("lookup_test", "")
+	Expect(err).To(MatchError("unknown message: lookup_test"))
+
+	// the index is rebuilt after registration
+	RegisterMessage((*lookupTestMsg)(nil), "LookupTestMsg")
+	msg, err := LookupMessage("lookup_test", "")
+	Expect(err).ShouldNot(HaveOccurred())
+	Expect(msg).To(BeAssignableToTypeOf(&lookupTestMsg{}))
+	msg, err = LookupMessage("lookup_test", "0A0B0C0D")
+	Expect(err).ShouldNot(HaveOccurred())
+	Expect(msg).To(BeAssignableToTypeOf(&lookupTestMsg{}))
+
+	RegisterMessage((*lookupTestMsgV2)(nil), "LookupTestMsgV2")
+	_, err = LookupMessage("lookup_test", "")
+	Expect(err).To(MatchError("message lookup_test is ambiguous without CRC"))
+	msg, err = LookupMessage("lookup_test", "01020304")
+	Expect(err).ShouldNot(HaveOccurred())
+	Expect(msg).To(BeAssignableToTypeOf(&lookupTestMsgV2{}))
+
+	_, err = LookupMessage("lookup_test", "ffffffff")
+	Expect(err).To(MatchError("unknown message: lookup_test_ffffffff"))
+}
+
+func TestSplitNameCrc(t *testing.T) {
+	RegisterTestingT(t)
+
+	name, crc := SplitNameCrc("control_ping_51077d14")
+	Expect(name).To(Equal("control_ping"))
+	Expect(crc).To(Equal("51077d14"))
+
+	name, crc = SplitNameCrc("noncrc")
+	Expect(name).To(Equal("noncrc"))
+	Expect(crc).To(BeEmpty())
+}
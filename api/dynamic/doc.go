@@ -0,0 +1,36 @@
+//  Copyright (c) 2023 Cisco and/or its affiliates.
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at:
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+// Package dynamic provides VPP API messages defined by the schema loaded at
+// runtime from VPP API files, without the generated Go code. This allows
+// talking to VPP plugins with no generated binapi packages.
+//
+// The schema is loaded from the directory with .api.json files and its messages
+// are registered, so the connection resolves their IDs and the replies:
+//
+//	schema, err := dynamic.LoadDir(vppapi.DefaultDir)
+//	...
+//	schema.Register()
+//	conn, err := govpp.Connect(socketclient.DefaultSocketName)
+//	...
+//	req, _ := schema.NewMessage("create_loopback")
+//	req.Set("mac_address", []byte{0xde, 0xad, 0, 0, 0, 1})
+//	reply, _ := schema.NewMessage("create_loopback_reply")
+//	err = conn.Invoke(ctx, req, reply)
+//	swIfIndex, _ := reply.Get("sw_if_index")
+//
+// The messages received on a stream are returned as *DynamicMessage if the
+// request was a dynamic message. The fields can be also set and read as JSON
+// using encoding/json.
+package dynamic
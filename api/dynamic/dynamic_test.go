@@ -0,0 +1,220 @@
+//  Copyright (c) 2023 Cisco and/or its affiliates.
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at:
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package dynamic_test
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	. "github.com/onsi/gomega"
+
+	"go.fd.io/govpp/adapter/simulator"
+	"go.fd.io/govpp/api"
+	"go.fd.io/govpp/api/dynamic"
+	"go.fd.io/govpp/binapi/ethernet_types"
+	interfaces "go.fd.io/govpp/binapi/interface"
+	"go.fd.io/govpp/binapi/interface_types"
+	"go.fd.io/govpp/binapi/ip_types"
+	"go.fd.io/govpp/binapi/memclnt"
+	"go.fd.io/govpp/binapigen/vppapi"
+	"go.fd.io/govpp/codec"
+	"go.fd.io/govpp/codec/textcodec"
+	"go.fd.io/govpp/core"
+)
+
+func loadSchema(t *testing.T) *dynamic.Schema {
+	RegisterTestingT(t)
+	file, err := vppapi.ParseFile("testdata/test.api.json")
+	Expect(err).ShouldNot(HaveOccurred())
+	schema, err := dynamic.NewSchema(file)
+	Expect(err).ShouldNot(HaveOccurred())
+	return schema
+}
+
+func newMessage(schema *dynamic.Schema, name string, fields map[string]interface{}) *dynamic.DynamicMessage {
+	msg, err := schema.NewMessage(name)
+	Expect(err).ShouldNot(HaveOccurred())
+	for name, value := range fields {
+		Expect(msg.Set(name, value)).To(Succeed())
+	}
+	return msg
+}
+
+// expectSameEncoding checks that the dynamic message is encoded the same
+// way as the generated one and decodes back to the same values.
+func expectSameEncoding(dyn *dynamic.DynamicMessage, generated api.Message) {
+	Expect(dyn.GetMessageType()).To(Equal(generated.GetMessageType()))
+	expected, err := codec.EncodeMsg(generated, 100)
+	Expect(err).ShouldNot(HaveOccurred())
+	data, err := codec.EncodeMsg(dyn, 100)
+	Expect(err).ShouldNot(HaveOccurred())
+	Expect(data).To(Equal(expected))
+
+	decoded := dyn.NewMessage().(*dynamic.DynamicMessage)
+	Expect(codec.DecodeMsg(expected, decoded)).To(Succeed())
+	Expect(decoded.MarshalJSON()).To(MatchJSON(mustMarshalJSON(dyn)))
+}
+
+func mustMarshalJSON(msg *dynamic.DynamicMessage) []byte {
+	data, err := msg.MarshalJSON()
+	Expect(err).ShouldNot(HaveOccurred())
+	return data
+}
+
+func TestEncoding(t *testing.T) {
+	schema := loadSchema(t)
+
+	mac, _ := ethernet_types.ParseMacAddress("de:ad:00:00:00:01")
+	expectSameEncoding(
+		newMessage(schema, "create_loopback", map[string]interface{}{"mac_address": mac[:]}),
+		&interfaces.CreateLoopback{MacAddress: mac},
+	)
+	expectSameEncoding(
+		newMessage(schema, "sw_interface_set_flags", map[string]interface{}{
+			"sw_if_index": 5,
+			"flags":       "IF_STATUS_API_FLAG_ADMIN_UP|IF_STATUS_API_FLAG_LINK_UP",
+		}),
+		&interfaces.SwInterfaceSetFlags{SwIfIndex: 5, Flags: 3},
+	)
+
+	prefix, _ := ip_types.ParseAddressWithPrefix("10.10.0.1/24")
+	expectSameEncoding(
+		newMessage(schema, "sw_interface_add_del_address_5463d73b", map[string]interface{}{
+			"sw_if_index": uint32(1),
+			"is_add":      true,
+			"prefix": map[string]interface{}{
+				"address": map[string]interface{}{"af": "ADDRESS_IP4", "un": []byte{10, 10, 0, 1}},
+				"len":     24,
+			},
+		}),
+		&interfaces.SwInterfaceAddDelAddress{SwIfIndex: 1, IsAdd: true, Prefix: prefix},
+	)
+
+	expectSameEncoding(
+		newMessage(schema, "sockclnt_create_reply", map[string]interface{}{
+			"index": 1,
+			"message_table": []interface{}{
+				map[string]interface{}{"index": 16, "name": "control_ping_51077d14"},
+				map[string]interface{}{"index": 17, "name": "control_ping_reply_f6b0b8ca"},
+			},
+		}),
+		&memclnt.SockclntCreateReply{
+			Index: 1,
+			Count: 2,
+			MessageTable: []memclnt.MessageTableEntry{
+				{Index: 16, Name: "control_ping_51077d14"},
+				{Index: 17, Name: "control_ping_reply_f6b0b8ca"},
+			},
+		},
+	)
+}
+
+func TestGetSet(t *testing.T) {
+	schema := loadSchema(t)
+
+	msg := newMessage(schema, "sockclnt_create_reply", nil)
+	Expect(msg.Fields()).To(Equal([]string{"response", "index", "message_table"}))
+	Expect(msg.Get("index")).To(Equal(uint32(0)))
+	Expect(msg.Set("index", int64(7))).To(Succeed())
+	Expect(msg.Get("index")).To(Equal(uint32(7)))
+	Expect(msg.Set("message_table", []interface{}{map[string]interface{}{"index": 1}})).To(Succeed())
+	Expect(msg.Get("count")).To(Equal(uint16(1)))
+
+	Expect(msg.Set("index", -1)).ToNot(Succeed())
+	Expect(msg.Set("index", "x")).ToNot(Succeed())
+	Expect(msg.Set("count", 1)).ToNot(Succeed())
+	Expect(msg.Set("unknown", 1)).ToNot(Succeed())
+	Expect(msg.Set("message_table", []interface{}{map[string]interface{}{"unknown": 1}})).ToNot(Succeed())
+
+	_, err := schema.NewMessage("unknown_message")
+	Expect(err).To(HaveOccurred())
+}
+
+func TestJSON(t *testing.T) {
+	schema := loadSchema(t)
+
+	msg := newMessage(schema, "sw_interface_add_del_address", nil)
+	Expect(json.Unmarshal([]byte(`{
+		"sw_if_index": 2,
+		"is_add": true,
+		"prefix": {"address": {"af": "ADDRESS_IP6", "un": "IAENuAAAAAAAAAAAAAAAAQ=="}, "len": 64}
+	}`), msg)).To(Succeed())
+
+	data, err := json.Marshal(msg)
+	Expect(err).ShouldNot(HaveOccurred())
+	Expect(string(data)).To(Equal(`{"sw_if_index":2,"is_add":true,"del_all":false,` +
+		`"prefix":{"address":{"af":"ADDRESS_IP6","un":"IAENuAAAAAAAAAAAAAAAAQ=="},"len":64}}`))
+
+	prefix, _ := ip_types.ParseAddressWithPrefix("2001:db8::1/64")
+	expectSameEncoding(msg, &interfaces.SwInterfaceAddDelAddress{SwIfIndex: 2, IsAdd: true, Prefix: prefix})
+
+	data, err = textcodec.NewCodec(nil).Marshal(textcodec.FormatYAML, msg)
+	Expect(err).ShouldNot(HaveOccurred())
+	Expect(string(data)).To(ContainSubstring("af: ADDRESS_IP6\n"))
+
+	// unknown flags are encoded as number
+	msg = newMessage(schema, "sw_interface_set_flags", map[string]interface{}{"flags": 0x8001})
+	Expect(msg.MarshalJSON()).To(MatchJSON(`{"sw_if_index":0,"flags":32769}`))
+}
+
+func TestRegister(t *testing.T) {
+	schema := loadSchema(t)
+
+	// the registry can be read while the messages are being registered
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		for i := 0; i < 10; i++ {
+			_ = api.GetRegisteredMessages()
+			_, _ = api.LookupMessage("create_loopback", "")
+		}
+	}()
+	schema.Register()
+	<-done
+
+	msgs := api.GetRegisteredMessages()["go.fd.io/govpp/api/dynamic"]
+	Expect(msgs).To(HaveLen(len(schema.Messages())))
+	for _, name := range schema.Messages() {
+		Expect(msgs).To(HaveKey(name))
+	}
+	Expect(api.GetRegisteredMessageTypes()["go.fd.io/govpp/api/dynamic"]).To(BeEmpty())
+}
+
+func TestInvoke(t *testing.T) {
+	schema := loadSchema(t)
+	schema.Register()
+
+	conn, err := core.Connect(simulator.NewVppAdapter())
+	Expect(err).ShouldNot(HaveOccurred())
+	defer conn.Disconnect()
+
+	reply := newMessage(schema, "create_loopback_reply", nil)
+	Expect(conn.Invoke(context.Background(), newMessage(schema, "create_loopback", nil), reply)).To(Succeed())
+	Expect(reply.Get("sw_if_index")).To(Equal(uint32(1)))
+
+	stream, err := conn.NewStream(context.Background())
+	Expect(err).ShouldNot(HaveOccurred())
+	defer stream.Close()
+	Expect(stream.SendMsg(newMessage(schema, "sw_interface_set_flags", map[string]interface{}{
+		"sw_if_index": 1,
+		"flags":       uint32(interface_types.IF_STATUS_API_FLAG_ADMIN_UP),
+	}))).To(Succeed())
+	msg, err := stream.RecvMsg()
+	Expect(err).ShouldNot(HaveOccurred())
+	Expect(msg).To(BeAssignableToTypeOf(&dynamic.DynamicMessage{}))
+	Expect(msg.GetMessageName()).To(Equal("sw_interface_set_flags_reply"))
+	Expect(msg.(*dynamic.DynamicMessage).Get("retval")).To(Equal(int32(0)))
+}
@@ -0,0 +1,284 @@
+//  Copyright (c) 2023 Cisco and/or its affiliates.
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at:
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package dynamic
+
+import (
+	"reflect"
+
+	"go.fd.io/govpp/codec"
+)
+
+// The encoding follows the code generated by binapigen, so the dynamic
+// messages are encoded the same way as the generated ones.
+
+func (m *DynamicMessage) Size() int {
+	if m == nil {
+		return 0
+	}
+	return sizeFields(m.desc.fields, m.values)
+}
+
+func (m *DynamicMessage) Marshal(b []byte) ([]byte, error) {
+	if b == nil {
+		b = make([]byte, m.Size())
+	}
+	buf := codec.NewBuffer(b)
+	encodeFields(buf, m.desc.fields, m.values)
+	return buf.Bytes(), nil
+}
+
+func (m *DynamicMessage) Unmarshal(b []byte) error {
+	buf := codec.NewBuffer(b)
+	values := decodeFields(buf, m.desc.fields)
+	if err := buf.Err(); err != nil {
+		return err
+	}
+	m.values = values
+	return nil
+}
+
+// arrayLen returns the number of elements of the array field to encode.
+func arrayLen(f *fieldDesc, value interface{}) int {
+	if f.length > 0 {
+		return f.length
+	}
+	return lengthOf(value)
+}
+
+// index returns the element of the array or nil if out of range.
+func index(value interface{}, i int) interface{} {
+	if i >= lengthOf(value) {
+		return nil
+	}
+	return reflect.ValueOf(value).Index(i).Interface()
+}
+
+func structValues(value interface{}) map[string]interface{} {
+	values, _ := value.(map[string]interface{})
+	return values
+}
+
+func sizeFields(fields []*fieldDesc, values map[string]interface{}) (size int) {
+	for _, f := range fields {
+		size += sizeField(f, values[f.name])
+	}
+	return size
+}
+
+func sizeField(f *fieldDesc, value interface{}) int {
+	switch {
+	case f.sizeOf != "":
+		return baseTypeSizes[f.base]
+	case f.base == "string":
+		if f.length > 0 {
+			return f.length
+		}
+		s, _ := value.(string)
+		return 4 + len(s)
+	case f.base != "":
+		if f.array {
+			return baseTypeSizes[f.base] * arrayLen(f, value)
+		}
+		return baseTypeSizes[f.base]
+	case f.array:
+		var size int
+		for i := 0; i < arrayLen(f, value); i++ {
+			size += sizeType(f.typ, index(value, i))
+		}
+		return size
+	}
+	return sizeType(f.typ, value)
+}
+
+func sizeType(t *typeDesc, value interface{}) int {
+	switch t.kind {
+	case aliasKind:
+		return baseSize(t.base, t.length)
+	case enumKind:
+		return baseTypeSizes[t.base]
+	case structKind:
+		return sizeFields(t.fields, structValues(value))
+	case unionKind:
+		return t.size
+	}
+	return 0
+}
+
+func encodeFields(buf *codec.Buffer, fields []*fieldDesc, values map[string]interface{}) {
+	for _, f := range fields {
+		if f.sizeOf != "" {
+			encodeBase(buf, f.base, uint64(lengthOf(values[f.sizeOf])))
+			continue
+		}
+		encodeField(buf, f, values[f.name])
+	}
+}
+
+func encodeField(buf *codec.Buffer, f *fieldDesc, value interface{}) {
+	switch {
+	case f.base == "string":
+		s, _ := value.(string)
+		buf.EncodeString(s, f.length)
+	case f.base == "u8" && f.array:
+		b, _ := value.([]byte)
+		buf.EncodeBytes(b, f.length)
+	case f.base != "" && f.array:
+		for i := 0; i < arrayLen(f, value); i++ {
+			encodeBase(buf, f.base, index(value, i))
+		}
+	case f.base != "":
+		encodeBase(buf, f.base, value)
+	case f.array:
+		for i := 0; i < arrayLen(f, value); i++ {
+			encodeType(buf, f.typ, index(value, i))
+		}
+	default:
+		encodeType(buf, f.typ, value)
+	}
+}
+
+func encodeType(buf *codec.Buffer, t *typeDesc, value interface{}) {
+	switch t.kind {
+	case aliasKind:
+		encodeField(buf, &fieldDesc{base: t.base, length: t.length, array: t.length > 0}, value)
+	case enumKind:
+		encodeBase(buf, t.base, value)
+	case structKind:
+		encodeFields(buf, t.fields, structValues(value))
+	case unionKind:
+		b, _ := value.([]byte)
+		buf.EncodeBytes(b, t.size)
+	}
+}
+
+// encodeBase encodes the value of base type, nil is encoded as zero value.
+// The lengths of arrays are passed as uint64.
+func encodeBase(buf *codec.Buffer, base string, value interface{}) {
+	if value == nil {
+		value = reflect.Zero(baseGoType(base)).Interface()
+	}
+	v := reflect.ValueOf(value)
+	switch base {
+	case "u8":
+		buf.EncodeUint8(uint8(v.Convert(reflect.TypeOf(uint8(0))).Uint()))
+	case "u16":
+		buf.EncodeUint16(uint16(v.Convert(reflect.TypeOf(uint16(0))).Uint()))
+	case "u32":
+		buf.EncodeUint32(uint32(v.Convert(reflect.TypeOf(uint32(0))).Uint()))
+	case "u64":
+		buf.EncodeUint64(v.Convert(reflect.TypeOf(uint64(0))).Uint())
+	case "i8":
+		buf.EncodeInt8(int8(v.Convert(reflect.TypeOf(int8(0))).Int()))
+	case "i16":
+		buf.EncodeInt16(int16(v.Convert(reflect.TypeOf(int16(0))).Int()))
+	case "i32":
+		buf.EncodeInt32(int32(v.Convert(reflect.TypeOf(int32(0))).Int()))
+	case "i64":
+		buf.EncodeInt64(v.Convert(reflect.TypeOf(int64(0))).Int())
+	case "f64":
+		buf.EncodeFloat64(v.Convert(reflect.TypeOf(float64(0))).Float())
+	case "bool":
+		buf.EncodeBool(v.Bool())
+	}
+}
+
+func decodeFields(buf *codec.Buffer, fields []*fieldDesc) map[string]interface{} {
+	values := make(map[string]interface{}, len(fields))
+	counts := make(map[string]int)
+	for _, f := range fields {
+		if f.sizeOf != "" {
+			counts[f.name] = int(reflect.ValueOf(decodeBase(buf, f.base)).Convert(reflect.TypeOf(0)).Int())
+			continue
+		}
+		values[f.name] = decodeField(buf, f, counts)
+	}
+	return values
+}
+
+func decodeField(buf *codec.Buffer, f *fieldDesc, counts map[string]int) interface{} {
+	n := f.length
+	if f.isVariable() && f.base != "string" {
+		elemSize := 1
+		if f.base != "" {
+			elemSize = baseTypeSizes[f.base]
+		}
+		n = buf.CheckArrayLen(counts[f.sizeFrom], elemSize)
+	}
+	switch {
+	case f.base == "string":
+		return buf.DecodeString(f.length)
+	case f.base == "u8" && f.array:
+		b := make([]byte, n)
+		copy(b, buf.DecodeBytes(n))
+		return b
+	case f.base != "" && f.array:
+		slice := reflect.MakeSlice(reflect.SliceOf(baseGoType(f.base)), n, n)
+		for i := 0; i < n; i++ {
+			slice.Index(i).Set(reflect.ValueOf(decodeBase(buf, f.base)))
+		}
+		return slice.Interface()
+	case f.base != "":
+		return decodeBase(buf, f.base)
+	case f.array:
+		list := make([]interface{}, n)
+		for i := range list {
+			list[i] = decodeType(buf, f.typ)
+		}
+		return list
+	}
+	return decodeType(buf, f.typ)
+}
+
+func decodeType(buf *codec.Buffer, t *typeDesc) interface{} {
+	switch t.kind {
+	case aliasKind:
+		return decodeField(buf, &fieldDesc{base: t.base, length: t.length, array: t.length > 0}, nil)
+	case enumKind:
+		return decodeBase(buf, t.base)
+	case structKind:
+		return decodeFields(buf, t.fields)
+	case unionKind:
+		b := make([]byte, t.size)
+		copy(b, buf.DecodeBytes(t.size))
+		return b
+	}
+	return nil
+}
+
+func decodeBase(buf *codec.Buffer, base string) interface{} {
+	switch base {
+	case "u8":
+		return buf.DecodeUint8()
+	case "u16":
+		return buf.DecodeUint16()
+	case "u32":
+		return buf.DecodeUint32()
+	case "u64":
+		return buf.DecodeUint64()
+	case "i8":
+		return buf.DecodeInt8()
+	case "i16":
+		return buf.DecodeInt16()
+	case "i32":
+		return buf.DecodeInt32()
+	case "i64":
+		return buf.DecodeInt64()
+	case "f64":
+		return buf.DecodeFloat64()
+	case "bool":
+		return buf.DecodeBool()
+	}
+	return nil
+}
@@ -0,0 +1,123 @@
+//  Copyright (c) 2023 Cisco and/or its affiliates.
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at:
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package dynamic
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"reflect"
+)
+
+// MarshalJSON returns the fields of the message as JSON object in the order
+// of definition. The enums are encoded as names if known.
+func (m *DynamicMessage) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	if err := writeFields(&buf, m.desc.fields, m.values); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// UnmarshalJSON sets the fields of the message from JSON object.
+func (m *DynamicMessage) UnmarshalJSON(data []byte) error {
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.UseNumber()
+	var fields map[string]interface{}
+	if err := dec.Decode(&fields); err != nil {
+		return err
+	}
+	m.Reset()
+	for name, value := range fields {
+		if err := m.Set(name, value); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func writeFields(buf *bytes.Buffer, fields []*fieldDesc, values map[string]interface{}) error {
+	buf.WriteByte('{')
+	var n int
+	for _, f := range fields {
+		if f.sizeOf != "" {
+			continue
+		}
+		if n > 0 {
+			buf.WriteByte(',')
+		}
+		n++
+		key, _ := json.Marshal(f.name)
+		buf.Write(key)
+		buf.WriteByte(':')
+		value, ok := values[f.name]
+		if !ok {
+			value = zeroField(f)
+		}
+		if err := writeField(buf, f, value); err != nil {
+			return fmt.Errorf("%s: %w", f.name, err)
+		}
+	}
+	buf.WriteByte('}')
+	return nil
+}
+
+func writeField(buf *bytes.Buffer, f *fieldDesc, value interface{}) error {
+	if f.typ == nil || !f.array {
+		if f.typ != nil {
+			return writeType(buf, f.typ, value)
+		}
+		return writeJSON(buf, value)
+	}
+	buf.WriteByte('[')
+	for i := 0; i < lengthOf(value); i++ {
+		if i > 0 {
+			buf.WriteByte(',')
+		}
+		if err := writeType(buf, f.typ, index(value, i)); err != nil {
+			return err
+		}
+	}
+	buf.WriteByte(']')
+	return nil
+}
+
+func writeType(buf *bytes.Buffer, t *typeDesc, value interface{}) error {
+	switch t.kind {
+	case enumKind:
+		if value != nil {
+			v := reflect.ValueOf(value)
+			n := v.Convert(reflect.TypeOf(uint64(0))).Uint()
+			if name := enumName(t, n); name != "" {
+				return writeJSON(buf, name)
+			}
+		}
+	case structKind:
+		return writeFields(buf, t.fields, structValues(value))
+	}
+	if value == nil {
+		value = zeroType(t)
+	}
+	return writeJSON(buf, value)
+}
+
+func writeJSON(buf *bytes.Buffer, value interface{}) error {
+	b, err := json.Marshal(value)
+	if err != nil {
+		return err
+	}
+	buf.Write(b)
+	return nil
+}
@@ -0,0 +1,505 @@
+//  Copyright (c) 2023 Cisco and/or its affiliates.
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at:
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package dynamic
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"math"
+	"reflect"
+	"strconv"
+	"strings"
+
+	"go.fd.io/govpp/api"
+)
+
+// DynamicMessage is a message defined by the schema loaded at runtime. It
+// implements api.Message and can be used with the connection and channels
+// like the generated messages.
+//
+// The values of the fields are represented by the Go types:
+//   - base types as uint8..uint64, int8..int64, float64, bool and string
+//   - enums as their base type, the names are accepted by Set
+//   - arrays of u8 and unions as []byte, other arrays of base types as slices, e.g. []uint32
+//   - structs as map[string]interface{}
+//   - arrays of other types as []interface{}
+//
+// The fields with the length of other arrays are set automatically when encoding.
+type DynamicMessage struct {
+	desc   *messageDesc
+	values map[string]interface{}
+}
+
+func newDynamicMessage(desc *messageDesc) *DynamicMessage {
+	return &DynamicMessage{
+		desc:   desc,
+		values: make(map[string]interface{}),
+	}
+}
+
+func (m *DynamicMessage) GetMessageName() string          { return m.desc.name }
+func (m *DynamicMessage) GetCrcString() string            { return m.desc.crc }
+func (m *DynamicMessage) GetMessageType() api.MessageType { return m.desc.msgType }
+
+// NewMessage returns a new empty message with the same definition.
+func (m *DynamicMessage) NewMessage() api.Message {
+	return newDynamicMessage(m.desc)
+}
+
+// Reset clears the values of all fields.
+func (m *DynamicMessage) Reset() {
+	m.values = make(map[string]interface{})
+}
+
+// Fields returns the names of the fields in the order of definition.
+func (m *DynamicMessage) Fields() []string {
+	names := make([]string, 0, len(m.desc.fields))
+	for _, f := range m.desc.fields {
+		if f.sizeOf == "" {
+			names = append(names, f.name)
+		}
+	}
+	return names
+}
+
+// Get returns the value of the field, or its zero value if not set.
+func (m *DynamicMessage) Get(name string) (interface{}, error) {
+	f := findField(m.desc.fields, name)
+	if f == nil {
+		return nil, fmt.Errorf("message %s has no field %q", m.desc.name, name)
+	}
+	if f.sizeOf != "" {
+		return convertBase(f.base, lengthOf(m.values[f.sizeOf]))
+	}
+	if v, ok := m.values[name]; ok {
+		return v, nil
+	}
+	return zeroField(f), nil
+}
+
+// Set sets the value of the field. The value is converted to the type
+// representing the field, e.g. any integer type is accepted for u32 field.
+func (m *DynamicMessage) Set(name string, value interface{}) error {
+	f := findField(m.desc.fields, name)
+	if f == nil {
+		return fmt.Errorf("message %s has no field %q", m.desc.name, name)
+	}
+	if f.sizeOf != "" {
+		return fmt.Errorf("field %s is set from length of %s", name, f.sizeOf)
+	}
+	v, err := convertField(f, value)
+	if err != nil {
+		return fmt.Errorf("field %s: %w", name, err)
+	}
+	m.values[name] = v
+	return nil
+}
+
+func findField(fields []*fieldDesc, name string) *fieldDesc {
+	for _, f := range fields {
+		if f.name == name {
+			return f
+		}
+	}
+	return nil
+}
+
+// lengthOf returns the length of the array value.
+func lengthOf(value interface{}) int {
+	if value == nil {
+		return 0
+	}
+	return reflect.ValueOf(value).Len()
+}
+
+func zeroField(f *fieldDesc) interface{} {
+	if f.base == "string" {
+		return ""
+	}
+	if f.array {
+		if f.base == "u8" {
+			return make([]byte, f.length)
+		}
+		if f.base != "" {
+			return reflect.MakeSlice(reflect.SliceOf(baseGoType(f.base)), f.length, f.length).Interface()
+		}
+		list := make([]interface{}, f.length)
+		for i := range list {
+			list[i] = zeroType(f.typ)
+		}
+		return list
+	}
+	if f.base != "" {
+		return reflect.Zero(baseGoType(f.base)).Interface()
+	}
+	return zeroType(f.typ)
+}
+
+func zeroType(t *typeDesc) interface{} {
+	switch t.kind {
+	case aliasKind:
+		return zeroField(&fieldDesc{base: t.base, length: t.length, array: t.length > 0})
+	case enumKind:
+		return reflect.Zero(baseGoType(t.base)).Interface()
+	case structKind:
+		values := make(map[string]interface{}, len(t.fields))
+		for _, f := range t.fields {
+			if f.sizeOf == "" {
+				values[f.name] = zeroField(f)
+			}
+		}
+		return values
+	case unionKind:
+		return make([]byte, t.size)
+	}
+	return nil
+}
+
+var baseGoTypes = map[string]reflect.Type{
+	"u8":     reflect.TypeOf(uint8(0)),
+	"i8":     reflect.TypeOf(int8(0)),
+	"u16":    reflect.TypeOf(uint16(0)),
+	"i16":    reflect.TypeOf(int16(0)),
+	"u32":    reflect.TypeOf(uint32(0)),
+	"i32":    reflect.TypeOf(int32(0)),
+	"u64":    reflect.TypeOf(uint64(0)),
+	"i64":    reflect.TypeOf(int64(0)),
+	"f64":    reflect.TypeOf(float64(0)),
+	"bool":   reflect.TypeOf(false),
+	"string": reflect.TypeOf(""),
+}
+
+func baseGoType(base string) reflect.Type {
+	return baseGoTypes[base]
+}
+
+// convertField converts the value to the type representing the field.
+func convertField(f *fieldDesc, value interface{}) (interface{}, error) {
+	if f.base == "string" {
+		s, ok := value.(string)
+		if !ok {
+			return nil, fmt.Errorf("expected string, got %T", value)
+		}
+		if f.length > 0 && len(s) > f.length {
+			return nil, fmt.Errorf("string longer than %d", f.length)
+		}
+		return s, nil
+	}
+	if !f.array {
+		if f.base != "" {
+			return convertBase(f.base, value)
+		}
+		return convertType(f.typ, value)
+	}
+	if f.base == "u8" {
+		return convertBytes(value, f.length)
+	}
+	list, err := toList(value)
+	if err != nil {
+		return nil, err
+	}
+	if f.length > 0 && len(list) > f.length {
+		return nil, fmt.Errorf("array longer than %d", f.length)
+	}
+	n := len(list)
+	if f.length > 0 {
+		n = f.length
+	}
+	if f.base != "" {
+		slice := reflect.MakeSlice(reflect.SliceOf(baseGoType(f.base)), n, n)
+		for i, elem := range list {
+			v, err := convertBase(f.base, elem)
+			if err != nil {
+				return nil, fmt.Errorf("[%d]: %w", i, err)
+			}
+			slice.Index(i).Set(reflect.ValueOf(v))
+		}
+		return slice.Interface(), nil
+	}
+	values := make([]interface{}, n)
+	for i := range values {
+		if i >= len(list) {
+			values[i] = zeroType(f.typ)
+			continue
+		}
+		v, err := convertType(f.typ, list[i])
+		if err != nil {
+			return nil, fmt.Errorf("[%d]: %w", i, err)
+		}
+		values[i] = v
+	}
+	return values, nil
+}
+
+// convertType converts the value to the type representing the API type.
+func convertType(t *typeDesc, value interface{}) (interface{}, error) {
+	switch t.kind {
+	case aliasKind:
+		return convertField(&fieldDesc{base: t.base, length: t.length, array: t.length > 0}, value)
+	case enumKind:
+		if name, ok := value.(string); ok {
+			n, err := parseEnum(t, name)
+			if err != nil {
+				return nil, err
+			}
+			return convertBase(t.base, n)
+		}
+		return convertBase(t.base, value)
+	case structKind:
+		fields, err := toMap(value)
+		if err != nil {
+			return nil, err
+		}
+		values := make(map[string]interface{}, len(fields))
+		for name, v := range fields {
+			f := findField(t.fields, name)
+			if f == nil {
+				return nil, fmt.Errorf("type %s has no field %q", t.name, name)
+			}
+			if f.sizeOf != "" {
+				continue
+			}
+			if values[name], err = convertField(f, v); err != nil {
+				return nil, fmt.Errorf("%s: %w", name, err)
+			}
+		}
+		return values, nil
+	case unionKind:
+		return convertBytes(value, t.size)
+	}
+	return nil, fmt.Errorf("unsupported type %s", t.name)
+}
+
+// convertBytes converts the value to bytes. Strings are decoded from base64
+// like encoding/json does for []byte.
+func convertBytes(value interface{}, length int) ([]byte, error) {
+	var b []byte
+	switch v := value.(type) {
+	case []byte:
+		b = v
+	case string:
+		var err error
+		if b, err = base64.StdEncoding.DecodeString(v); err != nil {
+			return nil, err
+		}
+	default:
+		list, err := toList(value)
+		if err != nil {
+			return nil, err
+		}
+		b = make([]byte, len(list))
+		for i, elem := range list {
+			n, err := convertBase("u8", elem)
+			if err != nil {
+				return nil, fmt.Errorf("[%d]: %w", i, err)
+			}
+			b[i] = n.(uint8)
+		}
+	}
+	if length > 0 && len(b) > length {
+		return nil, fmt.Errorf("array longer than %d", length)
+	}
+	if len(b) < length {
+		// fixed arrays have always the full length like when decoded
+		b = append(b, make([]byte, length-len(b))...)
+	}
+	return b, nil
+}
+
+func toList(value interface{}) ([]interface{}, error) {
+	if list, ok := value.([]interface{}); ok {
+		return list, nil
+	}
+	v := reflect.ValueOf(value)
+	if v.Kind() != reflect.Slice && v.Kind() != reflect.Array {
+		return nil, fmt.Errorf("expected array, got %T", value)
+	}
+	list := make([]interface{}, v.Len())
+	for i := range list {
+		list[i] = v.Index(i).Interface()
+	}
+	return list, nil
+}
+
+func toMap(value interface{}) (map[string]interface{}, error) {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		return v, nil
+	case map[interface{}]interface{}:
+		m := make(map[string]interface{}, len(v))
+		for key, elem := range v {
+			m[fmt.Sprint(key)] = elem
+		}
+		return m, nil
+	}
+	return nil, fmt.Errorf("expected object, got %T", value)
+}
+
+// convertBase converts the value to the Go type of the base type.
+func convertBase(base string, value interface{}) (interface{}, error) {
+	switch base {
+	case "bool":
+		b, ok := value.(bool)
+		if !ok {
+			return nil, fmt.Errorf("expected bool, got %T", value)
+		}
+		return b, nil
+	case "string":
+		s, ok := value.(string)
+		if !ok {
+			return nil, fmt.Errorf("expected string, got %T", value)
+		}
+		return s, nil
+	case "f64":
+		switch v := value.(type) {
+		case json.Number:
+			return v.Float64()
+		case float32:
+			return float64(v), nil
+		case float64:
+			return v, nil
+		}
+	}
+
+	typ := baseGoType(base)
+	if typ == nil {
+		return nil, fmt.Errorf("unknown base type %q", base)
+	}
+	v := reflect.ValueOf(value)
+	if n, ok := value.(json.Number); ok {
+		v = reflect.ValueOf(n.String())
+	}
+	out := reflect.New(typ).Elem()
+	switch v.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		n := v.Int()
+		if typ.Kind() == reflect.Float64 {
+			out.SetFloat(float64(n))
+		} else if isSigned(typ) {
+			if out.OverflowInt(n) {
+				return nil, fmt.Errorf("value %d overflows %s", n, base)
+			}
+			out.SetInt(n)
+		} else {
+			if n < 0 || out.OverflowUint(uint64(n)) {
+				return nil, fmt.Errorf("value %d overflows %s", n, base)
+			}
+			out.SetUint(uint64(n))
+		}
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		n := v.Uint()
+		if typ.Kind() == reflect.Float64 {
+			out.SetFloat(float64(n))
+		} else if isSigned(typ) {
+			if n > math.MaxInt64 || out.OverflowInt(int64(n)) {
+				return nil, fmt.Errorf("value %d overflows %s", n, base)
+			}
+			out.SetInt(int64(n))
+		} else {
+			if out.OverflowUint(n) {
+				return nil, fmt.Errorf("value %d overflows %s", n, base)
+			}
+			out.SetUint(n)
+		}
+	case reflect.Float32, reflect.Float64:
+		f := v.Float()
+		if f != math.Trunc(f) {
+			return nil, fmt.Errorf("value %v is not integer", f)
+		}
+		return convertBase(base, strconv.FormatFloat(f, 'f', -1, 64))
+	case reflect.String:
+		var err error
+		if typ.Kind() == reflect.Float64 {
+			var f float64
+			if f, err = strconv.ParseFloat(v.String(), 64); err == nil {
+				out.SetFloat(f)
+			}
+		} else if isSigned(typ) {
+			var n int64
+			if n, err = strconv.ParseInt(v.String(), 0, typ.Bits()); err == nil {
+				out.SetInt(n)
+			}
+		} else {
+			var n uint64
+			if n, err = strconv.ParseUint(v.String(), 0, typ.Bits()); err == nil {
+				out.SetUint(n)
+			}
+		}
+		if err != nil {
+			return nil, err
+		}
+	default:
+		return nil, fmt.Errorf("expected number, got %T", value)
+	}
+	return out.Interface(), nil
+}
+
+func isSigned(t reflect.Type) bool {
+	switch t.Kind() {
+	case reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return true
+	}
+	return false
+}
+
+// enumName returns the name of the enum value, names of flags joined by |,
+// or empty string if the value is not known.
+func enumName(t *typeDesc, n uint64) string {
+	for _, e := range t.entries {
+		if uint64(e.Value) == n {
+			return e.Name
+		}
+	}
+	if !t.flags || n == 0 {
+		return ""
+	}
+	var names []string
+	rest := n
+	for _, e := range t.entries {
+		if v := uint64(e.Value); v != 0 && rest&v == v {
+			names = append(names, e.Name)
+			rest &^= v
+		}
+	}
+	if rest != 0 {
+		return ""
+	}
+	return strings.Join(names, "|")
+}
+
+// parseEnum parses the enum value from its name, names of flags joined by |,
+// or a number.
+func parseEnum(t *typeDesc, text string) (uint64, error) {
+	var n uint64
+	for _, name := range strings.Split(text, "|") {
+		name = strings.TrimSpace(name)
+		value, found := uint64(0), false
+		for _, e := range t.entries {
+			if e.Name == name {
+				value, found = uint64(e.Value), true
+				break
+			}
+		}
+		if !found {
+			var err error
+			if value, err = strconv.ParseUint(name, 0, 64); err != nil {
+				return 0, fmt.Errorf("unknown value %q of enum %s", name, t.name)
+			}
+		}
+		n |= value
+	}
+	return n, nil
+}
@@ -0,0 +1,377 @@
+//  Copyright (c) 2023 Cisco and/or its affiliates.
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at:
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package dynamic
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"go.fd.io/govpp/api"
+	"go.fd.io/govpp/binapigen/vppapi"
+)
+
+// common message fields
+const (
+	fieldMsgID       = "_vl_msg_id"
+	fieldClientIndex = "client_index"
+	fieldContext     = "context"
+)
+
+const (
+	apiTypePrefix = "vl_api_"
+	apiTypeSuffix = "_t"
+)
+
+var baseTypeSizes = map[string]int{
+	"u8":     1,
+	"i8":     1,
+	"u16":    2,
+	"i16":    2,
+	"u32":    4,
+	"i32":    4,
+	"u64":    8,
+	"i64":    8,
+	"f64":    8,
+	"bool":   1,
+	"string": 1,
+}
+
+type typeKind int
+
+const (
+	aliasKind typeKind = iota
+	enumKind
+	structKind
+	unionKind
+)
+
+// typeDesc describes the type defined in the VPP API.
+type typeDesc struct {
+	name string
+	kind typeKind
+	// base type of aliases and enums
+	base string
+	// length of alias arrays
+	length int
+	// fields of structs and unions
+	fields []*fieldDesc
+	// entries of enums
+	entries []vppapi.EnumEntry
+	flags   bool
+	// size of unions
+	size int
+}
+
+// fieldDesc describes the field of a message, struct or union.
+type fieldDesc struct {
+	name string
+	// base type or nil if typ is set
+	base   string
+	typ    *typeDesc
+	length int
+	array  bool
+	// name of the field with the length of this array
+	sizeFrom string
+	// name of the array whose length is in this field
+	sizeOf string
+}
+
+// isVariable returns true for arrays with the length given by other field.
+func (f *fieldDesc) isVariable() bool {
+	return f.array && f.length == 0
+}
+
+// messageDesc describes the message defined in the VPP API.
+type messageDesc struct {
+	name    string
+	crc     string
+	msgType api.MessageType
+	fields  []*fieldDesc
+}
+
+// Schema holds the definitions of the messages loaded from VPP API files.
+type Schema struct {
+	messages map[string]*messageDesc // by name and CRC
+	byName   map[string]*messageDesc
+}
+
+// LoadDir loads the schema from the VPP API files (.api.json) in the directory,
+// e.g. vppapi.DefaultDir.
+func LoadDir(apiDir string) (*Schema, error) {
+	files, err := vppapi.ParseDir(apiDir)
+	if err != nil {
+		return nil, err
+	}
+	return NewSchema(files...)
+}
+
+// NewSchema returns the schema with the messages of the parsed VPP API files.
+// The files must include the imported files defining the types used by the messages.
+func NewSchema(files ...*vppapi.File) (*Schema, error) {
+	r := &typeResolver{
+		aliases:  make(map[string]vppapi.AliasType),
+		enums:    make(map[string]vppapi.EnumType),
+		flags:    make(map[string]bool),
+		structs:  make(map[string]vppapi.StructType),
+		unions:   make(map[string]vppapi.UnionType),
+		resolved: make(map[string]*typeDesc),
+	}
+	for _, file := range files {
+		for _, t := range file.AliasTypes {
+			r.aliases[t.Name] = t
+		}
+		for _, t := range file.EnumTypes {
+			r.enums[t.Name] = t
+		}
+		for _, t := range file.EnumflagTypes {
+			r.enums[t.Name] = t
+			r.flags[t.Name] = true
+		}
+		for _, t := range file.StructTypes {
+			r.structs[t.Name] = t
+		}
+		for _, t := range file.UnionTypes {
+			r.unions[t.Name] = t
+		}
+	}
+
+	s := &Schema{
+		messages: make(map[string]*messageDesc),
+		byName:   make(map[string]*messageDesc),
+	}
+	for _, file := range files {
+		for _, msg := range file.Messages {
+			desc, err := r.message(msg)
+			if err != nil {
+				return nil, fmt.Errorf("message %s in %s: %w", msg.Name, file.Name, err)
+			}
+			s.messages[desc.name+"_"+desc.crc] = desc
+			s.byName[desc.name] = desc
+		}
+	}
+	return s, nil
+}
+
+// NewMessage returns a new empty message with the given name. The name can
+// include the CRC (e.g. "show_version_51077d14") to select the exact definition.
+func (s *Schema) NewMessage(name string) (*DynamicMessage, error) {
+	desc, ok := s.messages[name]
+	if !ok {
+		desc, ok = s.byName[name]
+	}
+	if !ok {
+		return nil, fmt.Errorf("unknown message: %s", name)
+	}
+	return newDynamicMessage(desc), nil
+}
+
+// Messages returns the names of all messages in the schema with their CRC.
+func (s *Schema) Messages() []string {
+	names := make([]string, 0, len(s.messages))
+	for name := range s.messages {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// Register registers all messages of the schema like the generated binapi
+// packages do, so the connection resolves their IDs and decodes received
+// messages. The messages are registered by name and CRC under the path of
+// this package. It must be called before connecting to VPP, since the
+// message IDs are resolved on connect.
+func (s *Schema) Register() {
+	for _, name := range s.Messages() {
+		api.RegisterMessage(newDynamicMessage(s.messages[name]), "dynamic."+name)
+	}
+}
+
+// typeResolver resolves the types referenced by fields.
+type typeResolver struct {
+	aliases  map[string]vppapi.AliasType
+	enums    map[string]vppapi.EnumType
+	flags    map[string]bool
+	structs  map[string]vppapi.StructType
+	unions   map[string]vppapi.UnionType
+	resolved map[string]*typeDesc
+}
+
+func (r *typeResolver) message(msg vppapi.Message) (*messageDesc, error) {
+	desc := &messageDesc{
+		name:    msg.Name,
+		crc:     strings.TrimPrefix(msg.CRC, "0x"),
+		msgType: getMsgType(msg),
+	}
+	var n int
+	for _, field := range msg.Fields {
+		if n == 0 {
+			// skip header fields
+			switch strings.ToLower(field.Name) {
+			case fieldMsgID, fieldClientIndex, fieldContext:
+				continue
+			}
+		}
+		n++
+		f, err := r.field(field)
+		if err != nil {
+			return nil, err
+		}
+		desc.fields = append(desc.fields, f)
+	}
+	linkSizeFields(desc.fields)
+	return desc, nil
+}
+
+// getMsgType returns the message type derived from the header fields.
+func getMsgType(msg vppapi.Message) api.MessageType {
+	if len(msg.Fields) < 2 || msg.Fields[0].Name != fieldMsgID {
+		return api.OtherMessage
+	}
+	switch msg.Fields[1].Name {
+	case fieldContext:
+		return api.ReplyMessage
+	case fieldClientIndex:
+		if len(msg.Fields) > 2 && msg.Fields[2].Name == fieldContext {
+			return api.RequestMessage
+		}
+		return api.EventMessage
+	}
+	return api.OtherMessage
+}
+
+func (r *typeResolver) field(field vppapi.Field) (*fieldDesc, error) {
+	f := &fieldDesc{
+		name:     field.Name,
+		length:   field.Length,
+		array:    field.Array,
+		sizeFrom: field.SizeFrom,
+	}
+	if _, ok := baseTypeSizes[field.Type]; ok {
+		f.base = field.Type
+		return f, nil
+	}
+	typ, err := r.resolve(field.Type)
+	if err != nil {
+		return nil, fmt.Errorf("field %s: %w", field.Name, err)
+	}
+	f.typ = typ
+	return f, nil
+}
+
+// resolve returns the description of the type with the given name.
+func (r *typeResolver) resolve(name string) (*typeDesc, error) {
+	name = strings.TrimSuffix(strings.TrimPrefix(name, apiTypePrefix), apiTypeSuffix)
+	if t, ok := r.resolved[name]; ok {
+		return t, nil
+	}
+	t := &typeDesc{name: name}
+	if alias, ok := r.aliases[name]; ok {
+		if _, ok := baseTypeSizes[alias.Type]; !ok {
+			// alias of other type
+			return r.resolve(alias.Type)
+		}
+		t.kind = aliasKind
+		t.base = alias.Type
+		t.length = alias.Length
+	} else if enum, ok := r.enums[name]; ok {
+		t.kind = enumKind
+		t.base = enum.Type
+		if t.base == "" {
+			t.base = "u32"
+		}
+		t.entries = enum.Entries
+		t.flags = r.flags[name]
+	} else if typ, ok := r.structs[name]; ok {
+		t.kind = structKind
+		r.resolved[name] = t
+		for _, field := range typ.Fields {
+			f, err := r.field(field)
+			if err != nil {
+				delete(r.resolved, name)
+				return nil, fmt.Errorf("type %s: %w", name, err)
+			}
+			t.fields = append(t.fields, f)
+		}
+		linkSizeFields(t.fields)
+	} else if union, ok := r.unions[name]; ok {
+		t.kind = unionKind
+		for _, field := range union.Fields {
+			f, err := r.field(field)
+			if err != nil {
+				return nil, fmt.Errorf("union %s: %w", name, err)
+			}
+			t.fields = append(t.fields, f)
+		}
+		t.size = unionSize(t)
+	} else {
+		return nil, fmt.Errorf("unknown type: %q", name)
+	}
+	r.resolved[name] = t
+	return t, nil
+}
+
+// linkSizeFields links the fields holding the lengths of arrays with the arrays.
+func linkSizeFields(fields []*fieldDesc) {
+	for _, f := range fields {
+		if f.sizeFrom == "" {
+			continue
+		}
+		for _, count := range fields {
+			if count.name == f.sizeFrom && count.base != "" {
+				count.sizeOf = f.name
+			}
+		}
+	}
+}
+
+// unionSize returns the size of the largest member of the union, computed
+// the same way as by the binapi generator.
+func unionSize(t *typeDesc) (size int) {
+	for _, f := range t.fields {
+		if n := memberSize(f); n > size {
+			size = n
+		}
+	}
+	return size
+}
+
+func memberSize(f *fieldDesc) int {
+	if f.typ == nil {
+		return baseSize(f.base, f.length)
+	}
+	switch f.typ.kind {
+	case aliasKind:
+		return baseSize(f.typ.base, f.typ.length)
+	case enumKind:
+		return baseSize(f.typ.base, f.length)
+	case structKind:
+		var size int
+		for _, field := range f.typ.fields {
+			size += memberSize(field)
+		}
+		return size
+	case unionKind:
+		return f.typ.size
+	}
+	return 0
+}
+
+func baseSize(base string, length int) int {
+	if length > 1 {
+		return baseTypeSizes[base] * length
+	}
+	return baseTypeSizes[base]
+}
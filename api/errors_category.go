@@ -0,0 +1,256 @@
+//  Copyright (c) 2023 Cisco and/or its affiliates.
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at:
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package api
+
+import (
+	"fmt"
+	"reflect"
+	"sync"
+)
+
+// ErrorCategory is the category of VPPApiError. The categories are sentinel
+// errors, the VPPApiError (or error wrapping it) matches its category
+// using errors.Is:
+//
+//	if errors.Is(err, api.ErrAlreadyExists) {
+//		// nothing to do
+//	}
+type ErrorCategory int
+
+const (
+	// ErrNotFound is the category of errors for missing objects.
+	ErrNotFound ErrorCategory = iota + 1
+	// ErrAlreadyExists is the category of errors for objects that already exist or are in use.
+	ErrAlreadyExists
+	// ErrInvalidArgument is the category of errors for invalid values in the request.
+	ErrInvalidArgument
+	// ErrUnsupported is the category of errors for unsupported or unimplemented operations.
+	ErrUnsupported
+	// ErrRetryable is the category of errors for busy VPP, the request may succeed when retried.
+	ErrRetryable
+)
+
+var errorCategoryNames = map[ErrorCategory]string{
+	ErrNotFound:        "not found",
+	ErrAlreadyExists:   "already exists",
+	ErrInvalidArgument: "invalid argument",
+	ErrUnsupported:     "unsupported",
+	ErrRetryable:       "retryable",
+}
+
+func (c ErrorCategory) String() string {
+	if s, ok := errorCategoryNames[c]; ok {
+		return s
+	}
+	return fmt.Sprintf("ErrorCategory(%d)", int(c))
+}
+
+func (c ErrorCategory) Error() string {
+	return "VPPApiError: " + c.String()
+}
+
+// Category returns the category of the error or zero if the error is not
+// classified. Only the vnet errors (the constants of this package) are known,
+// the errors defined by the plugins are classified by RequestError.
+func (e VPPApiError) Category() ErrorCategory {
+	return vppApiErrorCategories[e]
+}
+
+// Is reports whether the error belongs to the target ErrorCategory.
+func (e VPPApiError) Is(target error) bool {
+	c, ok := target.(ErrorCategory)
+	return ok && c != 0 && e.Category() == c
+}
+
+type registeredErrorKey struct {
+	pkg  string
+	code VPPApiError
+}
+
+type registeredError struct {
+	message  string
+	category ErrorCategory
+}
+
+var (
+	registeredErrorsMu sync.RWMutex
+	registeredErrors   = map[registeredErrorKey]registeredError{}
+)
+
+// RegisterVPPApiError registers the error code defined by VPP plugin with
+// its description and category (zero if not classified). The code is valid
+// only for the messages of the binapi package with the Go import path pkg,
+// since the plugins may define the same codes with different meaning. It is
+// called from the packages generated with the "errors" plugin of
+// binapi-generator. The codes defined in vnet (the constants of this package)
+// cannot be redefined.
+func RegisterVPPApiError(pkg string, code VPPApiError, message string, category ErrorCategory) {
+	if _, ok := vppApiErrors[code]; ok {
+		return
+	}
+	registeredErrorsMu.Lock()
+	defer registeredErrorsMu.Unlock()
+	registeredErrors[registeredErrorKey{pkg: pkg, code: code}] = registeredError{message: message, category: category}
+}
+
+// lookupRegisteredError returns the error registered for the package.
+func lookupRegisteredError(pkg string, code VPPApiError) (registeredError, bool) {
+	registeredErrorsMu.RLock()
+	defer registeredErrorsMu.RUnlock()
+	r, ok := registeredErrors[registeredErrorKey{pkg: pkg, code: code}]
+	return r, ok
+}
+
+// RequestError is the error of the request whose reply contained non-zero
+// retval. It unwraps to VPPApiError, so it can be inspected using errors.Is
+// with the error categories or errors.As with VPPApiError. The retvals of the
+// plugins are described and classified using the errors registered for the
+// package of the request message.
+type RequestError struct {
+	// Request is the name of the request message.
+	Request string
+	// Package is the Go import path of the binapi package of the request message.
+	Package string
+	// Retval is the retval of the reply.
+	Retval int32
+}
+
+// RetvalToRequestError returns RequestError for the request message and retval
+// value. Retval 0 returns nil error.
+func RetvalToRequestError(request Message, retval int32) error {
+	if retval == 0 {
+		return nil
+	}
+	t := reflect.TypeOf(request)
+	if t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	return &RequestError{Request: request.GetMessageName(), Package: t.PkgPath(), Retval: retval}
+}
+
+func (e *RequestError) Error() string {
+	if r, ok := lookupRegisteredError(e.Package, VPPApiError(e.Retval)); ok {
+		return fmt.Sprintf("request %s failed: VPPApiError: %s (%d)", e.Request, r.message, e.Retval)
+	}
+	return fmt.Sprintf("request %s failed: %v", e.Request, VPPApiError(e.Retval))
+}
+
+// Category returns the category of the retval or zero if it is not classified.
+func (e *RequestError) Category() ErrorCategory {
+	if r, ok := lookupRegisteredError(e.Package, VPPApiError(e.Retval)); ok {
+		return r.category
+	}
+	return VPPApiError(e.Retval).Category()
+}
+
+// Is reports whether the error belongs to the target ErrorCategory.
+func (e *RequestError) Is(target error) bool {
+	c, ok := target.(ErrorCategory)
+	return ok && c != 0 && e.Category() == c
+}
+
+// Unwrap returns the VPPApiError of the retval, so errors.Is and errors.As
+// keep matching the VPPApiError values returned before RequestError was added.
+func (e *RequestError) Unwrap() error {
+	return VPPApiError(e.Retval)
+}
+
+var vppApiErrorCategories = map[VPPApiError]ErrorCategory{
+	INVALID_SW_IF_INDEX:                ErrNotFound,
+	NO_SUCH_FIB:                        ErrNotFound,
+	NO_SUCH_INNER_FIB:                  ErrNotFound,
+	NO_SUCH_LABEL:                      ErrNotFound,
+	NO_SUCH_ENTRY:                      ErrNotFound,
+	INVALID_SW_IF_INDEX_2:              ErrNotFound,
+	NEXT_HOP_NOT_IN_FIB:                ErrNotFound,
+	UNKNOWN_DESTINATION:                ErrNotFound,
+	NEXT_HOP_NOT_FOUND_MP:              ErrNotFound,
+	NO_MATCHING_INTERFACE:              ErrNotFound,
+	ADDRESS_NOT_FOUND_FOR_INTERFACE:    ErrNotFound,
+	NO_SUCH_NODE:                       ErrNotFound,
+	NO_SUCH_NODE2:                      ErrNotFound,
+	NO_SUCH_TABLE:                      ErrNotFound,
+	NO_SUCH_TABLE2:                     ErrNotFound,
+	NO_SUCH_TABLE3:                     ErrNotFound,
+	SR_POLICY_NAME_NOT_PRESENT:         ErrNotFound,
+	IP6_MULTICAST_ADDRESS_NOT_PRESENT:  ErrNotFound,
+	CLASSIFY_TABLE_NOT_FOUND:           ErrNotFound,
+	INVALID_SUB_SW_IF_INDEX:            ErrNotFound,
+	BFD_ENOENT:                         ErrNotFound,
+	ADDRESS_NOT_IN_USE:                 ErrNotFound,
+	SUBIF_DOESNT_EXIST:                 ErrNotFound,
+	NAME_SERVER_NOT_FOUND:              ErrNotFound,
+	INVALID_SESSION_ID:                 ErrNotFound,
+	VLAN_ALREADY_EXISTS:                ErrAlreadyExists,
+	SUBIF_ALREADY_EXISTS:               ErrAlreadyExists,
+	TUNNEL_EXIST:                       ErrAlreadyExists,
+	IF_ALREADY_EXISTS:                  ErrAlreadyExists,
+	VALUE_EXIST:                        ErrAlreadyExists,
+	ALREADY_CONNECTED:                  ErrAlreadyExists,
+	BFD_EEXIST:                         ErrAlreadyExists,
+	BFD_EINUSE:                         ErrAlreadyExists,
+	ADDRESS_IN_USE:                     ErrAlreadyExists,
+	ADDRESS_FOUND_FOR_INTERFACE:        ErrAlreadyExists,
+	ENTRY_ALREADY_EXISTS:               ErrAlreadyExists,
+	BD_ALREADY_EXISTS:                  ErrAlreadyExists,
+	BD_IN_USE:                          ErrAlreadyExists,
+	APP_ALREADY_ATTACHED:               ErrAlreadyExists,
+	DUPLICATE_IF_ADDRESS:               ErrAlreadyExists,
+	ACL_IN_USE_INBOUND:                 ErrAlreadyExists,
+	ACL_IN_USE_OUTBOUND:                ErrAlreadyExists,
+	INSTANCE_IN_USE:                    ErrAlreadyExists,
+	ACL_IN_USE_BY_LOOKUP_CONTEXT:       ErrAlreadyExists,
+	BD_ALREADY_HAS_BVI:                 ErrAlreadyExists,
+	INVALID_VALUE:                      ErrInvalidArgument,
+	INVALID_VALUE_2:                    ErrInvalidArgument,
+	INVALID_VALUE_3:                    ErrInvalidArgument,
+	INVALID_REGISTRATION:               ErrInvalidArgument,
+	PREFIX_MATCHES_NEXT_HOP:            ErrInvalidArgument,
+	INVALID_VLAN:                       ErrInvalidArgument,
+	INVALID_SRC_ADDRESS:                ErrInvalidArgument,
+	INVALID_DST_ADDRESS:                ErrInvalidArgument,
+	ADDRESS_LENGTH_MISMATCH:            ErrInvalidArgument,
+	INVALID_MEMORY_SIZE:                ErrInvalidArgument,
+	INVALID_INTERFACE:                  ErrInvalidArgument,
+	INVALID_VLAN_TAG_COUNT:             ErrInvalidArgument,
+	INVALID_ARGUMENT:                   ErrInvalidArgument,
+	INVALID_DECAP_NEXT:                 ErrInvalidArgument,
+	SAME_SRC_DST:                       ErrInvalidArgument,
+	INVALID_WORKER:                     ErrInvalidArgument,
+	INVALID_EID_TYPE:                   ErrInvalidArgument,
+	INCORRECT_ADJACENCY_TYPE:           ErrInvalidArgument,
+	EXCEEDED_NUMBER_OF_RANGES_CAPACITY: ErrInvalidArgument,
+	EXCEEDED_NUMBER_OF_PORTS_CAPACITY:  ErrInvalidArgument,
+	INVALID_ADDRESS_FAMILY:             ErrInvalidArgument,
+	TABLE_TOO_BIG:                      ErrInvalidArgument,
+	BD_ID_EXCEED_MAX:                   ErrInvalidArgument,
+	INVALID_QUEUE:                      ErrInvalidArgument,
+	APP_INVALID_NS:                     ErrInvalidArgument,
+	ILLEGAL_NAME:                       ErrInvalidArgument,
+	NON_ETHERNET:                       ErrInvalidArgument,
+	UNIMPLEMENTED:                      ErrUnsupported,
+	FEATURE_DISABLED:                   ErrUnsupported,
+	UNSUPPORTED_JNI_VERSION:            ErrUnsupported,
+	LISP_DISABLED:                      ErrUnsupported,
+	BFD_NOTSUPP:                        ErrUnsupported,
+	APP_UNSUPPORTED_CFG:                ErrUnsupported,
+	UNSUPPORTED:                        ErrUnsupported,
+	NAME_RESOLUTION_NOT_ENABLED:        ErrUnsupported,
+	BIER_BSL_UNSUP:                     ErrUnsupported,
+	IN_PROGRESS:                        ErrRetryable,
+	RESPONSE_NOT_READY:                 ErrRetryable,
+	QUEUE_FULL:                         ErrRetryable,
+	BFD_EAGAIN:                         ErrRetryable,
+}
@@ -1,6 +1,8 @@
 package api
 
 import (
+	"errors"
+	"fmt"
 	"testing"
 
 	. "github.com/onsi/gomega"
@@ -21,3 +23,69 @@ func TestUnknown(t *testing.T) {
 	errstr := err.Error()
 	Expect(errstr).Should(BeEquivalentTo("VPPApiError: -999"))
 }
+
+func TestCategory(t *testing.T) {
+	RegisterTestingT(t)
+
+	var err error = NO_SUCH_ENTRY
+	Expect(errors.Is(err, ErrNotFound)).To(BeTrue())
+	Expect(errors.Is(err, ErrAlreadyExists)).To(BeFalse())
+	Expect(errors.Is(VPPApiError(-999), ErrNotFound)).To(BeFalse())
+	Expect(errors.Is(UNSPECIFIED, ErrorCategory(0))).To(BeFalse())
+
+	Expect(errors.Is(IF_ALREADY_EXISTS, ErrAlreadyExists)).To(BeTrue())
+	Expect(errors.Is(INVALID_VALUE, ErrInvalidArgument)).To(BeTrue())
+	Expect(errors.Is(UNSUPPORTED, ErrUnsupported)).To(BeTrue())
+	Expect(errors.Is(QUEUE_FULL, ErrRetryable)).To(BeTrue())
+}
+
+// errorTestMsg is a request of the test package registering the errors.
+type errorTestMsg struct{ name string }
+
+func (m *errorTestMsg) GetMessageName() string    { return m.name }
+func (*errorTestMsg) GetCrcString() string        { return "00000000" }
+func (*errorTestMsg) GetMessageType() MessageType { return RequestMessage }
+
+const errorTestPkg = "go.fd.io/govpp/api"
+
+func TestRequestError(t *testing.T) {
+	RegisterTestingT(t)
+
+	Expect(RetvalToRequestError(&errorTestMsg{"create_loopback"}, 0)).To(Succeed())
+
+	err := RetvalToRequestError(&errorTestMsg{"bridge_domain_add_del"}, int32(BD_ALREADY_EXISTS))
+	Expect(err).Should(MatchError("request bridge_domain_add_del failed: VPPApiError: Bridge domain already exists (-119)"))
+	Expect(errors.Is(err, ErrAlreadyExists)).To(BeTrue())
+
+	wrapped := fmt.Errorf("configuring bridge domain: %w", err)
+	var reqErr *RequestError
+	Expect(errors.As(wrapped, &reqErr)).To(BeTrue())
+	Expect(reqErr.Request).To(Equal("bridge_domain_add_del"))
+	Expect(reqErr.Package).To(Equal(errorTestPkg))
+	Expect(reqErr.Retval).To(BeEquivalentTo(-119))
+	var apiErr VPPApiError
+	Expect(errors.As(wrapped, &apiErr)).To(BeTrue())
+	Expect(apiErr).To(Equal(BD_ALREADY_EXISTS))
+}
+
+func TestRegisterVPPApiError(t *testing.T) {
+	RegisterTestingT(t)
+
+	RegisterVPPApiError(errorTestPkg, -1000, "No such pool", ErrNotFound)
+	RegisterVPPApiError("example.com/binapi/other", -1000, "Pool busy", ErrRetryable)
+
+	err := RetvalToRequestError(&errorTestMsg{"pool_del"}, -1000)
+	Expect(err).Should(MatchError("request pool_del failed: VPPApiError: No such pool (-1000)"))
+	Expect(errors.Is(err, ErrNotFound)).To(BeTrue())
+	Expect(errors.Is(err, ErrRetryable)).To(BeFalse())
+
+	// the code is not known without the package
+	Expect(VPPApiError(-1000).Error()).To(Equal("VPPApiError: -1000"))
+	Expect(errors.Is(VPPApiError(-1000), ErrNotFound)).To(BeFalse())
+
+	// vnet errors cannot be redefined
+	RegisterVPPApiError(errorTestPkg, NO_SUCH_ENTRY, "Pool not found", ErrRetryable)
+	err = RetvalToRequestError(&errorTestMsg{"pool_del"}, int32(NO_SUCH_ENTRY))
+	Expect(err).Should(MatchError("request pool_del failed: VPPApiError: No such entry (-6)"))
+	Expect(errors.Is(err, ErrNotFound)).To(BeTrue())
+}
@@ -14,6 +14,8 @@
 
 package api
 
+import "time"
+
 // StatsProvider provides methods for retrieving statistics.
 type StatsProvider interface {
 	GetSystemStats(*SystemStats) error
@@ -22,6 +24,7 @@ type StatsProvider interface {
 	GetErrorStats(*ErrorStats) error
 	GetBufferStats(*BufferStats) error
 	GetMemoryStats(*MemoryStats) error
+	GetNetworkStats(*NetworkStats) error
 }
 
 // SystemStats represents global system statistics.
@@ -137,3 +140,136 @@ type MemoryCounters struct {
 	FreeChunks uint64
 	Releasable uint64
 }
+
+// NetworkStats represents per FIB entry statistics.
+type NetworkStats struct {
+	Routes      []RouteCounters
+	MRoutes     []NetworkCounter
+	Adjacencies []NetworkCounter
+	Punts       []NetworkCounter
+}
+
+// RouteCounters represents counters of the FIB entry. The counters are
+// indexed by the stats index of the route (its load-balance index).
+type RouteCounters struct {
+	StatsIndex uint32
+
+	// TableID and Prefix identify the route, they are set only if the route
+	// was resolved, e.g. by the ip_route_dump.
+	TableID uint32
+	Prefix  string
+
+	// To counts traffic forwarded to the prefix.
+	To CombinedCounter
+	// Via counts traffic forwarded via the prefix as recursive next-hop.
+	Via CombinedCounter
+}
+
+// RouteInfo identifies the FIB entry with the stats index, it is used
+// to resolve the route counters.
+type RouteInfo struct {
+	StatsIndex uint32
+	TableID    uint32
+	Prefix     string
+}
+
+// NetworkCounter represents counter of the network object with the stats index.
+type NetworkCounter struct {
+	StatsIndex uint32
+
+	CombinedCounter
+}
+
+// CombinedCounter defines combined packets and bytes counters.
+type CombinedCounter struct {
+	Packets uint64
+	Bytes   uint64
+}
+
+// StatsRates represents per-second rates of the counters computed from two
+// consecutive samples of the stats.
+type StatsRates struct {
+	Timestamp time.Time
+	// Interval is the time elapsed since the previous sample.
+	Interval time.Duration
+	// Reset is true if the counters were cleared since the previous sample,
+	// the rates are not computed for such sample.
+	Reset bool
+
+	Interfaces []InterfaceRates
+	Nodes      []NodeRates
+	Errors     []ErrorRate
+}
+
+// InterfaceRates represents per-second rates of interface counters.
+type InterfaceRates struct {
+	InterfaceIndex uint32
+	InterfaceName  string
+
+	RxPps float64 // received packets per second
+	RxBps float64 // received bits per second
+	TxPps float64 // transmitted packets per second
+	TxBps float64 // transmitted bits per second
+
+	RxErrors float64
+	TxErrors float64
+	Drops    float64
+	RxNoBuf  float64
+	RxMiss   float64
+}
+
+// NodeRates represents per-second rates of node counters.
+type NodeRates struct {
+	NodeIndex uint32
+	NodeName  string
+
+	Clocks   float64
+	Vectors  float64
+	Calls    float64
+	Suspends float64
+
+	// VectorsPerCall is the average number of vectors processed per call
+	// during the interval.
+	VectorsPerCall float64
+}
+
+// ErrorRate represents per-second rate of error counter summed for all workers.
+type ErrorRate struct {
+	CounterName string
+
+	Rate float64
+}
+
+// StatKind is the kind of the stat value.
+type StatKind int
+
+const (
+	// ScalarStatKind is the kind of scalar stats, e.g. /sys/vector_rate.
+	ScalarStatKind StatKind = iota + 1
+	// CounterStatKind is the kind of simple and error counters, e.g. /if/drops.
+	CounterStatKind
+	// CombinedStatKind is the kind of combined (packets and bytes) counters, e.g. /if/rx.
+	CombinedStatKind
+	// NameStatKind is the kind of name vectors, e.g. /if/names.
+	NameStatKind
+)
+
+// StatValue represents values of the stat entry summed for all threads.
+// The vectors are indexed by the object index, e.g. the interface index.
+type StatValue struct {
+	Name string
+	Kind StatKind
+
+	// Symlink is true for symlinks (e.g. /interfaces/<name>/rx), their vectors
+	// contain only the value on the index the symlink points to. Target and
+	// TargetIndex are the name of the stat and the index in its vectors
+	// (e.g. /if/rx and the interface index), if resolved.
+	Symlink     bool
+	Target      string
+	TargetIndex uint32
+
+	Scalar   float64
+	Counters []uint64
+	Combined []CombinedCounter
+	Names    []string
+}
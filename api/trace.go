@@ -43,4 +43,15 @@ type Record struct {
 	Timestamp  time.Time
 	IsReceived bool
 	ChannelID  uint16
+
+	// Context is the context of the message sent to or received from VPP.
+	// It is zero for messages without context (e.g. events).
+	Context uint32
+
+	// SeqNum is the sequence number of the request the message belongs to.
+	SeqNum uint16
+
+	// Latency is the time elapsed since the request was sent. It is only set
+	// for the received replies.
+	Latency time.Duration
 }
@@ -91,7 +91,7 @@ func (m *AbfItfAttachAddDel) Unmarshal(b []byte) error {
 	m.Attach.SwIfIndex = interface_types.InterfaceIndex(buf.DecodeUint32())
 	m.Attach.Priority = buf.DecodeUint32()
 	m.Attach.IsIPv6 = buf.DecodeBool()
-	return nil
+	return buf.Err()
 }
 
 // AbfItfAttachAddDelReply defines message 'abf_itf_attach_add_del_reply'.
@@ -125,7 +125,7 @@ func (m *AbfItfAttachAddDelReply) Marshal(b []byte) ([]byte, error) {
 func (m *AbfItfAttachAddDelReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
-	return nil
+	return buf.Err()
 }
 
 // AbfItfAttachDetails defines message 'abf_itf_attach_details'.
@@ -168,7 +168,7 @@ func (m *AbfItfAttachDetails) Unmarshal(b []byte) error {
 	m.Attach.SwIfIndex = interface_types.InterfaceIndex(buf.DecodeUint32())
 	m.Attach.Priority = buf.DecodeUint32()
 	m.Attach.IsIPv6 = buf.DecodeBool()
-	return nil
+	return buf.Err()
 }
 
 // AbfItfAttachDump defines message 'abf_itf_attach_dump'.
@@ -262,7 +262,7 @@ func (m *AbfPluginGetVersionReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Major = buf.DecodeUint32()
 	m.Minor = buf.DecodeUint32()
-	return nil
+	return buf.Err()
 }
 
 // AbfPolicyAddDel defines message 'abf_policy_add_del'.
@@ -357,7 +357,7 @@ func (m *AbfPolicyAddDel) Unmarshal(b []byte) error {
 	m.Policy.PolicyID = buf.DecodeUint32()
 	m.Policy.ACLIndex = buf.DecodeUint32()
 	m.Policy.NPaths = buf.DecodeUint8()
-	m.Policy.Paths = make([]fib_types.FibPath, m.Policy.NPaths)
+	m.Policy.Paths = make([]fib_types.FibPath, buf.CheckArrayLen(int(m.Policy.NPaths), 1))
 	for j1 := 0; j1 < len(m.Policy.Paths); j1++ {
 		m.Policy.Paths[j1].SwIfIndex = buf.DecodeUint32()
 		m.Policy.Paths[j1].TableID = buf.DecodeUint32()
@@ -379,7 +379,7 @@ func (m *AbfPolicyAddDel) Unmarshal(b []byte) error {
 			m.Policy.Paths[j1].LabelStack[j2].Exp = buf.DecodeUint8()
 		}
 	}
-	return nil
+	return buf.Err()
 }
 
 // AbfPolicyAddDelReply defines message 'abf_policy_add_del_reply'.
@@ -413,7 +413,7 @@ func (m *AbfPolicyAddDelReply) Marshal(b []byte) ([]byte, error) {
 func (m *AbfPolicyAddDelReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
-	return nil
+	return buf.Err()
 }
 
 // AbfPolicyDetails defines message 'abf_policy_details'.
@@ -504,7 +504,7 @@ func (m *AbfPolicyDetails) Unmarshal(b []byte) error {
 	m.Policy.PolicyID = buf.DecodeUint32()
 	m.Policy.ACLIndex = buf.DecodeUint32()
 	m.Policy.NPaths = buf.DecodeUint8()
-	m.Policy.Paths = make([]fib_types.FibPath, m.Policy.NPaths)
+	m.Policy.Paths = make([]fib_types.FibPath, buf.CheckArrayLen(int(m.Policy.NPaths), 1))
 	for j1 := 0; j1 < len(m.Policy.Paths); j1++ {
 		m.Policy.Paths[j1].SwIfIndex = buf.DecodeUint32()
 		m.Policy.Paths[j1].TableID = buf.DecodeUint32()
@@ -526,7 +526,7 @@ func (m *AbfPolicyDetails) Unmarshal(b []byte) error {
 			m.Policy.Paths[j1].LabelStack[j2].Exp = buf.DecodeUint8()
 		}
 	}
-	return nil
+	return buf.Err()
 }
 
 // AbfPolicyDump defines message 'abf_policy_dump'.
@@ -34,7 +34,7 @@ func (c *serviceClient) AbfItfAttachAddDel(ctx context.Context, in *AbfItfAttach
 	if err != nil {
 		return nil, err
 	}
-	return out, api.RetvalToVPPApiError(out.Retval)
+	return out, api.RetvalToRequestError(in, out.Retval)
 }
 
 func (c *serviceClient) AbfItfAttachDump(ctx context.Context, in *AbfItfAttachDump) (RPCService_AbfItfAttachDumpClient, error) {
@@ -95,7 +95,7 @@ func (c *serviceClient) AbfPolicyAddDel(ctx context.Context, in *AbfPolicyAddDel
 	if err != nil {
 		return nil, err
 	}
-	return out, api.RetvalToVPPApiError(out.Retval)
+	return out, api.RetvalToRequestError(in, out.Retval)
 }
 
 func (c *serviceClient) AbfPolicyDump(ctx context.Context, in *AbfPolicyDump) (RPCService_AbfPolicyDumpClient, error) {
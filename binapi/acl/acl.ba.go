@@ -111,7 +111,7 @@ func (m *ACLAddReplace) Unmarshal(b []byte) error {
 	m.ACLIndex = buf.DecodeUint32()
 	m.Tag = buf.DecodeString(64)
 	m.Count = buf.DecodeUint32()
-	m.R = make([]acl_types.ACLRule, m.Count)
+	m.R = make([]acl_types.ACLRule, buf.CheckArrayLen(int(m.Count), 1))
 	for j0 := 0; j0 < len(m.R); j0++ {
 		m.R[j0].IsPermit = acl_types.ACLAction(buf.DecodeUint8())
 		m.R[j0].SrcPrefix.Address.Af = ip_types.AddressFamily(buf.DecodeUint8())
@@ -128,7 +128,7 @@ func (m *ACLAddReplace) Unmarshal(b []byte) error {
 		m.R[j0].TCPFlagsMask = buf.DecodeUint8()
 		m.R[j0].TCPFlagsValue = buf.DecodeUint8()
 	}
-	return nil
+	return buf.Err()
 }
 
 // ACLAddReplaceReply defines message 'acl_add_replace_reply'.
@@ -165,7 +165,7 @@ func (m *ACLAddReplaceReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.ACLIndex = buf.DecodeUint32()
 	m.Retval = buf.DecodeInt32()
-	return nil
+	return buf.Err()
 }
 
 // ACLDel defines message 'acl_del'.
@@ -198,7 +198,7 @@ func (m *ACLDel) Marshal(b []byte) ([]byte, error) {
 func (m *ACLDel) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.ACLIndex = buf.DecodeUint32()
-	return nil
+	return buf.Err()
 }
 
 // ACLDelReply defines message 'acl_del_reply'.
@@ -231,7 +231,7 @@ func (m *ACLDelReply) Marshal(b []byte) ([]byte, error) {
 func (m *ACLDelReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
-	return nil
+	return buf.Err()
 }
 
 // ACLDetails defines message 'acl_details'.
@@ -314,7 +314,7 @@ func (m *ACLDetails) Unmarshal(b []byte) error {
 	m.ACLIndex = buf.DecodeUint32()
 	m.Tag = buf.DecodeString(64)
 	m.Count = buf.DecodeUint32()
-	m.R = make([]acl_types.ACLRule, m.Count)
+	m.R = make([]acl_types.ACLRule, buf.CheckArrayLen(int(m.Count), 1))
 	for j0 := 0; j0 < len(m.R); j0++ {
 		m.R[j0].IsPermit = acl_types.ACLAction(buf.DecodeUint8())
 		m.R[j0].SrcPrefix.Address.Af = ip_types.AddressFamily(buf.DecodeUint8())
@@ -331,7 +331,7 @@ func (m *ACLDetails) Unmarshal(b []byte) error {
 		m.R[j0].TCPFlagsMask = buf.DecodeUint8()
 		m.R[j0].TCPFlagsValue = buf.DecodeUint8()
 	}
-	return nil
+	return buf.Err()
 }
 
 // ACLDump defines message 'acl_dump'.
@@ -364,7 +364,7 @@ func (m *ACLDump) Marshal(b []byte) ([]byte, error) {
 func (m *ACLDump) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.ACLIndex = buf.DecodeUint32()
-	return nil
+	return buf.Err()
 }
 
 // ACLInterfaceAddDel defines message 'acl_interface_add_del'.
@@ -409,7 +409,7 @@ func (m *ACLInterfaceAddDel) Unmarshal(b []byte) error {
 	m.IsInput = buf.DecodeBool()
 	m.SwIfIndex = interface_types.InterfaceIndex(buf.DecodeUint32())
 	m.ACLIndex = buf.DecodeUint32()
-	return nil
+	return buf.Err()
 }
 
 // ACLInterfaceAddDelReply defines message 'acl_interface_add_del_reply'.
@@ -442,7 +442,7 @@ func (m *ACLInterfaceAddDelReply) Marshal(b []byte) ([]byte, error) {
 func (m *ACLInterfaceAddDelReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
-	return nil
+	return buf.Err()
 }
 
 // ACLInterfaceEtypeWhitelistDetails defines message 'acl_interface_etype_whitelist_details'.
@@ -494,11 +494,11 @@ func (m *ACLInterfaceEtypeWhitelistDetails) Unmarshal(b []byte) error {
 	m.SwIfIndex = interface_types.InterfaceIndex(buf.DecodeUint32())
 	m.Count = buf.DecodeUint8()
 	m.NInput = buf.DecodeUint8()
-	m.Whitelist = make([]uint16, m.Count)
+	m.Whitelist = make([]uint16, buf.CheckArrayLen(int(m.Count), 2))
 	for i := 0; i < len(m.Whitelist); i++ {
 		m.Whitelist[i] = buf.DecodeUint16()
 	}
-	return nil
+	return buf.Err()
 }
 
 // ACLInterfaceEtypeWhitelistDump defines message 'acl_interface_etype_whitelist_dump'.
@@ -533,7 +533,7 @@ func (m *ACLInterfaceEtypeWhitelistDump) Marshal(b []byte) ([]byte, error) {
 func (m *ACLInterfaceEtypeWhitelistDump) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.SwIfIndex = interface_types.InterfaceIndex(buf.DecodeUint32())
-	return nil
+	return buf.Err()
 }
 
 // ACLInterfaceListDetails defines message 'acl_interface_list_details'.
@@ -583,11 +583,11 @@ func (m *ACLInterfaceListDetails) Unmarshal(b []byte) error {
 	m.SwIfIndex = interface_types.InterfaceIndex(buf.DecodeUint32())
 	m.Count = buf.DecodeUint8()
 	m.NInput = buf.DecodeUint8()
-	m.Acls = make([]uint32, m.Count)
+	m.Acls = make([]uint32, buf.CheckArrayLen(int(m.Count), 4))
 	for i := 0; i < len(m.Acls); i++ {
 		m.Acls[i] = buf.DecodeUint32()
 	}
-	return nil
+	return buf.Err()
 }
 
 // ACLInterfaceListDump defines message 'acl_interface_list_dump'.
@@ -620,7 +620,7 @@ func (m *ACLInterfaceListDump) Marshal(b []byte) ([]byte, error) {
 func (m *ACLInterfaceListDump) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.SwIfIndex = interface_types.InterfaceIndex(buf.DecodeUint32())
-	return nil
+	return buf.Err()
 }
 
 // ACLInterfaceSetACLList defines message 'acl_interface_set_acl_list'.
@@ -670,11 +670,11 @@ func (m *ACLInterfaceSetACLList) Unmarshal(b []byte) error {
 	m.SwIfIndex = interface_types.InterfaceIndex(buf.DecodeUint32())
 	m.Count = buf.DecodeUint8()
 	m.NInput = buf.DecodeUint8()
-	m.Acls = make([]uint32, m.Count)
+	m.Acls = make([]uint32, buf.CheckArrayLen(int(m.Count), 4))
 	for i := 0; i < len(m.Acls); i++ {
 		m.Acls[i] = buf.DecodeUint32()
 	}
-	return nil
+	return buf.Err()
 }
 
 // ACLInterfaceSetACLListReply defines message 'acl_interface_set_acl_list_reply'.
@@ -709,7 +709,7 @@ func (m *ACLInterfaceSetACLListReply) Marshal(b []byte) ([]byte, error) {
 func (m *ACLInterfaceSetACLListReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
-	return nil
+	return buf.Err()
 }
 
 // ACLInterfaceSetEtypeWhitelist defines message 'acl_interface_set_etype_whitelist'.
@@ -761,11 +761,11 @@ func (m *ACLInterfaceSetEtypeWhitelist) Unmarshal(b []byte) error {
 	m.SwIfIndex = interface_types.InterfaceIndex(buf.DecodeUint32())
 	m.Count = buf.DecodeUint8()
 	m.NInput = buf.DecodeUint8()
-	m.Whitelist = make([]uint16, m.Count)
+	m.Whitelist = make([]uint16, buf.CheckArrayLen(int(m.Count), 2))
 	for i := 0; i < len(m.Whitelist); i++ {
 		m.Whitelist[i] = buf.DecodeUint16()
 	}
-	return nil
+	return buf.Err()
 }
 
 // ACLInterfaceSetEtypeWhitelistReply defines message 'acl_interface_set_etype_whitelist_reply'.
@@ -800,7 +800,7 @@ func (m *ACLInterfaceSetEtypeWhitelistReply) Marshal(b []byte) ([]byte, error) {
 func (m *ACLInterfaceSetEtypeWhitelistReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
-	return nil
+	return buf.Err()
 }
 
 // ACLPluginControlPing defines message 'acl_plugin_control_ping'.
@@ -868,7 +868,7 @@ func (m *ACLPluginControlPingReply) Unmarshal(b []byte) error {
 	m.Retval = buf.DecodeInt32()
 	m.ClientIndex = buf.DecodeUint32()
 	m.VpePID = buf.DecodeUint32()
-	return nil
+	return buf.Err()
 }
 
 // ACLPluginGetConnTableMaxEntries defines message 'acl_plugin_get_conn_table_max_entries'.
@@ -932,7 +932,7 @@ func (m *ACLPluginGetConnTableMaxEntriesReply) Marshal(b []byte) ([]byte, error)
 func (m *ACLPluginGetConnTableMaxEntriesReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.ConnTableMaxEntries = buf.DecodeUint64()
-	return nil
+	return buf.Err()
 }
 
 // ACLPluginGetVersion defines message 'acl_plugin_get_version'.
@@ -996,7 +996,7 @@ func (m *ACLPluginGetVersionReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Major = buf.DecodeUint32()
 	m.Minor = buf.DecodeUint32()
-	return nil
+	return buf.Err()
 }
 
 // ACLPluginUseHashLookupGet defines message 'acl_plugin_use_hash_lookup_get'.
@@ -1060,7 +1060,7 @@ func (m *ACLPluginUseHashLookupGetReply) Marshal(b []byte) ([]byte, error) {
 func (m *ACLPluginUseHashLookupGetReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Enable = buf.DecodeBool()
-	return nil
+	return buf.Err()
 }
 
 // ACLPluginUseHashLookupSet defines message 'acl_plugin_use_hash_lookup_set'.
@@ -1094,7 +1094,7 @@ func (m *ACLPluginUseHashLookupSet) Marshal(b []byte) ([]byte, error) {
 func (m *ACLPluginUseHashLookupSet) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Enable = buf.DecodeBool()
-	return nil
+	return buf.Err()
 }
 
 // ACLPluginUseHashLookupSetReply defines message 'acl_plugin_use_hash_lookup_set_reply'.
@@ -1130,7 +1130,7 @@ func (m *ACLPluginUseHashLookupSetReply) Marshal(b []byte) ([]byte, error) {
 func (m *ACLPluginUseHashLookupSetReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
-	return nil
+	return buf.Err()
 }
 
 // ACLStatsIntfCountersEnable defines message 'acl_stats_intf_counters_enable'.
@@ -1163,7 +1163,7 @@ func (m *ACLStatsIntfCountersEnable) Marshal(b []byte) ([]byte, error) {
 func (m *ACLStatsIntfCountersEnable) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Enable = buf.DecodeBool()
-	return nil
+	return buf.Err()
 }
 
 // ACLStatsIntfCountersEnableReply defines message 'acl_stats_intf_counters_enable_reply'.
@@ -1198,7 +1198,7 @@ func (m *ACLStatsIntfCountersEnableReply) Marshal(b []byte) ([]byte, error) {
 func (m *ACLStatsIntfCountersEnableReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
-	return nil
+	return buf.Err()
 }
 
 // MacipACLAdd defines message 'macip_acl_add'.
@@ -1261,7 +1261,7 @@ func (m *MacipACLAdd) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Tag = buf.DecodeString(64)
 	m.Count = buf.DecodeUint32()
-	m.R = make([]acl_types.MacipACLRule, m.Count)
+	m.R = make([]acl_types.MacipACLRule, buf.CheckArrayLen(int(m.Count), 1))
 	for j0 := 0; j0 < len(m.R); j0++ {
 		m.R[j0].IsPermit = acl_types.ACLAction(buf.DecodeUint8())
 		copy(m.R[j0].SrcMac[:], buf.DecodeBytes(6))
@@ -1270,7 +1270,7 @@ func (m *MacipACLAdd) Unmarshal(b []byte) error {
 		copy(m.R[j0].SrcPrefix.Address.Un.XXX_UnionData[:], buf.DecodeBytes(16))
 		m.R[j0].SrcPrefix.Len = buf.DecodeUint8()
 	}
-	return nil
+	return buf.Err()
 }
 
 // MacipACLAddReplace defines message 'macip_acl_add_replace'.
@@ -1337,7 +1337,7 @@ func (m *MacipACLAddReplace) Unmarshal(b []byte) error {
 	m.ACLIndex = buf.DecodeUint32()
 	m.Tag = buf.DecodeString(64)
 	m.Count = buf.DecodeUint32()
-	m.R = make([]acl_types.MacipACLRule, m.Count)
+	m.R = make([]acl_types.MacipACLRule, buf.CheckArrayLen(int(m.Count), 1))
 	for j0 := 0; j0 < len(m.R); j0++ {
 		m.R[j0].IsPermit = acl_types.ACLAction(buf.DecodeUint8())
 		copy(m.R[j0].SrcMac[:], buf.DecodeBytes(6))
@@ -1346,7 +1346,7 @@ func (m *MacipACLAddReplace) Unmarshal(b []byte) error {
 		copy(m.R[j0].SrcPrefix.Address.Un.XXX_UnionData[:], buf.DecodeBytes(16))
 		m.R[j0].SrcPrefix.Len = buf.DecodeUint8()
 	}
-	return nil
+	return buf.Err()
 }
 
 // MacipACLAddReplaceReply defines message 'macip_acl_add_replace_reply'.
@@ -1383,7 +1383,7 @@ func (m *MacipACLAddReplaceReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.ACLIndex = buf.DecodeUint32()
 	m.Retval = buf.DecodeInt32()
-	return nil
+	return buf.Err()
 }
 
 // MacipACLAddReply defines message 'macip_acl_add_reply'.
@@ -1420,7 +1420,7 @@ func (m *MacipACLAddReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.ACLIndex = buf.DecodeUint32()
 	m.Retval = buf.DecodeInt32()
-	return nil
+	return buf.Err()
 }
 
 // MacipACLDel defines message 'macip_acl_del'.
@@ -1453,7 +1453,7 @@ func (m *MacipACLDel) Marshal(b []byte) ([]byte, error) {
 func (m *MacipACLDel) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.ACLIndex = buf.DecodeUint32()
-	return nil
+	return buf.Err()
 }
 
 // MacipACLDelReply defines message 'macip_acl_del_reply'.
@@ -1486,7 +1486,7 @@ func (m *MacipACLDelReply) Marshal(b []byte) ([]byte, error) {
 func (m *MacipACLDelReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
-	return nil
+	return buf.Err()
 }
 
 // MacipACLDetails defines message 'macip_acl_details'.
@@ -1553,7 +1553,7 @@ func (m *MacipACLDetails) Unmarshal(b []byte) error {
 	m.ACLIndex = buf.DecodeUint32()
 	m.Tag = buf.DecodeString(64)
 	m.Count = buf.DecodeUint32()
-	m.R = make([]acl_types.MacipACLRule, m.Count)
+	m.R = make([]acl_types.MacipACLRule, buf.CheckArrayLen(int(m.Count), 1))
 	for j0 := 0; j0 < len(m.R); j0++ {
 		m.R[j0].IsPermit = acl_types.ACLAction(buf.DecodeUint8())
 		copy(m.R[j0].SrcMac[:], buf.DecodeBytes(6))
@@ -1562,7 +1562,7 @@ func (m *MacipACLDetails) Unmarshal(b []byte) error {
 		copy(m.R[j0].SrcPrefix.Address.Un.XXX_UnionData[:], buf.DecodeBytes(16))
 		m.R[j0].SrcPrefix.Len = buf.DecodeUint8()
 	}
-	return nil
+	return buf.Err()
 }
 
 // MacipACLDump defines message 'macip_acl_dump'.
@@ -1595,7 +1595,7 @@ func (m *MacipACLDump) Marshal(b []byte) ([]byte, error) {
 func (m *MacipACLDump) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.ACLIndex = buf.DecodeUint32()
-	return nil
+	return buf.Err()
 }
 
 // MacipACLInterfaceAddDel defines message 'macip_acl_interface_add_del'.
@@ -1636,7 +1636,7 @@ func (m *MacipACLInterfaceAddDel) Unmarshal(b []byte) error {
 	m.IsAdd = buf.DecodeBool()
 	m.SwIfIndex = interface_types.InterfaceIndex(buf.DecodeUint32())
 	m.ACLIndex = buf.DecodeUint32()
-	return nil
+	return buf.Err()
 }
 
 // MacipACLInterfaceAddDelReply defines message 'macip_acl_interface_add_del_reply'.
@@ -1671,7 +1671,7 @@ func (m *MacipACLInterfaceAddDelReply) Marshal(b []byte) ([]byte, error) {
 func (m *MacipACLInterfaceAddDelReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
-	return nil
+	return buf.Err()
 }
 
 // MacipACLInterfaceGet defines message 'macip_acl_interface_get'.
@@ -1740,11 +1740,11 @@ func (m *MacipACLInterfaceGetReply) Marshal(b []byte) ([]byte, error) {
 func (m *MacipACLInterfaceGetReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Count = buf.DecodeUint32()
-	m.Acls = make([]uint32, m.Count)
+	m.Acls = make([]uint32, buf.CheckArrayLen(int(m.Count), 4))
 	for i := 0; i < len(m.Acls); i++ {
 		m.Acls[i] = buf.DecodeUint32()
 	}
-	return nil
+	return buf.Err()
 }
 
 // MacipACLInterfaceListDetails defines message 'macip_acl_interface_list_details'.
@@ -1792,11 +1792,11 @@ func (m *MacipACLInterfaceListDetails) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.SwIfIndex = interface_types.InterfaceIndex(buf.DecodeUint32())
 	m.Count = buf.DecodeUint8()
-	m.Acls = make([]uint32, m.Count)
+	m.Acls = make([]uint32, buf.CheckArrayLen(int(m.Count), 4))
 	for i := 0; i < len(m.Acls); i++ {
 		m.Acls[i] = buf.DecodeUint32()
 	}
-	return nil
+	return buf.Err()
 }
 
 // MacipACLInterfaceListDump defines message 'macip_acl_interface_list_dump'.
@@ -1829,7 +1829,7 @@ func (m *MacipACLInterfaceListDump) Marshal(b []byte) ([]byte, error) {
 func (m *MacipACLInterfaceListDump) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.SwIfIndex = interface_types.InterfaceIndex(buf.DecodeUint32())
-	return nil
+	return buf.Err()
 }
 
 func init() { file_acl_binapi_init() }
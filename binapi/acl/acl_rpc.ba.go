@@ -50,7 +50,7 @@ func (c *serviceClient) ACLAddReplace(ctx context.Context, in *ACLAddReplace) (*
 	if err != nil {
 		return nil, err
 	}
-	return out, api.RetvalToVPPApiError(out.Retval)
+	return out, api.RetvalToRequestError(in, out.Retval)
 }
 
 func (c *serviceClient) ACLDel(ctx context.Context, in *ACLDel) (*ACLDelReply, error) {
@@ -59,7 +59,7 @@ func (c *serviceClient) ACLDel(ctx context.Context, in *ACLDel) (*ACLDelReply, e
 	if err != nil {
 		return nil, err
 	}
-	return out, api.RetvalToVPPApiError(out.Retval)
+	return out, api.RetvalToRequestError(in, out.Retval)
 }
 
 func (c *serviceClient) ACLDump(ctx context.Context, in *ACLDump) (RPCService_ACLDumpClient, error) {
@@ -111,7 +111,7 @@ func (c *serviceClient) ACLInterfaceAddDel(ctx context.Context, in *ACLInterface
 	if err != nil {
 		return nil, err
 	}
-	return out, api.RetvalToVPPApiError(out.Retval)
+	return out, api.RetvalToRequestError(in, out.Retval)
 }
 
 func (c *serviceClient) ACLInterfaceEtypeWhitelistDump(ctx context.Context, in *ACLInterfaceEtypeWhitelistDump) (RPCService_ACLInterfaceEtypeWhitelistDumpClient, error) {
@@ -206,7 +206,7 @@ func (c *serviceClient) ACLInterfaceSetACLList(ctx context.Context, in *ACLInter
 	if err != nil {
 		return nil, err
 	}
-	return out, api.RetvalToVPPApiError(out.Retval)
+	return out, api.RetvalToRequestError(in, out.Retval)
 }
 
 func (c *serviceClient) ACLInterfaceSetEtypeWhitelist(ctx context.Context, in *ACLInterfaceSetEtypeWhitelist) (*ACLInterfaceSetEtypeWhitelistReply, error) {
@@ -215,7 +215,7 @@ func (c *serviceClient) ACLInterfaceSetEtypeWhitelist(ctx context.Context, in *A
 	if err != nil {
 		return nil, err
 	}
-	return out, api.RetvalToVPPApiError(out.Retval)
+	return out, api.RetvalToRequestError(in, out.Retval)
 }
 
 func (c *serviceClient) ACLPluginControlPing(ctx context.Context, in *ACLPluginControlPing) (*ACLPluginControlPingReply, error) {
@@ -224,7 +224,7 @@ func (c *serviceClient) ACLPluginControlPing(ctx context.Context, in *ACLPluginC
 	if err != nil {
 		return nil, err
 	}
-	return out, api.RetvalToVPPApiError(out.Retval)
+	return out, api.RetvalToRequestError(in, out.Retval)
 }
 
 func (c *serviceClient) ACLPluginGetConnTableMaxEntries(ctx context.Context, in *ACLPluginGetConnTableMaxEntries) (*ACLPluginGetConnTableMaxEntriesReply, error) {
@@ -260,7 +260,7 @@ func (c *serviceClient) ACLPluginUseHashLookupSet(ctx context.Context, in *ACLPl
 	if err != nil {
 		return nil, err
 	}
-	return out, api.RetvalToVPPApiError(out.Retval)
+	return out, api.RetvalToRequestError(in, out.Retval)
 }
 
 func (c *serviceClient) ACLStatsIntfCountersEnable(ctx context.Context, in *ACLStatsIntfCountersEnable) (*ACLStatsIntfCountersEnableReply, error) {
@@ -269,7 +269,7 @@ func (c *serviceClient) ACLStatsIntfCountersEnable(ctx context.Context, in *ACLS
 	if err != nil {
 		return nil, err
 	}
-	return out, api.RetvalToVPPApiError(out.Retval)
+	return out, api.RetvalToRequestError(in, out.Retval)
 }
 
 func (c *serviceClient) MacipACLAdd(ctx context.Context, in *MacipACLAdd) (*MacipACLAddReply, error) {
@@ -278,7 +278,7 @@ func (c *serviceClient) MacipACLAdd(ctx context.Context, in *MacipACLAdd) (*Maci
 	if err != nil {
 		return nil, err
 	}
-	return out, api.RetvalToVPPApiError(out.Retval)
+	return out, api.RetvalToRequestError(in, out.Retval)
 }
 
 func (c *serviceClient) MacipACLAddReplace(ctx context.Context, in *MacipACLAddReplace) (*MacipACLAddReplaceReply, error) {
@@ -287,7 +287,7 @@ func (c *serviceClient) MacipACLAddReplace(ctx context.Context, in *MacipACLAddR
 	if err != nil {
 		return nil, err
 	}
-	return out, api.RetvalToVPPApiError(out.Retval)
+	return out, api.RetvalToRequestError(in, out.Retval)
 }
 
 func (c *serviceClient) MacipACLDel(ctx context.Context, in *MacipACLDel) (*MacipACLDelReply, error) {
@@ -296,7 +296,7 @@ func (c *serviceClient) MacipACLDel(ctx context.Context, in *MacipACLDel) (*Maci
 	if err != nil {
 		return nil, err
 	}
-	return out, api.RetvalToVPPApiError(out.Retval)
+	return out, api.RetvalToRequestError(in, out.Retval)
 }
 
 func (c *serviceClient) MacipACLDump(ctx context.Context, in *MacipACLDump) (RPCService_MacipACLDumpClient, error) {
@@ -348,7 +348,7 @@ func (c *serviceClient) MacipACLInterfaceAddDel(ctx context.Context, in *MacipAC
 	if err != nil {
 		return nil, err
 	}
-	return out, api.RetvalToVPPApiError(out.Retval)
+	return out, api.RetvalToRequestError(in, out.Retval)
 }
 
 func (c *serviceClient) MacipACLInterfaceGet(ctx context.Context, in *MacipACLInterfaceGet) (*MacipACLInterfaceGetReply, error) {
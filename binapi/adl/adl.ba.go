@@ -75,7 +75,7 @@ func (m *AdlAllowlistEnableDisable) Unmarshal(b []byte) error {
 	m.IP4 = buf.DecodeBool()
 	m.IP6 = buf.DecodeBool()
 	m.DefaultAdl = buf.DecodeBool()
-	return nil
+	return buf.Err()
 }
 
 // AdlAllowlistEnableDisableReply defines message 'adl_allowlist_enable_disable_reply'.
@@ -111,7 +111,7 @@ func (m *AdlAllowlistEnableDisableReply) Marshal(b []byte) ([]byte, error) {
 func (m *AdlAllowlistEnableDisableReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
-	return nil
+	return buf.Err()
 }
 
 // AdlInterfaceEnableDisable defines message 'adl_interface_enable_disable'.
@@ -149,7 +149,7 @@ func (m *AdlInterfaceEnableDisable) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.SwIfIndex = interface_types.InterfaceIndex(buf.DecodeUint32())
 	m.EnableDisable = buf.DecodeBool()
-	return nil
+	return buf.Err()
 }
 
 // AdlInterfaceEnableDisableReply defines message 'adl_interface_enable_disable_reply'.
@@ -185,7 +185,7 @@ func (m *AdlInterfaceEnableDisableReply) Marshal(b []byte) ([]byte, error) {
 func (m *AdlInterfaceEnableDisableReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
-	return nil
+	return buf.Err()
 }
 
 func init() { file_adl_binapi_init() }
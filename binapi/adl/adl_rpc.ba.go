@@ -28,7 +28,7 @@ func (c *serviceClient) AdlAllowlistEnableDisable(ctx context.Context, in *AdlAl
 	if err != nil {
 		return nil, err
 	}
-	return out, api.RetvalToVPPApiError(out.Retval)
+	return out, api.RetvalToRequestError(in, out.Retval)
 }
 
 func (c *serviceClient) AdlInterfaceEnableDisable(ctx context.Context, in *AdlInterfaceEnableDisable) (*AdlInterfaceEnableDisableReply, error) {
@@ -37,5 +37,5 @@ func (c *serviceClient) AdlInterfaceEnableDisable(ctx context.Context, in *AdlIn
 	if err != nil {
 		return nil, err
 	}
-	return out, api.RetvalToVPPApiError(out.Retval)
+	return out, api.RetvalToRequestError(in, out.Retval)
 }
@@ -143,7 +143,7 @@ func (m *AfPacketCreate) Unmarshal(b []byte) error {
 	copy(m.HwAddr[:], buf.DecodeBytes(6))
 	m.UseRandomHwAddr = buf.DecodeBool()
 	m.HostIfName = buf.DecodeString(64)
-	return nil
+	return buf.Err()
 }
 
 // AfPacketCreateReply defines message 'af_packet_create_reply'.
@@ -180,7 +180,7 @@ func (m *AfPacketCreateReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
 	m.SwIfIndex = interface_types.InterfaceIndex(buf.DecodeUint32())
-	return nil
+	return buf.Err()
 }
 
 // AfPacketCreateV2 defines message 'af_packet_create_v2'.
@@ -245,7 +245,7 @@ func (m *AfPacketCreateV2) Unmarshal(b []byte) error {
 	m.TxFramesPerBlock = buf.DecodeUint32()
 	m.Flags = buf.DecodeUint32()
 	m.NumRxQueues = buf.DecodeUint16()
-	return nil
+	return buf.Err()
 }
 
 // AfPacketCreateV2Reply defines message 'af_packet_create_v2_reply'.
@@ -282,7 +282,7 @@ func (m *AfPacketCreateV2Reply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
 	m.SwIfIndex = interface_types.InterfaceIndex(buf.DecodeUint32())
-	return nil
+	return buf.Err()
 }
 
 // AfPacketCreateV3 defines message 'af_packet_create_v3'.
@@ -355,7 +355,7 @@ func (m *AfPacketCreateV3) Unmarshal(b []byte) error {
 	m.Flags = AfPacketFlags(buf.DecodeUint32())
 	m.NumRxQueues = buf.DecodeUint16()
 	m.NumTxQueues = buf.DecodeUint16()
-	return nil
+	return buf.Err()
 }
 
 // AfPacketCreateV3Reply defines message 'af_packet_create_v3_reply'.
@@ -392,7 +392,7 @@ func (m *AfPacketCreateV3Reply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
 	m.SwIfIndex = interface_types.InterfaceIndex(buf.DecodeUint32())
-	return nil
+	return buf.Err()
 }
 
 // AfPacketDelete defines message 'af_packet_delete'.
@@ -425,7 +425,7 @@ func (m *AfPacketDelete) Marshal(b []byte) ([]byte, error) {
 func (m *AfPacketDelete) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.HostIfName = buf.DecodeString(64)
-	return nil
+	return buf.Err()
 }
 
 // AfPacketDeleteReply defines message 'af_packet_delete_reply'.
@@ -458,7 +458,7 @@ func (m *AfPacketDeleteReply) Marshal(b []byte) ([]byte, error) {
 func (m *AfPacketDeleteReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
-	return nil
+	return buf.Err()
 }
 
 // AfPacketDetails defines message 'af_packet_details'.
@@ -495,7 +495,7 @@ func (m *AfPacketDetails) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.SwIfIndex = interface_types.InterfaceIndex(buf.DecodeUint32())
 	m.HostIfName = buf.DecodeString(64)
-	return nil
+	return buf.Err()
 }
 
 // AfPacketDump defines message 'af_packet_dump'.
@@ -559,7 +559,7 @@ func (m *AfPacketSetL4CksumOffload) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.SwIfIndex = interface_types.InterfaceIndex(buf.DecodeUint32())
 	m.Set = buf.DecodeBool()
-	return nil
+	return buf.Err()
 }
 
 // AfPacketSetL4CksumOffloadReply defines message 'af_packet_set_l4_cksum_offload_reply'.
@@ -594,7 +594,7 @@ func (m *AfPacketSetL4CksumOffloadReply) Marshal(b []byte) ([]byte, error) {
 func (m *AfPacketSetL4CksumOffloadReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
-	return nil
+	return buf.Err()
 }
 
 func init() { file_af_packet_binapi_init() }
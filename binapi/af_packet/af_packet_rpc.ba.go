@@ -35,7 +35,7 @@ func (c *serviceClient) AfPacketCreate(ctx context.Context, in *AfPacketCreate)
 	if err != nil {
 		return nil, err
 	}
-	return out, api.RetvalToVPPApiError(out.Retval)
+	return out, api.RetvalToRequestError(in, out.Retval)
 }
 
 func (c *serviceClient) AfPacketCreateV2(ctx context.Context, in *AfPacketCreateV2) (*AfPacketCreateV2Reply, error) {
@@ -44,7 +44,7 @@ func (c *serviceClient) AfPacketCreateV2(ctx context.Context, in *AfPacketCreate
 	if err != nil {
 		return nil, err
 	}
-	return out, api.RetvalToVPPApiError(out.Retval)
+	return out, api.RetvalToRequestError(in, out.Retval)
 }
 
 func (c *serviceClient) AfPacketCreateV3(ctx context.Context, in *AfPacketCreateV3) (*AfPacketCreateV3Reply, error) {
@@ -53,7 +53,7 @@ func (c *serviceClient) AfPacketCreateV3(ctx context.Context, in *AfPacketCreate
 	if err != nil {
 		return nil, err
 	}
-	return out, api.RetvalToVPPApiError(out.Retval)
+	return out, api.RetvalToRequestError(in, out.Retval)
 }
 
 func (c *serviceClient) AfPacketDelete(ctx context.Context, in *AfPacketDelete) (*AfPacketDeleteReply, error) {
@@ -62,7 +62,7 @@ func (c *serviceClient) AfPacketDelete(ctx context.Context, in *AfPacketDelete)
 	if err != nil {
 		return nil, err
 	}
-	return out, api.RetvalToVPPApiError(out.Retval)
+	return out, api.RetvalToRequestError(in, out.Retval)
 }
 
 func (c *serviceClient) AfPacketDump(ctx context.Context, in *AfPacketDump) (RPCService_AfPacketDumpClient, error) {
@@ -114,5 +114,5 @@ func (c *serviceClient) AfPacketSetL4CksumOffload(ctx context.Context, in *AfPac
 	if err != nil {
 		return nil, err
 	}
-	return out, api.RetvalToVPPApiError(out.Retval)
+	return out, api.RetvalToRequestError(in, out.Retval)
 }
@@ -162,7 +162,7 @@ func (m *AfXdpCreate) Unmarshal(b []byte) error {
 	m.Mode = AfXdpMode(buf.DecodeUint32())
 	m.Flags = AfXdpFlag(buf.DecodeUint8())
 	m.Prog = buf.DecodeString(256)
-	return nil
+	return buf.Err()
 }
 
 // AfXdpCreateReply defines message 'af_xdp_create_reply'.
@@ -199,7 +199,7 @@ func (m *AfXdpCreateReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
 	m.SwIfIndex = interface_types.InterfaceIndex(buf.DecodeUint32())
-	return nil
+	return buf.Err()
 }
 
 // AfXdpCreateV2 defines message 'af_xdp_create_v2'.
@@ -264,7 +264,7 @@ func (m *AfXdpCreateV2) Unmarshal(b []byte) error {
 	m.Flags = AfXdpFlag(buf.DecodeUint8())
 	m.Prog = buf.DecodeString(256)
 	m.Namespace = buf.DecodeString(64)
-	return nil
+	return buf.Err()
 }
 
 // AfXdpCreateV2Reply defines message 'af_xdp_create_v2_reply'.
@@ -301,7 +301,7 @@ func (m *AfXdpCreateV2Reply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
 	m.SwIfIndex = interface_types.InterfaceIndex(buf.DecodeUint32())
-	return nil
+	return buf.Err()
 }
 
 // AfXdpDelete defines message 'af_xdp_delete'.
@@ -334,7 +334,7 @@ func (m *AfXdpDelete) Marshal(b []byte) ([]byte, error) {
 func (m *AfXdpDelete) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.SwIfIndex = interface_types.InterfaceIndex(buf.DecodeUint32())
-	return nil
+	return buf.Err()
 }
 
 // AfXdpDeleteReply defines message 'af_xdp_delete_reply'.
@@ -367,7 +367,7 @@ func (m *AfXdpDeleteReply) Marshal(b []byte) ([]byte, error) {
 func (m *AfXdpDeleteReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
-	return nil
+	return buf.Err()
 }
 
 func init() { file_af_xdp_binapi_init() }
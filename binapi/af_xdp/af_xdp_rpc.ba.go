@@ -29,7 +29,7 @@ func (c *serviceClient) AfXdpCreate(ctx context.Context, in *AfXdpCreate) (*AfXd
 	if err != nil {
 		return nil, err
 	}
-	return out, api.RetvalToVPPApiError(out.Retval)
+	return out, api.RetvalToRequestError(in, out.Retval)
 }
 
 func (c *serviceClient) AfXdpCreateV2(ctx context.Context, in *AfXdpCreateV2) (*AfXdpCreateV2Reply, error) {
@@ -38,7 +38,7 @@ func (c *serviceClient) AfXdpCreateV2(ctx context.Context, in *AfXdpCreateV2) (*
 	if err != nil {
 		return nil, err
 	}
-	return out, api.RetvalToVPPApiError(out.Retval)
+	return out, api.RetvalToRequestError(in, out.Retval)
 }
 
 func (c *serviceClient) AfXdpDelete(ctx context.Context, in *AfXdpDelete) (*AfXdpDeleteReply, error) {
@@ -47,5 +47,5 @@ func (c *serviceClient) AfXdpDelete(ctx context.Context, in *AfXdpDelete) (*AfXd
 	if err != nil {
 		return nil, err
 	}
-	return out, api.RetvalToVPPApiError(out.Retval)
+	return out, api.RetvalToRequestError(in, out.Retval)
 }
@@ -78,7 +78,7 @@ func (m *ProxyArpAddDel) Unmarshal(b []byte) error {
 	m.Proxy.TableID = buf.DecodeUint32()
 	copy(m.Proxy.Low[:], buf.DecodeBytes(4))
 	copy(m.Proxy.Hi[:], buf.DecodeBytes(4))
-	return nil
+	return buf.Err()
 }
 
 // ProxyArpAddDelReply defines message 'proxy_arp_add_del_reply'.
@@ -111,7 +111,7 @@ func (m *ProxyArpAddDelReply) Marshal(b []byte) ([]byte, error) {
 func (m *ProxyArpAddDelReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
-	return nil
+	return buf.Err()
 }
 
 // ProxyArpDetails defines message 'proxy_arp_details'.
@@ -150,7 +150,7 @@ func (m *ProxyArpDetails) Unmarshal(b []byte) error {
 	m.Proxy.TableID = buf.DecodeUint32()
 	copy(m.Proxy.Low[:], buf.DecodeBytes(4))
 	copy(m.Proxy.Hi[:], buf.DecodeBytes(4))
-	return nil
+	return buf.Err()
 }
 
 // ProxyArpDump defines message 'proxy_arp_dump'.
@@ -210,7 +210,7 @@ func (m *ProxyArpIntfcDetails) Marshal(b []byte) ([]byte, error) {
 func (m *ProxyArpIntfcDetails) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.SwIfIndex = buf.DecodeUint32()
-	return nil
+	return buf.Err()
 }
 
 // ProxyArpIntfcDump defines message 'proxy_arp_intfc_dump'.
@@ -274,7 +274,7 @@ func (m *ProxyArpIntfcEnableDisable) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.SwIfIndex = interface_types.InterfaceIndex(buf.DecodeUint32())
 	m.Enable = buf.DecodeBool()
-	return nil
+	return buf.Err()
 }
 
 // ProxyArpIntfcEnableDisableReply defines message 'proxy_arp_intfc_enable_disable_reply'.
@@ -309,7 +309,7 @@ func (m *ProxyArpIntfcEnableDisableReply) Marshal(b []byte) ([]byte, error) {
 func (m *ProxyArpIntfcEnableDisableReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
-	return nil
+	return buf.Err()
 }
 
 func init() { file_arp_binapi_init() }
@@ -78,7 +78,7 @@ func (m *Arping) Unmarshal(b []byte) error {
 	m.IsGarp = buf.DecodeBool()
 	m.Repeat = buf.DecodeUint32()
 	m.Interval = buf.DecodeFloat64()
-	return nil
+	return buf.Err()
 }
 
 // ArpingReply defines message 'arping_reply'.
@@ -115,7 +115,7 @@ func (m *ArpingReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
 	m.ReplyCount = buf.DecodeUint32()
-	return nil
+	return buf.Err()
 }
 
 func init() { file_arping_binapi_init() }
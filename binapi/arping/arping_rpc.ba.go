@@ -27,5 +27,5 @@ func (c *serviceClient) Arping(ctx context.Context, in *Arping) (*ArpingReply, e
 	if err != nil {
 		return nil, err
 	}
-	return out, api.RetvalToVPPApiError(out.Retval)
+	return out, api.RetvalToRequestError(in, out.Retval)
 }
@@ -74,7 +74,7 @@ func (m *AvfCreate) Unmarshal(b []byte) error {
 	m.RxqNum = buf.DecodeUint16()
 	m.RxqSize = buf.DecodeUint16()
 	m.TxqSize = buf.DecodeUint16()
-	return nil
+	return buf.Err()
 }
 
 // AvfCreateReply defines message 'avf_create_reply'.
@@ -111,7 +111,7 @@ func (m *AvfCreateReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
 	m.SwIfIndex = interface_types.InterfaceIndex(buf.DecodeUint32())
-	return nil
+	return buf.Err()
 }
 
 // AvfDelete defines message 'avf_delete'.
@@ -144,7 +144,7 @@ func (m *AvfDelete) Marshal(b []byte) ([]byte, error) {
 func (m *AvfDelete) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.SwIfIndex = interface_types.InterfaceIndex(buf.DecodeUint32())
-	return nil
+	return buf.Err()
 }
 
 // AvfDeleteReply defines message 'avf_delete_reply'.
@@ -177,7 +177,7 @@ func (m *AvfDeleteReply) Marshal(b []byte) ([]byte, error) {
 func (m *AvfDeleteReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
-	return nil
+	return buf.Err()
 }
 
 func init() { file_avf_binapi_init() }
@@ -95,7 +95,7 @@ func (m *BfdAuthDelKey) Marshal(b []byte) ([]byte, error) {
 func (m *BfdAuthDelKey) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.ConfKeyID = buf.DecodeUint32()
-	return nil
+	return buf.Err()
 }
 
 // BfdAuthDelKeyReply defines message 'bfd_auth_del_key_reply'.
@@ -128,7 +128,7 @@ func (m *BfdAuthDelKeyReply) Marshal(b []byte) ([]byte, error) {
 func (m *BfdAuthDelKeyReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
-	return nil
+	return buf.Err()
 }
 
 // BfdAuthKeysDetails defines message 'bfd_auth_keys_details'.
@@ -169,7 +169,7 @@ func (m *BfdAuthKeysDetails) Unmarshal(b []byte) error {
 	m.ConfKeyID = buf.DecodeUint32()
 	m.UseCount = buf.DecodeUint32()
 	m.AuthType = buf.DecodeUint8()
-	return nil
+	return buf.Err()
 }
 
 // BfdAuthKeysDump defines message 'bfd_auth_keys_dump'.
@@ -242,7 +242,7 @@ func (m *BfdAuthSetKey) Unmarshal(b []byte) error {
 	m.AuthType = buf.DecodeUint8()
 	m.Key = make([]byte, 20)
 	copy(m.Key, buf.DecodeBytes(len(m.Key)))
-	return nil
+	return buf.Err()
 }
 
 // BfdAuthSetKeyReply defines message 'bfd_auth_set_key_reply'.
@@ -275,7 +275,7 @@ func (m *BfdAuthSetKeyReply) Marshal(b []byte) ([]byte, error) {
 func (m *BfdAuthSetKeyReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
-	return nil
+	return buf.Err()
 }
 
 // BfdUDPAdd defines message 'bfd_udp_add'.
@@ -346,7 +346,7 @@ func (m *BfdUDPAdd) Unmarshal(b []byte) error {
 	m.IsAuthenticated = buf.DecodeBool()
 	m.BfdKeyID = buf.DecodeUint8()
 	m.ConfKeyID = buf.DecodeUint32()
-	return nil
+	return buf.Err()
 }
 
 // BfdUDPAddReply defines message 'bfd_udp_add_reply'.
@@ -379,7 +379,7 @@ func (m *BfdUDPAddReply) Marshal(b []byte) ([]byte, error) {
 func (m *BfdUDPAddReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
-	return nil
+	return buf.Err()
 }
 
 // BfdUDPAuthActivate defines message 'bfd_udp_auth_activate'.
@@ -438,7 +438,7 @@ func (m *BfdUDPAuthActivate) Unmarshal(b []byte) error {
 	m.IsDelayed = buf.DecodeBool()
 	m.BfdKeyID = buf.DecodeUint8()
 	m.ConfKeyID = buf.DecodeUint32()
-	return nil
+	return buf.Err()
 }
 
 // BfdUDPAuthActivateReply defines message 'bfd_udp_auth_activate_reply'.
@@ -471,7 +471,7 @@ func (m *BfdUDPAuthActivateReply) Marshal(b []byte) ([]byte, error) {
 func (m *BfdUDPAuthActivateReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
-	return nil
+	return buf.Err()
 }
 
 // BfdUDPAuthDeactivate defines message 'bfd_udp_auth_deactivate'.
@@ -522,7 +522,7 @@ func (m *BfdUDPAuthDeactivate) Unmarshal(b []byte) error {
 	m.PeerAddr.Af = ip_types.AddressFamily(buf.DecodeUint8())
 	copy(m.PeerAddr.Un.XXX_UnionData[:], buf.DecodeBytes(16))
 	m.IsDelayed = buf.DecodeBool()
-	return nil
+	return buf.Err()
 }
 
 // BfdUDPAuthDeactivateReply defines message 'bfd_udp_auth_deactivate_reply'.
@@ -555,7 +555,7 @@ func (m *BfdUDPAuthDeactivateReply) Marshal(b []byte) ([]byte, error) {
 func (m *BfdUDPAuthDeactivateReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
-	return nil
+	return buf.Err()
 }
 
 // BfdUDPDel defines message 'bfd_udp_del'.
@@ -602,7 +602,7 @@ func (m *BfdUDPDel) Unmarshal(b []byte) error {
 	copy(m.LocalAddr.Un.XXX_UnionData[:], buf.DecodeBytes(16))
 	m.PeerAddr.Af = ip_types.AddressFamily(buf.DecodeUint8())
 	copy(m.PeerAddr.Un.XXX_UnionData[:], buf.DecodeBytes(16))
-	return nil
+	return buf.Err()
 }
 
 // BfdUDPDelEchoSource defines message 'bfd_udp_del_echo_source'.
@@ -662,7 +662,7 @@ func (m *BfdUDPDelEchoSourceReply) Marshal(b []byte) ([]byte, error) {
 func (m *BfdUDPDelEchoSourceReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
-	return nil
+	return buf.Err()
 }
 
 // BfdUDPDelReply defines message 'bfd_udp_del_reply'.
@@ -695,7 +695,7 @@ func (m *BfdUDPDelReply) Marshal(b []byte) ([]byte, error) {
 func (m *BfdUDPDelReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
-	return nil
+	return buf.Err()
 }
 
 // BfdUDPGetEchoSource defines message 'bfd_udp_get_echo_source'.
@@ -779,7 +779,7 @@ func (m *BfdUDPGetEchoSourceReply) Unmarshal(b []byte) error {
 	copy(m.IP4Addr[:], buf.DecodeBytes(4))
 	m.HaveUsableIP6 = buf.DecodeBool()
 	copy(m.IP6Addr[:], buf.DecodeBytes(16))
-	return nil
+	return buf.Err()
 }
 
 // BfdUDPMod defines message 'bfd_udp_mod'.
@@ -838,7 +838,7 @@ func (m *BfdUDPMod) Unmarshal(b []byte) error {
 	m.PeerAddr.Af = ip_types.AddressFamily(buf.DecodeUint8())
 	copy(m.PeerAddr.Un.XXX_UnionData[:], buf.DecodeBytes(16))
 	m.DetectMult = buf.DecodeUint8()
-	return nil
+	return buf.Err()
 }
 
 // BfdUDPModReply defines message 'bfd_udp_mod_reply'.
@@ -871,7 +871,7 @@ func (m *BfdUDPModReply) Marshal(b []byte) ([]byte, error) {
 func (m *BfdUDPModReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
-	return nil
+	return buf.Err()
 }
 
 // BfdUDPSessionDetails defines message 'bfd_udp_session_details'.
@@ -946,7 +946,7 @@ func (m *BfdUDPSessionDetails) Unmarshal(b []byte) error {
 	m.RequiredMinRx = buf.DecodeUint32()
 	m.DesiredMinTx = buf.DecodeUint32()
 	m.DetectMult = buf.DecodeUint8()
-	return nil
+	return buf.Err()
 }
 
 // BfdUDPSessionDump defines message 'bfd_udp_session_dump'.
@@ -1052,7 +1052,7 @@ func (m *BfdUDPSessionEvent) Unmarshal(b []byte) error {
 	m.RequiredMinRx = buf.DecodeUint32()
 	m.DesiredMinTx = buf.DecodeUint32()
 	m.DetectMult = buf.DecodeUint8()
-	return nil
+	return buf.Err()
 }
 
 // BfdUDPSessionSetFlags defines message 'bfd_udp_session_set_flags'.
@@ -1103,7 +1103,7 @@ func (m *BfdUDPSessionSetFlags) Unmarshal(b []byte) error {
 	m.PeerAddr.Af = ip_types.AddressFamily(buf.DecodeUint8())
 	copy(m.PeerAddr.Un.XXX_UnionData[:], buf.DecodeBytes(16))
 	m.Flags = interface_types.IfStatusFlags(buf.DecodeUint32())
-	return nil
+	return buf.Err()
 }
 
 // BfdUDPSessionSetFlagsReply defines message 'bfd_udp_session_set_flags_reply'.
@@ -1136,7 +1136,7 @@ func (m *BfdUDPSessionSetFlagsReply) Marshal(b []byte) ([]byte, error) {
 func (m *BfdUDPSessionSetFlagsReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
-	return nil
+	return buf.Err()
 }
 
 // BfdUDPSetEchoSource defines message 'bfd_udp_set_echo_source'.
@@ -1169,7 +1169,7 @@ func (m *BfdUDPSetEchoSource) Marshal(b []byte) ([]byte, error) {
 func (m *BfdUDPSetEchoSource) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.SwIfIndex = interface_types.InterfaceIndex(buf.DecodeUint32())
-	return nil
+	return buf.Err()
 }
 
 // BfdUDPSetEchoSourceReply defines message 'bfd_udp_set_echo_source_reply'.
@@ -1202,7 +1202,7 @@ func (m *BfdUDPSetEchoSourceReply) Marshal(b []byte) ([]byte, error) {
 func (m *BfdUDPSetEchoSourceReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
-	return nil
+	return buf.Err()
 }
 
 // BfdUDPUpd defines message 'bfd_udp_upd'.
@@ -1273,7 +1273,7 @@ func (m *BfdUDPUpd) Unmarshal(b []byte) error {
 	m.IsAuthenticated = buf.DecodeBool()
 	m.BfdKeyID = buf.DecodeUint8()
 	m.ConfKeyID = buf.DecodeUint32()
-	return nil
+	return buf.Err()
 }
 
 // BfdUDPUpdReply defines message 'bfd_udp_upd_reply'.
@@ -1310,7 +1310,7 @@ func (m *BfdUDPUpdReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
 	m.StatsIndex = buf.DecodeUint32()
-	return nil
+	return buf.Err()
 }
 
 // WantBfdEvents defines message 'want_bfd_events'.
@@ -1347,7 +1347,7 @@ func (m *WantBfdEvents) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.EnableDisable = buf.DecodeBool()
 	m.PID = buf.DecodeUint32()
-	return nil
+	return buf.Err()
 }
 
 // WantBfdEventsReply defines message 'want_bfd_events_reply'.
@@ -1380,7 +1380,7 @@ func (m *WantBfdEventsReply) Marshal(b []byte) ([]byte, error) {
 func (m *WantBfdEventsReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
-	return nil
+	return buf.Err()
 }
 
 func init() { file_bfd_binapi_init() }
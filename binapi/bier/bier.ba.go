@@ -143,7 +143,7 @@ func (m *BierDispEntryAddDel) Unmarshal(b []byte) error {
 	m.BdeIsAdd = buf.DecodeBool()
 	m.BdePayloadProto = buf.DecodeUint8()
 	m.BdeNPaths = buf.DecodeUint8()
-	m.BdePaths = make([]fib_types.FibPath, m.BdeNPaths)
+	m.BdePaths = make([]fib_types.FibPath, buf.CheckArrayLen(int(m.BdeNPaths), 1))
 	for j0 := 0; j0 < len(m.BdePaths); j0++ {
 		m.BdePaths[j0].SwIfIndex = buf.DecodeUint32()
 		m.BdePaths[j0].TableID = buf.DecodeUint32()
@@ -165,7 +165,7 @@ func (m *BierDispEntryAddDel) Unmarshal(b []byte) error {
 			m.BdePaths[j0].LabelStack[j1].Exp = buf.DecodeUint8()
 		}
 	}
-	return nil
+	return buf.Err()
 }
 
 // BierDispEntryAddDelReply defines message 'bier_disp_entry_add_del_reply'.
@@ -198,7 +198,7 @@ func (m *BierDispEntryAddDelReply) Marshal(b []byte) ([]byte, error) {
 func (m *BierDispEntryAddDelReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
-	return nil
+	return buf.Err()
 }
 
 // BierDispEntryDetails defines message 'bier_disp_entry_details'.
@@ -299,7 +299,7 @@ func (m *BierDispEntryDetails) Unmarshal(b []byte) error {
 	m.BdeIsAdd = buf.DecodeBool()
 	m.BdePayloadProto = buf.DecodeUint8()
 	m.BdeNPaths = buf.DecodeUint8()
-	m.BdePaths = make([]fib_types.FibPath, m.BdeNPaths)
+	m.BdePaths = make([]fib_types.FibPath, buf.CheckArrayLen(int(m.BdeNPaths), 1))
 	for j0 := 0; j0 < len(m.BdePaths); j0++ {
 		m.BdePaths[j0].SwIfIndex = buf.DecodeUint32()
 		m.BdePaths[j0].TableID = buf.DecodeUint32()
@@ -321,7 +321,7 @@ func (m *BierDispEntryDetails) Unmarshal(b []byte) error {
 			m.BdePaths[j0].LabelStack[j1].Exp = buf.DecodeUint8()
 		}
 	}
-	return nil
+	return buf.Err()
 }
 
 // BierDispEntryDump defines message 'bier_disp_entry_dump'.
@@ -354,7 +354,7 @@ func (m *BierDispEntryDump) Marshal(b []byte) ([]byte, error) {
 func (m *BierDispEntryDump) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.BdeTblID = buf.DecodeUint32()
-	return nil
+	return buf.Err()
 }
 
 // BierDispTableAddDel defines message 'bier_disp_table_add_del'.
@@ -391,7 +391,7 @@ func (m *BierDispTableAddDel) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.BdtTblID = buf.DecodeUint32()
 	m.BdtIsAdd = buf.DecodeBool()
-	return nil
+	return buf.Err()
 }
 
 // BierDispTableAddDelReply defines message 'bier_disp_table_add_del_reply'.
@@ -424,7 +424,7 @@ func (m *BierDispTableAddDelReply) Marshal(b []byte) ([]byte, error) {
 func (m *BierDispTableAddDelReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
-	return nil
+	return buf.Err()
 }
 
 // BierDispTableDetails defines message 'bier_disp_table_details'.
@@ -457,7 +457,7 @@ func (m *BierDispTableDetails) Marshal(b []byte) ([]byte, error) {
 func (m *BierDispTableDetails) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.BdtTblID = buf.DecodeUint32()
-	return nil
+	return buf.Err()
 }
 
 // BierDispTableDump defines message 'bier_disp_table_dump'.
@@ -534,9 +534,9 @@ func (m *BierImpAdd) Unmarshal(b []byte) error {
 	m.BiTblID.BtHdrLenID = buf.DecodeUint8()
 	m.BiSrc = buf.DecodeUint16()
 	m.BiNBytes = buf.DecodeUint8()
-	m.BiBytes = make([]byte, m.BiNBytes)
+	m.BiBytes = make([]byte, buf.CheckArrayLen(int(m.BiNBytes), 1))
 	copy(m.BiBytes, buf.DecodeBytes(len(m.BiBytes)))
-	return nil
+	return buf.Err()
 }
 
 // BierImpAddReply defines message 'bier_imp_add_reply'.
@@ -573,7 +573,7 @@ func (m *BierImpAddReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
 	m.BiIndex = buf.DecodeUint32()
-	return nil
+	return buf.Err()
 }
 
 // BierImpDel defines message 'bier_imp_del'.
@@ -606,7 +606,7 @@ func (m *BierImpDel) Marshal(b []byte) ([]byte, error) {
 func (m *BierImpDel) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.BiIndex = buf.DecodeUint32()
-	return nil
+	return buf.Err()
 }
 
 // BierImpDelReply defines message 'bier_imp_del_reply'.
@@ -639,7 +639,7 @@ func (m *BierImpDelReply) Marshal(b []byte) ([]byte, error) {
 func (m *BierImpDelReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
-	return nil
+	return buf.Err()
 }
 
 // BierImpDetails defines message 'bier_imp_details'.
@@ -689,9 +689,9 @@ func (m *BierImpDetails) Unmarshal(b []byte) error {
 	m.BiTblID.BtHdrLenID = buf.DecodeUint8()
 	m.BiSrc = buf.DecodeUint16()
 	m.BiNBytes = buf.DecodeUint8()
-	m.BiBytes = make([]byte, m.BiNBytes)
+	m.BiBytes = make([]byte, buf.CheckArrayLen(int(m.BiNBytes), 1))
 	copy(m.BiBytes, buf.DecodeBytes(len(m.BiBytes)))
-	return nil
+	return buf.Err()
 }
 
 // BierImpDump defines message 'bier_imp_dump'.
@@ -822,7 +822,7 @@ func (m *BierRouteAddDel) Unmarshal(b []byte) error {
 	m.BrRoute.BrTblID.BtSubDomain = buf.DecodeUint8()
 	m.BrRoute.BrTblID.BtHdrLenID = buf.DecodeUint8()
 	m.BrRoute.BrNPaths = buf.DecodeUint8()
-	m.BrRoute.BrPaths = make([]fib_types.FibPath, m.BrRoute.BrNPaths)
+	m.BrRoute.BrPaths = make([]fib_types.FibPath, buf.CheckArrayLen(int(m.BrRoute.BrNPaths), 1))
 	for j1 := 0; j1 < len(m.BrRoute.BrPaths); j1++ {
 		m.BrRoute.BrPaths[j1].SwIfIndex = buf.DecodeUint32()
 		m.BrRoute.BrPaths[j1].TableID = buf.DecodeUint32()
@@ -844,7 +844,7 @@ func (m *BierRouteAddDel) Unmarshal(b []byte) error {
 			m.BrRoute.BrPaths[j1].LabelStack[j2].Exp = buf.DecodeUint8()
 		}
 	}
-	return nil
+	return buf.Err()
 }
 
 // BierRouteAddDelReply defines message 'bier_route_add_del_reply'.
@@ -877,7 +877,7 @@ func (m *BierRouteAddDelReply) Marshal(b []byte) ([]byte, error) {
 func (m *BierRouteAddDelReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
-	return nil
+	return buf.Err()
 }
 
 // BierRouteDetails defines message 'bier_route_details'.
@@ -973,7 +973,7 @@ func (m *BierRouteDetails) Unmarshal(b []byte) error {
 	m.BrRoute.BrTblID.BtSubDomain = buf.DecodeUint8()
 	m.BrRoute.BrTblID.BtHdrLenID = buf.DecodeUint8()
 	m.BrRoute.BrNPaths = buf.DecodeUint8()
-	m.BrRoute.BrPaths = make([]fib_types.FibPath, m.BrRoute.BrNPaths)
+	m.BrRoute.BrPaths = make([]fib_types.FibPath, buf.CheckArrayLen(int(m.BrRoute.BrNPaths), 1))
 	for j1 := 0; j1 < len(m.BrRoute.BrPaths); j1++ {
 		m.BrRoute.BrPaths[j1].SwIfIndex = buf.DecodeUint32()
 		m.BrRoute.BrPaths[j1].TableID = buf.DecodeUint32()
@@ -995,7 +995,7 @@ func (m *BierRouteDetails) Unmarshal(b []byte) error {
 			m.BrRoute.BrPaths[j1].LabelStack[j2].Exp = buf.DecodeUint8()
 		}
 	}
-	return nil
+	return buf.Err()
 }
 
 // BierRouteDump defines message 'bier_route_dump'.
@@ -1034,7 +1034,7 @@ func (m *BierRouteDump) Unmarshal(b []byte) error {
 	m.BrTblID.BtSet = buf.DecodeUint8()
 	m.BrTblID.BtSubDomain = buf.DecodeUint8()
 	m.BrTblID.BtHdrLenID = buf.DecodeUint8()
-	return nil
+	return buf.Err()
 }
 
 // BierTableAddDel defines message 'bier_table_add_del'.
@@ -1081,7 +1081,7 @@ func (m *BierTableAddDel) Unmarshal(b []byte) error {
 	m.BtTblID.BtHdrLenID = buf.DecodeUint8()
 	m.BtLabel = buf.DecodeUint32()
 	m.BtIsAdd = buf.DecodeBool()
-	return nil
+	return buf.Err()
 }
 
 // BierTableAddDelReply defines message 'bier_table_add_del_reply'.
@@ -1114,7 +1114,7 @@ func (m *BierTableAddDelReply) Marshal(b []byte) ([]byte, error) {
 func (m *BierTableAddDelReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
-	return nil
+	return buf.Err()
 }
 
 // BierTableDetails defines message 'bier_table_details'.
@@ -1157,7 +1157,7 @@ func (m *BierTableDetails) Unmarshal(b []byte) error {
 	m.BtTblID.BtSet = buf.DecodeUint8()
 	m.BtTblID.BtSubDomain = buf.DecodeUint8()
 	m.BtTblID.BtHdrLenID = buf.DecodeUint8()
-	return nil
+	return buf.Err()
 }
 
 // BierTableDump defines message 'bier_table_dump'.
@@ -149,7 +149,7 @@ func (m *BondAddMember) Unmarshal(b []byte) error {
 	m.BondSwIfIndex = interface_types.InterfaceIndex(buf.DecodeUint32())
 	m.IsPassive = buf.DecodeBool()
 	m.IsLongTimeout = buf.DecodeBool()
-	return nil
+	return buf.Err()
 }
 
 // BondAddMemberReply defines message 'bond_add_member_reply'.
@@ -182,7 +182,7 @@ func (m *BondAddMemberReply) Marshal(b []byte) ([]byte, error) {
 func (m *BondAddMemberReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
-	return nil
+	return buf.Err()
 }
 
 // BondCreate defines message 'bond_create'.
@@ -236,7 +236,7 @@ func (m *BondCreate) Unmarshal(b []byte) error {
 	m.Mode = BondMode(buf.DecodeUint32())
 	m.Lb = BondLbAlgo(buf.DecodeUint32())
 	m.NumaOnly = buf.DecodeBool()
-	return nil
+	return buf.Err()
 }
 
 // BondCreate2 defines message 'bond_create2'.
@@ -293,7 +293,7 @@ func (m *BondCreate2) Unmarshal(b []byte) error {
 	m.UseCustomMac = buf.DecodeBool()
 	copy(m.MacAddress[:], buf.DecodeBytes(6))
 	m.ID = buf.DecodeUint32()
-	return nil
+	return buf.Err()
 }
 
 // BondCreate2Reply defines message 'bond_create2_reply'.
@@ -330,7 +330,7 @@ func (m *BondCreate2Reply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
 	m.SwIfIndex = interface_types.InterfaceIndex(buf.DecodeUint32())
-	return nil
+	return buf.Err()
 }
 
 // BondCreateReply defines message 'bond_create_reply'.
@@ -367,7 +367,7 @@ func (m *BondCreateReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
 	m.SwIfIndex = interface_types.InterfaceIndex(buf.DecodeUint32())
-	return nil
+	return buf.Err()
 }
 
 // BondDelete defines message 'bond_delete'.
@@ -400,7 +400,7 @@ func (m *BondDelete) Marshal(b []byte) ([]byte, error) {
 func (m *BondDelete) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.SwIfIndex = interface_types.InterfaceIndex(buf.DecodeUint32())
-	return nil
+	return buf.Err()
 }
 
 // BondDeleteReply defines message 'bond_delete_reply'.
@@ -433,7 +433,7 @@ func (m *BondDeleteReply) Marshal(b []byte) ([]byte, error) {
 func (m *BondDeleteReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
-	return nil
+	return buf.Err()
 }
 
 // BondDetachMember defines message 'bond_detach_member'.
@@ -466,7 +466,7 @@ func (m *BondDetachMember) Marshal(b []byte) ([]byte, error) {
 func (m *BondDetachMember) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.SwIfIndex = interface_types.InterfaceIndex(buf.DecodeUint32())
-	return nil
+	return buf.Err()
 }
 
 // BondDetachMemberReply defines message 'bond_detach_member_reply'.
@@ -499,7 +499,7 @@ func (m *BondDetachMemberReply) Marshal(b []byte) ([]byte, error) {
 func (m *BondDetachMemberReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
-	return nil
+	return buf.Err()
 }
 
 // BondDetachSlave defines message 'bond_detach_slave'.
@@ -533,7 +533,7 @@ func (m *BondDetachSlave) Marshal(b []byte) ([]byte, error) {
 func (m *BondDetachSlave) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.SwIfIndex = interface_types.InterfaceIndex(buf.DecodeUint32())
-	return nil
+	return buf.Err()
 }
 
 // BondDetachSlaveReply defines message 'bond_detach_slave_reply'.
@@ -567,7 +567,7 @@ func (m *BondDetachSlaveReply) Marshal(b []byte) ([]byte, error) {
 func (m *BondDetachSlaveReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
-	return nil
+	return buf.Err()
 }
 
 // BondEnslave defines message 'bond_enslave'.
@@ -613,7 +613,7 @@ func (m *BondEnslave) Unmarshal(b []byte) error {
 	m.BondSwIfIndex = interface_types.InterfaceIndex(buf.DecodeUint32())
 	m.IsPassive = buf.DecodeBool()
 	m.IsLongTimeout = buf.DecodeBool()
-	return nil
+	return buf.Err()
 }
 
 // BondEnslaveReply defines message 'bond_enslave_reply'.
@@ -646,7 +646,7 @@ func (m *BondEnslaveReply) Marshal(b []byte) ([]byte, error) {
 func (m *BondEnslaveReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
-	return nil
+	return buf.Err()
 }
 
 // SwBondInterfaceDetails defines message 'sw_bond_interface_details'.
@@ -707,7 +707,7 @@ func (m *SwBondInterfaceDetails) Unmarshal(b []byte) error {
 	m.ActiveMembers = buf.DecodeUint32()
 	m.Members = buf.DecodeUint32()
 	m.InterfaceName = buf.DecodeString(64)
-	return nil
+	return buf.Err()
 }
 
 // SwBondInterfaceDump defines message 'sw_bond_interface_dump'.
@@ -740,7 +740,7 @@ func (m *SwBondInterfaceDump) Marshal(b []byte) ([]byte, error) {
 func (m *SwBondInterfaceDump) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.SwIfIndex = interface_types.InterfaceIndex(buf.DecodeUint32())
-	return nil
+	return buf.Err()
 }
 
 // SwInterfaceBondDetails defines message 'sw_interface_bond_details'.
@@ -801,7 +801,7 @@ func (m *SwInterfaceBondDetails) Unmarshal(b []byte) error {
 	m.ActiveSlaves = buf.DecodeUint32()
 	m.Slaves = buf.DecodeUint32()
 	m.InterfaceName = buf.DecodeString(64)
-	return nil
+	return buf.Err()
 }
 
 // SwInterfaceBondDump defines message 'sw_interface_bond_dump'.
@@ -866,7 +866,7 @@ func (m *SwInterfaceSetBondWeight) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.SwIfIndex = interface_types.InterfaceIndex(buf.DecodeUint32())
 	m.Weight = buf.DecodeUint32()
-	return nil
+	return buf.Err()
 }
 
 // SwInterfaceSetBondWeightReply defines message 'sw_interface_set_bond_weight_reply'.
@@ -901,7 +901,7 @@ func (m *SwInterfaceSetBondWeightReply) Marshal(b []byte) ([]byte, error) {
 func (m *SwInterfaceSetBondWeightReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
-	return nil
+	return buf.Err()
 }
 
 // SwInterfaceSlaveDetails defines message 'sw_interface_slave_details'.
@@ -954,7 +954,7 @@ func (m *SwInterfaceSlaveDetails) Unmarshal(b []byte) error {
 	m.IsLongTimeout = buf.DecodeBool()
 	m.IsLocalNuma = buf.DecodeBool()
 	m.Weight = buf.DecodeUint32()
-	return nil
+	return buf.Err()
 }
 
 // SwInterfaceSlaveDump defines message 'sw_interface_slave_dump'.
@@ -988,7 +988,7 @@ func (m *SwInterfaceSlaveDump) Marshal(b []byte) ([]byte, error) {
 func (m *SwInterfaceSlaveDump) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.SwIfIndex = interface_types.InterfaceIndex(buf.DecodeUint32())
-	return nil
+	return buf.Err()
 }
 
 // SwMemberInterfaceDetails defines message 'sw_member_interface_details'.
@@ -1041,7 +1041,7 @@ func (m *SwMemberInterfaceDetails) Unmarshal(b []byte) error {
 	m.IsLongTimeout = buf.DecodeBool()
 	m.IsLocalNuma = buf.DecodeBool()
 	m.Weight = buf.DecodeUint32()
-	return nil
+	return buf.Err()
 }
 
 // SwMemberInterfaceDump defines message 'sw_member_interface_dump'.
@@ -1074,7 +1074,7 @@ func (m *SwMemberInterfaceDump) Marshal(b []byte) ([]byte, error) {
 func (m *SwMemberInterfaceDump) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.SwIfIndex = interface_types.InterfaceIndex(buf.DecodeUint32())
-	return nil
+	return buf.Err()
 }
 
 func init() { file_bond_binapi_init() }
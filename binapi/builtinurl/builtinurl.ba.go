@@ -84,7 +84,7 @@ func (m *BuiltinurlEnableReply) Marshal(b []byte) ([]byte, error) {
 func (m *BuiltinurlEnableReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
-	return nil
+	return buf.Err()
 }
 
 func init() { file_builtinurl_binapi_init() }
@@ -57,7 +57,7 @@ func (m *CdpEnableDisable) Marshal(b []byte) ([]byte, error) {
 func (m *CdpEnableDisable) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.EnableDisable = buf.DecodeBool()
-	return nil
+	return buf.Err()
 }
 
 // CdpEnableDisableReply defines message 'cdp_enable_disable_reply'.
@@ -90,7 +90,7 @@ func (m *CdpEnableDisableReply) Marshal(b []byte) ([]byte, error) {
 func (m *CdpEnableDisableReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
-	return nil
+	return buf.Err()
 }
 
 func init() { file_cdp_binapi_init() }
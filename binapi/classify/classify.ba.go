@@ -182,9 +182,9 @@ func (m *ClassifyAddDelSession) Unmarshal(b []byte) error {
 	m.Action = ClassifyAction(buf.DecodeUint8())
 	m.Metadata = buf.DecodeUint32()
 	m.MatchLen = buf.DecodeUint32()
-	m.Match = make([]byte, m.MatchLen)
+	m.Match = make([]byte, buf.CheckArrayLen(int(m.MatchLen), 1))
 	copy(m.Match, buf.DecodeBytes(len(m.Match)))
-	return nil
+	return buf.Err()
 }
 
 // ClassifyAddDelSessionReply defines message 'classify_add_del_session_reply'.
@@ -217,7 +217,7 @@ func (m *ClassifyAddDelSessionReply) Marshal(b []byte) ([]byte, error) {
 func (m *ClassifyAddDelSessionReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
-	return nil
+	return buf.Err()
 }
 
 // ClassifyAddDelTable defines message 'classify_add_del_table'.
@@ -297,9 +297,9 @@ func (m *ClassifyAddDelTable) Unmarshal(b []byte) error {
 	m.CurrentDataFlag = buf.DecodeUint8()
 	m.CurrentDataOffset = buf.DecodeInt16()
 	m.MaskLen = buf.DecodeUint32()
-	m.Mask = make([]byte, m.MaskLen)
+	m.Mask = make([]byte, buf.CheckArrayLen(int(m.MaskLen), 1))
 	copy(m.Mask, buf.DecodeBytes(len(m.Mask)))
-	return nil
+	return buf.Err()
 }
 
 // ClassifyAddDelTableReply defines message 'classify_add_del_table_reply'.
@@ -344,7 +344,7 @@ func (m *ClassifyAddDelTableReply) Unmarshal(b []byte) error {
 	m.NewTableIndex = buf.DecodeUint32()
 	m.SkipNVectors = buf.DecodeUint32()
 	m.MatchNVectors = buf.DecodeUint32()
-	return nil
+	return buf.Err()
 }
 
 // ClassifyPcapGetTables defines message 'classify_pcap_get_tables'.
@@ -377,7 +377,7 @@ func (m *ClassifyPcapGetTables) Marshal(b []byte) ([]byte, error) {
 func (m *ClassifyPcapGetTables) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.SwIfIndex = interface_types.InterfaceIndex(buf.DecodeUint32())
-	return nil
+	return buf.Err()
 }
 
 // ClassifyPcapGetTablesReply defines message 'classify_pcap_get_tables_reply'.
@@ -423,11 +423,11 @@ func (m *ClassifyPcapGetTablesReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
 	m.Count = buf.DecodeUint32()
-	m.Indices = make([]uint32, m.Count)
+	m.Indices = make([]uint32, buf.CheckArrayLen(int(m.Count), 4))
 	for i := 0; i < len(m.Indices); i++ {
 		m.Indices[i] = buf.DecodeUint32()
 	}
-	return nil
+	return buf.Err()
 }
 
 // ClassifyPcapLookupTable defines message 'classify_pcap_lookup_table'.
@@ -475,9 +475,9 @@ func (m *ClassifyPcapLookupTable) Unmarshal(b []byte) error {
 	m.SkipNVectors = buf.DecodeUint32()
 	m.MatchNVectors = buf.DecodeUint32()
 	m.MaskLen = buf.DecodeUint32()
-	m.Mask = make([]byte, m.MaskLen)
+	m.Mask = make([]byte, buf.CheckArrayLen(int(m.MaskLen), 1))
 	copy(m.Mask, buf.DecodeBytes(len(m.Mask)))
-	return nil
+	return buf.Err()
 }
 
 // ClassifyPcapLookupTableReply defines message 'classify_pcap_lookup_table_reply'.
@@ -516,7 +516,7 @@ func (m *ClassifyPcapLookupTableReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
 	m.TableIndex = buf.DecodeUint32()
-	return nil
+	return buf.Err()
 }
 
 // ClassifyPcapSetTable defines message 'classify_pcap_set_table'.
@@ -557,7 +557,7 @@ func (m *ClassifyPcapSetTable) Unmarshal(b []byte) error {
 	m.SwIfIndex = interface_types.InterfaceIndex(buf.DecodeUint32())
 	m.TableIndex = buf.DecodeUint32()
 	m.SortMasks = buf.DecodeBool()
-	return nil
+	return buf.Err()
 }
 
 // ClassifyPcapSetTableReply defines message 'classify_pcap_set_table_reply'.
@@ -594,7 +594,7 @@ func (m *ClassifyPcapSetTableReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
 	m.TableIndex = buf.DecodeUint32()
-	return nil
+	return buf.Err()
 }
 
 // ClassifySessionDetails defines message 'classify_session_details'.
@@ -650,9 +650,9 @@ func (m *ClassifySessionDetails) Unmarshal(b []byte) error {
 	m.Advance = buf.DecodeInt32()
 	m.OpaqueIndex = buf.DecodeUint32()
 	m.MatchLength = buf.DecodeUint32()
-	m.Match = make([]byte, m.MatchLength)
+	m.Match = make([]byte, buf.CheckArrayLen(int(m.MatchLength), 1))
 	copy(m.Match, buf.DecodeBytes(len(m.Match)))
-	return nil
+	return buf.Err()
 }
 
 // ClassifySessionDump defines message 'classify_session_dump'.
@@ -685,7 +685,7 @@ func (m *ClassifySessionDump) Marshal(b []byte) ([]byte, error) {
 func (m *ClassifySessionDump) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.TableID = buf.DecodeUint32()
-	return nil
+	return buf.Err()
 }
 
 // ClassifySetInterfaceIPTable defines message 'classify_set_interface_ip_table'.
@@ -726,7 +726,7 @@ func (m *ClassifySetInterfaceIPTable) Unmarshal(b []byte) error {
 	m.IsIPv6 = buf.DecodeBool()
 	m.SwIfIndex = interface_types.InterfaceIndex(buf.DecodeUint32())
 	m.TableIndex = buf.DecodeUint32()
-	return nil
+	return buf.Err()
 }
 
 // ClassifySetInterfaceIPTableReply defines message 'classify_set_interface_ip_table_reply'.
@@ -761,7 +761,7 @@ func (m *ClassifySetInterfaceIPTableReply) Marshal(b []byte) ([]byte, error) {
 func (m *ClassifySetInterfaceIPTableReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
-	return nil
+	return buf.Err()
 }
 
 // ClassifySetInterfaceL2Tables defines message 'classify_set_interface_l2_tables'.
@@ -812,7 +812,7 @@ func (m *ClassifySetInterfaceL2Tables) Unmarshal(b []byte) error {
 	m.IP6TableIndex = buf.DecodeUint32()
 	m.OtherTableIndex = buf.DecodeUint32()
 	m.IsInput = buf.DecodeBool()
-	return nil
+	return buf.Err()
 }
 
 // ClassifySetInterfaceL2TablesReply defines message 'classify_set_interface_l2_tables_reply'.
@@ -847,7 +847,7 @@ func (m *ClassifySetInterfaceL2TablesReply) Marshal(b []byte) ([]byte, error) {
 func (m *ClassifySetInterfaceL2TablesReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
-	return nil
+	return buf.Err()
 }
 
 // ClassifyTableByInterface defines message 'classify_table_by_interface'.
@@ -880,7 +880,7 @@ func (m *ClassifyTableByInterface) Marshal(b []byte) ([]byte, error) {
 func (m *ClassifyTableByInterface) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.SwIfIndex = interface_types.InterfaceIndex(buf.DecodeUint32())
-	return nil
+	return buf.Err()
 }
 
 // ClassifyTableByInterfaceReply defines message 'classify_table_by_interface_reply'.
@@ -931,7 +931,7 @@ func (m *ClassifyTableByInterfaceReply) Unmarshal(b []byte) error {
 	m.L2TableID = buf.DecodeUint32()
 	m.IP4TableID = buf.DecodeUint32()
 	m.IP6TableID = buf.DecodeUint32()
-	return nil
+	return buf.Err()
 }
 
 // ClassifyTableIds defines message 'classify_table_ids'.
@@ -1004,11 +1004,11 @@ func (m *ClassifyTableIdsReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
 	m.Count = buf.DecodeUint32()
-	m.Ids = make([]uint32, m.Count)
+	m.Ids = make([]uint32, buf.CheckArrayLen(int(m.Count), 4))
 	for i := 0; i < len(m.Ids); i++ {
 		m.Ids[i] = buf.DecodeUint32()
 	}
-	return nil
+	return buf.Err()
 }
 
 // ClassifyTableInfo defines message 'classify_table_info'.
@@ -1041,7 +1041,7 @@ func (m *ClassifyTableInfo) Marshal(b []byte) ([]byte, error) {
 func (m *ClassifyTableInfo) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.TableID = buf.DecodeUint32()
-	return nil
+	return buf.Err()
 }
 
 // ClassifyTableInfoReply defines message 'classify_table_info_reply'.
@@ -1109,9 +1109,9 @@ func (m *ClassifyTableInfoReply) Unmarshal(b []byte) error {
 	m.NextTableIndex = buf.DecodeUint32()
 	m.MissNextIndex = buf.DecodeUint32()
 	m.MaskLength = buf.DecodeUint32()
-	m.Mask = make([]byte, m.MaskLength)
+	m.Mask = make([]byte, buf.CheckArrayLen(int(m.MaskLength), 1))
 	copy(m.Mask, buf.DecodeBytes(len(m.Mask)))
-	return nil
+	return buf.Err()
 }
 
 // ClassifyTraceGetTables defines message 'classify_trace_get_tables'.
@@ -1184,11 +1184,11 @@ func (m *ClassifyTraceGetTablesReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
 	m.Count = buf.DecodeUint32()
-	m.Indices = make([]uint32, m.Count)
+	m.Indices = make([]uint32, buf.CheckArrayLen(int(m.Count), 4))
 	for i := 0; i < len(m.Indices); i++ {
 		m.Indices[i] = buf.DecodeUint32()
 	}
-	return nil
+	return buf.Err()
 }
 
 // ClassifyTraceLookupTable defines message 'classify_trace_lookup_table'.
@@ -1232,9 +1232,9 @@ func (m *ClassifyTraceLookupTable) Unmarshal(b []byte) error {
 	m.SkipNVectors = buf.DecodeUint32()
 	m.MatchNVectors = buf.DecodeUint32()
 	m.MaskLen = buf.DecodeUint32()
-	m.Mask = make([]byte, m.MaskLen)
+	m.Mask = make([]byte, buf.CheckArrayLen(int(m.MaskLen), 1))
 	copy(m.Mask, buf.DecodeBytes(len(m.Mask)))
-	return nil
+	return buf.Err()
 }
 
 // ClassifyTraceLookupTableReply defines message 'classify_trace_lookup_table_reply'.
@@ -1273,7 +1273,7 @@ func (m *ClassifyTraceLookupTableReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
 	m.TableIndex = buf.DecodeUint32()
-	return nil
+	return buf.Err()
 }
 
 // ClassifyTraceSetTable defines message 'classify_trace_set_table'.
@@ -1310,7 +1310,7 @@ func (m *ClassifyTraceSetTable) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.TableIndex = buf.DecodeUint32()
 	m.SortMasks = buf.DecodeBool()
-	return nil
+	return buf.Err()
 }
 
 // ClassifyTraceSetTableReply defines message 'classify_trace_set_table_reply'.
@@ -1347,7 +1347,7 @@ func (m *ClassifyTraceSetTableReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
 	m.TableIndex = buf.DecodeUint32()
-	return nil
+	return buf.Err()
 }
 
 // FlowClassifyDetails defines message 'flow_classify_details'.
@@ -1384,7 +1384,7 @@ func (m *FlowClassifyDetails) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.SwIfIndex = interface_types.InterfaceIndex(buf.DecodeUint32())
 	m.TableIndex = buf.DecodeUint32()
-	return nil
+	return buf.Err()
 }
 
 // FlowClassifyDump defines message 'flow_classify_dump'.
@@ -1421,7 +1421,7 @@ func (m *FlowClassifyDump) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Type = FlowClassifyTable(buf.DecodeUint8())
 	m.SwIfIndex = interface_types.InterfaceIndex(buf.DecodeUint32())
-	return nil
+	return buf.Err()
 }
 
 // FlowClassifySetInterface defines message 'flow_classify_set_interface'.
@@ -1466,7 +1466,7 @@ func (m *FlowClassifySetInterface) Unmarshal(b []byte) error {
 	m.IP4TableIndex = buf.DecodeUint32()
 	m.IP6TableIndex = buf.DecodeUint32()
 	m.IsAdd = buf.DecodeBool()
-	return nil
+	return buf.Err()
 }
 
 // FlowClassifySetInterfaceReply defines message 'flow_classify_set_interface_reply'.
@@ -1501,7 +1501,7 @@ func (m *FlowClassifySetInterfaceReply) Marshal(b []byte) ([]byte, error) {
 func (m *FlowClassifySetInterfaceReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
-	return nil
+	return buf.Err()
 }
 
 // InputACLSetInterface defines message 'input_acl_set_interface'.
@@ -1550,7 +1550,7 @@ func (m *InputACLSetInterface) Unmarshal(b []byte) error {
 	m.IP6TableIndex = buf.DecodeUint32()
 	m.L2TableIndex = buf.DecodeUint32()
 	m.IsAdd = buf.DecodeBool()
-	return nil
+	return buf.Err()
 }
 
 // InputACLSetInterfaceReply defines message 'input_acl_set_interface_reply'.
@@ -1583,7 +1583,7 @@ func (m *InputACLSetInterfaceReply) Marshal(b []byte) ([]byte, error) {
 func (m *InputACLSetInterfaceReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
-	return nil
+	return buf.Err()
 }
 
 // OutputACLSetInterface defines message 'output_acl_set_interface'.
@@ -1632,7 +1632,7 @@ func (m *OutputACLSetInterface) Unmarshal(b []byte) error {
 	m.IP6TableIndex = buf.DecodeUint32()
 	m.L2TableIndex = buf.DecodeUint32()
 	m.IsAdd = buf.DecodeBool()
-	return nil
+	return buf.Err()
 }
 
 // OutputACLSetInterfaceReply defines message 'output_acl_set_interface_reply'.
@@ -1665,7 +1665,7 @@ func (m *OutputACLSetInterfaceReply) Marshal(b []byte) ([]byte, error) {
 func (m *OutputACLSetInterfaceReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
-	return nil
+	return buf.Err()
 }
 
 // PolicerClassifyDetails defines message 'policer_classify_details'.
@@ -1702,7 +1702,7 @@ func (m *PolicerClassifyDetails) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.SwIfIndex = interface_types.InterfaceIndex(buf.DecodeUint32())
 	m.TableIndex = buf.DecodeUint32()
-	return nil
+	return buf.Err()
 }
 
 // PolicerClassifyDump defines message 'policer_classify_dump'.
@@ -1739,7 +1739,7 @@ func (m *PolicerClassifyDump) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Type = PolicerClassifyTable(buf.DecodeUint8())
 	m.SwIfIndex = interface_types.InterfaceIndex(buf.DecodeUint32())
-	return nil
+	return buf.Err()
 }
 
 // PolicerClassifySetInterface defines message 'policer_classify_set_interface'.
@@ -1788,7 +1788,7 @@ func (m *PolicerClassifySetInterface) Unmarshal(b []byte) error {
 	m.IP6TableIndex = buf.DecodeUint32()
 	m.L2TableIndex = buf.DecodeUint32()
 	m.IsAdd = buf.DecodeBool()
-	return nil
+	return buf.Err()
 }
 
 // PolicerClassifySetInterfaceReply defines message 'policer_classify_set_interface_reply'.
@@ -1823,7 +1823,7 @@ func (m *PolicerClassifySetInterfaceReply) Marshal(b []byte) ([]byte, error) {
 func (m *PolicerClassifySetInterfaceReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
-	return nil
+	return buf.Err()
 }
 
 // PuntACLAddDel defines message 'punt_acl_add_del'.
@@ -1864,7 +1864,7 @@ func (m *PuntACLAddDel) Unmarshal(b []byte) error {
 	m.IP4TableIndex = buf.DecodeUint32()
 	m.IP6TableIndex = buf.DecodeUint32()
 	m.IsAdd = buf.DecodeBool()
-	return nil
+	return buf.Err()
 }
 
 // PuntACLAddDelReply defines message 'punt_acl_add_del_reply'.
@@ -1897,7 +1897,7 @@ func (m *PuntACLAddDelReply) Marshal(b []byte) ([]byte, error) {
 func (m *PuntACLAddDelReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
-	return nil
+	return buf.Err()
 }
 
 // PuntACLGet defines message 'punt_acl_get'.
@@ -1965,7 +1965,7 @@ func (m *PuntACLGetReply) Unmarshal(b []byte) error {
 	m.Retval = buf.DecodeInt32()
 	m.IP4TableIndex = buf.DecodeUint32()
 	m.IP6TableIndex = buf.DecodeUint32()
-	return nil
+	return buf.Err()
 }
 
 func init() { file_classify_binapi_init() }
@@ -319,7 +319,7 @@ func (m *CnatGetSnatAddressesReply) Unmarshal(b []byte) error {
 	copy(m.SnatIP4[:], buf.DecodeBytes(4))
 	copy(m.SnatIP6[:], buf.DecodeBytes(16))
 	m.SwIfIndex = interface_types.InterfaceIndex(buf.DecodeUint32())
-	return nil
+	return buf.Err()
 }
 
 // CnatSessionDetails defines message 'cnat_session_details'.
@@ -404,7 +404,7 @@ func (m *CnatSessionDetails) Unmarshal(b []byte) error {
 	m.Session.IPProto = ip_types.IPProto(buf.DecodeUint8())
 	m.Session.Location = buf.DecodeUint8()
 	m.Session.Timestamp = buf.DecodeFloat64()
-	return nil
+	return buf.Err()
 }
 
 // CnatSessionDump defines message 'cnat_session_dump'.
@@ -494,7 +494,7 @@ func (m *CnatSessionPurgeReply) Marshal(b []byte) ([]byte, error) {
 func (m *CnatSessionPurgeReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
-	return nil
+	return buf.Err()
 }
 
 // CnatSetSnatAddresses defines message 'cnat_set_snat_addresses'.
@@ -536,7 +536,7 @@ func (m *CnatSetSnatAddresses) Unmarshal(b []byte) error {
 	copy(m.SnatIP4[:], buf.DecodeBytes(4))
 	copy(m.SnatIP6[:], buf.DecodeBytes(16))
 	m.SwIfIndex = interface_types.InterfaceIndex(buf.DecodeUint32())
-	return nil
+	return buf.Err()
 }
 
 // CnatSetSnatAddressesReply defines message 'cnat_set_snat_addresses_reply'.
@@ -570,7 +570,7 @@ func (m *CnatSetSnatAddressesReply) Marshal(b []byte) ([]byte, error) {
 func (m *CnatSetSnatAddressesReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
-	return nil
+	return buf.Err()
 }
 
 // CnatSetSnatPolicy defines message 'cnat_set_snat_policy'.
@@ -604,7 +604,7 @@ func (m *CnatSetSnatPolicy) Marshal(b []byte) ([]byte, error) {
 func (m *CnatSetSnatPolicy) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Policy = CnatSnatPolicies(buf.DecodeUint8())
-	return nil
+	return buf.Err()
 }
 
 // CnatSetSnatPolicyReply defines message 'cnat_set_snat_policy_reply'.
@@ -638,7 +638,7 @@ func (m *CnatSetSnatPolicyReply) Marshal(b []byte) ([]byte, error) {
 func (m *CnatSetSnatPolicyReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
-	return nil
+	return buf.Err()
 }
 
 // CnatSnatPolicyAddDelExcludePfx defines message 'cnat_snat_policy_add_del_exclude_pfx'.
@@ -684,7 +684,7 @@ func (m *CnatSnatPolicyAddDelExcludePfx) Unmarshal(b []byte) error {
 	m.Prefix.Address.Af = ip_types.AddressFamily(buf.DecodeUint8())
 	copy(m.Prefix.Address.Un.XXX_UnionData[:], buf.DecodeBytes(16))
 	m.Prefix.Len = buf.DecodeUint8()
-	return nil
+	return buf.Err()
 }
 
 // CnatSnatPolicyAddDelExcludePfxReply defines message 'cnat_snat_policy_add_del_exclude_pfx_reply'.
@@ -720,7 +720,7 @@ func (m *CnatSnatPolicyAddDelExcludePfxReply) Marshal(b []byte) ([]byte, error)
 func (m *CnatSnatPolicyAddDelExcludePfxReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
-	return nil
+	return buf.Err()
 }
 
 // CnatSnatPolicyAddDelIf defines message 'cnat_snat_policy_add_del_if'.
@@ -762,7 +762,7 @@ func (m *CnatSnatPolicyAddDelIf) Unmarshal(b []byte) error {
 	m.SwIfIndex = interface_types.InterfaceIndex(buf.DecodeUint32())
 	m.IsAdd = buf.DecodeUint8()
 	m.Table = CnatSnatPolicyTable(buf.DecodeUint8())
-	return nil
+	return buf.Err()
 }
 
 // CnatSnatPolicyAddDelIfReply defines message 'cnat_snat_policy_add_del_if_reply'.
@@ -798,7 +798,7 @@ func (m *CnatSnatPolicyAddDelIfReply) Marshal(b []byte) ([]byte, error) {
 func (m *CnatSnatPolicyAddDelIfReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
-	return nil
+	return buf.Err()
 }
 
 // CnatTranslationDel defines message 'cnat_translation_del'.
@@ -832,7 +832,7 @@ func (m *CnatTranslationDel) Marshal(b []byte) ([]byte, error) {
 func (m *CnatTranslationDel) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.ID = buf.DecodeUint32()
-	return nil
+	return buf.Err()
 }
 
 // CnatTranslationDelReply defines message 'cnat_translation_del_reply'.
@@ -866,7 +866,7 @@ func (m *CnatTranslationDelReply) Marshal(b []byte) ([]byte, error) {
 func (m *CnatTranslationDelReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
-	return nil
+	return buf.Err()
 }
 
 // CnatTranslationDetails defines message 'cnat_translation_details'.
@@ -965,7 +965,7 @@ func (m *CnatTranslationDetails) Unmarshal(b []byte) error {
 	m.Translation.Flags = buf.DecodeUint8()
 	m.Translation.LbType = CnatLbType(buf.DecodeUint8())
 	m.Translation.NPaths = buf.DecodeUint32()
-	m.Translation.Paths = make([]CnatEndpointTuple, m.Translation.NPaths)
+	m.Translation.Paths = make([]CnatEndpointTuple, buf.CheckArrayLen(int(m.Translation.NPaths), 1))
 	for j1 := 0; j1 < len(m.Translation.Paths); j1++ {
 		m.Translation.Paths[j1].DstEp.Addr.Af = ip_types.AddressFamily(buf.DecodeUint8())
 		copy(m.Translation.Paths[j1].DstEp.Addr.Un.XXX_UnionData[:], buf.DecodeBytes(16))
@@ -979,7 +979,7 @@ func (m *CnatTranslationDetails) Unmarshal(b []byte) error {
 		m.Translation.Paths[j1].SrcEp.Port = buf.DecodeUint16()
 		m.Translation.Paths[j1].Flags = buf.DecodeUint8()
 	}
-	return nil
+	return buf.Err()
 }
 
 // CnatTranslationDump defines message 'cnat_translation_dump'.
@@ -1106,7 +1106,7 @@ func (m *CnatTranslationUpdate) Unmarshal(b []byte) error {
 	m.Translation.Flags = buf.DecodeUint8()
 	m.Translation.LbType = CnatLbType(buf.DecodeUint8())
 	m.Translation.NPaths = buf.DecodeUint32()
-	m.Translation.Paths = make([]CnatEndpointTuple, m.Translation.NPaths)
+	m.Translation.Paths = make([]CnatEndpointTuple, buf.CheckArrayLen(int(m.Translation.NPaths), 1))
 	for j1 := 0; j1 < len(m.Translation.Paths); j1++ {
 		m.Translation.Paths[j1].DstEp.Addr.Af = ip_types.AddressFamily(buf.DecodeUint8())
 		copy(m.Translation.Paths[j1].DstEp.Addr.Un.XXX_UnionData[:], buf.DecodeBytes(16))
@@ -1120,7 +1120,7 @@ func (m *CnatTranslationUpdate) Unmarshal(b []byte) error {
 		m.Translation.Paths[j1].SrcEp.Port = buf.DecodeUint16()
 		m.Translation.Paths[j1].Flags = buf.DecodeUint8()
 	}
-	return nil
+	return buf.Err()
 }
 
 // CnatTranslationUpdateReply defines message 'cnat_translation_update_reply'.
@@ -1158,7 +1158,7 @@ func (m *CnatTranslationUpdateReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
 	m.ID = buf.DecodeUint32()
-	return nil
+	return buf.Err()
 }
 
 func init() { file_cnat_binapi_init() }
@@ -117,7 +117,7 @@ func (m *CryptoSetAsyncDispatch) Marshal(b []byte) ([]byte, error) {
 func (m *CryptoSetAsyncDispatch) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Mode = CryptoDispatchMode(buf.DecodeUint8())
-	return nil
+	return buf.Err()
 }
 
 // CryptoSetAsyncDispatchReply defines message 'crypto_set_async_dispatch_reply'.
@@ -150,7 +150,7 @@ func (m *CryptoSetAsyncDispatchReply) Marshal(b []byte) ([]byte, error) {
 func (m *CryptoSetAsyncDispatchReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
-	return nil
+	return buf.Err()
 }
 
 // CryptoSetHandler defines message 'crypto_set_handler'.
@@ -195,7 +195,7 @@ func (m *CryptoSetHandler) Unmarshal(b []byte) error {
 	m.Engine = buf.DecodeString(16)
 	m.Oct = CryptoOpClassType(buf.DecodeUint8())
 	m.IsAsync = buf.DecodeUint8()
-	return nil
+	return buf.Err()
 }
 
 // CryptoSetHandlerReply defines message 'crypto_set_handler_reply'.
@@ -228,7 +228,7 @@ func (m *CryptoSetHandlerReply) Marshal(b []byte) ([]byte, error) {
 func (m *CryptoSetHandlerReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
-	return nil
+	return buf.Err()
 }
 
 func init() { file_crypto_binapi_init() }
@@ -28,7 +28,7 @@ func (c *serviceClient) CryptoSetAsyncDispatch(ctx context.Context, in *CryptoSe
 	if err != nil {
 		return nil, err
 	}
-	return out, api.RetvalToVPPApiError(out.Retval)
+	return out, api.RetvalToRequestError(in, out.Retval)
 }
 
 func (c *serviceClient) CryptoSetHandler(ctx context.Context, in *CryptoSetHandler) (*CryptoSetHandlerReply, error) {
@@ -37,5 +37,5 @@ func (c *serviceClient) CryptoSetHandler(ctx context.Context, in *CryptoSetHandl
 	if err != nil {
 		return nil, err
 	}
-	return out, api.RetvalToVPPApiError(out.Retval)
+	return out, api.RetvalToRequestError(in, out.Retval)
 }
@@ -62,7 +62,7 @@ func (m *CryptoSwSchedulerSetWorker) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.WorkerIndex = buf.DecodeUint32()
 	m.CryptoEnable = buf.DecodeBool()
-	return nil
+	return buf.Err()
 }
 
 // CryptoSwSchedulerSetWorkerReply defines message 'crypto_sw_scheduler_set_worker_reply'.
@@ -98,7 +98,7 @@ func (m *CryptoSwSchedulerSetWorkerReply) Marshal(b []byte) ([]byte, error) {
 func (m *CryptoSwSchedulerSetWorkerReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
-	return nil
+	return buf.Err()
 }
 
 func init() { file_crypto_sw_scheduler_binapi_init() }
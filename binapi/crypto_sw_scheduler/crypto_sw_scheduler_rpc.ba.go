@@ -27,5 +27,5 @@ func (c *serviceClient) CryptoSwSchedulerSetWorker(ctx context.Context, in *Cryp
 	if err != nil {
 		return nil, err
 	}
-	return out, api.RetvalToVPPApiError(out.Retval)
+	return out, api.RetvalToRequestError(in, out.Retval)
 }
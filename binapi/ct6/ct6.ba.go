@@ -66,7 +66,7 @@ func (m *Ct6EnableDisable) Unmarshal(b []byte) error {
 	m.EnableDisable = buf.DecodeBool()
 	m.IsInside = buf.DecodeBool()
 	m.SwIfIndex = interface_types.InterfaceIndex(buf.DecodeUint32())
-	return nil
+	return buf.Err()
 }
 
 // Ct6EnableDisableReply defines message 'ct6_enable_disable_reply'.
@@ -99,7 +99,7 @@ func (m *Ct6EnableDisableReply) Marshal(b []byte) ([]byte, error) {
 func (m *Ct6EnableDisableReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
-	return nil
+	return buf.Err()
 }
 
 func init() { file_ct6_binapi_init() }
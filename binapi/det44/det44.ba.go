@@ -76,7 +76,7 @@ func (m *Det44AddDelMap) Unmarshal(b []byte) error {
 	m.InPlen = buf.DecodeUint8()
 	copy(m.OutAddr[:], buf.DecodeBytes(4))
 	m.OutPlen = buf.DecodeUint8()
-	return nil
+	return buf.Err()
 }
 
 // Det44AddDelMapReply defines message 'det44_add_del_map_reply'.
@@ -109,7 +109,7 @@ func (m *Det44AddDelMapReply) Marshal(b []byte) ([]byte, error) {
 func (m *Det44AddDelMapReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
-	return nil
+	return buf.Err()
 }
 
 // Det44CloseSessionIn defines message 'det44_close_session_in'.
@@ -154,7 +154,7 @@ func (m *Det44CloseSessionIn) Unmarshal(b []byte) error {
 	m.InPort = buf.DecodeUint16()
 	copy(m.ExtAddr[:], buf.DecodeBytes(4))
 	m.ExtPort = buf.DecodeUint16()
-	return nil
+	return buf.Err()
 }
 
 // Det44CloseSessionInReply defines message 'det44_close_session_in_reply'.
@@ -187,7 +187,7 @@ func (m *Det44CloseSessionInReply) Marshal(b []byte) ([]byte, error) {
 func (m *Det44CloseSessionInReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
-	return nil
+	return buf.Err()
 }
 
 // Det44CloseSessionOut defines message 'det44_close_session_out'.
@@ -232,7 +232,7 @@ func (m *Det44CloseSessionOut) Unmarshal(b []byte) error {
 	m.OutPort = buf.DecodeUint16()
 	copy(m.ExtAddr[:], buf.DecodeBytes(4))
 	m.ExtPort = buf.DecodeUint16()
-	return nil
+	return buf.Err()
 }
 
 // Det44CloseSessionOutReply defines message 'det44_close_session_out_reply'.
@@ -265,7 +265,7 @@ func (m *Det44CloseSessionOutReply) Marshal(b []byte) ([]byte, error) {
 func (m *Det44CloseSessionOutReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
-	return nil
+	return buf.Err()
 }
 
 // Det44Forward defines message 'det44_forward'.
@@ -298,7 +298,7 @@ func (m *Det44Forward) Marshal(b []byte) ([]byte, error) {
 func (m *Det44Forward) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	copy(m.InAddr[:], buf.DecodeBytes(4))
-	return nil
+	return buf.Err()
 }
 
 // Det44ForwardReply defines message 'det44_forward_reply'.
@@ -343,7 +343,7 @@ func (m *Det44ForwardReply) Unmarshal(b []byte) error {
 	m.OutPortLo = buf.DecodeUint16()
 	m.OutPortHi = buf.DecodeUint16()
 	copy(m.OutAddr[:], buf.DecodeBytes(4))
-	return nil
+	return buf.Err()
 }
 
 // Det44GetTimeouts defines message 'det44_get_timeouts'.
@@ -420,7 +420,7 @@ func (m *Det44GetTimeoutsReply) Unmarshal(b []byte) error {
 	m.TCPEstablished = buf.DecodeUint32()
 	m.TCPTransitory = buf.DecodeUint32()
 	m.ICMP = buf.DecodeUint32()
-	return nil
+	return buf.Err()
 }
 
 // Det44InterfaceAddDelFeature defines message 'det44_interface_add_del_feature'.
@@ -462,7 +462,7 @@ func (m *Det44InterfaceAddDelFeature) Unmarshal(b []byte) error {
 	m.IsAdd = buf.DecodeBool()
 	m.IsInside = buf.DecodeBool()
 	m.SwIfIndex = interface_types.InterfaceIndex(buf.DecodeUint32())
-	return nil
+	return buf.Err()
 }
 
 // Det44InterfaceAddDelFeatureReply defines message 'det44_interface_add_del_feature_reply'.
@@ -498,7 +498,7 @@ func (m *Det44InterfaceAddDelFeatureReply) Marshal(b []byte) ([]byte, error) {
 func (m *Det44InterfaceAddDelFeatureReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
-	return nil
+	return buf.Err()
 }
 
 // Det44InterfaceDetails defines message 'det44_interface_details'.
@@ -540,7 +540,7 @@ func (m *Det44InterfaceDetails) Unmarshal(b []byte) error {
 	m.IsInside = buf.DecodeBool()
 	m.IsOutside = buf.DecodeBool()
 	m.SwIfIndex = interface_types.InterfaceIndex(buf.DecodeUint32())
-	return nil
+	return buf.Err()
 }
 
 // Det44InterfaceDump defines message 'det44_interface_dump'.
@@ -625,7 +625,7 @@ func (m *Det44MapDetails) Unmarshal(b []byte) error {
 	m.SharingRatio = buf.DecodeUint32()
 	m.PortsPerHost = buf.DecodeUint16()
 	m.SesNum = buf.DecodeUint32()
-	return nil
+	return buf.Err()
 }
 
 // Det44MapDump defines message 'det44_map_dump'.
@@ -694,7 +694,7 @@ func (m *Det44PluginEnableDisable) Unmarshal(b []byte) error {
 	m.InsideVrf = buf.DecodeUint32()
 	m.OutsideVrf = buf.DecodeUint32()
 	m.Enable = buf.DecodeBool()
-	return nil
+	return buf.Err()
 }
 
 // Det44PluginEnableDisableReply defines message 'det44_plugin_enable_disable_reply'.
@@ -730,7 +730,7 @@ func (m *Det44PluginEnableDisableReply) Marshal(b []byte) ([]byte, error) {
 func (m *Det44PluginEnableDisableReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
-	return nil
+	return buf.Err()
 }
 
 // Det44Reverse defines message 'det44_reverse'.
@@ -767,7 +767,7 @@ func (m *Det44Reverse) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.OutPort = buf.DecodeUint16()
 	copy(m.OutAddr[:], buf.DecodeBytes(4))
-	return nil
+	return buf.Err()
 }
 
 // Det44ReverseReply defines message 'det44_reverse_reply'.
@@ -804,7 +804,7 @@ func (m *Det44ReverseReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
 	copy(m.InAddr[:], buf.DecodeBytes(4))
-	return nil
+	return buf.Err()
 }
 
 // Det44SessionDetails defines message 'det44_session_details'.
@@ -857,7 +857,7 @@ func (m *Det44SessionDetails) Unmarshal(b []byte) error {
 	m.OutPort = buf.DecodeUint16()
 	m.State = buf.DecodeUint8()
 	m.Expire = buf.DecodeUint32()
-	return nil
+	return buf.Err()
 }
 
 // Det44SessionDump defines message 'det44_session_dump'.
@@ -890,7 +890,7 @@ func (m *Det44SessionDump) Marshal(b []byte) ([]byte, error) {
 func (m *Det44SessionDump) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	copy(m.UserAddr[:], buf.DecodeBytes(4))
-	return nil
+	return buf.Err()
 }
 
 // Det44SetTimeouts defines message 'det44_set_timeouts'.
@@ -936,7 +936,7 @@ func (m *Det44SetTimeouts) Unmarshal(b []byte) error {
 	m.TCPEstablished = buf.DecodeUint32()
 	m.TCPTransitory = buf.DecodeUint32()
 	m.ICMP = buf.DecodeUint32()
-	return nil
+	return buf.Err()
 }
 
 // Det44SetTimeoutsReply defines message 'det44_set_timeouts_reply'.
@@ -970,7 +970,7 @@ func (m *Det44SetTimeoutsReply) Marshal(b []byte) ([]byte, error) {
 func (m *Det44SetTimeoutsReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
-	return nil
+	return buf.Err()
 }
 
 // NatDetAddDelMap defines message 'nat_det_add_del_map'.
@@ -1020,7 +1020,7 @@ func (m *NatDetAddDelMap) Unmarshal(b []byte) error {
 	m.InPlen = buf.DecodeUint8()
 	copy(m.OutAddr[:], buf.DecodeBytes(4))
 	m.OutPlen = buf.DecodeUint8()
-	return nil
+	return buf.Err()
 }
 
 // NatDetAddDelMapReply defines message 'nat_det_add_del_map_reply'.
@@ -1054,7 +1054,7 @@ func (m *NatDetAddDelMapReply) Marshal(b []byte) ([]byte, error) {
 func (m *NatDetAddDelMapReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
-	return nil
+	return buf.Err()
 }
 
 // NatDetCloseSessionIn defines message 'nat_det_close_session_in'.
@@ -1100,7 +1100,7 @@ func (m *NatDetCloseSessionIn) Unmarshal(b []byte) error {
 	m.InPort = buf.DecodeUint16()
 	copy(m.ExtAddr[:], buf.DecodeBytes(4))
 	m.ExtPort = buf.DecodeUint16()
-	return nil
+	return buf.Err()
 }
 
 // NatDetCloseSessionInReply defines message 'nat_det_close_session_in_reply'.
@@ -1134,7 +1134,7 @@ func (m *NatDetCloseSessionInReply) Marshal(b []byte) ([]byte, error) {
 func (m *NatDetCloseSessionInReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
-	return nil
+	return buf.Err()
 }
 
 // NatDetCloseSessionOut defines message 'nat_det_close_session_out'.
@@ -1180,7 +1180,7 @@ func (m *NatDetCloseSessionOut) Unmarshal(b []byte) error {
 	m.OutPort = buf.DecodeUint16()
 	copy(m.ExtAddr[:], buf.DecodeBytes(4))
 	m.ExtPort = buf.DecodeUint16()
-	return nil
+	return buf.Err()
 }
 
 // NatDetCloseSessionOutReply defines message 'nat_det_close_session_out_reply'.
@@ -1214,7 +1214,7 @@ func (m *NatDetCloseSessionOutReply) Marshal(b []byte) ([]byte, error) {
 func (m *NatDetCloseSessionOutReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
-	return nil
+	return buf.Err()
 }
 
 // NatDetForward defines message 'nat_det_forward'.
@@ -1248,7 +1248,7 @@ func (m *NatDetForward) Marshal(b []byte) ([]byte, error) {
 func (m *NatDetForward) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	copy(m.InAddr[:], buf.DecodeBytes(4))
-	return nil
+	return buf.Err()
 }
 
 // NatDetForwardReply defines message 'nat_det_forward_reply'.
@@ -1294,7 +1294,7 @@ func (m *NatDetForwardReply) Unmarshal(b []byte) error {
 	m.OutPortLo = buf.DecodeUint16()
 	m.OutPortHi = buf.DecodeUint16()
 	copy(m.OutAddr[:], buf.DecodeBytes(4))
-	return nil
+	return buf.Err()
 }
 
 // NatDetMapDetails defines message 'nat_det_map_details'.
@@ -1352,7 +1352,7 @@ func (m *NatDetMapDetails) Unmarshal(b []byte) error {
 	m.SharingRatio = buf.DecodeUint32()
 	m.PortsPerHost = buf.DecodeUint16()
 	m.SesNum = buf.DecodeUint32()
-	return nil
+	return buf.Err()
 }
 
 // NatDetMapDump defines message 'nat_det_map_dump'.
@@ -1418,7 +1418,7 @@ func (m *NatDetReverse) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.OutPort = buf.DecodeUint16()
 	copy(m.OutAddr[:], buf.DecodeBytes(4))
-	return nil
+	return buf.Err()
 }
 
 // NatDetReverseReply defines message 'nat_det_reverse_reply'.
@@ -1456,7 +1456,7 @@ func (m *NatDetReverseReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
 	copy(m.InAddr[:], buf.DecodeBytes(4))
-	return nil
+	return buf.Err()
 }
 
 // NatDetSessionDetails defines message 'nat_det_session_details'.
@@ -1510,7 +1510,7 @@ func (m *NatDetSessionDetails) Unmarshal(b []byte) error {
 	m.OutPort = buf.DecodeUint16()
 	m.State = buf.DecodeUint8()
 	m.Expire = buf.DecodeUint32()
-	return nil
+	return buf.Err()
 }
 
 // NatDetSessionDump defines message 'nat_det_session_dump'.
@@ -1544,7 +1544,7 @@ func (m *NatDetSessionDump) Marshal(b []byte) ([]byte, error) {
 func (m *NatDetSessionDump) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	copy(m.UserAddr[:], buf.DecodeBytes(4))
-	return nil
+	return buf.Err()
 }
 
 func init() { file_det44_binapi_init() }
@@ -237,7 +237,7 @@ func (m *DHCP6ClientsEnableDisable) Marshal(b []byte) ([]byte, error) {
 func (m *DHCP6ClientsEnableDisable) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Enable = buf.DecodeBool()
-	return nil
+	return buf.Err()
 }
 
 // DHCP6ClientsEnableDisableReply defines message 'dhcp6_clients_enable_disable_reply'.
@@ -272,7 +272,7 @@ func (m *DHCP6ClientsEnableDisableReply) Marshal(b []byte) ([]byte, error) {
 func (m *DHCP6ClientsEnableDisableReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
-	return nil
+	return buf.Err()
 }
 
 // DHCP6DuidLlSet defines message 'dhcp6_duid_ll_set'.
@@ -306,7 +306,7 @@ func (m *DHCP6DuidLlSet) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.DuidLl = make([]byte, 10)
 	copy(m.DuidLl, buf.DecodeBytes(len(m.DuidLl)))
-	return nil
+	return buf.Err()
 }
 
 // DHCP6DuidLlSetReply defines message 'dhcp6_duid_ll_set_reply'.
@@ -339,7 +339,7 @@ func (m *DHCP6DuidLlSetReply) Marshal(b []byte) ([]byte, error) {
 func (m *DHCP6DuidLlSetReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
-	return nil
+	return buf.Err()
 }
 
 // DHCP6PdReplyEvent defines message 'dhcp6_pd_reply_event'.
@@ -430,14 +430,14 @@ func (m *DHCP6PdReplyEvent) Unmarshal(b []byte) error {
 	m.StatusCode = buf.DecodeUint16()
 	m.Preference = buf.DecodeUint8()
 	m.NPrefixes = buf.DecodeUint32()
-	m.Prefixes = make([]DHCP6PdPrefixInfo, m.NPrefixes)
+	m.Prefixes = make([]DHCP6PdPrefixInfo, buf.CheckArrayLen(int(m.NPrefixes), 1))
 	for j0 := 0; j0 < len(m.Prefixes); j0++ {
 		copy(m.Prefixes[j0].Prefix.Address[:], buf.DecodeBytes(16))
 		m.Prefixes[j0].Prefix.Len = buf.DecodeUint8()
 		m.Prefixes[j0].ValidTime = buf.DecodeUint32()
 		m.Prefixes[j0].PreferredTime = buf.DecodeUint32()
 	}
-	return nil
+	return buf.Err()
 }
 
 // DHCP6PdSendClientMessage defines message 'dhcp6_pd_send_client_message'.
@@ -532,14 +532,14 @@ func (m *DHCP6PdSendClientMessage) Unmarshal(b []byte) error {
 	m.T1 = buf.DecodeUint32()
 	m.T2 = buf.DecodeUint32()
 	m.NPrefixes = buf.DecodeUint32()
-	m.Prefixes = make([]DHCP6PdPrefixInfo, m.NPrefixes)
+	m.Prefixes = make([]DHCP6PdPrefixInfo, buf.CheckArrayLen(int(m.NPrefixes), 1))
 	for j0 := 0; j0 < len(m.Prefixes); j0++ {
 		copy(m.Prefixes[j0].Prefix.Address[:], buf.DecodeBytes(16))
 		m.Prefixes[j0].Prefix.Len = buf.DecodeUint8()
 		m.Prefixes[j0].ValidTime = buf.DecodeUint32()
 		m.Prefixes[j0].PreferredTime = buf.DecodeUint32()
 	}
-	return nil
+	return buf.Err()
 }
 
 // DHCP6PdSendClientMessageReply defines message 'dhcp6_pd_send_client_message_reply'.
@@ -574,7 +574,7 @@ func (m *DHCP6PdSendClientMessageReply) Marshal(b []byte) ([]byte, error) {
 func (m *DHCP6PdSendClientMessageReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
-	return nil
+	return buf.Err()
 }
 
 // DHCP6ReplyEvent defines message 'dhcp6_reply_event'.
@@ -663,13 +663,13 @@ func (m *DHCP6ReplyEvent) Unmarshal(b []byte) error {
 	m.StatusCode = buf.DecodeUint16()
 	m.Preference = buf.DecodeUint8()
 	m.NAddresses = buf.DecodeUint32()
-	m.Addresses = make([]DHCP6AddressInfo, m.NAddresses)
+	m.Addresses = make([]DHCP6AddressInfo, buf.CheckArrayLen(int(m.NAddresses), 1))
 	for j0 := 0; j0 < len(m.Addresses); j0++ {
 		copy(m.Addresses[j0].Address[:], buf.DecodeBytes(16))
 		m.Addresses[j0].ValidTime = buf.DecodeUint32()
 		m.Addresses[j0].PreferredTime = buf.DecodeUint32()
 	}
-	return nil
+	return buf.Err()
 }
 
 // DHCP6SendClientMessage defines message 'dhcp6_send_client_message'.
@@ -762,13 +762,13 @@ func (m *DHCP6SendClientMessage) Unmarshal(b []byte) error {
 	m.T1 = buf.DecodeUint32()
 	m.T2 = buf.DecodeUint32()
 	m.NAddresses = buf.DecodeUint32()
-	m.Addresses = make([]DHCP6AddressInfo, m.NAddresses)
+	m.Addresses = make([]DHCP6AddressInfo, buf.CheckArrayLen(int(m.NAddresses), 1))
 	for j0 := 0; j0 < len(m.Addresses); j0++ {
 		copy(m.Addresses[j0].Address[:], buf.DecodeBytes(16))
 		m.Addresses[j0].ValidTime = buf.DecodeUint32()
 		m.Addresses[j0].PreferredTime = buf.DecodeUint32()
 	}
-	return nil
+	return buf.Err()
 }
 
 // DHCP6SendClientMessageReply defines message 'dhcp6_send_client_message_reply'.
@@ -801,7 +801,7 @@ func (m *DHCP6SendClientMessageReply) Marshal(b []byte) ([]byte, error) {
 func (m *DHCP6SendClientMessageReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
-	return nil
+	return buf.Err()
 }
 
 // DHCPClientConfig defines message 'dhcp_client_config'.
@@ -857,7 +857,7 @@ func (m *DHCPClientConfig) Unmarshal(b []byte) error {
 	m.Client.SetBroadcastFlag = buf.DecodeBool()
 	m.Client.Dscp = ip_types.IPDscp(buf.DecodeUint8())
 	m.Client.PID = buf.DecodeUint32()
-	return nil
+	return buf.Err()
 }
 
 // DHCPClientConfigReply defines message 'dhcp_client_config_reply'.
@@ -890,7 +890,7 @@ func (m *DHCPClientConfigReply) Marshal(b []byte) ([]byte, error) {
 func (m *DHCPClientConfigReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
-	return nil
+	return buf.Err()
 }
 
 // DHCPClientDetails defines message 'dhcp_client_details'.
@@ -993,12 +993,12 @@ func (m *DHCPClientDetails) Unmarshal(b []byte) error {
 	copy(m.Lease.RouterAddress.Un.XXX_UnionData[:], buf.DecodeBytes(16))
 	copy(m.Lease.HostMac[:], buf.DecodeBytes(6))
 	m.Lease.Count = buf.DecodeUint8()
-	m.Lease.DomainServer = make([]DomainServer, m.Lease.Count)
+	m.Lease.DomainServer = make([]DomainServer, buf.CheckArrayLen(int(m.Lease.Count), 1))
 	for j1 := 0; j1 < len(m.Lease.DomainServer); j1++ {
 		m.Lease.DomainServer[j1].Address.Af = ip_types.AddressFamily(buf.DecodeUint8())
 		copy(m.Lease.DomainServer[j1].Address.Un.XXX_UnionData[:], buf.DecodeBytes(16))
 	}
-	return nil
+	return buf.Err()
 }
 
 // DHCPClientDump defines message 'dhcp_client_dump'.
@@ -1109,12 +1109,12 @@ func (m *DHCPComplEvent) Unmarshal(b []byte) error {
 	copy(m.Lease.RouterAddress.Un.XXX_UnionData[:], buf.DecodeBytes(16))
 	copy(m.Lease.HostMac[:], buf.DecodeBytes(6))
 	m.Lease.Count = buf.DecodeUint8()
-	m.Lease.DomainServer = make([]DomainServer, m.Lease.Count)
+	m.Lease.DomainServer = make([]DomainServer, buf.CheckArrayLen(int(m.Lease.Count), 1))
 	for j1 := 0; j1 < len(m.Lease.DomainServer); j1++ {
 		m.Lease.DomainServer[j1].Address.Af = ip_types.AddressFamily(buf.DecodeUint8())
 		copy(m.Lease.DomainServer[j1].Address.Un.XXX_UnionData[:], buf.DecodeBytes(16))
 	}
-	return nil
+	return buf.Err()
 }
 
 // DHCPPluginControlPing defines message 'dhcp_plugin_control_ping'.
@@ -1182,7 +1182,7 @@ func (m *DHCPPluginControlPingReply) Unmarshal(b []byte) error {
 	m.Retval = buf.DecodeInt32()
 	m.ClientIndex = buf.DecodeUint32()
 	m.VpePID = buf.DecodeUint32()
-	return nil
+	return buf.Err()
 }
 
 // DHCPPluginGetVersion defines message 'dhcp_plugin_get_version'.
@@ -1246,7 +1246,7 @@ func (m *DHCPPluginGetVersionReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Major = buf.DecodeUint32()
 	m.Minor = buf.DecodeUint32()
-	return nil
+	return buf.Err()
 }
 
 // DHCPProxyConfig defines message 'dhcp_proxy_config'.
@@ -1301,7 +1301,7 @@ func (m *DHCPProxyConfig) Unmarshal(b []byte) error {
 	copy(m.DHCPServer.Un.XXX_UnionData[:], buf.DecodeBytes(16))
 	m.DHCPSrcAddress.Af = ip_types.AddressFamily(buf.DecodeUint8())
 	copy(m.DHCPSrcAddress.Un.XXX_UnionData[:], buf.DecodeBytes(16))
-	return nil
+	return buf.Err()
 }
 
 // DHCPProxyConfigReply defines message 'dhcp_proxy_config_reply'.
@@ -1334,7 +1334,7 @@ func (m *DHCPProxyConfigReply) Marshal(b []byte) ([]byte, error) {
 func (m *DHCPProxyConfigReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
-	return nil
+	return buf.Err()
 }
 
 // DHCPProxyDetails defines message 'dhcp_proxy_details'.
@@ -1418,13 +1418,13 @@ func (m *DHCPProxyDetails) Unmarshal(b []byte) error {
 	m.DHCPSrcAddress.Af = ip_types.AddressFamily(buf.DecodeUint8())
 	copy(m.DHCPSrcAddress.Un.XXX_UnionData[:], buf.DecodeBytes(16))
 	m.Count = buf.DecodeUint8()
-	m.Servers = make([]DHCPServer, m.Count)
+	m.Servers = make([]DHCPServer, buf.CheckArrayLen(int(m.Count), 1))
 	for j0 := 0; j0 < len(m.Servers); j0++ {
 		m.Servers[j0].ServerVrfID = buf.DecodeUint32()
 		m.Servers[j0].DHCPServer.Af = ip_types.AddressFamily(buf.DecodeUint8())
 		copy(m.Servers[j0].DHCPServer.Un.XXX_UnionData[:], buf.DecodeBytes(16))
 	}
-	return nil
+	return buf.Err()
 }
 
 // DHCPProxyDump defines message 'dhcp_proxy_dump'.
@@ -1457,7 +1457,7 @@ func (m *DHCPProxyDump) Marshal(b []byte) ([]byte, error) {
 func (m *DHCPProxyDump) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.IsIP6 = buf.DecodeBool()
-	return nil
+	return buf.Err()
 }
 
 // DHCPProxySetVss defines message 'dhcp_proxy_set_vss'.
@@ -1514,7 +1514,7 @@ func (m *DHCPProxySetVss) Unmarshal(b []byte) error {
 	m.VPNIndex = buf.DecodeUint32()
 	m.IsIPv6 = buf.DecodeBool()
 	m.IsAdd = buf.DecodeBool()
-	return nil
+	return buf.Err()
 }
 
 // DHCPProxySetVssReply defines message 'dhcp_proxy_set_vss_reply'.
@@ -1547,7 +1547,7 @@ func (m *DHCPProxySetVssReply) Marshal(b []byte) ([]byte, error) {
 func (m *DHCPProxySetVssReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
-	return nil
+	return buf.Err()
 }
 
 // WantDHCP6PdReplyEvents defines message 'want_dhcp6_pd_reply_events'.
@@ -1584,7 +1584,7 @@ func (m *WantDHCP6PdReplyEvents) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.EnableDisable = buf.DecodeBool()
 	m.PID = buf.DecodeUint32()
-	return nil
+	return buf.Err()
 }
 
 // WantDHCP6PdReplyEventsReply defines message 'want_dhcp6_pd_reply_events_reply'.
@@ -1619,7 +1619,7 @@ func (m *WantDHCP6PdReplyEventsReply) Marshal(b []byte) ([]byte, error) {
 func (m *WantDHCP6PdReplyEventsReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
-	return nil
+	return buf.Err()
 }
 
 // WantDHCP6ReplyEvents defines message 'want_dhcp6_reply_events'.
@@ -1656,7 +1656,7 @@ func (m *WantDHCP6ReplyEvents) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.EnableDisable = buf.DecodeUint8()
 	m.PID = buf.DecodeUint32()
-	return nil
+	return buf.Err()
 }
 
 // WantDHCP6ReplyEventsReply defines message 'want_dhcp6_reply_events_reply'.
@@ -1689,7 +1689,7 @@ func (m *WantDHCP6ReplyEventsReply) Marshal(b []byte) ([]byte, error) {
 func (m *WantDHCP6ReplyEventsReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
-	return nil
+	return buf.Err()
 }
 
 func init() { file_dhcp_binapi_init() }
@@ -62,7 +62,7 @@ func (m *DHCP6ClientEnableDisable) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.SwIfIndex = interface_types.InterfaceIndex(buf.DecodeUint32())
 	m.Enable = buf.DecodeBool()
-	return nil
+	return buf.Err()
 }
 
 // DHCP6ClientEnableDisableReply defines message 'dhcp6_client_enable_disable_reply'.
@@ -97,7 +97,7 @@ func (m *DHCP6ClientEnableDisableReply) Marshal(b []byte) ([]byte, error) {
 func (m *DHCP6ClientEnableDisableReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
-	return nil
+	return buf.Err()
 }
 
 func init() { file_dhcp6_ia_na_client_cp_binapi_init() }
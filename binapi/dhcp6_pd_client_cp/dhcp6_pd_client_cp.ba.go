@@ -67,7 +67,7 @@ func (m *DHCP6PdClientEnableDisable) Unmarshal(b []byte) error {
 	m.SwIfIndex = interface_types.InterfaceIndex(buf.DecodeUint32())
 	m.PrefixGroup = buf.DecodeString(64)
 	m.Enable = buf.DecodeBool()
-	return nil
+	return buf.Err()
 }
 
 // DHCP6PdClientEnableDisableReply defines message 'dhcp6_pd_client_enable_disable_reply'.
@@ -102,7 +102,7 @@ func (m *DHCP6PdClientEnableDisableReply) Marshal(b []byte) ([]byte, error) {
 func (m *DHCP6PdClientEnableDisableReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
-	return nil
+	return buf.Err()
 }
 
 // IP6AddDelAddressUsingPrefix defines message 'ip6_add_del_address_using_prefix'.
@@ -152,7 +152,7 @@ func (m *IP6AddDelAddressUsingPrefix) Unmarshal(b []byte) error {
 	copy(m.AddressWithPrefix.Address[:], buf.DecodeBytes(16))
 	m.AddressWithPrefix.Len = buf.DecodeUint8()
 	m.IsAdd = buf.DecodeBool()
-	return nil
+	return buf.Err()
 }
 
 // IP6AddDelAddressUsingPrefixReply defines message 'ip6_add_del_address_using_prefix_reply'.
@@ -187,7 +187,7 @@ func (m *IP6AddDelAddressUsingPrefixReply) Marshal(b []byte) ([]byte, error) {
 func (m *IP6AddDelAddressUsingPrefixReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
-	return nil
+	return buf.Err()
 }
 
 func init() { file_dhcp6_pd_client_cp_binapi_init() }
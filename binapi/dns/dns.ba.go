@@ -57,7 +57,7 @@ func (m *DNSEnableDisable) Marshal(b []byte) ([]byte, error) {
 func (m *DNSEnableDisable) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Enable = buf.DecodeUint8()
-	return nil
+	return buf.Err()
 }
 
 // DNSEnableDisableReply defines message 'dns_enable_disable_reply'.
@@ -90,7 +90,7 @@ func (m *DNSEnableDisableReply) Marshal(b []byte) ([]byte, error) {
 func (m *DNSEnableDisableReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
-	return nil
+	return buf.Err()
 }
 
 // DNSNameServerAddDel defines message 'dns_name_server_add_del'.
@@ -132,7 +132,7 @@ func (m *DNSNameServerAddDel) Unmarshal(b []byte) error {
 	m.IsAdd = buf.DecodeUint8()
 	m.ServerAddress = make([]byte, 16)
 	copy(m.ServerAddress, buf.DecodeBytes(len(m.ServerAddress)))
-	return nil
+	return buf.Err()
 }
 
 // DNSNameServerAddDelReply defines message 'dns_name_server_add_del_reply'.
@@ -165,7 +165,7 @@ func (m *DNSNameServerAddDelReply) Marshal(b []byte) ([]byte, error) {
 func (m *DNSNameServerAddDelReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
-	return nil
+	return buf.Err()
 }
 
 // DNSResolveIP defines message 'dns_resolve_ip'.
@@ -203,7 +203,7 @@ func (m *DNSResolveIP) Unmarshal(b []byte) error {
 	m.IsIP6 = buf.DecodeUint8()
 	m.Address = make([]byte, 16)
 	copy(m.Address, buf.DecodeBytes(len(m.Address)))
-	return nil
+	return buf.Err()
 }
 
 // DNSResolveIPReply defines message 'dns_resolve_ip_reply'.
@@ -241,7 +241,7 @@ func (m *DNSResolveIPReply) Unmarshal(b []byte) error {
 	m.Retval = buf.DecodeInt32()
 	m.Name = make([]byte, 256)
 	copy(m.Name, buf.DecodeBytes(len(m.Name)))
-	return nil
+	return buf.Err()
 }
 
 // DNSResolveName defines message 'dns_resolve_name'.
@@ -275,7 +275,7 @@ func (m *DNSResolveName) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Name = make([]byte, 256)
 	copy(m.Name, buf.DecodeBytes(len(m.Name)))
-	return nil
+	return buf.Err()
 }
 
 // DNSResolveNameReply defines message 'dns_resolve_name_reply'.
@@ -326,7 +326,7 @@ func (m *DNSResolveNameReply) Unmarshal(b []byte) error {
 	copy(m.IP4Address, buf.DecodeBytes(len(m.IP4Address)))
 	m.IP6Address = make([]byte, 16)
 	copy(m.IP6Address, buf.DecodeBytes(len(m.IP6Address)))
-	return nil
+	return buf.Err()
 }
 
 func init() { file_dns_binapi_init() }
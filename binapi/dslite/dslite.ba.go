@@ -67,7 +67,7 @@ func (m *DsliteAddDelPoolAddrRange) Unmarshal(b []byte) error {
 	copy(m.StartAddr[:], buf.DecodeBytes(4))
 	copy(m.EndAddr[:], buf.DecodeBytes(4))
 	m.IsAdd = buf.DecodeBool()
-	return nil
+	return buf.Err()
 }
 
 // DsliteAddDelPoolAddrRangeReply defines message 'dslite_add_del_pool_addr_range_reply'.
@@ -102,7 +102,7 @@ func (m *DsliteAddDelPoolAddrRangeReply) Marshal(b []byte) ([]byte, error) {
 func (m *DsliteAddDelPoolAddrRangeReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
-	return nil
+	return buf.Err()
 }
 
 // DsliteAddressDetails defines message 'dslite_address_details'.
@@ -135,7 +135,7 @@ func (m *DsliteAddressDetails) Marshal(b []byte) ([]byte, error) {
 func (m *DsliteAddressDetails) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	copy(m.IPAddress[:], buf.DecodeBytes(4))
-	return nil
+	return buf.Err()
 }
 
 // DsliteAddressDump defines message 'dslite_address_dump'.
@@ -230,7 +230,7 @@ func (m *DsliteGetAftrAddrReply) Unmarshal(b []byte) error {
 	m.Retval = buf.DecodeInt32()
 	copy(m.IP4Addr[:], buf.DecodeBytes(4))
 	copy(m.IP6Addr[:], buf.DecodeBytes(16))
-	return nil
+	return buf.Err()
 }
 
 // DsliteGetB4Addr defines message 'dslite_get_b4_addr'.
@@ -298,7 +298,7 @@ func (m *DsliteGetB4AddrReply) Unmarshal(b []byte) error {
 	m.Retval = buf.DecodeInt32()
 	copy(m.IP4Addr[:], buf.DecodeBytes(4))
 	copy(m.IP6Addr[:], buf.DecodeBytes(16))
-	return nil
+	return buf.Err()
 }
 
 // DsliteSetAftrAddr defines message 'dslite_set_aftr_addr'.
@@ -335,7 +335,7 @@ func (m *DsliteSetAftrAddr) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	copy(m.IP4Addr[:], buf.DecodeBytes(4))
 	copy(m.IP6Addr[:], buf.DecodeBytes(16))
-	return nil
+	return buf.Err()
 }
 
 // DsliteSetAftrAddrReply defines message 'dslite_set_aftr_addr_reply'.
@@ -368,7 +368,7 @@ func (m *DsliteSetAftrAddrReply) Marshal(b []byte) ([]byte, error) {
 func (m *DsliteSetAftrAddrReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
-	return nil
+	return buf.Err()
 }
 
 // DsliteSetB4Addr defines message 'dslite_set_b4_addr'.
@@ -405,7 +405,7 @@ func (m *DsliteSetB4Addr) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	copy(m.IP4Addr[:], buf.DecodeBytes(4))
 	copy(m.IP6Addr[:], buf.DecodeBytes(16))
-	return nil
+	return buf.Err()
 }
 
 // DsliteSetB4AddrReply defines message 'dslite_set_b4_addr_reply'.
@@ -438,7 +438,7 @@ func (m *DsliteSetB4AddrReply) Marshal(b []byte) ([]byte, error) {
 func (m *DsliteSetB4AddrReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
-	return nil
+	return buf.Err()
 }
 
 func init() { file_dslite_binapi_init() }
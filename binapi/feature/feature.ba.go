@@ -70,7 +70,7 @@ func (m *FeatureEnableDisable) Unmarshal(b []byte) error {
 	m.Enable = buf.DecodeBool()
 	m.ArcName = buf.DecodeString(64)
 	m.FeatureName = buf.DecodeString(64)
-	return nil
+	return buf.Err()
 }
 
 // FeatureEnableDisableReply defines message 'feature_enable_disable_reply'.
@@ -103,7 +103,7 @@ func (m *FeatureEnableDisableReply) Marshal(b []byte) ([]byte, error) {
 func (m *FeatureEnableDisableReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
-	return nil
+	return buf.Err()
 }
 
 func init() { file_feature_binapi_init() }
@@ -73,7 +73,7 @@ func (m *FibSourceAdd) Unmarshal(b []byte) error {
 	m.Src.Priority = buf.DecodeUint8()
 	m.Src.ID = buf.DecodeUint8()
 	m.Src.Name = buf.DecodeString(64)
-	return nil
+	return buf.Err()
 }
 
 // FibSourceAddReply defines message 'fib_source_add_reply'.
@@ -110,7 +110,7 @@ func (m *FibSourceAddReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
 	m.ID = buf.DecodeUint8()
-	return nil
+	return buf.Err()
 }
 
 // FibSourceDetails defines message 'fib_source_details'.
@@ -149,7 +149,7 @@ func (m *FibSourceDetails) Unmarshal(b []byte) error {
 	m.Src.Priority = buf.DecodeUint8()
 	m.Src.ID = buf.DecodeUint8()
 	m.Src.Name = buf.DecodeString(64)
-	return nil
+	return buf.Err()
 }
 
 // FibSourceDump defines message 'fib_source_dump'.
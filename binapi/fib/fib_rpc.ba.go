@@ -31,7 +31,7 @@ func (c *serviceClient) FibSourceAdd(ctx context.Context, in *FibSourceAdd) (*Fi
 	if err != nil {
 		return nil, err
 	}
-	return out, api.RetvalToVPPApiError(out.Retval)
+	return out, api.RetvalToRequestError(in, out.Retval)
 }
 
 func (c *serviceClient) FibSourceDump(ctx context.Context, in *FibSourceDump) (RPCService_FibSourceDumpClient, error) {
@@ -86,7 +86,7 @@ func (m *FlowAdd) Unmarshal(b []byte) error {
 	m.Flow.RedirectQueue = buf.DecodeUint32()
 	m.Flow.BufferAdvance = buf.DecodeInt32()
 	copy(m.Flow.Flow.XXX_UnionData[:], buf.DecodeBytes(82))
-	return nil
+	return buf.Err()
 }
 
 // FlowAddReply defines message 'flow_add_reply'.
@@ -124,7 +124,7 @@ func (m *FlowAddReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
 	m.FlowIndex = buf.DecodeUint32()
-	return nil
+	return buf.Err()
 }
 
 // FlowAddV2 defines message 'flow_add_v2'.
@@ -194,7 +194,7 @@ func (m *FlowAddV2) Unmarshal(b []byte) error {
 	m.Flow.RssTypes = buf.DecodeUint64()
 	m.Flow.RssFun = flow_types.RssFunction(buf.DecodeUint32())
 	copy(m.Flow.Flow.XXX_UnionData[:], buf.DecodeBytes(2052))
-	return nil
+	return buf.Err()
 }
 
 // FlowAddV2Reply defines message 'flow_add_v2_reply'.
@@ -232,7 +232,7 @@ func (m *FlowAddV2Reply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
 	m.FlowIndex = buf.DecodeUint32()
-	return nil
+	return buf.Err()
 }
 
 // FlowDel defines message 'flow_del'.
@@ -266,7 +266,7 @@ func (m *FlowDel) Marshal(b []byte) ([]byte, error) {
 func (m *FlowDel) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.FlowIndex = buf.DecodeUint32()
-	return nil
+	return buf.Err()
 }
 
 // FlowDelReply defines message 'flow_del_reply'.
@@ -300,7 +300,7 @@ func (m *FlowDelReply) Marshal(b []byte) ([]byte, error) {
 func (m *FlowDelReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
-	return nil
+	return buf.Err()
 }
 
 // FlowDisable defines message 'flow_disable'.
@@ -338,7 +338,7 @@ func (m *FlowDisable) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.FlowIndex = buf.DecodeUint32()
 	m.HwIfIndex = buf.DecodeUint32()
-	return nil
+	return buf.Err()
 }
 
 // FlowDisableReply defines message 'flow_disable_reply'.
@@ -372,7 +372,7 @@ func (m *FlowDisableReply) Marshal(b []byte) ([]byte, error) {
 func (m *FlowDisableReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
-	return nil
+	return buf.Err()
 }
 
 // FlowEnable defines message 'flow_enable'.
@@ -410,7 +410,7 @@ func (m *FlowEnable) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.FlowIndex = buf.DecodeUint32()
 	m.HwIfIndex = buf.DecodeUint32()
-	return nil
+	return buf.Err()
 }
 
 // FlowEnableReply defines message 'flow_enable_reply'.
@@ -444,7 +444,7 @@ func (m *FlowEnableReply) Marshal(b []byte) ([]byte, error) {
 func (m *FlowEnableReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
-	return nil
+	return buf.Err()
 }
 
 func init() { file_flow_binapi_init() }
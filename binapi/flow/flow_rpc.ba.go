@@ -31,7 +31,7 @@ func (c *serviceClient) FlowAdd(ctx context.Context, in *FlowAdd) (*FlowAddReply
 	if err != nil {
 		return nil, err
 	}
-	return out, api.RetvalToVPPApiError(out.Retval)
+	return out, api.RetvalToRequestError(in, out.Retval)
 }
 
 func (c *serviceClient) FlowAddV2(ctx context.Context, in *FlowAddV2) (*FlowAddV2Reply, error) {
@@ -40,7 +40,7 @@ func (c *serviceClient) FlowAddV2(ctx context.Context, in *FlowAddV2) (*FlowAddV
 	if err != nil {
 		return nil, err
 	}
-	return out, api.RetvalToVPPApiError(out.Retval)
+	return out, api.RetvalToRequestError(in, out.Retval)
 }
 
 func (c *serviceClient) FlowDel(ctx context.Context, in *FlowDel) (*FlowDelReply, error) {
@@ -49,7 +49,7 @@ func (c *serviceClient) FlowDel(ctx context.Context, in *FlowDel) (*FlowDelReply
 	if err != nil {
 		return nil, err
 	}
-	return out, api.RetvalToVPPApiError(out.Retval)
+	return out, api.RetvalToRequestError(in, out.Retval)
 }
 
 func (c *serviceClient) FlowDisable(ctx context.Context, in *FlowDisable) (*FlowDisableReply, error) {
@@ -58,7 +58,7 @@ func (c *serviceClient) FlowDisable(ctx context.Context, in *FlowDisable) (*Flow
 	if err != nil {
 		return nil, err
 	}
-	return out, api.RetvalToVPPApiError(out.Retval)
+	return out, api.RetvalToRequestError(in, out.Retval)
 }
 
 func (c *serviceClient) FlowEnable(ctx context.Context, in *FlowEnable) (*FlowEnableReply, error) {
@@ -67,5 +67,5 @@ func (c *serviceClient) FlowEnable(ctx context.Context, in *FlowEnable) (*FlowEn
 	if err != nil {
 		return nil, err
 	}
-	return out, api.RetvalToVPPApiError(out.Retval)
+	return out, api.RetvalToRequestError(in, out.Retval)
 }
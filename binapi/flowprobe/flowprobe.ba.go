@@ -260,7 +260,7 @@ func (m *FlowprobeGetParamsReply) Unmarshal(b []byte) error {
 	m.RecordFlags = FlowprobeRecordFlags(buf.DecodeUint8())
 	m.ActiveTimer = buf.DecodeUint32()
 	m.PassiveTimer = buf.DecodeUint32()
-	return nil
+	return buf.Err()
 }
 
 // FlowprobeInterfaceAddDel defines message 'flowprobe_interface_add_del'.
@@ -306,7 +306,7 @@ func (m *FlowprobeInterfaceAddDel) Unmarshal(b []byte) error {
 	m.Which = FlowprobeWhich(buf.DecodeUint8())
 	m.Direction = FlowprobeDirection(buf.DecodeUint8())
 	m.SwIfIndex = interface_types.InterfaceIndex(buf.DecodeUint32())
-	return nil
+	return buf.Err()
 }
 
 // FlowprobeInterfaceAddDelReply defines message 'flowprobe_interface_add_del_reply'.
@@ -342,7 +342,7 @@ func (m *FlowprobeInterfaceAddDelReply) Marshal(b []byte) ([]byte, error) {
 func (m *FlowprobeInterfaceAddDelReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
-	return nil
+	return buf.Err()
 }
 
 // FlowprobeInterfaceDetails defines message 'flowprobe_interface_details'.
@@ -384,7 +384,7 @@ func (m *FlowprobeInterfaceDetails) Unmarshal(b []byte) error {
 	m.Which = FlowprobeWhich(buf.DecodeUint8())
 	m.Direction = FlowprobeDirection(buf.DecodeUint8())
 	m.SwIfIndex = interface_types.InterfaceIndex(buf.DecodeUint32())
-	return nil
+	return buf.Err()
 }
 
 // FlowprobeInterfaceDump defines message 'flowprobe_interface_dump'.
@@ -418,7 +418,7 @@ func (m *FlowprobeInterfaceDump) Marshal(b []byte) ([]byte, error) {
 func (m *FlowprobeInterfaceDump) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.SwIfIndex = interface_types.InterfaceIndex(buf.DecodeUint32())
-	return nil
+	return buf.Err()
 }
 
 // FlowprobeParams defines message 'flowprobe_params'.
@@ -459,7 +459,7 @@ func (m *FlowprobeParams) Unmarshal(b []byte) error {
 	m.RecordFlags = FlowprobeRecordFlags(buf.DecodeUint8())
 	m.ActiveTimer = buf.DecodeUint32()
 	m.PassiveTimer = buf.DecodeUint32()
-	return nil
+	return buf.Err()
 }
 
 // FlowprobeParamsReply defines message 'flowprobe_params_reply'.
@@ -492,7 +492,7 @@ func (m *FlowprobeParamsReply) Marshal(b []byte) ([]byte, error) {
 func (m *FlowprobeParamsReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
-	return nil
+	return buf.Err()
 }
 
 // FlowprobeSetParams defines message 'flowprobe_set_params'.
@@ -534,7 +534,7 @@ func (m *FlowprobeSetParams) Unmarshal(b []byte) error {
 	m.RecordFlags = FlowprobeRecordFlags(buf.DecodeUint8())
 	m.ActiveTimer = buf.DecodeUint32()
 	m.PassiveTimer = buf.DecodeUint32()
-	return nil
+	return buf.Err()
 }
 
 // FlowprobeSetParamsReply defines message 'flowprobe_set_params_reply'.
@@ -568,7 +568,7 @@ func (m *FlowprobeSetParamsReply) Marshal(b []byte) ([]byte, error) {
 func (m *FlowprobeSetParamsReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
-	return nil
+	return buf.Err()
 }
 
 // FlowprobeTxInterfaceAddDel defines message 'flowprobe_tx_interface_add_del'.
@@ -609,7 +609,7 @@ func (m *FlowprobeTxInterfaceAddDel) Unmarshal(b []byte) error {
 	m.IsAdd = buf.DecodeBool()
 	m.Which = FlowprobeWhichFlags(buf.DecodeUint8())
 	m.SwIfIndex = interface_types.InterfaceIndex(buf.DecodeUint32())
-	return nil
+	return buf.Err()
 }
 
 // FlowprobeTxInterfaceAddDelReply defines message 'flowprobe_tx_interface_add_del_reply'.
@@ -644,7 +644,7 @@ func (m *FlowprobeTxInterfaceAddDelReply) Marshal(b []byte) ([]byte, error) {
 func (m *FlowprobeTxInterfaceAddDelReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
-	return nil
+	return buf.Err()
 }
 
 func init() { file_flowprobe_binapi_init() }
@@ -35,7 +35,7 @@ func (c *serviceClient) FlowprobeGetParams(ctx context.Context, in *FlowprobeGet
 	if err != nil {
 		return nil, err
 	}
-	return out, api.RetvalToVPPApiError(out.Retval)
+	return out, api.RetvalToRequestError(in, out.Retval)
 }
 
 func (c *serviceClient) FlowprobeInterfaceAddDel(ctx context.Context, in *FlowprobeInterfaceAddDel) (*FlowprobeInterfaceAddDelReply, error) {
@@ -44,7 +44,7 @@ func (c *serviceClient) FlowprobeInterfaceAddDel(ctx context.Context, in *Flowpr
 	if err != nil {
 		return nil, err
 	}
-	return out, api.RetvalToVPPApiError(out.Retval)
+	return out, api.RetvalToRequestError(in, out.Retval)
 }
 
 func (c *serviceClient) FlowprobeInterfaceDump(ctx context.Context, in *FlowprobeInterfaceDump) (RPCService_FlowprobeInterfaceDumpClient, error) {
@@ -96,7 +96,7 @@ func (c *serviceClient) FlowprobeParams(ctx context.Context, in *FlowprobeParams
 	if err != nil {
 		return nil, err
 	}
-	return out, api.RetvalToVPPApiError(out.Retval)
+	return out, api.RetvalToRequestError(in, out.Retval)
 }
 
 func (c *serviceClient) FlowprobeSetParams(ctx context.Context, in *FlowprobeSetParams) (*FlowprobeSetParamsReply, error) {
@@ -105,7 +105,7 @@ func (c *serviceClient) FlowprobeSetParams(ctx context.Context, in *FlowprobeSet
 	if err != nil {
 		return nil, err
 	}
-	return out, api.RetvalToVPPApiError(out.Retval)
+	return out, api.RetvalToRequestError(in, out.Retval)
 }
 
 func (c *serviceClient) FlowprobeTxInterfaceAddDel(ctx context.Context, in *FlowprobeTxInterfaceAddDel) (*FlowprobeTxInterfaceAddDelReply, error) {
@@ -114,5 +114,5 @@ func (c *serviceClient) FlowprobeTxInterfaceAddDel(ctx context.Context, in *Flow
 	if err != nil {
 		return nil, err
 	}
-	return out, api.RetvalToVPPApiError(out.Retval)
+	return out, api.RetvalToRequestError(in, out.Retval)
 }
@@ -0,0 +1,111 @@
+//  Copyright (c) 2023 Cisco and/or its affiliates.
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at:
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package binapi_test
+
+import (
+	"bytes"
+	"encoding/binary"
+	"reflect"
+	"sort"
+	"testing"
+
+	"go.fd.io/govpp/api"
+	"go.fd.io/govpp/codec"
+
+	// all generated packages, so their messages are registered
+	_ "go.fd.io/govpp/binapi/all"
+)
+
+type generatedMessage interface {
+	api.Message
+	codec.Marshaler
+	codec.Unmarshaler
+}
+
+// generatedMessages returns the registered generated messages sorted by path and name.
+func generatedMessages() []generatedMessage {
+	registered := api.GetRegisteredMessages()
+	paths := make([]string, 0, len(registered))
+	for path := range registered {
+		paths = append(paths, path)
+	}
+	sort.Strings(paths)
+
+	var msgs []generatedMessage
+	for _, path := range paths {
+		names := make([]string, 0, len(registered[path]))
+		for name := range registered[path] {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		for _, name := range names {
+			if msg, ok := registered[path][name].(generatedMessage); ok {
+				msgs = append(msgs, msg)
+			}
+		}
+	}
+	return msgs
+}
+
+func newMessage(msg generatedMessage) generatedMessage {
+	return reflect.New(reflect.TypeOf(msg).Elem()).Interface().(generatedMessage)
+}
+
+// FuzzUnmarshal decodes the fuzzed data into every generated message. The first
+// two bytes of the data select the message, the rest is the encoded message.
+// Decoding must not panic and the decoded message must be encoded back into
+// data decoding to the same message.
+func FuzzUnmarshal(f *testing.F) {
+	msgs := generatedMessages()
+	if len(msgs) == 0 {
+		f.Fatal("no generated messages registered")
+	}
+	for i, msg := range msgs {
+		data, err := newMessage(msg).Marshal(nil)
+		if err != nil {
+			f.Fatalf("encoding %s failed: %v", msg.GetMessageName(), err)
+		}
+		seed := make([]byte, 2, 2+len(data))
+		binary.BigEndian.PutUint16(seed, uint16(i))
+		f.Add(append(seed, data...))
+	}
+
+	f.Fuzz(func(t *testing.T, data []byte) {
+		if len(data) < 2 {
+			return
+		}
+		msg := msgs[int(binary.BigEndian.Uint16(data))%len(msgs)]
+
+		decoded := newMessage(msg)
+		if err := decoded.Unmarshal(data[2:]); err != nil {
+			return
+		}
+		encoded, err := decoded.Marshal(nil)
+		if err != nil {
+			t.Fatalf("encoding decoded %s failed: %v", msg.GetMessageName(), err)
+		}
+		redecoded := newMessage(msg)
+		if err := redecoded.Unmarshal(encoded); err != nil {
+			t.Fatalf("decoding encoded %s failed: %v", msg.GetMessageName(), err)
+		}
+		reencoded, err := redecoded.Marshal(nil)
+		if err != nil {
+			t.Fatalf("encoding redecoded %s failed: %v", msg.GetMessageName(), err)
+		}
+		if !bytes.Equal(encoded, reencoded) {
+			t.Fatalf("%s changed after encoding and decoding:\n% x\n% x", msg.GetMessageName(), encoded, reencoded)
+		}
+	})
+}
@@ -18,5 +18,5 @@ package binapi
 //
 //     go generate ./binapi
 //
-//go:generate binapi-generator --input=/usr/share/vpp/api --output-dir=. --gen=rpc
+//go:generate binapi-generator --input=/usr/share/vpp/api --output-dir=. --gen=rpc,all
 //go:generate binapi-generator --output-dir=. --gen=http /usr/share/vpp/api/core/vpe.api.json
@@ -91,7 +91,7 @@ func (m *GeneveAddDelTunnel) Unmarshal(b []byte) error {
 	m.EncapVrfID = buf.DecodeUint32()
 	m.DecapNextIndex = buf.DecodeUint32()
 	m.Vni = buf.DecodeUint32()
-	return nil
+	return buf.Err()
 }
 
 // GeneveAddDelTunnel2 defines message 'geneve_add_del_tunnel2'.
@@ -158,7 +158,7 @@ func (m *GeneveAddDelTunnel2) Unmarshal(b []byte) error {
 	m.DecapNextIndex = buf.DecodeUint32()
 	m.Vni = buf.DecodeUint32()
 	m.L3Mode = buf.DecodeBool()
-	return nil
+	return buf.Err()
 }
 
 // GeneveAddDelTunnel2Reply defines message 'geneve_add_del_tunnel2_reply'.
@@ -195,7 +195,7 @@ func (m *GeneveAddDelTunnel2Reply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
 	m.SwIfIndex = interface_types.InterfaceIndex(buf.DecodeUint32())
-	return nil
+	return buf.Err()
 }
 
 // GeneveAddDelTunnelReply defines message 'geneve_add_del_tunnel_reply'.
@@ -232,7 +232,7 @@ func (m *GeneveAddDelTunnelReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
 	m.SwIfIndex = interface_types.InterfaceIndex(buf.DecodeUint32())
-	return nil
+	return buf.Err()
 }
 
 // GeneveTunnelDetails defines message 'geneve_tunnel_details'.
@@ -295,7 +295,7 @@ func (m *GeneveTunnelDetails) Unmarshal(b []byte) error {
 	m.EncapVrfID = buf.DecodeUint32()
 	m.DecapNextIndex = buf.DecodeUint32()
 	m.Vni = buf.DecodeUint32()
-	return nil
+	return buf.Err()
 }
 
 // GeneveTunnelDump defines message 'geneve_tunnel_dump'.
@@ -328,7 +328,7 @@ func (m *GeneveTunnelDump) Marshal(b []byte) ([]byte, error) {
 func (m *GeneveTunnelDump) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.SwIfIndex = interface_types.InterfaceIndex(buf.DecodeUint32())
-	return nil
+	return buf.Err()
 }
 
 // SwInterfaceSetGeneveBypass defines message 'sw_interface_set_geneve_bypass'.
@@ -369,7 +369,7 @@ func (m *SwInterfaceSetGeneveBypass) Unmarshal(b []byte) error {
 	m.SwIfIndex = interface_types.InterfaceIndex(buf.DecodeUint32())
 	m.IsIPv6 = buf.DecodeBool()
 	m.Enable = buf.DecodeBool()
-	return nil
+	return buf.Err()
 }
 
 // SwInterfaceSetGeneveBypassReply defines message 'sw_interface_set_geneve_bypass_reply'.
@@ -404,7 +404,7 @@ func (m *SwInterfaceSetGeneveBypassReply) Marshal(b []byte) ([]byte, error) {
 func (m *SwInterfaceSetGeneveBypassReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
-	return nil
+	return buf.Err()
 }
 
 func init() { file_geneve_binapi_init() }
@@ -129,11 +129,11 @@ func (m *GraphNodeDetails) Unmarshal(b []byte) error {
 	m.Name = buf.DecodeString(64)
 	m.Flags = NodeFlag(buf.DecodeUint32())
 	m.NArcs = buf.DecodeUint32()
-	m.ArcsOut = make([]uint32, m.NArcs)
+	m.ArcsOut = make([]uint32, buf.CheckArrayLen(int(m.NArcs), 4))
 	for i := 0; i < len(m.ArcsOut); i++ {
 		m.ArcsOut[i] = buf.DecodeUint32()
 	}
-	return nil
+	return buf.Err()
 }
 
 // GraphNodeGet defines message 'graph_node_get'.
@@ -182,7 +182,7 @@ func (m *GraphNodeGet) Unmarshal(b []byte) error {
 	m.Name = buf.DecodeString(64)
 	m.Flags = NodeFlag(buf.DecodeUint32())
 	m.WantArcs = buf.DecodeBool()
-	return nil
+	return buf.Err()
 }
 
 // GraphNodeGetReply defines message 'graph_node_get_reply'.
@@ -219,7 +219,7 @@ func (m *GraphNodeGetReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
 	m.Cursor = buf.DecodeUint32()
-	return nil
+	return buf.Err()
 }
 
 func init() { file_graph_binapi_init() }
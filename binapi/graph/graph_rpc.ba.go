@@ -53,7 +53,7 @@ func (c *serviceClient_GraphNodeGetClient) Recv() (*GraphNodeDetails, *GraphNode
 	case *GraphNodeDetails:
 		return m, nil, nil
 	case *GraphNodeGetReply:
-		if err := api.RetvalToVPPApiError(m.Retval); err != nil {
+		if err := api.RetvalToRequestError((*GraphNodeGet)(nil), m.Retval); err != nil {
 			return nil, nil, err
 		}
 		err = c.Stream.Close()
@@ -141,7 +141,7 @@ func (m *GreTunnelAddDel) Unmarshal(b []byte) error {
 	copy(m.Tunnel.Src.Un.XXX_UnionData[:], buf.DecodeBytes(16))
 	m.Tunnel.Dst.Af = ip_types.AddressFamily(buf.DecodeUint8())
 	copy(m.Tunnel.Dst.Un.XXX_UnionData[:], buf.DecodeBytes(16))
-	return nil
+	return buf.Err()
 }
 
 // GreTunnelAddDelReply defines message 'gre_tunnel_add_del_reply'.
@@ -178,7 +178,7 @@ func (m *GreTunnelAddDelReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
 	m.SwIfIndex = interface_types.InterfaceIndex(buf.DecodeUint32())
-	return nil
+	return buf.Err()
 }
 
 // GreTunnelDetails defines message 'gre_tunnel_details'.
@@ -241,7 +241,7 @@ func (m *GreTunnelDetails) Unmarshal(b []byte) error {
 	copy(m.Tunnel.Src.Un.XXX_UnionData[:], buf.DecodeBytes(16))
 	m.Tunnel.Dst.Af = ip_types.AddressFamily(buf.DecodeUint8())
 	copy(m.Tunnel.Dst.Un.XXX_UnionData[:], buf.DecodeBytes(16))
-	return nil
+	return buf.Err()
 }
 
 // GreTunnelDump defines message 'gre_tunnel_dump'.
@@ -274,7 +274,7 @@ func (m *GreTunnelDump) Marshal(b []byte) ([]byte, error) {
 func (m *GreTunnelDump) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.SwIfIndex = interface_types.InterfaceIndex(buf.DecodeUint32())
-	return nil
+	return buf.Err()
 }
 
 func init() { file_gre_binapi_init() }
@@ -62,7 +62,7 @@ func (m *FeatureGsoEnableDisable) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.SwIfIndex = interface_types.InterfaceIndex(buf.DecodeUint32())
 	m.EnableDisable = buf.DecodeBool()
-	return nil
+	return buf.Err()
 }
 
 // FeatureGsoEnableDisableReply defines message 'feature_gso_enable_disable_reply'.
@@ -97,7 +97,7 @@ func (m *FeatureGsoEnableDisableReply) Marshal(b []byte) ([]byte, error) {
 func (m *FeatureGsoEnableDisableReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
-	return nil
+	return buf.Err()
 }
 
 func init() { file_gso_binapi_init() }
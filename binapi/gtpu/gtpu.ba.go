@@ -93,7 +93,7 @@ func (m *GtpuAddDelTunnel) Unmarshal(b []byte) error {
 	m.DecapNextIndex = buf.DecodeUint32()
 	m.Teid = buf.DecodeUint32()
 	m.Tteid = buf.DecodeUint32()
-	return nil
+	return buf.Err()
 }
 
 // GtpuAddDelTunnelReply defines message 'gtpu_add_del_tunnel_reply'.
@@ -130,7 +130,7 @@ func (m *GtpuAddDelTunnelReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
 	m.SwIfIndex = interface_types.InterfaceIndex(buf.DecodeUint32())
-	return nil
+	return buf.Err()
 }
 
 // GtpuOffloadRx defines message 'gtpu_offload_rx'.
@@ -171,7 +171,7 @@ func (m *GtpuOffloadRx) Unmarshal(b []byte) error {
 	m.HwIfIndex = buf.DecodeUint32()
 	m.SwIfIndex = buf.DecodeUint32()
 	m.Enable = buf.DecodeUint8()
-	return nil
+	return buf.Err()
 }
 
 // GtpuOffloadRxReply defines message 'gtpu_offload_rx_reply'.
@@ -204,7 +204,7 @@ func (m *GtpuOffloadRxReply) Marshal(b []byte) ([]byte, error) {
 func (m *GtpuOffloadRxReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
-	return nil
+	return buf.Err()
 }
 
 // GtpuTunnelDetails defines message 'gtpu_tunnel_details'.
@@ -271,7 +271,7 @@ func (m *GtpuTunnelDetails) Unmarshal(b []byte) error {
 	m.DecapNextIndex = buf.DecodeUint32()
 	m.Teid = buf.DecodeUint32()
 	m.Tteid = buf.DecodeUint32()
-	return nil
+	return buf.Err()
 }
 
 // GtpuTunnelDump defines message 'gtpu_tunnel_dump'.
@@ -304,7 +304,7 @@ func (m *GtpuTunnelDump) Marshal(b []byte) ([]byte, error) {
 func (m *GtpuTunnelDump) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.SwIfIndex = interface_types.InterfaceIndex(buf.DecodeUint32())
-	return nil
+	return buf.Err()
 }
 
 // GtpuTunnelUpdateTteid defines message 'gtpu_tunnel_update_tteid'.
@@ -352,7 +352,7 @@ func (m *GtpuTunnelUpdateTteid) Unmarshal(b []byte) error {
 	m.EncapVrfID = buf.DecodeUint32()
 	m.Teid = buf.DecodeUint32()
 	m.Tteid = buf.DecodeUint32()
-	return nil
+	return buf.Err()
 }
 
 // GtpuTunnelUpdateTteidReply defines message 'gtpu_tunnel_update_tteid_reply'.
@@ -385,7 +385,7 @@ func (m *GtpuTunnelUpdateTteidReply) Marshal(b []byte) ([]byte, error) {
 func (m *GtpuTunnelUpdateTteidReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
-	return nil
+	return buf.Err()
 }
 
 // SwInterfaceSetGtpuBypass defines message 'sw_interface_set_gtpu_bypass'.
@@ -426,7 +426,7 @@ func (m *SwInterfaceSetGtpuBypass) Unmarshal(b []byte) error {
 	m.SwIfIndex = interface_types.InterfaceIndex(buf.DecodeUint32())
 	m.IsIPv6 = buf.DecodeBool()
 	m.Enable = buf.DecodeBool()
-	return nil
+	return buf.Err()
 }
 
 // SwInterfaceSetGtpuBypassReply defines message 'sw_interface_set_gtpu_bypass_reply'.
@@ -461,7 +461,7 @@ func (m *SwInterfaceSetGtpuBypassReply) Marshal(b []byte) ([]byte, error) {
 func (m *SwInterfaceSetGtpuBypassReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
-	return nil
+	return buf.Err()
 }
 
 func init() { file_gtpu_binapi_init() }
@@ -77,7 +77,7 @@ func (m *HTTPStaticEnable) Unmarshal(b []byte) error {
 	m.PrivateSegmentSize = buf.DecodeUint32()
 	m.WwwRoot = buf.DecodeString(256)
 	m.URI = buf.DecodeString(256)
-	return nil
+	return buf.Err()
 }
 
 // HTTPStaticEnableReply defines message 'http_static_enable_reply'.
@@ -110,7 +110,7 @@ func (m *HTTPStaticEnableReply) Marshal(b []byte) ([]byte, error) {
 func (m *HTTPStaticEnableReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
-	return nil
+	return buf.Err()
 }
 
 func init() { file_http_static_binapi_init() }
@@ -132,7 +132,7 @@ func (m *IgmpClearInterface) Marshal(b []byte) ([]byte, error) {
 func (m *IgmpClearInterface) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.SwIfIndex = interface_types.InterfaceIndex(buf.DecodeUint32())
-	return nil
+	return buf.Err()
 }
 
 // IgmpClearInterfaceReply defines message 'igmp_clear_interface_reply'.
@@ -165,7 +165,7 @@ func (m *IgmpClearInterfaceReply) Marshal(b []byte) ([]byte, error) {
 func (m *IgmpClearInterfaceReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
-	return nil
+	return buf.Err()
 }
 
 // IgmpDetails defines message 'igmp_details'.
@@ -206,7 +206,7 @@ func (m *IgmpDetails) Unmarshal(b []byte) error {
 	m.SwIfIndex = interface_types.InterfaceIndex(buf.DecodeUint32())
 	copy(m.Saddr[:], buf.DecodeBytes(4))
 	copy(m.Gaddr[:], buf.DecodeBytes(4))
-	return nil
+	return buf.Err()
 }
 
 // IgmpDump defines message 'igmp_dump'.
@@ -239,7 +239,7 @@ func (m *IgmpDump) Marshal(b []byte) ([]byte, error) {
 func (m *IgmpDump) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.SwIfIndex = interface_types.InterfaceIndex(buf.DecodeUint32())
-	return nil
+	return buf.Err()
 }
 
 // IgmpEnableDisable defines message 'igmp_enable_disable'.
@@ -280,7 +280,7 @@ func (m *IgmpEnableDisable) Unmarshal(b []byte) error {
 	m.Enable = buf.DecodeBool()
 	m.Mode = buf.DecodeUint8()
 	m.SwIfIndex = interface_types.InterfaceIndex(buf.DecodeUint32())
-	return nil
+	return buf.Err()
 }
 
 // IgmpEnableDisableReply defines message 'igmp_enable_disable_reply'.
@@ -313,7 +313,7 @@ func (m *IgmpEnableDisableReply) Marshal(b []byte) ([]byte, error) {
 func (m *IgmpEnableDisableReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
-	return nil
+	return buf.Err()
 }
 
 // IgmpEvent defines message 'igmp_event'.
@@ -358,7 +358,7 @@ func (m *IgmpEvent) Unmarshal(b []byte) error {
 	m.Filter = FilterMode(buf.DecodeUint32())
 	copy(m.Saddr[:], buf.DecodeBytes(4))
 	copy(m.Gaddr[:], buf.DecodeBytes(4))
-	return nil
+	return buf.Err()
 }
 
 // IgmpGroupPrefixDetails defines message 'igmp_group_prefix_details'.
@@ -400,7 +400,7 @@ func (m *IgmpGroupPrefixDetails) Unmarshal(b []byte) error {
 	m.Gp.Prefix.Address.Af = ip_types.AddressFamily(buf.DecodeUint8())
 	copy(m.Gp.Prefix.Address.Un.XXX_UnionData[:], buf.DecodeBytes(16))
 	m.Gp.Prefix.Len = buf.DecodeUint8()
-	return nil
+	return buf.Err()
 }
 
 // IgmpGroupPrefixDump defines message 'igmp_group_prefix_dump'.
@@ -469,7 +469,7 @@ func (m *IgmpGroupPrefixSet) Unmarshal(b []byte) error {
 	m.Gp.Prefix.Address.Af = ip_types.AddressFamily(buf.DecodeUint8())
 	copy(m.Gp.Prefix.Address.Un.XXX_UnionData[:], buf.DecodeBytes(16))
 	m.Gp.Prefix.Len = buf.DecodeUint8()
-	return nil
+	return buf.Err()
 }
 
 // IgmpGroupPrefixSetReply defines message 'igmp_group_prefix_set_reply'.
@@ -502,7 +502,7 @@ func (m *IgmpGroupPrefixSetReply) Marshal(b []byte) ([]byte, error) {
 func (m *IgmpGroupPrefixSetReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
-	return nil
+	return buf.Err()
 }
 
 // IgmpListen defines message 'igmp_listen'.
@@ -559,11 +559,11 @@ func (m *IgmpListen) Unmarshal(b []byte) error {
 	m.Group.NSrcs = buf.DecodeUint8()
 	m.Group.SwIfIndex = interface_types.InterfaceIndex(buf.DecodeUint32())
 	copy(m.Group.Gaddr[:], buf.DecodeBytes(4))
-	m.Group.Saddrs = make([]ip_types.IP4Address, m.Group.NSrcs)
+	m.Group.Saddrs = make([]ip_types.IP4Address, buf.CheckArrayLen(int(m.Group.NSrcs), 1))
 	for j1 := 0; j1 < len(m.Group.Saddrs); j1++ {
 		copy(m.Group.Saddrs[j1][:], buf.DecodeBytes(4))
 	}
-	return nil
+	return buf.Err()
 }
 
 // IgmpListenReply defines message 'igmp_listen_reply'.
@@ -596,7 +596,7 @@ func (m *IgmpListenReply) Marshal(b []byte) ([]byte, error) {
 func (m *IgmpListenReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
-	return nil
+	return buf.Err()
 }
 
 // IgmpProxyDeviceAddDel defines message 'igmp_proxy_device_add_del'.
@@ -637,7 +637,7 @@ func (m *IgmpProxyDeviceAddDel) Unmarshal(b []byte) error {
 	m.Add = buf.DecodeUint8()
 	m.VrfID = buf.DecodeUint32()
 	m.SwIfIndex = interface_types.InterfaceIndex(buf.DecodeUint32())
-	return nil
+	return buf.Err()
 }
 
 // IgmpProxyDeviceAddDelInterface defines message 'igmp_proxy_device_add_del_interface'.
@@ -680,7 +680,7 @@ func (m *IgmpProxyDeviceAddDelInterface) Unmarshal(b []byte) error {
 	m.Add = buf.DecodeBool()
 	m.VrfID = buf.DecodeUint32()
 	m.SwIfIndex = interface_types.InterfaceIndex(buf.DecodeUint32())
-	return nil
+	return buf.Err()
 }
 
 // IgmpProxyDeviceAddDelInterfaceReply defines message 'igmp_proxy_device_add_del_interface_reply'.
@@ -715,7 +715,7 @@ func (m *IgmpProxyDeviceAddDelInterfaceReply) Marshal(b []byte) ([]byte, error)
 func (m *IgmpProxyDeviceAddDelInterfaceReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
-	return nil
+	return buf.Err()
 }
 
 // IgmpProxyDeviceAddDelReply defines message 'igmp_proxy_device_add_del_reply'.
@@ -748,7 +748,7 @@ func (m *IgmpProxyDeviceAddDelReply) Marshal(b []byte) ([]byte, error) {
 func (m *IgmpProxyDeviceAddDelReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
-	return nil
+	return buf.Err()
 }
 
 // WantIgmpEvents defines message 'want_igmp_events'.
@@ -785,7 +785,7 @@ func (m *WantIgmpEvents) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Enable = buf.DecodeUint32()
 	m.PID = buf.DecodeUint32()
-	return nil
+	return buf.Err()
 }
 
 // WantIgmpEventsReply defines message 'want_igmp_events_reply'.
@@ -818,7 +818,7 @@ func (m *WantIgmpEventsReply) Marshal(b []byte) ([]byte, error) {
 func (m *WantIgmpEventsReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
-	return nil
+	return buf.Err()
 }
 
 func init() { file_igmp_binapi_init() }
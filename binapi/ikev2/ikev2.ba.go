@@ -177,7 +177,7 @@ func (m *Ikev2ChildSaDetails) Unmarshal(b []byte) error {
 	m.ChildSa.Esn.KeyTrunc = buf.DecodeUint16()
 	m.ChildSa.Esn.BlockSize = buf.DecodeUint16()
 	m.ChildSa.Esn.DhGroup = buf.DecodeUint8()
-	return nil
+	return buf.Err()
 }
 
 // Ikev2ChildSaDump defines message 'ikev2_child_sa_dump'.
@@ -211,7 +211,7 @@ func (m *Ikev2ChildSaDump) Marshal(b []byte) ([]byte, error) {
 func (m *Ikev2ChildSaDump) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.SaIndex = buf.DecodeUint32()
-	return nil
+	return buf.Err()
 }
 
 // Ikev2InitiateDelChildSa defines message 'ikev2_initiate_del_child_sa'.
@@ -245,7 +245,7 @@ func (m *Ikev2InitiateDelChildSa) Marshal(b []byte) ([]byte, error) {
 func (m *Ikev2InitiateDelChildSa) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Ispi = buf.DecodeUint32()
-	return nil
+	return buf.Err()
 }
 
 // Ikev2InitiateDelChildSaReply defines message 'ikev2_initiate_del_child_sa_reply'.
@@ -281,7 +281,7 @@ func (m *Ikev2InitiateDelChildSaReply) Marshal(b []byte) ([]byte, error) {
 func (m *Ikev2InitiateDelChildSaReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
-	return nil
+	return buf.Err()
 }
 
 // Ikev2InitiateDelIkeSa defines message 'ikev2_initiate_del_ike_sa'.
@@ -315,7 +315,7 @@ func (m *Ikev2InitiateDelIkeSa) Marshal(b []byte) ([]byte, error) {
 func (m *Ikev2InitiateDelIkeSa) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Ispi = buf.DecodeUint64()
-	return nil
+	return buf.Err()
 }
 
 // Ikev2InitiateDelIkeSaReply defines message 'ikev2_initiate_del_ike_sa_reply'.
@@ -349,7 +349,7 @@ func (m *Ikev2InitiateDelIkeSaReply) Marshal(b []byte) ([]byte, error) {
 func (m *Ikev2InitiateDelIkeSaReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
-	return nil
+	return buf.Err()
 }
 
 // Ikev2InitiateRekeyChildSa defines message 'ikev2_initiate_rekey_child_sa'.
@@ -383,7 +383,7 @@ func (m *Ikev2InitiateRekeyChildSa) Marshal(b []byte) ([]byte, error) {
 func (m *Ikev2InitiateRekeyChildSa) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Ispi = buf.DecodeUint32()
-	return nil
+	return buf.Err()
 }
 
 // Ikev2InitiateRekeyChildSaReply defines message 'ikev2_initiate_rekey_child_sa_reply'.
@@ -419,7 +419,7 @@ func (m *Ikev2InitiateRekeyChildSaReply) Marshal(b []byte) ([]byte, error) {
 func (m *Ikev2InitiateRekeyChildSaReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
-	return nil
+	return buf.Err()
 }
 
 // Ikev2InitiateSaInit defines message 'ikev2_initiate_sa_init'.
@@ -453,7 +453,7 @@ func (m *Ikev2InitiateSaInit) Marshal(b []byte) ([]byte, error) {
 func (m *Ikev2InitiateSaInit) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Name = buf.DecodeString(64)
-	return nil
+	return buf.Err()
 }
 
 // Ikev2InitiateSaInitReply defines message 'ikev2_initiate_sa_init_reply'.
@@ -487,7 +487,7 @@ func (m *Ikev2InitiateSaInitReply) Marshal(b []byte) ([]byte, error) {
 func (m *Ikev2InitiateSaInitReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
-	return nil
+	return buf.Err()
 }
 
 // Ikev2NonceGet defines message 'ikev2_nonce_get'.
@@ -525,7 +525,7 @@ func (m *Ikev2NonceGet) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.IsInitiator = buf.DecodeBool()
 	m.SaIndex = buf.DecodeUint32()
-	return nil
+	return buf.Err()
 }
 
 // Ikev2NonceGetReply defines message 'ikev2_nonce_get_reply'.
@@ -566,9 +566,9 @@ func (m *Ikev2NonceGetReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
 	m.DataLen = buf.DecodeUint32()
-	m.Nonce = make([]byte, m.DataLen)
+	m.Nonce = make([]byte, buf.CheckArrayLen(int(m.DataLen), 1))
 	copy(m.Nonce, buf.DecodeBytes(len(m.Nonce)))
-	return nil
+	return buf.Err()
 }
 
 // Ikev2PluginGetVersion defines message 'ikev2_plugin_get_version'.
@@ -632,7 +632,7 @@ func (m *Ikev2PluginGetVersionReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Major = buf.DecodeUint32()
 	m.Minor = buf.DecodeUint32()
-	return nil
+	return buf.Err()
 }
 
 // Ikev2ProfileAddDel defines message 'ikev2_profile_add_del'.
@@ -670,7 +670,7 @@ func (m *Ikev2ProfileAddDel) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Name = buf.DecodeString(64)
 	m.IsAdd = buf.DecodeBool()
-	return nil
+	return buf.Err()
 }
 
 // Ikev2ProfileAddDelReply defines message 'ikev2_profile_add_del_reply'.
@@ -704,7 +704,7 @@ func (m *Ikev2ProfileAddDelReply) Marshal(b []byte) ([]byte, error) {
 func (m *Ikev2ProfileAddDelReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
-	return nil
+	return buf.Err()
 }
 
 // Ikev2ProfileDetails defines message 'ikev2_profile_details'.
@@ -881,9 +881,9 @@ func (m *Ikev2ProfileDetails) Unmarshal(b []byte) error {
 	m.Profile.Auth.Method = buf.DecodeUint8()
 	m.Profile.Auth.Hex = buf.DecodeUint8()
 	m.Profile.Auth.DataLen = buf.DecodeUint32()
-	m.Profile.Auth.Data = make([]byte, m.Profile.Auth.DataLen)
+	m.Profile.Auth.Data = make([]byte, buf.CheckArrayLen(int(m.Profile.Auth.DataLen), 1))
 	copy(m.Profile.Auth.Data, buf.DecodeBytes(len(m.Profile.Auth.Data)))
-	return nil
+	return buf.Err()
 }
 
 // Ikev2ProfileDisableNatt defines message 'ikev2_profile_disable_natt'.
@@ -917,7 +917,7 @@ func (m *Ikev2ProfileDisableNatt) Marshal(b []byte) ([]byte, error) {
 func (m *Ikev2ProfileDisableNatt) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Name = buf.DecodeString(64)
-	return nil
+	return buf.Err()
 }
 
 // Ikev2ProfileDisableNattReply defines message 'ikev2_profile_disable_natt_reply'.
@@ -953,7 +953,7 @@ func (m *Ikev2ProfileDisableNattReply) Marshal(b []byte) ([]byte, error) {
 func (m *Ikev2ProfileDisableNattReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
-	return nil
+	return buf.Err()
 }
 
 // Ikev2ProfileDump defines message 'ikev2_profile_dump'.
@@ -1030,9 +1030,9 @@ func (m *Ikev2ProfileSetAuth) Unmarshal(b []byte) error {
 	m.AuthMethod = buf.DecodeUint8()
 	m.IsHex = buf.DecodeBool()
 	m.DataLen = buf.DecodeUint32()
-	m.Data = make([]byte, m.DataLen)
+	m.Data = make([]byte, buf.CheckArrayLen(int(m.DataLen), 1))
 	copy(m.Data, buf.DecodeBytes(len(m.Data)))
-	return nil
+	return buf.Err()
 }
 
 // Ikev2ProfileSetAuthReply defines message 'ikev2_profile_set_auth_reply'.
@@ -1066,7 +1066,7 @@ func (m *Ikev2ProfileSetAuthReply) Marshal(b []byte) ([]byte, error) {
 func (m *Ikev2ProfileSetAuthReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
-	return nil
+	return buf.Err()
 }
 
 // Ikev2ProfileSetID defines message 'ikev2_profile_set_id'.
@@ -1115,9 +1115,9 @@ func (m *Ikev2ProfileSetID) Unmarshal(b []byte) error {
 	m.IsLocal = buf.DecodeBool()
 	m.IDType = buf.DecodeUint8()
 	m.DataLen = buf.DecodeUint32()
-	m.Data = make([]byte, m.DataLen)
+	m.Data = make([]byte, buf.CheckArrayLen(int(m.DataLen), 1))
 	copy(m.Data, buf.DecodeBytes(len(m.Data)))
-	return nil
+	return buf.Err()
 }
 
 // Ikev2ProfileSetIDReply defines message 'ikev2_profile_set_id_reply'.
@@ -1151,7 +1151,7 @@ func (m *Ikev2ProfileSetIDReply) Marshal(b []byte) ([]byte, error) {
 func (m *Ikev2ProfileSetIDReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
-	return nil
+	return buf.Err()
 }
 
 // Ikev2ProfileSetIpsecUDPPort defines message 'ikev2_profile_set_ipsec_udp_port'.
@@ -1195,7 +1195,7 @@ func (m *Ikev2ProfileSetIpsecUDPPort) Unmarshal(b []byte) error {
 	m.IsSet = buf.DecodeUint8()
 	m.Port = buf.DecodeUint16()
 	m.Name = buf.DecodeString(64)
-	return nil
+	return buf.Err()
 }
 
 // Ikev2ProfileSetIpsecUDPPortReply defines message 'ikev2_profile_set_ipsec_udp_port_reply'.
@@ -1231,7 +1231,7 @@ func (m *Ikev2ProfileSetIpsecUDPPortReply) Marshal(b []byte) ([]byte, error) {
 func (m *Ikev2ProfileSetIpsecUDPPortReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
-	return nil
+	return buf.Err()
 }
 
 // Ikev2ProfileSetLiveness defines message 'ikev2_profile_set_liveness'.
@@ -1269,7 +1269,7 @@ func (m *Ikev2ProfileSetLiveness) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Period = buf.DecodeUint32()
 	m.MaxRetries = buf.DecodeUint32()
-	return nil
+	return buf.Err()
 }
 
 // Ikev2ProfileSetLivenessReply defines message 'ikev2_profile_set_liveness_reply'.
@@ -1305,7 +1305,7 @@ func (m *Ikev2ProfileSetLivenessReply) Marshal(b []byte) ([]byte, error) {
 func (m *Ikev2ProfileSetLivenessReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
-	return nil
+	return buf.Err()
 }
 
 // Ikev2ProfileSetTs defines message 'ikev2_profile_set_ts'.
@@ -1370,7 +1370,7 @@ func (m *Ikev2ProfileSetTs) Unmarshal(b []byte) error {
 	copy(m.Ts.StartAddr.Un.XXX_UnionData[:], buf.DecodeBytes(16))
 	m.Ts.EndAddr.Af = ip_types.AddressFamily(buf.DecodeUint8())
 	copy(m.Ts.EndAddr.Un.XXX_UnionData[:], buf.DecodeBytes(16))
-	return nil
+	return buf.Err()
 }
 
 // Ikev2ProfileSetTsReply defines message 'ikev2_profile_set_ts_reply'.
@@ -1404,7 +1404,7 @@ func (m *Ikev2ProfileSetTsReply) Marshal(b []byte) ([]byte, error) {
 func (m *Ikev2ProfileSetTsReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
-	return nil
+	return buf.Err()
 }
 
 // Ikev2ProfileSetUDPEncap defines message 'ikev2_profile_set_udp_encap'.
@@ -1438,7 +1438,7 @@ func (m *Ikev2ProfileSetUDPEncap) Marshal(b []byte) ([]byte, error) {
 func (m *Ikev2ProfileSetUDPEncap) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Name = buf.DecodeString(64)
-	return nil
+	return buf.Err()
 }
 
 // Ikev2ProfileSetUDPEncapReply defines message 'ikev2_profile_set_udp_encap_reply'.
@@ -1474,7 +1474,7 @@ func (m *Ikev2ProfileSetUDPEncapReply) Marshal(b []byte) ([]byte, error) {
 func (m *Ikev2ProfileSetUDPEncapReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
-	return nil
+	return buf.Err()
 }
 
 // Ikev2SaDetails defines message 'ikev2_sa_details'.
@@ -1690,7 +1690,7 @@ func (m *Ikev2SaDetails) Unmarshal(b []byte) error {
 	m.Sa.Stats.NSaAuthReq = buf.DecodeUint16()
 	m.Sa.Stats.NRetransmit = buf.DecodeUint16()
 	m.Sa.Stats.NInitSaRetransmit = buf.DecodeUint16()
-	return nil
+	return buf.Err()
 }
 
 // Ikev2SaDump defines message 'ikev2_sa_dump'.
@@ -1762,7 +1762,7 @@ func (m *Ikev2SetEspTransforms) Unmarshal(b []byte) error {
 	m.Tr.CryptoAlg = buf.DecodeUint8()
 	m.Tr.CryptoKeySize = buf.DecodeUint32()
 	m.Tr.IntegAlg = buf.DecodeUint8()
-	return nil
+	return buf.Err()
 }
 
 // Ikev2SetEspTransformsReply defines message 'ikev2_set_esp_transforms_reply'.
@@ -1796,7 +1796,7 @@ func (m *Ikev2SetEspTransformsReply) Marshal(b []byte) ([]byte, error) {
 func (m *Ikev2SetEspTransformsReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
-	return nil
+	return buf.Err()
 }
 
 // Ikev2SetIkeTransforms defines message 'ikev2_set_ike_transforms'.
@@ -1843,7 +1843,7 @@ func (m *Ikev2SetIkeTransforms) Unmarshal(b []byte) error {
 	m.Tr.CryptoKeySize = buf.DecodeUint32()
 	m.Tr.IntegAlg = buf.DecodeUint8()
 	m.Tr.DhGroup = buf.DecodeUint8()
-	return nil
+	return buf.Err()
 }
 
 // Ikev2SetIkeTransformsReply defines message 'ikev2_set_ike_transforms_reply'.
@@ -1877,7 +1877,7 @@ func (m *Ikev2SetIkeTransformsReply) Marshal(b []byte) ([]byte, error) {
 func (m *Ikev2SetIkeTransformsReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
-	return nil
+	return buf.Err()
 }
 
 // Ikev2SetLocalKey defines message 'ikev2_set_local_key'.
@@ -1911,7 +1911,7 @@ func (m *Ikev2SetLocalKey) Marshal(b []byte) ([]byte, error) {
 func (m *Ikev2SetLocalKey) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.KeyFile = buf.DecodeString(256)
-	return nil
+	return buf.Err()
 }
 
 // Ikev2SetLocalKeyReply defines message 'ikev2_set_local_key_reply'.
@@ -1945,7 +1945,7 @@ func (m *Ikev2SetLocalKeyReply) Marshal(b []byte) ([]byte, error) {
 func (m *Ikev2SetLocalKeyReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
-	return nil
+	return buf.Err()
 }
 
 // Ikev2SetResponder defines message 'ikev2_set_responder'.
@@ -1989,7 +1989,7 @@ func (m *Ikev2SetResponder) Unmarshal(b []byte) error {
 	m.Responder.SwIfIndex = interface_types.InterfaceIndex(buf.DecodeUint32())
 	m.Responder.Addr.Af = ip_types.AddressFamily(buf.DecodeUint8())
 	copy(m.Responder.Addr.Un.XXX_UnionData[:], buf.DecodeBytes(16))
-	return nil
+	return buf.Err()
 }
 
 // Ikev2SetResponderHostname defines message 'ikev2_set_responder_hostname'.
@@ -2031,7 +2031,7 @@ func (m *Ikev2SetResponderHostname) Unmarshal(b []byte) error {
 	m.Name = buf.DecodeString(64)
 	m.Hostname = buf.DecodeString(64)
 	m.SwIfIndex = interface_types.InterfaceIndex(buf.DecodeUint32())
-	return nil
+	return buf.Err()
 }
 
 // Ikev2SetResponderHostnameReply defines message 'ikev2_set_responder_hostname_reply'.
@@ -2067,7 +2067,7 @@ func (m *Ikev2SetResponderHostnameReply) Marshal(b []byte) ([]byte, error) {
 func (m *Ikev2SetResponderHostnameReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
-	return nil
+	return buf.Err()
 }
 
 // Ikev2SetResponderReply defines message 'ikev2_set_responder_reply'.
@@ -2101,7 +2101,7 @@ func (m *Ikev2SetResponderReply) Marshal(b []byte) ([]byte, error) {
 func (m *Ikev2SetResponderReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
-	return nil
+	return buf.Err()
 }
 
 // Ikev2SetSaLifetime defines message 'ikev2_set_sa_lifetime'.
@@ -2151,7 +2151,7 @@ func (m *Ikev2SetSaLifetime) Unmarshal(b []byte) error {
 	m.LifetimeJitter = buf.DecodeUint32()
 	m.Handover = buf.DecodeUint32()
 	m.LifetimeMaxdata = buf.DecodeUint64()
-	return nil
+	return buf.Err()
 }
 
 // Ikev2SetSaLifetimeReply defines message 'ikev2_set_sa_lifetime_reply'.
@@ -2185,7 +2185,7 @@ func (m *Ikev2SetSaLifetimeReply) Marshal(b []byte) ([]byte, error) {
 func (m *Ikev2SetSaLifetimeReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
-	return nil
+	return buf.Err()
 }
 
 // Ikev2SetTunnelInterface defines message 'ikev2_set_tunnel_interface'.
@@ -2223,7 +2223,7 @@ func (m *Ikev2SetTunnelInterface) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Name = buf.DecodeString(64)
 	m.SwIfIndex = interface_types.InterfaceIndex(buf.DecodeUint32())
-	return nil
+	return buf.Err()
 }
 
 // Ikev2SetTunnelInterfaceReply defines message 'ikev2_set_tunnel_interface_reply'.
@@ -2259,7 +2259,7 @@ func (m *Ikev2SetTunnelInterfaceReply) Marshal(b []byte) ([]byte, error) {
 func (m *Ikev2SetTunnelInterfaceReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
-	return nil
+	return buf.Err()
 }
 
 // Ikev2TrafficSelectorDetails defines message 'ikev2_traffic_selector_details'.
@@ -2324,7 +2324,7 @@ func (m *Ikev2TrafficSelectorDetails) Unmarshal(b []byte) error {
 	copy(m.Ts.StartAddr.Un.XXX_UnionData[:], buf.DecodeBytes(16))
 	m.Ts.EndAddr.Af = ip_types.AddressFamily(buf.DecodeUint8())
 	copy(m.Ts.EndAddr.Un.XXX_UnionData[:], buf.DecodeBytes(16))
-	return nil
+	return buf.Err()
 }
 
 // Ikev2TrafficSelectorDump defines message 'ikev2_traffic_selector_dump'.
@@ -2366,7 +2366,7 @@ func (m *Ikev2TrafficSelectorDump) Unmarshal(b []byte) error {
 	m.IsInitiator = buf.DecodeBool()
 	m.SaIndex = buf.DecodeUint32()
 	m.ChildSaIndex = buf.DecodeUint32()
-	return nil
+	return buf.Err()
 }
 
 func init() { file_ikev2_binapi_init() }
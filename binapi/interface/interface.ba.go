@@ -66,7 +66,7 @@ func (m *CollectDetailedInterfaceStats) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.SwIfIndex = interface_types.InterfaceIndex(buf.DecodeUint32())
 	m.EnableDisable = buf.DecodeBool()
-	return nil
+	return buf.Err()
 }
 
 // CollectDetailedInterfaceStatsReply defines message 'collect_detailed_interface_stats_reply'.
@@ -101,7 +101,7 @@ func (m *CollectDetailedInterfaceStatsReply) Marshal(b []byte) ([]byte, error) {
 func (m *CollectDetailedInterfaceStatsReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
-	return nil
+	return buf.Err()
 }
 
 // CreateLoopback defines message 'create_loopback'.
@@ -134,7 +134,7 @@ func (m *CreateLoopback) Marshal(b []byte) ([]byte, error) {
 func (m *CreateLoopback) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	copy(m.MacAddress[:], buf.DecodeBytes(6))
-	return nil
+	return buf.Err()
 }
 
 // CreateLoopbackInstance defines message 'create_loopback_instance'.
@@ -175,7 +175,7 @@ func (m *CreateLoopbackInstance) Unmarshal(b []byte) error {
 	copy(m.MacAddress[:], buf.DecodeBytes(6))
 	m.IsSpecified = buf.DecodeBool()
 	m.UserInstance = buf.DecodeUint32()
-	return nil
+	return buf.Err()
 }
 
 // CreateLoopbackInstanceReply defines message 'create_loopback_instance_reply'.
@@ -212,7 +212,7 @@ func (m *CreateLoopbackInstanceReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
 	m.SwIfIndex = interface_types.InterfaceIndex(buf.DecodeUint32())
-	return nil
+	return buf.Err()
 }
 
 // CreateLoopbackReply defines message 'create_loopback_reply'.
@@ -249,7 +249,7 @@ func (m *CreateLoopbackReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
 	m.SwIfIndex = interface_types.InterfaceIndex(buf.DecodeUint32())
-	return nil
+	return buf.Err()
 }
 
 // CreateSubif defines message 'create_subif'.
@@ -298,7 +298,7 @@ func (m *CreateSubif) Unmarshal(b []byte) error {
 	m.SubIfFlags = interface_types.SubIfFlags(buf.DecodeUint32())
 	m.OuterVlanID = buf.DecodeUint16()
 	m.InnerVlanID = buf.DecodeUint16()
-	return nil
+	return buf.Err()
 }
 
 // CreateSubifReply defines message 'create_subif_reply'.
@@ -335,7 +335,7 @@ func (m *CreateSubifReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
 	m.SwIfIndex = interface_types.InterfaceIndex(buf.DecodeUint32())
-	return nil
+	return buf.Err()
 }
 
 // CreateVlanSubif defines message 'create_vlan_subif'.
@@ -372,7 +372,7 @@ func (m *CreateVlanSubif) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.SwIfIndex = interface_types.InterfaceIndex(buf.DecodeUint32())
 	m.VlanID = buf.DecodeUint32()
-	return nil
+	return buf.Err()
 }
 
 // CreateVlanSubifReply defines message 'create_vlan_subif_reply'.
@@ -409,7 +409,7 @@ func (m *CreateVlanSubifReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
 	m.SwIfIndex = interface_types.InterfaceIndex(buf.DecodeUint32())
-	return nil
+	return buf.Err()
 }
 
 // DeleteLoopback defines message 'delete_loopback'.
@@ -442,7 +442,7 @@ func (m *DeleteLoopback) Marshal(b []byte) ([]byte, error) {
 func (m *DeleteLoopback) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.SwIfIndex = interface_types.InterfaceIndex(buf.DecodeUint32())
-	return nil
+	return buf.Err()
 }
 
 // DeleteLoopbackReply defines message 'delete_loopback_reply'.
@@ -475,7 +475,7 @@ func (m *DeleteLoopbackReply) Marshal(b []byte) ([]byte, error) {
 func (m *DeleteLoopbackReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
-	return nil
+	return buf.Err()
 }
 
 // DeleteSubif defines message 'delete_subif'.
@@ -508,7 +508,7 @@ func (m *DeleteSubif) Marshal(b []byte) ([]byte, error) {
 func (m *DeleteSubif) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.SwIfIndex = interface_types.InterfaceIndex(buf.DecodeUint32())
-	return nil
+	return buf.Err()
 }
 
 // DeleteSubifReply defines message 'delete_subif_reply'.
@@ -541,7 +541,7 @@ func (m *DeleteSubifReply) Marshal(b []byte) ([]byte, error) {
 func (m *DeleteSubifReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
-	return nil
+	return buf.Err()
 }
 
 // HwInterfaceSetMtu defines message 'hw_interface_set_mtu'.
@@ -578,7 +578,7 @@ func (m *HwInterfaceSetMtu) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.SwIfIndex = interface_types.InterfaceIndex(buf.DecodeUint32())
 	m.Mtu = buf.DecodeUint16()
-	return nil
+	return buf.Err()
 }
 
 // HwInterfaceSetMtuReply defines message 'hw_interface_set_mtu_reply'.
@@ -611,7 +611,7 @@ func (m *HwInterfaceSetMtuReply) Marshal(b []byte) ([]byte, error) {
 func (m *HwInterfaceSetMtuReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
-	return nil
+	return buf.Err()
 }
 
 // InterfaceNameRenumber defines message 'interface_name_renumber'.
@@ -648,7 +648,7 @@ func (m *InterfaceNameRenumber) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.SwIfIndex = interface_types.InterfaceIndex(buf.DecodeUint32())
 	m.NewShowDevInstance = buf.DecodeUint32()
-	return nil
+	return buf.Err()
 }
 
 // InterfaceNameRenumberReply defines message 'interface_name_renumber_reply'.
@@ -681,7 +681,7 @@ func (m *InterfaceNameRenumberReply) Marshal(b []byte) ([]byte, error) {
 func (m *InterfaceNameRenumberReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
-	return nil
+	return buf.Err()
 }
 
 // SwInterfaceAddDelAddress defines message 'sw_interface_add_del_address'.
@@ -732,7 +732,7 @@ func (m *SwInterfaceAddDelAddress) Unmarshal(b []byte) error {
 	m.Prefix.Address.Af = ip_types.AddressFamily(buf.DecodeUint8())
 	copy(m.Prefix.Address.Un.XXX_UnionData[:], buf.DecodeBytes(16))
 	m.Prefix.Len = buf.DecodeUint8()
-	return nil
+	return buf.Err()
 }
 
 // SwInterfaceAddDelAddressReply defines message 'sw_interface_add_del_address_reply'.
@@ -767,7 +767,7 @@ func (m *SwInterfaceAddDelAddressReply) Marshal(b []byte) ([]byte, error) {
 func (m *SwInterfaceAddDelAddressReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
-	return nil
+	return buf.Err()
 }
 
 // SwInterfaceAddDelMacAddress defines message 'sw_interface_add_del_mac_address'.
@@ -810,7 +810,7 @@ func (m *SwInterfaceAddDelMacAddress) Unmarshal(b []byte) error {
 	m.SwIfIndex = buf.DecodeUint32()
 	copy(m.Addr[:], buf.DecodeBytes(6))
 	m.IsAdd = buf.DecodeUint8()
-	return nil
+	return buf.Err()
 }
 
 // SwInterfaceAddDelMacAddressReply defines message 'sw_interface_add_del_mac_address_reply'.
@@ -845,7 +845,7 @@ func (m *SwInterfaceAddDelMacAddressReply) Marshal(b []byte) ([]byte, error) {
 func (m *SwInterfaceAddDelMacAddressReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
-	return nil
+	return buf.Err()
 }
 
 // SwInterfaceAddressReplaceBegin defines message 'sw_interface_address_replace_begin'.
@@ -909,7 +909,7 @@ func (m *SwInterfaceAddressReplaceBeginReply) Marshal(b []byte) ([]byte, error)
 func (m *SwInterfaceAddressReplaceBeginReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
-	return nil
+	return buf.Err()
 }
 
 // SwInterfaceAddressReplaceEnd defines message 'sw_interface_address_replace_end'.
@@ -973,7 +973,7 @@ func (m *SwInterfaceAddressReplaceEndReply) Marshal(b []byte) ([]byte, error) {
 func (m *SwInterfaceAddressReplaceEndReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
-	return nil
+	return buf.Err()
 }
 
 // SwInterfaceClearStats defines message 'sw_interface_clear_stats'.
@@ -1006,7 +1006,7 @@ func (m *SwInterfaceClearStats) Marshal(b []byte) ([]byte, error) {
 func (m *SwInterfaceClearStats) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.SwIfIndex = interface_types.InterfaceIndex(buf.DecodeUint32())
-	return nil
+	return buf.Err()
 }
 
 // SwInterfaceClearStatsReply defines message 'sw_interface_clear_stats_reply'.
@@ -1039,7 +1039,7 @@ func (m *SwInterfaceClearStatsReply) Marshal(b []byte) ([]byte, error) {
 func (m *SwInterfaceClearStatsReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
-	return nil
+	return buf.Err()
 }
 
 // SwInterfaceDetails defines message 'sw_interface_details'.
@@ -1181,7 +1181,7 @@ func (m *SwInterfaceDetails) Unmarshal(b []byte) error {
 	m.InterfaceName = buf.DecodeString(64)
 	m.InterfaceDevType = buf.DecodeString(64)
 	m.Tag = buf.DecodeString(64)
-	return nil
+	return buf.Err()
 }
 
 // SwInterfaceDump defines message 'sw_interface_dump'.
@@ -1222,7 +1222,7 @@ func (m *SwInterfaceDump) Unmarshal(b []byte) error {
 	m.SwIfIndex = interface_types.InterfaceIndex(buf.DecodeUint32())
 	m.NameFilterValid = buf.DecodeBool()
 	m.NameFilter = buf.DecodeString(0)
-	return nil
+	return buf.Err()
 }
 
 // SwInterfaceEvent defines message 'sw_interface_event'.
@@ -1267,7 +1267,7 @@ func (m *SwInterfaceEvent) Unmarshal(b []byte) error {
 	m.SwIfIndex = interface_types.InterfaceIndex(buf.DecodeUint32())
 	m.Flags = interface_types.IfStatusFlags(buf.DecodeUint32())
 	m.Deleted = buf.DecodeBool()
-	return nil
+	return buf.Err()
 }
 
 // SwInterfaceGetMacAddress defines message 'sw_interface_get_mac_address'.
@@ -1300,7 +1300,7 @@ func (m *SwInterfaceGetMacAddress) Marshal(b []byte) ([]byte, error) {
 func (m *SwInterfaceGetMacAddress) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.SwIfIndex = interface_types.InterfaceIndex(buf.DecodeUint32())
-	return nil
+	return buf.Err()
 }
 
 // SwInterfaceGetMacAddressReply defines message 'sw_interface_get_mac_address_reply'.
@@ -1339,7 +1339,7 @@ func (m *SwInterfaceGetMacAddressReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
 	copy(m.MacAddress[:], buf.DecodeBytes(6))
-	return nil
+	return buf.Err()
 }
 
 // SwInterfaceGetTable defines message 'sw_interface_get_table'.
@@ -1376,7 +1376,7 @@ func (m *SwInterfaceGetTable) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.SwIfIndex = interface_types.InterfaceIndex(buf.DecodeUint32())
 	m.IsIPv6 = buf.DecodeBool()
-	return nil
+	return buf.Err()
 }
 
 // SwInterfaceGetTableReply defines message 'sw_interface_get_table_reply'.
@@ -1413,7 +1413,7 @@ func (m *SwInterfaceGetTableReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
 	m.VrfID = buf.DecodeUint32()
-	return nil
+	return buf.Err()
 }
 
 // SwInterfaceRxPlacementDetails defines message 'sw_interface_rx_placement_details'.
@@ -1460,7 +1460,7 @@ func (m *SwInterfaceRxPlacementDetails) Unmarshal(b []byte) error {
 	m.QueueID = buf.DecodeUint32()
 	m.WorkerID = buf.DecodeUint32()
 	m.Mode = interface_types.RxMode(buf.DecodeUint32())
-	return nil
+	return buf.Err()
 }
 
 // SwInterfaceRxPlacementDump defines message 'sw_interface_rx_placement_dump'.
@@ -1493,7 +1493,7 @@ func (m *SwInterfaceRxPlacementDump) Marshal(b []byte) ([]byte, error) {
 func (m *SwInterfaceRxPlacementDump) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.SwIfIndex = interface_types.InterfaceIndex(buf.DecodeUint32())
-	return nil
+	return buf.Err()
 }
 
 // SwInterfaceSetFlags defines message 'sw_interface_set_flags'.
@@ -1530,7 +1530,7 @@ func (m *SwInterfaceSetFlags) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.SwIfIndex = interface_types.InterfaceIndex(buf.DecodeUint32())
 	m.Flags = interface_types.IfStatusFlags(buf.DecodeUint32())
-	return nil
+	return buf.Err()
 }
 
 // SwInterfaceSetFlagsReply defines message 'sw_interface_set_flags_reply'.
@@ -1563,7 +1563,7 @@ func (m *SwInterfaceSetFlagsReply) Marshal(b []byte) ([]byte, error) {
 func (m *SwInterfaceSetFlagsReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
-	return nil
+	return buf.Err()
 }
 
 // SwInterfaceSetInterfaceName defines message 'sw_interface_set_interface_name'.
@@ -1600,7 +1600,7 @@ func (m *SwInterfaceSetInterfaceName) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.SwIfIndex = interface_types.InterfaceIndex(buf.DecodeUint32())
 	m.Name = buf.DecodeString(64)
-	return nil
+	return buf.Err()
 }
 
 // SwInterfaceSetInterfaceNameReply defines message 'sw_interface_set_interface_name_reply'.
@@ -1635,7 +1635,7 @@ func (m *SwInterfaceSetInterfaceNameReply) Marshal(b []byte) ([]byte, error) {
 func (m *SwInterfaceSetInterfaceNameReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
-	return nil
+	return buf.Err()
 }
 
 // SwInterfaceSetIPDirectedBroadcast defines message 'sw_interface_set_ip_directed_broadcast'.
@@ -1674,7 +1674,7 @@ func (m *SwInterfaceSetIPDirectedBroadcast) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.SwIfIndex = interface_types.InterfaceIndex(buf.DecodeUint32())
 	m.Enable = buf.DecodeBool()
-	return nil
+	return buf.Err()
 }
 
 // SwInterfaceSetIPDirectedBroadcastReply defines message 'sw_interface_set_ip_directed_broadcast_reply'.
@@ -1711,7 +1711,7 @@ func (m *SwInterfaceSetIPDirectedBroadcastReply) Marshal(b []byte) ([]byte, erro
 func (m *SwInterfaceSetIPDirectedBroadcastReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
-	return nil
+	return buf.Err()
 }
 
 // SwInterfaceSetMacAddress defines message 'sw_interface_set_mac_address'.
@@ -1748,7 +1748,7 @@ func (m *SwInterfaceSetMacAddress) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.SwIfIndex = interface_types.InterfaceIndex(buf.DecodeUint32())
 	copy(m.MacAddress[:], buf.DecodeBytes(6))
-	return nil
+	return buf.Err()
 }
 
 // SwInterfaceSetMacAddressReply defines message 'sw_interface_set_mac_address_reply'.
@@ -1783,7 +1783,7 @@ func (m *SwInterfaceSetMacAddressReply) Marshal(b []byte) ([]byte, error) {
 func (m *SwInterfaceSetMacAddressReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
-	return nil
+	return buf.Err()
 }
 
 // SwInterfaceSetMtu defines message 'sw_interface_set_mtu'.
@@ -1829,7 +1829,7 @@ func (m *SwInterfaceSetMtu) Unmarshal(b []byte) error {
 	for i := 0; i < len(m.Mtu); i++ {
 		m.Mtu[i] = buf.DecodeUint32()
 	}
-	return nil
+	return buf.Err()
 }
 
 // SwInterfaceSetMtuReply defines message 'sw_interface_set_mtu_reply'.
@@ -1862,7 +1862,7 @@ func (m *SwInterfaceSetMtuReply) Marshal(b []byte) ([]byte, error) {
 func (m *SwInterfaceSetMtuReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
-	return nil
+	return buf.Err()
 }
 
 // SwInterfaceSetPromisc defines message 'sw_interface_set_promisc'.
@@ -1899,7 +1899,7 @@ func (m *SwInterfaceSetPromisc) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.SwIfIndex = interface_types.InterfaceIndex(buf.DecodeUint32())
 	m.PromiscOn = buf.DecodeBool()
-	return nil
+	return buf.Err()
 }
 
 // SwInterfaceSetPromiscReply defines message 'sw_interface_set_promisc_reply'.
@@ -1932,7 +1932,7 @@ func (m *SwInterfaceSetPromiscReply) Marshal(b []byte) ([]byte, error) {
 func (m *SwInterfaceSetPromiscReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
-	return nil
+	return buf.Err()
 }
 
 // SwInterfaceSetRxMode defines message 'sw_interface_set_rx_mode'.
@@ -1977,7 +1977,7 @@ func (m *SwInterfaceSetRxMode) Unmarshal(b []byte) error {
 	m.QueueIDValid = buf.DecodeBool()
 	m.QueueID = buf.DecodeUint32()
 	m.Mode = interface_types.RxMode(buf.DecodeUint32())
-	return nil
+	return buf.Err()
 }
 
 // SwInterfaceSetRxModeReply defines message 'sw_interface_set_rx_mode_reply'.
@@ -2010,7 +2010,7 @@ func (m *SwInterfaceSetRxModeReply) Marshal(b []byte) ([]byte, error) {
 func (m *SwInterfaceSetRxModeReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
-	return nil
+	return buf.Err()
 }
 
 // SwInterfaceSetRxPlacement defines message 'sw_interface_set_rx_placement'.
@@ -2055,7 +2055,7 @@ func (m *SwInterfaceSetRxPlacement) Unmarshal(b []byte) error {
 	m.QueueID = buf.DecodeUint32()
 	m.WorkerID = buf.DecodeUint32()
 	m.IsMain = buf.DecodeBool()
-	return nil
+	return buf.Err()
 }
 
 // SwInterfaceSetRxPlacementReply defines message 'sw_interface_set_rx_placement_reply'.
@@ -2090,7 +2090,7 @@ func (m *SwInterfaceSetRxPlacementReply) Marshal(b []byte) ([]byte, error) {
 func (m *SwInterfaceSetRxPlacementReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
-	return nil
+	return buf.Err()
 }
 
 // SwInterfaceSetTable defines message 'sw_interface_set_table'.
@@ -2131,7 +2131,7 @@ func (m *SwInterfaceSetTable) Unmarshal(b []byte) error {
 	m.SwIfIndex = interface_types.InterfaceIndex(buf.DecodeUint32())
 	m.IsIPv6 = buf.DecodeBool()
 	m.VrfID = buf.DecodeUint32()
-	return nil
+	return buf.Err()
 }
 
 // SwInterfaceSetTableReply defines message 'sw_interface_set_table_reply'.
@@ -2164,7 +2164,7 @@ func (m *SwInterfaceSetTableReply) Marshal(b []byte) ([]byte, error) {
 func (m *SwInterfaceSetTableReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
-	return nil
+	return buf.Err()
 }
 
 // SwInterfaceSetTxPlacement defines message 'sw_interface_set_tx_placement'.
@@ -2214,11 +2214,11 @@ func (m *SwInterfaceSetTxPlacement) Unmarshal(b []byte) error {
 	m.SwIfIndex = interface_types.InterfaceIndex(buf.DecodeUint32())
 	m.QueueID = buf.DecodeUint32()
 	m.ArraySize = buf.DecodeUint32()
-	m.Threads = make([]uint32, m.ArraySize)
+	m.Threads = make([]uint32, buf.CheckArrayLen(int(m.ArraySize), 4))
 	for i := 0; i < len(m.Threads); i++ {
 		m.Threads[i] = buf.DecodeUint32()
 	}
-	return nil
+	return buf.Err()
 }
 
 // SwInterfaceSetTxPlacementReply defines message 'sw_interface_set_tx_placement_reply'.
@@ -2253,7 +2253,7 @@ func (m *SwInterfaceSetTxPlacementReply) Marshal(b []byte) ([]byte, error) {
 func (m *SwInterfaceSetTxPlacementReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
-	return nil
+	return buf.Err()
 }
 
 // SwInterfaceSetUnnumbered defines message 'sw_interface_set_unnumbered'.
@@ -2294,7 +2294,7 @@ func (m *SwInterfaceSetUnnumbered) Unmarshal(b []byte) error {
 	m.SwIfIndex = interface_types.InterfaceIndex(buf.DecodeUint32())
 	m.UnnumberedSwIfIndex = interface_types.InterfaceIndex(buf.DecodeUint32())
 	m.IsAdd = buf.DecodeBool()
-	return nil
+	return buf.Err()
 }
 
 // SwInterfaceSetUnnumberedReply defines message 'sw_interface_set_unnumbered_reply'.
@@ -2329,7 +2329,7 @@ func (m *SwInterfaceSetUnnumberedReply) Marshal(b []byte) ([]byte, error) {
 func (m *SwInterfaceSetUnnumberedReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
-	return nil
+	return buf.Err()
 }
 
 // SwInterfaceTagAddDel defines message 'sw_interface_tag_add_del'.
@@ -2370,7 +2370,7 @@ func (m *SwInterfaceTagAddDel) Unmarshal(b []byte) error {
 	m.IsAdd = buf.DecodeBool()
 	m.SwIfIndex = interface_types.InterfaceIndex(buf.DecodeUint32())
 	m.Tag = buf.DecodeString(64)
-	return nil
+	return buf.Err()
 }
 
 // SwInterfaceTagAddDelReply defines message 'sw_interface_tag_add_del_reply'.
@@ -2403,7 +2403,7 @@ func (m *SwInterfaceTagAddDelReply) Marshal(b []byte) ([]byte, error) {
 func (m *SwInterfaceTagAddDelReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
-	return nil
+	return buf.Err()
 }
 
 // SwInterfaceTxPlacementDetails defines message 'sw_interface_tx_placement_details'.
@@ -2459,11 +2459,11 @@ func (m *SwInterfaceTxPlacementDetails) Unmarshal(b []byte) error {
 	m.QueueID = buf.DecodeUint32()
 	m.Shared = buf.DecodeUint8()
 	m.ArraySize = buf.DecodeUint32()
-	m.Threads = make([]uint32, m.ArraySize)
+	m.Threads = make([]uint32, buf.CheckArrayLen(int(m.ArraySize), 4))
 	for i := 0; i < len(m.Threads); i++ {
 		m.Threads[i] = buf.DecodeUint32()
 	}
-	return nil
+	return buf.Err()
 }
 
 // SwInterfaceTxPlacementGet defines message 'sw_interface_tx_placement_get'.
@@ -2500,7 +2500,7 @@ func (m *SwInterfaceTxPlacementGet) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Cursor = buf.DecodeUint32()
 	m.SwIfIndex = interface_types.InterfaceIndex(buf.DecodeUint32())
-	return nil
+	return buf.Err()
 }
 
 // SwInterfaceTxPlacementGetReply defines message 'sw_interface_tx_placement_get_reply'.
@@ -2539,7 +2539,7 @@ func (m *SwInterfaceTxPlacementGetReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
 	m.Cursor = buf.DecodeUint32()
-	return nil
+	return buf.Err()
 }
 
 // WantInterfaceEvents defines message 'want_interface_events'.
@@ -2576,7 +2576,7 @@ func (m *WantInterfaceEvents) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.EnableDisable = buf.DecodeUint32()
 	m.PID = buf.DecodeUint32()
-	return nil
+	return buf.Err()
 }
 
 // WantInterfaceEventsReply defines message 'want_interface_events_reply'.
@@ -2609,7 +2609,7 @@ func (m *WantInterfaceEventsReply) Marshal(b []byte) ([]byte, error) {
 func (m *WantInterfaceEventsReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
-	return nil
+	return buf.Err()
 }
 
 func init() { file_interfaces_binapi_init() }
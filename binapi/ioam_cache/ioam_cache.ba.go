@@ -57,7 +57,7 @@ func (m *IoamCacheIP6EnableDisable) Marshal(b []byte) ([]byte, error) {
 func (m *IoamCacheIP6EnableDisable) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.IsDisable = buf.DecodeBool()
-	return nil
+	return buf.Err()
 }
 
 // IoamCacheIP6EnableDisableReply defines message 'ioam_cache_ip6_enable_disable_reply'.
@@ -92,7 +92,7 @@ func (m *IoamCacheIP6EnableDisableReply) Marshal(b []byte) ([]byte, error) {
 func (m *IoamCacheIP6EnableDisableReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
-	return nil
+	return buf.Err()
 }
 
 func init() { file_ioam_cache_binapi_init() }
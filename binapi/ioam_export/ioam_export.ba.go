@@ -66,7 +66,7 @@ func (m *IoamExportIP6EnableDisable) Unmarshal(b []byte) error {
 	m.IsDisable = buf.DecodeBool()
 	copy(m.CollectorAddress[:], buf.DecodeBytes(4))
 	copy(m.SrcAddress[:], buf.DecodeBytes(4))
-	return nil
+	return buf.Err()
 }
 
 // IoamExportIP6EnableDisableReply defines message 'ioam_export_ip6_enable_disable_reply'.
@@ -101,7 +101,7 @@ func (m *IoamExportIP6EnableDisableReply) Marshal(b []byte) ([]byte, error) {
 func (m *IoamExportIP6EnableDisableReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
-	return nil
+	return buf.Err()
 }
 
 func init() { file_ioam_export_binapi_init() }
@@ -58,7 +58,7 @@ func (m *VxlanGpeIoamDisable) Marshal(b []byte) ([]byte, error) {
 func (m *VxlanGpeIoamDisable) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.ID = buf.DecodeUint16()
-	return nil
+	return buf.Err()
 }
 
 // VxlanGpeIoamDisableReply defines message 'vxlan_gpe_ioam_disable_reply'.
@@ -91,7 +91,7 @@ func (m *VxlanGpeIoamDisableReply) Marshal(b []byte) ([]byte, error) {
 func (m *VxlanGpeIoamDisableReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
-	return nil
+	return buf.Err()
 }
 
 // VxlanGpeIoamEnable defines message 'vxlan_gpe_ioam_enable'.
@@ -136,7 +136,7 @@ func (m *VxlanGpeIoamEnable) Unmarshal(b []byte) error {
 	m.TracePpc = buf.DecodeUint8()
 	m.PowEnable = buf.DecodeBool()
 	m.TraceEnable = buf.DecodeBool()
-	return nil
+	return buf.Err()
 }
 
 // VxlanGpeIoamEnableReply defines message 'vxlan_gpe_ioam_enable_reply'.
@@ -169,7 +169,7 @@ func (m *VxlanGpeIoamEnableReply) Marshal(b []byte) ([]byte, error) {
 func (m *VxlanGpeIoamEnableReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
-	return nil
+	return buf.Err()
 }
 
 // VxlanGpeIoamTransitDisable defines message 'vxlan_gpe_ioam_transit_disable'.
@@ -209,7 +209,7 @@ func (m *VxlanGpeIoamTransitDisable) Unmarshal(b []byte) error {
 	m.OuterFibIndex = buf.DecodeUint32()
 	m.DstAddr.Af = ip_types.AddressFamily(buf.DecodeUint8())
 	copy(m.DstAddr.Un.XXX_UnionData[:], buf.DecodeBytes(16))
-	return nil
+	return buf.Err()
 }
 
 // VxlanGpeIoamTransitDisableReply defines message 'vxlan_gpe_ioam_transit_disable_reply'.
@@ -244,7 +244,7 @@ func (m *VxlanGpeIoamTransitDisableReply) Marshal(b []byte) ([]byte, error) {
 func (m *VxlanGpeIoamTransitDisableReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
-	return nil
+	return buf.Err()
 }
 
 // VxlanGpeIoamTransitEnable defines message 'vxlan_gpe_ioam_transit_enable'.
@@ -284,7 +284,7 @@ func (m *VxlanGpeIoamTransitEnable) Unmarshal(b []byte) error {
 	m.OuterFibIndex = buf.DecodeUint32()
 	m.DstAddr.Af = ip_types.AddressFamily(buf.DecodeUint8())
 	copy(m.DstAddr.Un.XXX_UnionData[:], buf.DecodeBytes(16))
-	return nil
+	return buf.Err()
 }
 
 // VxlanGpeIoamTransitEnableReply defines message 'vxlan_gpe_ioam_transit_enable_reply'.
@@ -319,7 +319,7 @@ func (m *VxlanGpeIoamTransitEnableReply) Marshal(b []byte) ([]byte, error) {
 func (m *VxlanGpeIoamTransitEnableReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
-	return nil
+	return buf.Err()
 }
 
 // VxlanGpeIoamVniDisable defines message 'vxlan_gpe_ioam_vni_disable'.
@@ -366,7 +366,7 @@ func (m *VxlanGpeIoamVniDisable) Unmarshal(b []byte) error {
 	copy(m.Local.Un.XXX_UnionData[:], buf.DecodeBytes(16))
 	m.Remote.Af = ip_types.AddressFamily(buf.DecodeUint8())
 	copy(m.Remote.Un.XXX_UnionData[:], buf.DecodeBytes(16))
-	return nil
+	return buf.Err()
 }
 
 // VxlanGpeIoamVniDisableReply defines message 'vxlan_gpe_ioam_vni_disable_reply'.
@@ -401,7 +401,7 @@ func (m *VxlanGpeIoamVniDisableReply) Marshal(b []byte) ([]byte, error) {
 func (m *VxlanGpeIoamVniDisableReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
-	return nil
+	return buf.Err()
 }
 
 // VxlanGpeIoamVniEnable defines message 'vxlan_gpe_ioam_vni_enable'.
@@ -448,7 +448,7 @@ func (m *VxlanGpeIoamVniEnable) Unmarshal(b []byte) error {
 	copy(m.Local.Un.XXX_UnionData[:], buf.DecodeBytes(16))
 	m.Remote.Af = ip_types.AddressFamily(buf.DecodeUint8())
 	copy(m.Remote.Un.XXX_UnionData[:], buf.DecodeBytes(16))
-	return nil
+	return buf.Err()
 }
 
 // VxlanGpeIoamVniEnableReply defines message 'vxlan_gpe_ioam_vni_enable_reply'.
@@ -481,7 +481,7 @@ func (m *VxlanGpeIoamVniEnableReply) Marshal(b []byte) ([]byte, error) {
 func (m *VxlanGpeIoamVniEnableReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
-	return nil
+	return buf.Err()
 }
 
 func init() { file_ioam_vxlan_gpe_binapi_init() }
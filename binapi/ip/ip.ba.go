@@ -278,7 +278,7 @@ func (m *AddDelIPPuntRedirectV2) Unmarshal(b []byte) error {
 	m.Punt.RxSwIfIndex = interface_types.InterfaceIndex(buf.DecodeUint32())
 	m.Punt.Af = ip_types.AddressFamily(buf.DecodeUint8())
 	m.Punt.NPaths = buf.DecodeUint32()
-	m.Punt.Paths = make([]fib_types.FibPath, m.Punt.NPaths)
+	m.Punt.Paths = make([]fib_types.FibPath, buf.CheckArrayLen(int(m.Punt.NPaths), 1))
 	for j1 := 0; j1 < len(m.Punt.Paths); j1++ {
 		m.Punt.Paths[j1].SwIfIndex = buf.DecodeUint32()
 		m.Punt.Paths[j1].TableID = buf.DecodeUint32()
@@ -300,7 +300,7 @@ func (m *AddDelIPPuntRedirectV2) Unmarshal(b []byte) error {
 			m.Punt.Paths[j1].LabelStack[j2].Exp = buf.DecodeUint8()
 		}
 	}
-	return nil
+	return buf.Err()
 }
 
 // AddDelIPPuntRedirectV2Reply defines message 'add_del_ip_punt_redirect_v2_reply'.
@@ -335,7 +335,7 @@ func (m *AddDelIPPuntRedirectV2Reply) Marshal(b []byte) ([]byte, error) {
 func (m *AddDelIPPuntRedirectV2Reply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
-	return nil
+	return buf.Err()
 }
 
 // IoamDisable defines message 'ioam_disable'.
@@ -368,7 +368,7 @@ func (m *IoamDisable) Marshal(b []byte) ([]byte, error) {
 func (m *IoamDisable) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.ID = buf.DecodeUint16()
-	return nil
+	return buf.Err()
 }
 
 // IoamDisableReply defines message 'ioam_disable_reply'.
@@ -401,7 +401,7 @@ func (m *IoamDisableReply) Marshal(b []byte) ([]byte, error) {
 func (m *IoamDisableReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
-	return nil
+	return buf.Err()
 }
 
 // IoamEnable defines message 'ioam_enable'.
@@ -454,7 +454,7 @@ func (m *IoamEnable) Unmarshal(b []byte) error {
 	m.PotEnable = buf.DecodeBool()
 	m.TraceEnable = buf.DecodeBool()
 	m.NodeID = buf.DecodeUint32()
-	return nil
+	return buf.Err()
 }
 
 // IoamEnableReply defines message 'ioam_enable_reply'.
@@ -487,7 +487,7 @@ func (m *IoamEnableReply) Marshal(b []byte) ([]byte, error) {
 func (m *IoamEnableReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
-	return nil
+	return buf.Err()
 }
 
 // IPAddressDetails defines message 'ip_address_details'.
@@ -530,7 +530,7 @@ func (m *IPAddressDetails) Unmarshal(b []byte) error {
 	m.Prefix.Address.Af = ip_types.AddressFamily(buf.DecodeUint8())
 	copy(m.Prefix.Address.Un.XXX_UnionData[:], buf.DecodeBytes(16))
 	m.Prefix.Len = buf.DecodeUint8()
-	return nil
+	return buf.Err()
 }
 
 // IPAddressDump defines message 'ip_address_dump'.
@@ -567,7 +567,7 @@ func (m *IPAddressDump) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.SwIfIndex = interface_types.InterfaceIndex(buf.DecodeUint32())
 	m.IsIPv6 = buf.DecodeBool()
-	return nil
+	return buf.Err()
 }
 
 // IPContainerProxyAddDel defines message 'ip_container_proxy_add_del'.
@@ -614,7 +614,7 @@ func (m *IPContainerProxyAddDel) Unmarshal(b []byte) error {
 	m.Pfx.Len = buf.DecodeUint8()
 	m.SwIfIndex = interface_types.InterfaceIndex(buf.DecodeUint32())
 	m.IsAdd = buf.DecodeBool()
-	return nil
+	return buf.Err()
 }
 
 // IPContainerProxyAddDelReply defines message 'ip_container_proxy_add_del_reply'.
@@ -649,7 +649,7 @@ func (m *IPContainerProxyAddDelReply) Marshal(b []byte) ([]byte, error) {
 func (m *IPContainerProxyAddDelReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
-	return nil
+	return buf.Err()
 }
 
 // IPContainerProxyDetails defines message 'ip_container_proxy_details'.
@@ -692,7 +692,7 @@ func (m *IPContainerProxyDetails) Unmarshal(b []byte) error {
 	m.Prefix.Address.Af = ip_types.AddressFamily(buf.DecodeUint8())
 	copy(m.Prefix.Address.Un.XXX_UnionData[:], buf.DecodeBytes(16))
 	m.Prefix.Len = buf.DecodeUint8()
-	return nil
+	return buf.Err()
 }
 
 // IPContainerProxyDump defines message 'ip_container_proxy_dump'.
@@ -756,7 +756,7 @@ func (m *IPDetails) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.SwIfIndex = interface_types.InterfaceIndex(buf.DecodeUint32())
 	m.IsIPv6 = buf.DecodeBool()
-	return nil
+	return buf.Err()
 }
 
 // IPDump defines message 'ip_dump'.
@@ -789,7 +789,7 @@ func (m *IPDump) Marshal(b []byte) ([]byte, error) {
 func (m *IPDump) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.IsIPv6 = buf.DecodeBool()
-	return nil
+	return buf.Err()
 }
 
 // IPLocalReassEnableDisable defines message 'ip_local_reass_enable_disable'.
@@ -826,7 +826,7 @@ func (m *IPLocalReassEnableDisable) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.EnableIP4 = buf.DecodeBool()
 	m.EnableIP6 = buf.DecodeBool()
-	return nil
+	return buf.Err()
 }
 
 // IPLocalReassEnableDisableReply defines message 'ip_local_reass_enable_disable_reply'.
@@ -861,7 +861,7 @@ func (m *IPLocalReassEnableDisableReply) Marshal(b []byte) ([]byte, error) {
 func (m *IPLocalReassEnableDisableReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
-	return nil
+	return buf.Err()
 }
 
 // IPLocalReassGet defines message 'ip_local_reass_get'.
@@ -929,7 +929,7 @@ func (m *IPLocalReassGetReply) Unmarshal(b []byte) error {
 	m.Retval = buf.DecodeInt32()
 	m.IP4IsEnabled = buf.DecodeBool()
 	m.IP6IsEnabled = buf.DecodeBool()
-	return nil
+	return buf.Err()
 }
 
 // IPMrouteAddDel defines message 'ip_mroute_add_del'.
@@ -1044,7 +1044,7 @@ func (m *IPMrouteAddDel) Unmarshal(b []byte) error {
 	copy(m.Route.Prefix.GrpAddress.XXX_UnionData[:], buf.DecodeBytes(16))
 	copy(m.Route.Prefix.SrcAddress.XXX_UnionData[:], buf.DecodeBytes(16))
 	m.Route.NPaths = buf.DecodeUint8()
-	m.Route.Paths = make([]mfib_types.MfibPath, m.Route.NPaths)
+	m.Route.Paths = make([]mfib_types.MfibPath, buf.CheckArrayLen(int(m.Route.NPaths), 1))
 	for j1 := 0; j1 < len(m.Route.Paths); j1++ {
 		m.Route.Paths[j1].ItfFlags = mfib_types.MfibItfFlags(buf.DecodeUint32())
 		m.Route.Paths[j1].Path.SwIfIndex = buf.DecodeUint32()
@@ -1067,7 +1067,7 @@ func (m *IPMrouteAddDel) Unmarshal(b []byte) error {
 			m.Route.Paths[j1].Path.LabelStack[j3].Exp = buf.DecodeUint8()
 		}
 	}
-	return nil
+	return buf.Err()
 }
 
 // IPMrouteAddDelReply defines message 'ip_mroute_add_del_reply'.
@@ -1104,7 +1104,7 @@ func (m *IPMrouteAddDelReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
 	m.StatsIndex = buf.DecodeUint32()
-	return nil
+	return buf.Err()
 }
 
 // IPMrouteDetails defines message 'ip_mroute_details'.
@@ -1211,7 +1211,7 @@ func (m *IPMrouteDetails) Unmarshal(b []byte) error {
 	copy(m.Route.Prefix.GrpAddress.XXX_UnionData[:], buf.DecodeBytes(16))
 	copy(m.Route.Prefix.SrcAddress.XXX_UnionData[:], buf.DecodeBytes(16))
 	m.Route.NPaths = buf.DecodeUint8()
-	m.Route.Paths = make([]mfib_types.MfibPath, m.Route.NPaths)
+	m.Route.Paths = make([]mfib_types.MfibPath, buf.CheckArrayLen(int(m.Route.NPaths), 1))
 	for j1 := 0; j1 < len(m.Route.Paths); j1++ {
 		m.Route.Paths[j1].ItfFlags = mfib_types.MfibItfFlags(buf.DecodeUint32())
 		m.Route.Paths[j1].Path.SwIfIndex = buf.DecodeUint32()
@@ -1234,7 +1234,7 @@ func (m *IPMrouteDetails) Unmarshal(b []byte) error {
 			m.Route.Paths[j1].Path.LabelStack[j3].Exp = buf.DecodeUint8()
 		}
 	}
-	return nil
+	return buf.Err()
 }
 
 // IPMrouteDump defines message 'ip_mroute_dump'.
@@ -1273,7 +1273,7 @@ func (m *IPMrouteDump) Unmarshal(b []byte) error {
 	m.Table.TableID = buf.DecodeUint32()
 	m.Table.IsIP6 = buf.DecodeBool()
 	m.Table.Name = buf.DecodeString(64)
-	return nil
+	return buf.Err()
 }
 
 // IPMtableDetails defines message 'ip_mtable_details'.
@@ -1312,7 +1312,7 @@ func (m *IPMtableDetails) Unmarshal(b []byte) error {
 	m.Table.TableID = buf.DecodeUint32()
 	m.Table.IsIP6 = buf.DecodeBool()
 	m.Table.Name = buf.DecodeString(64)
-	return nil
+	return buf.Err()
 }
 
 // IPMtableDump defines message 'ip_mtable_dump'.
@@ -1387,7 +1387,7 @@ func (m *IPPathMtuDetails) Unmarshal(b []byte) error {
 	m.Pmtu.Nh.Af = ip_types.AddressFamily(buf.DecodeUint8())
 	copy(m.Pmtu.Nh.Un.XXX_UnionData[:], buf.DecodeBytes(16))
 	m.Pmtu.PathMtu = buf.DecodeUint16()
-	return nil
+	return buf.Err()
 }
 
 // IPPathMtuGet defines message 'ip_path_mtu_get'.
@@ -1420,7 +1420,7 @@ func (m *IPPathMtuGet) Marshal(b []byte) ([]byte, error) {
 func (m *IPPathMtuGet) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Cursor = buf.DecodeUint32()
-	return nil
+	return buf.Err()
 }
 
 // IPPathMtuGetReply defines message 'ip_path_mtu_get_reply'.
@@ -1457,7 +1457,7 @@ func (m *IPPathMtuGetReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
 	m.Cursor = buf.DecodeUint32()
-	return nil
+	return buf.Err()
 }
 
 // IPPathMtuReplaceBegin defines message 'ip_path_mtu_replace_begin'.
@@ -1517,7 +1517,7 @@ func (m *IPPathMtuReplaceBeginReply) Marshal(b []byte) ([]byte, error) {
 func (m *IPPathMtuReplaceBeginReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
-	return nil
+	return buf.Err()
 }
 
 // IPPathMtuReplaceEnd defines message 'ip_path_mtu_replace_end'.
@@ -1577,7 +1577,7 @@ func (m *IPPathMtuReplaceEndReply) Marshal(b []byte) ([]byte, error) {
 func (m *IPPathMtuReplaceEndReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
-	return nil
+	return buf.Err()
 }
 
 // IPPathMtuUpdate defines message 'ip_path_mtu_update'.
@@ -1625,7 +1625,7 @@ func (m *IPPathMtuUpdate) Unmarshal(b []byte) error {
 	m.Pmtu.Nh.Af = ip_types.AddressFamily(buf.DecodeUint8())
 	copy(m.Pmtu.Nh.Un.XXX_UnionData[:], buf.DecodeBytes(16))
 	m.Pmtu.PathMtu = buf.DecodeUint16()
-	return nil
+	return buf.Err()
 }
 
 // IPPathMtuUpdateReply defines message 'ip_path_mtu_update_reply'.
@@ -1658,7 +1658,7 @@ func (m *IPPathMtuUpdateReply) Marshal(b []byte) ([]byte, error) {
 func (m *IPPathMtuUpdateReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
-	return nil
+	return buf.Err()
 }
 
 // IPPuntPolice defines message 'ip_punt_police'.
@@ -1699,7 +1699,7 @@ func (m *IPPuntPolice) Unmarshal(b []byte) error {
 	m.PolicerIndex = buf.DecodeUint32()
 	m.IsAdd = buf.DecodeBool()
 	m.IsIP6 = buf.DecodeBool()
-	return nil
+	return buf.Err()
 }
 
 // IPPuntPoliceReply defines message 'ip_punt_police_reply'.
@@ -1732,7 +1732,7 @@ func (m *IPPuntPoliceReply) Marshal(b []byte) ([]byte, error) {
 func (m *IPPuntPoliceReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
-	return nil
+	return buf.Err()
 }
 
 // IPPuntRedirect defines message 'ip_punt_redirect'.
@@ -1779,7 +1779,7 @@ func (m *IPPuntRedirect) Unmarshal(b []byte) error {
 	m.Punt.Nh.Af = ip_types.AddressFamily(buf.DecodeUint8())
 	copy(m.Punt.Nh.Un.XXX_UnionData[:], buf.DecodeBytes(16))
 	m.IsAdd = buf.DecodeBool()
-	return nil
+	return buf.Err()
 }
 
 // IPPuntRedirectDetails defines message 'ip_punt_redirect_details'.
@@ -1821,7 +1821,7 @@ func (m *IPPuntRedirectDetails) Unmarshal(b []byte) error {
 	m.Punt.TxSwIfIndex = interface_types.InterfaceIndex(buf.DecodeUint32())
 	m.Punt.Nh.Af = ip_types.AddressFamily(buf.DecodeUint8())
 	copy(m.Punt.Nh.Un.XXX_UnionData[:], buf.DecodeBytes(16))
-	return nil
+	return buf.Err()
 }
 
 // IPPuntRedirectDump defines message 'ip_punt_redirect_dump'.
@@ -1858,7 +1858,7 @@ func (m *IPPuntRedirectDump) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.SwIfIndex = interface_types.InterfaceIndex(buf.DecodeUint32())
 	m.IsIPv6 = buf.DecodeBool()
-	return nil
+	return buf.Err()
 }
 
 // IPPuntRedirectReply defines message 'ip_punt_redirect_reply'.
@@ -1892,7 +1892,7 @@ func (m *IPPuntRedirectReply) Marshal(b []byte) ([]byte, error) {
 func (m *IPPuntRedirectReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
-	return nil
+	return buf.Err()
 }
 
 // IPPuntRedirectV2Details defines message 'ip_punt_redirect_v2_details'.
@@ -1982,7 +1982,7 @@ func (m *IPPuntRedirectV2Details) Unmarshal(b []byte) error {
 	m.Punt.RxSwIfIndex = interface_types.InterfaceIndex(buf.DecodeUint32())
 	m.Punt.Af = ip_types.AddressFamily(buf.DecodeUint8())
 	m.Punt.NPaths = buf.DecodeUint32()
-	m.Punt.Paths = make([]fib_types.FibPath, m.Punt.NPaths)
+	m.Punt.Paths = make([]fib_types.FibPath, buf.CheckArrayLen(int(m.Punt.NPaths), 1))
 	for j1 := 0; j1 < len(m.Punt.Paths); j1++ {
 		m.Punt.Paths[j1].SwIfIndex = buf.DecodeUint32()
 		m.Punt.Paths[j1].TableID = buf.DecodeUint32()
@@ -2004,7 +2004,7 @@ func (m *IPPuntRedirectV2Details) Unmarshal(b []byte) error {
 			m.Punt.Paths[j1].LabelStack[j2].Exp = buf.DecodeUint8()
 		}
 	}
-	return nil
+	return buf.Err()
 }
 
 // IPPuntRedirectV2Dump defines message 'ip_punt_redirect_v2_dump'.
@@ -2041,7 +2041,7 @@ func (m *IPPuntRedirectV2Dump) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.SwIfIndex = interface_types.InterfaceIndex(buf.DecodeUint32())
 	m.Af = ip_types.AddressFamily(buf.DecodeUint8())
-	return nil
+	return buf.Err()
 }
 
 // IPReassemblyEnableDisable defines message 'ip_reassembly_enable_disable'.
@@ -2086,7 +2086,7 @@ func (m *IPReassemblyEnableDisable) Unmarshal(b []byte) error {
 	m.EnableIP4 = buf.DecodeBool()
 	m.EnableIP6 = buf.DecodeBool()
 	m.Type = IPReassType(buf.DecodeUint32())
-	return nil
+	return buf.Err()
 }
 
 // IPReassemblyEnableDisableReply defines message 'ip_reassembly_enable_disable_reply'.
@@ -2121,7 +2121,7 @@ func (m *IPReassemblyEnableDisableReply) Marshal(b []byte) ([]byte, error) {
 func (m *IPReassemblyEnableDisableReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
-	return nil
+	return buf.Err()
 }
 
 // IPReassemblyGet defines message 'ip_reassembly_get'.
@@ -2158,7 +2158,7 @@ func (m *IPReassemblyGet) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.IsIP6 = buf.DecodeBool()
 	m.Type = IPReassType(buf.DecodeUint32())
-	return nil
+	return buf.Err()
 }
 
 // IPReassemblyGetReply defines message 'ip_reassembly_get_reply'.
@@ -2211,7 +2211,7 @@ func (m *IPReassemblyGetReply) Unmarshal(b []byte) error {
 	m.MaxReassemblyLength = buf.DecodeUint32()
 	m.ExpireWalkIntervalMs = buf.DecodeUint32()
 	m.IsIP6 = buf.DecodeBool()
-	return nil
+	return buf.Err()
 }
 
 // IPReassemblySet defines message 'ip_reassembly_set'.
@@ -2264,7 +2264,7 @@ func (m *IPReassemblySet) Unmarshal(b []byte) error {
 	m.ExpireWalkIntervalMs = buf.DecodeUint32()
 	m.IsIP6 = buf.DecodeBool()
 	m.Type = IPReassType(buf.DecodeUint32())
-	return nil
+	return buf.Err()
 }
 
 // IPReassemblySetReply defines message 'ip_reassembly_set_reply'.
@@ -2297,7 +2297,7 @@ func (m *IPReassemblySetReply) Marshal(b []byte) ([]byte, error) {
 func (m *IPReassemblySetReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
-	return nil
+	return buf.Err()
 }
 
 // IPRouteAddDel defines message 'ip_route_add_del'.
@@ -2404,7 +2404,7 @@ func (m *IPRouteAddDel) Unmarshal(b []byte) error {
 	copy(m.Route.Prefix.Address.Un.XXX_UnionData[:], buf.DecodeBytes(16))
 	m.Route.Prefix.Len = buf.DecodeUint8()
 	m.Route.NPaths = buf.DecodeUint8()
-	m.Route.Paths = make([]fib_types.FibPath, m.Route.NPaths)
+	m.Route.Paths = make([]fib_types.FibPath, buf.CheckArrayLen(int(m.Route.NPaths), 1))
 	for j1 := 0; j1 < len(m.Route.Paths); j1++ {
 		m.Route.Paths[j1].SwIfIndex = buf.DecodeUint32()
 		m.Route.Paths[j1].TableID = buf.DecodeUint32()
@@ -2426,7 +2426,7 @@ func (m *IPRouteAddDel) Unmarshal(b []byte) error {
 			m.Route.Paths[j1].LabelStack[j2].Exp = buf.DecodeUint8()
 		}
 	}
-	return nil
+	return buf.Err()
 }
 
 // IPRouteAddDelReply defines message 'ip_route_add_del_reply'.
@@ -2463,7 +2463,7 @@ func (m *IPRouteAddDelReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
 	m.StatsIndex = buf.DecodeUint32()
-	return nil
+	return buf.Err()
 }
 
 // IPRouteAddDelV2 defines message 'ip_route_add_del_v2'.
@@ -2574,7 +2574,7 @@ func (m *IPRouteAddDelV2) Unmarshal(b []byte) error {
 	m.Route.Prefix.Len = buf.DecodeUint8()
 	m.Route.NPaths = buf.DecodeUint8()
 	m.Route.Src = buf.DecodeUint8()
-	m.Route.Paths = make([]fib_types.FibPath, m.Route.NPaths)
+	m.Route.Paths = make([]fib_types.FibPath, buf.CheckArrayLen(int(m.Route.NPaths), 1))
 	for j1 := 0; j1 < len(m.Route.Paths); j1++ {
 		m.Route.Paths[j1].SwIfIndex = buf.DecodeUint32()
 		m.Route.Paths[j1].TableID = buf.DecodeUint32()
@@ -2596,7 +2596,7 @@ func (m *IPRouteAddDelV2) Unmarshal(b []byte) error {
 			m.Route.Paths[j1].LabelStack[j2].Exp = buf.DecodeUint8()
 		}
 	}
-	return nil
+	return buf.Err()
 }
 
 // IPRouteAddDelV2Reply defines message 'ip_route_add_del_v2_reply'.
@@ -2634,7 +2634,7 @@ func (m *IPRouteAddDelV2Reply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
 	m.StatsIndex = buf.DecodeUint32()
-	return nil
+	return buf.Err()
 }
 
 // IPRouteDetails defines message 'ip_route_details'.
@@ -2733,7 +2733,7 @@ func (m *IPRouteDetails) Unmarshal(b []byte) error {
 	copy(m.Route.Prefix.Address.Un.XXX_UnionData[:], buf.DecodeBytes(16))
 	m.Route.Prefix.Len = buf.DecodeUint8()
 	m.Route.NPaths = buf.DecodeUint8()
-	m.Route.Paths = make([]fib_types.FibPath, m.Route.NPaths)
+	m.Route.Paths = make([]fib_types.FibPath, buf.CheckArrayLen(int(m.Route.NPaths), 1))
 	for j1 := 0; j1 < len(m.Route.Paths); j1++ {
 		m.Route.Paths[j1].SwIfIndex = buf.DecodeUint32()
 		m.Route.Paths[j1].TableID = buf.DecodeUint32()
@@ -2755,7 +2755,7 @@ func (m *IPRouteDetails) Unmarshal(b []byte) error {
 			m.Route.Paths[j1].LabelStack[j2].Exp = buf.DecodeUint8()
 		}
 	}
-	return nil
+	return buf.Err()
 }
 
 // IPRouteDump defines message 'ip_route_dump'.
@@ -2794,7 +2794,7 @@ func (m *IPRouteDump) Unmarshal(b []byte) error {
 	m.Table.TableID = buf.DecodeUint32()
 	m.Table.IsIP6 = buf.DecodeBool()
 	m.Table.Name = buf.DecodeString(64)
-	return nil
+	return buf.Err()
 }
 
 // IPRouteLookup defines message 'ip_route_lookup'.
@@ -2841,7 +2841,7 @@ func (m *IPRouteLookup) Unmarshal(b []byte) error {
 	m.Prefix.Address.Af = ip_types.AddressFamily(buf.DecodeUint8())
 	copy(m.Prefix.Address.Un.XXX_UnionData[:], buf.DecodeBytes(16))
 	m.Prefix.Len = buf.DecodeUint8()
-	return nil
+	return buf.Err()
 }
 
 // IPRouteLookupReply defines message 'ip_route_lookup_reply'.
@@ -2944,7 +2944,7 @@ func (m *IPRouteLookupReply) Unmarshal(b []byte) error {
 	copy(m.Route.Prefix.Address.Un.XXX_UnionData[:], buf.DecodeBytes(16))
 	m.Route.Prefix.Len = buf.DecodeUint8()
 	m.Route.NPaths = buf.DecodeUint8()
-	m.Route.Paths = make([]fib_types.FibPath, m.Route.NPaths)
+	m.Route.Paths = make([]fib_types.FibPath, buf.CheckArrayLen(int(m.Route.NPaths), 1))
 	for j1 := 0; j1 < len(m.Route.Paths); j1++ {
 		m.Route.Paths[j1].SwIfIndex = buf.DecodeUint32()
 		m.Route.Paths[j1].TableID = buf.DecodeUint32()
@@ -2966,7 +2966,7 @@ func (m *IPRouteLookupReply) Unmarshal(b []byte) error {
 			m.Route.Paths[j1].LabelStack[j2].Exp = buf.DecodeUint8()
 		}
 	}
-	return nil
+	return buf.Err()
 }
 
 // IPRouteLookupV2 defines message 'ip_route_lookup_v2'.
@@ -3014,7 +3014,7 @@ func (m *IPRouteLookupV2) Unmarshal(b []byte) error {
 	m.Prefix.Address.Af = ip_types.AddressFamily(buf.DecodeUint8())
 	copy(m.Prefix.Address.Un.XXX_UnionData[:], buf.DecodeBytes(16))
 	m.Prefix.Len = buf.DecodeUint8()
-	return nil
+	return buf.Err()
 }
 
 // IPRouteLookupV2Reply defines message 'ip_route_lookup_v2_reply'.
@@ -3121,7 +3121,7 @@ func (m *IPRouteLookupV2Reply) Unmarshal(b []byte) error {
 	m.Route.Prefix.Len = buf.DecodeUint8()
 	m.Route.NPaths = buf.DecodeUint8()
 	m.Route.Src = buf.DecodeUint8()
-	m.Route.Paths = make([]fib_types.FibPath, m.Route.NPaths)
+	m.Route.Paths = make([]fib_types.FibPath, buf.CheckArrayLen(int(m.Route.NPaths), 1))
 	for j1 := 0; j1 < len(m.Route.Paths); j1++ {
 		m.Route.Paths[j1].SwIfIndex = buf.DecodeUint32()
 		m.Route.Paths[j1].TableID = buf.DecodeUint32()
@@ -3143,7 +3143,7 @@ func (m *IPRouteLookupV2Reply) Unmarshal(b []byte) error {
 			m.Route.Paths[j1].LabelStack[j2].Exp = buf.DecodeUint8()
 		}
 	}
-	return nil
+	return buf.Err()
 }
 
 // IPRouteV2Details defines message 'ip_route_v2_details'.
@@ -3246,7 +3246,7 @@ func (m *IPRouteV2Details) Unmarshal(b []byte) error {
 	m.Route.Prefix.Len = buf.DecodeUint8()
 	m.Route.NPaths = buf.DecodeUint8()
 	m.Route.Src = buf.DecodeUint8()
-	m.Route.Paths = make([]fib_types.FibPath, m.Route.NPaths)
+	m.Route.Paths = make([]fib_types.FibPath, buf.CheckArrayLen(int(m.Route.NPaths), 1))
 	for j1 := 0; j1 < len(m.Route.Paths); j1++ {
 		m.Route.Paths[j1].SwIfIndex = buf.DecodeUint32()
 		m.Route.Paths[j1].TableID = buf.DecodeUint32()
@@ -3268,7 +3268,7 @@ func (m *IPRouteV2Details) Unmarshal(b []byte) error {
 			m.Route.Paths[j1].LabelStack[j2].Exp = buf.DecodeUint8()
 		}
 	}
-	return nil
+	return buf.Err()
 }
 
 // IPRouteV2Dump defines message 'ip_route_v2_dump'.
@@ -3312,7 +3312,7 @@ func (m *IPRouteV2Dump) Unmarshal(b []byte) error {
 	m.Table.TableID = buf.DecodeUint32()
 	m.Table.IsIP6 = buf.DecodeBool()
 	m.Table.Name = buf.DecodeString(64)
-	return nil
+	return buf.Err()
 }
 
 // IPSourceAndPortRangeCheckAddDel defines message 'ip_source_and_port_range_check_add_del'.
@@ -3391,7 +3391,7 @@ func (m *IPSourceAndPortRangeCheckAddDel) Unmarshal(b []byte) error {
 		m.HighPorts[i] = buf.DecodeUint16()
 	}
 	m.VrfID = buf.DecodeUint32()
-	return nil
+	return buf.Err()
 }
 
 // IPSourceAndPortRangeCheckAddDelReply defines message 'ip_source_and_port_range_check_add_del_reply'.
@@ -3426,7 +3426,7 @@ func (m *IPSourceAndPortRangeCheckAddDelReply) Marshal(b []byte) ([]byte, error)
 func (m *IPSourceAndPortRangeCheckAddDelReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
-	return nil
+	return buf.Err()
 }
 
 // IPSourceAndPortRangeCheckInterfaceAddDel defines message 'ip_source_and_port_range_check_interface_add_del'.
@@ -3483,7 +3483,7 @@ func (m *IPSourceAndPortRangeCheckInterfaceAddDel) Unmarshal(b []byte) error {
 	m.TCPOutVrfID = buf.DecodeUint32()
 	m.UDPInVrfID = buf.DecodeUint32()
 	m.UDPOutVrfID = buf.DecodeUint32()
-	return nil
+	return buf.Err()
 }
 
 // IPSourceAndPortRangeCheckInterfaceAddDelReply defines message 'ip_source_and_port_range_check_interface_add_del_reply'.
@@ -3520,7 +3520,7 @@ func (m *IPSourceAndPortRangeCheckInterfaceAddDelReply) Marshal(b []byte) ([]byt
 func (m *IPSourceAndPortRangeCheckInterfaceAddDelReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
-	return nil
+	return buf.Err()
 }
 
 // IPTableAddDel defines message 'ip_table_add_del'.
@@ -3563,7 +3563,7 @@ func (m *IPTableAddDel) Unmarshal(b []byte) error {
 	m.Table.TableID = buf.DecodeUint32()
 	m.Table.IsIP6 = buf.DecodeBool()
 	m.Table.Name = buf.DecodeString(64)
-	return nil
+	return buf.Err()
 }
 
 // IPTableAddDelReply defines message 'ip_table_add_del_reply'.
@@ -3596,7 +3596,7 @@ func (m *IPTableAddDelReply) Marshal(b []byte) ([]byte, error) {
 func (m *IPTableAddDelReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
-	return nil
+	return buf.Err()
 }
 
 // IPTableAllocate defines message 'ip_table_allocate'.
@@ -3635,7 +3635,7 @@ func (m *IPTableAllocate) Unmarshal(b []byte) error {
 	m.Table.TableID = buf.DecodeUint32()
 	m.Table.IsIP6 = buf.DecodeBool()
 	m.Table.Name = buf.DecodeString(64)
-	return nil
+	return buf.Err()
 }
 
 // IPTableAllocateReply defines message 'ip_table_allocate_reply'.
@@ -3678,7 +3678,7 @@ func (m *IPTableAllocateReply) Unmarshal(b []byte) error {
 	m.Table.TableID = buf.DecodeUint32()
 	m.Table.IsIP6 = buf.DecodeBool()
 	m.Table.Name = buf.DecodeString(64)
-	return nil
+	return buf.Err()
 }
 
 // IPTableDetails defines message 'ip_table_details'.
@@ -3717,7 +3717,7 @@ func (m *IPTableDetails) Unmarshal(b []byte) error {
 	m.Table.TableID = buf.DecodeUint32()
 	m.Table.IsIP6 = buf.DecodeBool()
 	m.Table.Name = buf.DecodeString(64)
-	return nil
+	return buf.Err()
 }
 
 // IPTableDump defines message 'ip_table_dump'.
@@ -3783,7 +3783,7 @@ func (m *IPTableFlush) Unmarshal(b []byte) error {
 	m.Table.TableID = buf.DecodeUint32()
 	m.Table.IsIP6 = buf.DecodeBool()
 	m.Table.Name = buf.DecodeString(64)
-	return nil
+	return buf.Err()
 }
 
 // IPTableFlushReply defines message 'ip_table_flush_reply'.
@@ -3816,7 +3816,7 @@ func (m *IPTableFlushReply) Marshal(b []byte) ([]byte, error) {
 func (m *IPTableFlushReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
-	return nil
+	return buf.Err()
 }
 
 // IPTableReplaceBegin defines message 'ip_table_replace_begin'.
@@ -3855,7 +3855,7 @@ func (m *IPTableReplaceBegin) Unmarshal(b []byte) error {
 	m.Table.TableID = buf.DecodeUint32()
 	m.Table.IsIP6 = buf.DecodeBool()
 	m.Table.Name = buf.DecodeString(64)
-	return nil
+	return buf.Err()
 }
 
 // IPTableReplaceBeginReply defines message 'ip_table_replace_begin_reply'.
@@ -3888,7 +3888,7 @@ func (m *IPTableReplaceBeginReply) Marshal(b []byte) ([]byte, error) {
 func (m *IPTableReplaceBeginReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
-	return nil
+	return buf.Err()
 }
 
 // IPTableReplaceEnd defines message 'ip_table_replace_end'.
@@ -3927,7 +3927,7 @@ func (m *IPTableReplaceEnd) Unmarshal(b []byte) error {
 	m.Table.TableID = buf.DecodeUint32()
 	m.Table.IsIP6 = buf.DecodeBool()
 	m.Table.Name = buf.DecodeString(64)
-	return nil
+	return buf.Err()
 }
 
 // IPTableReplaceEndReply defines message 'ip_table_replace_end_reply'.
@@ -3960,7 +3960,7 @@ func (m *IPTableReplaceEndReply) Marshal(b []byte) ([]byte, error) {
 func (m *IPTableReplaceEndReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
-	return nil
+	return buf.Err()
 }
 
 // IPUnnumberedDetails defines message 'ip_unnumbered_details'.
@@ -3997,7 +3997,7 @@ func (m *IPUnnumberedDetails) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.SwIfIndex = interface_types.InterfaceIndex(buf.DecodeUint32())
 	m.IPSwIfIndex = interface_types.InterfaceIndex(buf.DecodeUint32())
-	return nil
+	return buf.Err()
 }
 
 // IPUnnumberedDump defines message 'ip_unnumbered_dump'.
@@ -4030,7 +4030,7 @@ func (m *IPUnnumberedDump) Marshal(b []byte) ([]byte, error) {
 func (m *IPUnnumberedDump) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.SwIfIndex = interface_types.InterfaceIndex(buf.DecodeUint32())
-	return nil
+	return buf.Err()
 }
 
 // MfibSignalDetails defines message 'mfib_signal_details'.
@@ -4089,7 +4089,7 @@ func (m *MfibSignalDetails) Unmarshal(b []byte) error {
 	m.IPPacketLen = buf.DecodeUint16()
 	m.IPPacketData = make([]byte, 256)
 	copy(m.IPPacketData, buf.DecodeBytes(len(m.IPPacketData)))
-	return nil
+	return buf.Err()
 }
 
 // MfibSignalDump defines message 'mfib_signal_dump'.
@@ -4182,7 +4182,7 @@ func (m *SetIPFlowHash) Unmarshal(b []byte) error {
 	m.Proto = buf.DecodeBool()
 	m.Reverse = buf.DecodeBool()
 	m.Symmetric = buf.DecodeBool()
-	return nil
+	return buf.Err()
 }
 
 // SetIPFlowHashReply defines message 'set_ip_flow_hash_reply'.
@@ -4216,7 +4216,7 @@ func (m *SetIPFlowHashReply) Marshal(b []byte) ([]byte, error) {
 func (m *SetIPFlowHashReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
-	return nil
+	return buf.Err()
 }
 
 // SetIPFlowHashRouterID defines message 'set_ip_flow_hash_router_id'.
@@ -4249,7 +4249,7 @@ func (m *SetIPFlowHashRouterID) Marshal(b []byte) ([]byte, error) {
 func (m *SetIPFlowHashRouterID) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.RouterID = buf.DecodeUint32()
-	return nil
+	return buf.Err()
 }
 
 // SetIPFlowHashRouterIDReply defines message 'set_ip_flow_hash_router_id_reply'.
@@ -4282,7 +4282,7 @@ func (m *SetIPFlowHashRouterIDReply) Marshal(b []byte) ([]byte, error) {
 func (m *SetIPFlowHashRouterIDReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
-	return nil
+	return buf.Err()
 }
 
 // SetIPFlowHashV2 defines message 'set_ip_flow_hash_v2'.
@@ -4323,7 +4323,7 @@ func (m *SetIPFlowHashV2) Unmarshal(b []byte) error {
 	m.TableID = buf.DecodeUint32()
 	m.Af = ip_types.AddressFamily(buf.DecodeUint8())
 	m.FlowHashConfig = IPFlowHashConfig(buf.DecodeUint32())
-	return nil
+	return buf.Err()
 }
 
 // SetIPFlowHashV2Reply defines message 'set_ip_flow_hash_v2_reply'.
@@ -4356,7 +4356,7 @@ func (m *SetIPFlowHashV2Reply) Marshal(b []byte) ([]byte, error) {
 func (m *SetIPFlowHashV2Reply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
-	return nil
+	return buf.Err()
 }
 
 // SwInterfaceIP6EnableDisable defines message 'sw_interface_ip6_enable_disable'.
@@ -4393,7 +4393,7 @@ func (m *SwInterfaceIP6EnableDisable) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.SwIfIndex = interface_types.InterfaceIndex(buf.DecodeUint32())
 	m.Enable = buf.DecodeBool()
-	return nil
+	return buf.Err()
 }
 
 // SwInterfaceIP6EnableDisableReply defines message 'sw_interface_ip6_enable_disable_reply'.
@@ -4428,7 +4428,7 @@ func (m *SwInterfaceIP6EnableDisableReply) Marshal(b []byte) ([]byte, error) {
 func (m *SwInterfaceIP6EnableDisableReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
-	return nil
+	return buf.Err()
 }
 
 // SwInterfaceIP6GetLinkLocalAddress defines message 'sw_interface_ip6_get_link_local_address'.
@@ -4463,7 +4463,7 @@ func (m *SwInterfaceIP6GetLinkLocalAddress) Marshal(b []byte) ([]byte, error) {
 func (m *SwInterfaceIP6GetLinkLocalAddress) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.SwIfIndex = interface_types.InterfaceIndex(buf.DecodeUint32())
-	return nil
+	return buf.Err()
 }
 
 // SwInterfaceIP6GetLinkLocalAddressReply defines message 'sw_interface_ip6_get_link_local_address_reply'.
@@ -4504,7 +4504,7 @@ func (m *SwInterfaceIP6GetLinkLocalAddressReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
 	copy(m.IP[:], buf.DecodeBytes(16))
-	return nil
+	return buf.Err()
 }
 
 // SwInterfaceIP6SetLinkLocalAddress defines message 'sw_interface_ip6_set_link_local_address'.
@@ -4543,7 +4543,7 @@ func (m *SwInterfaceIP6SetLinkLocalAddress) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.SwIfIndex = interface_types.InterfaceIndex(buf.DecodeUint32())
 	copy(m.IP[:], buf.DecodeBytes(16))
-	return nil
+	return buf.Err()
 }
 
 // SwInterfaceIP6SetLinkLocalAddressReply defines message 'sw_interface_ip6_set_link_local_address_reply'.
@@ -4580,7 +4580,7 @@ func (m *SwInterfaceIP6SetLinkLocalAddressReply) Marshal(b []byte) ([]byte, erro
 func (m *SwInterfaceIP6SetLinkLocalAddressReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
-	return nil
+	return buf.Err()
 }
 
 func init() { file_ip_binapi_init() }
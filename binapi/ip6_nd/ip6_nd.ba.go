@@ -126,7 +126,7 @@ func (m *IP6RaEvent) Unmarshal(b []byte) error {
 	m.NeighborReachableTimeInMsec = buf.DecodeUint32()
 	m.TimeInMsecBetweenRetransmittedNeighborSolicitations = buf.DecodeUint32()
 	m.NPrefixes = buf.DecodeUint32()
-	m.Prefixes = make([]IP6RaPrefixInfo, m.NPrefixes)
+	m.Prefixes = make([]IP6RaPrefixInfo, buf.CheckArrayLen(int(m.NPrefixes), 1))
 	for j0 := 0; j0 < len(m.Prefixes); j0++ {
 		m.Prefixes[j0].Prefix.Address.Af = ip_types.AddressFamily(buf.DecodeUint8())
 		copy(m.Prefixes[j0].Prefix.Address.Un.XXX_UnionData[:], buf.DecodeBytes(16))
@@ -135,7 +135,7 @@ func (m *IP6RaEvent) Unmarshal(b []byte) error {
 		m.Prefixes[j0].ValidTime = buf.DecodeUint32()
 		m.Prefixes[j0].PreferredTime = buf.DecodeUint32()
 	}
-	return nil
+	return buf.Err()
 }
 
 // IP6ndProxyAddDel defines message 'ip6nd_proxy_add_del'.
@@ -176,7 +176,7 @@ func (m *IP6ndProxyAddDel) Unmarshal(b []byte) error {
 	m.SwIfIndex = interface_types.InterfaceIndex(buf.DecodeUint32())
 	m.IsAdd = buf.DecodeBool()
 	copy(m.IP[:], buf.DecodeBytes(16))
-	return nil
+	return buf.Err()
 }
 
 // IP6ndProxyAddDelReply defines message 'ip6nd_proxy_add_del_reply'.
@@ -209,7 +209,7 @@ func (m *IP6ndProxyAddDelReply) Marshal(b []byte) ([]byte, error) {
 func (m *IP6ndProxyAddDelReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
-	return nil
+	return buf.Err()
 }
 
 // IP6ndProxyDetails defines message 'ip6nd_proxy_details'.
@@ -246,7 +246,7 @@ func (m *IP6ndProxyDetails) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.SwIfIndex = interface_types.InterfaceIndex(buf.DecodeUint32())
 	copy(m.IP[:], buf.DecodeBytes(16))
-	return nil
+	return buf.Err()
 }
 
 // IP6ndProxyDump defines message 'ip6nd_proxy_dump'.
@@ -310,7 +310,7 @@ func (m *IP6ndProxyEnableDisable) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.SwIfIndex = interface_types.InterfaceIndex(buf.DecodeUint32())
 	m.IsEnable = buf.DecodeBool()
-	return nil
+	return buf.Err()
 }
 
 // IP6ndProxyEnableDisableReply defines message 'ip6nd_proxy_enable_disable_reply'.
@@ -345,7 +345,7 @@ func (m *IP6ndProxyEnableDisableReply) Marshal(b []byte) ([]byte, error) {
 func (m *IP6ndProxyEnableDisableReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
-	return nil
+	return buf.Err()
 }
 
 // IP6ndSendRouterSolicitation defines message 'ip6nd_send_router_solicitation'.
@@ -398,7 +398,7 @@ func (m *IP6ndSendRouterSolicitation) Unmarshal(b []byte) error {
 	m.Mrd = buf.DecodeUint32()
 	m.SwIfIndex = interface_types.InterfaceIndex(buf.DecodeUint32())
 	m.Stop = buf.DecodeBool()
-	return nil
+	return buf.Err()
 }
 
 // IP6ndSendRouterSolicitationReply defines message 'ip6nd_send_router_solicitation_reply'.
@@ -433,7 +433,7 @@ func (m *IP6ndSendRouterSolicitationReply) Marshal(b []byte) ([]byte, error) {
 func (m *IP6ndSendRouterSolicitationReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
-	return nil
+	return buf.Err()
 }
 
 // SwInterfaceIP6ndRaConfig defines message 'sw_interface_ip6nd_ra_config'.
@@ -518,7 +518,7 @@ func (m *SwInterfaceIP6ndRaConfig) Unmarshal(b []byte) error {
 	m.Lifetime = buf.DecodeUint32()
 	m.InitialCount = buf.DecodeUint32()
 	m.InitialInterval = buf.DecodeUint32()
-	return nil
+	return buf.Err()
 }
 
 // SwInterfaceIP6ndRaConfigReply defines message 'sw_interface_ip6nd_ra_config_reply'.
@@ -553,7 +553,7 @@ func (m *SwInterfaceIP6ndRaConfigReply) Marshal(b []byte) ([]byte, error) {
 func (m *SwInterfaceIP6ndRaConfigReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
-	return nil
+	return buf.Err()
 }
 
 // SwInterfaceIP6ndRaPrefix defines message 'sw_interface_ip6nd_ra_prefix'.
@@ -628,7 +628,7 @@ func (m *SwInterfaceIP6ndRaPrefix) Unmarshal(b []byte) error {
 	m.IsNo = buf.DecodeBool()
 	m.ValLifetime = buf.DecodeUint32()
 	m.PrefLifetime = buf.DecodeUint32()
-	return nil
+	return buf.Err()
 }
 
 // SwInterfaceIP6ndRaPrefixReply defines message 'sw_interface_ip6nd_ra_prefix_reply'.
@@ -663,7 +663,7 @@ func (m *SwInterfaceIP6ndRaPrefixReply) Marshal(b []byte) ([]byte, error) {
 func (m *SwInterfaceIP6ndRaPrefixReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
-	return nil
+	return buf.Err()
 }
 
 // WantIP6RaEvents defines message 'want_ip6_ra_events'.
@@ -700,7 +700,7 @@ func (m *WantIP6RaEvents) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Enable = buf.DecodeBool()
 	m.PID = buf.DecodeUint32()
-	return nil
+	return buf.Err()
 }
 
 // WantIP6RaEventsReply defines message 'want_ip6_ra_events_reply'.
@@ -733,7 +733,7 @@ func (m *WantIP6RaEventsReply) Marshal(b []byte) ([]byte, error) {
 func (m *WantIP6RaEventsReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
-	return nil
+	return buf.Err()
 }
 
 func init() { file_ip6_nd_binapi_init() }
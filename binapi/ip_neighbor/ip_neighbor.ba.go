@@ -183,7 +183,7 @@ func (m *IPNeighborAddDel) Unmarshal(b []byte) error {
 	copy(m.Neighbor.MacAddress[:], buf.DecodeBytes(6))
 	m.Neighbor.IPAddress.Af = ip_types.AddressFamily(buf.DecodeUint8())
 	copy(m.Neighbor.IPAddress.Un.XXX_UnionData[:], buf.DecodeBytes(16))
-	return nil
+	return buf.Err()
 }
 
 // IPNeighborAddDelReply defines message 'ip_neighbor_add_del_reply'.
@@ -220,7 +220,7 @@ func (m *IPNeighborAddDelReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
 	m.StatsIndex = buf.DecodeUint32()
-	return nil
+	return buf.Err()
 }
 
 // IPNeighborConfig defines message 'ip_neighbor_config'.
@@ -265,7 +265,7 @@ func (m *IPNeighborConfig) Unmarshal(b []byte) error {
 	m.MaxNumber = buf.DecodeUint32()
 	m.MaxAge = buf.DecodeUint32()
 	m.Recycle = buf.DecodeBool()
-	return nil
+	return buf.Err()
 }
 
 // IPNeighborConfigReply defines message 'ip_neighbor_config_reply'.
@@ -298,7 +298,7 @@ func (m *IPNeighborConfigReply) Marshal(b []byte) ([]byte, error) {
 func (m *IPNeighborConfigReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
-	return nil
+	return buf.Err()
 }
 
 // IPNeighborDetails defines message 'ip_neighbor_details'.
@@ -347,7 +347,7 @@ func (m *IPNeighborDetails) Unmarshal(b []byte) error {
 	copy(m.Neighbor.MacAddress[:], buf.DecodeBytes(6))
 	m.Neighbor.IPAddress.Af = ip_types.AddressFamily(buf.DecodeUint8())
 	copy(m.Neighbor.IPAddress.Un.XXX_UnionData[:], buf.DecodeBytes(16))
-	return nil
+	return buf.Err()
 }
 
 // IPNeighborDump defines message 'ip_neighbor_dump'.
@@ -384,7 +384,7 @@ func (m *IPNeighborDump) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.SwIfIndex = interface_types.InterfaceIndex(buf.DecodeUint32())
 	m.Af = ip_types.AddressFamily(buf.DecodeUint8())
-	return nil
+	return buf.Err()
 }
 
 // IPNeighborEvent defines message 'ip_neighbor_event'.
@@ -434,7 +434,7 @@ func (m *IPNeighborEvent) Unmarshal(b []byte) error {
 	copy(m.Neighbor.MacAddress[:], buf.DecodeBytes(6))
 	m.Neighbor.IPAddress.Af = ip_types.AddressFamily(buf.DecodeUint8())
 	copy(m.Neighbor.IPAddress.Un.XXX_UnionData[:], buf.DecodeBytes(16))
-	return nil
+	return buf.Err()
 }
 
 // IPNeighborEventV2 defines message 'ip_neighbor_event_v2'.
@@ -487,7 +487,7 @@ func (m *IPNeighborEventV2) Unmarshal(b []byte) error {
 	copy(m.Neighbor.MacAddress[:], buf.DecodeBytes(6))
 	m.Neighbor.IPAddress.Af = ip_types.AddressFamily(buf.DecodeUint8())
 	copy(m.Neighbor.IPAddress.Un.XXX_UnionData[:], buf.DecodeBytes(16))
-	return nil
+	return buf.Err()
 }
 
 // IPNeighborFlush defines message 'ip_neighbor_flush'.
@@ -524,7 +524,7 @@ func (m *IPNeighborFlush) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Af = ip_types.AddressFamily(buf.DecodeUint8())
 	m.SwIfIndex = interface_types.InterfaceIndex(buf.DecodeUint32())
-	return nil
+	return buf.Err()
 }
 
 // IPNeighborFlushReply defines message 'ip_neighbor_flush_reply'.
@@ -557,7 +557,7 @@ func (m *IPNeighborFlushReply) Marshal(b []byte) ([]byte, error) {
 func (m *IPNeighborFlushReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
-	return nil
+	return buf.Err()
 }
 
 // IPNeighborReplaceBegin defines message 'ip_neighbor_replace_begin'.
@@ -617,7 +617,7 @@ func (m *IPNeighborReplaceBeginReply) Marshal(b []byte) ([]byte, error) {
 func (m *IPNeighborReplaceBeginReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
-	return nil
+	return buf.Err()
 }
 
 // IPNeighborReplaceEnd defines message 'ip_neighbor_replace_end'.
@@ -677,7 +677,7 @@ func (m *IPNeighborReplaceEndReply) Marshal(b []byte) ([]byte, error) {
 func (m *IPNeighborReplaceEndReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
-	return nil
+	return buf.Err()
 }
 
 // WantIPNeighborEvents defines message 'want_ip_neighbor_events'.
@@ -726,7 +726,7 @@ func (m *WantIPNeighborEvents) Unmarshal(b []byte) error {
 	m.IP.Af = ip_types.AddressFamily(buf.DecodeUint8())
 	copy(m.IP.Un.XXX_UnionData[:], buf.DecodeBytes(16))
 	m.SwIfIndex = interface_types.InterfaceIndex(buf.DecodeUint32())
-	return nil
+	return buf.Err()
 }
 
 // WantIPNeighborEventsReply defines message 'want_ip_neighbor_events_reply'.
@@ -760,7 +760,7 @@ func (m *WantIPNeighborEventsReply) Marshal(b []byte) ([]byte, error) {
 func (m *WantIPNeighborEventsReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
-	return nil
+	return buf.Err()
 }
 
 // WantIPNeighborEventsV2 defines message 'want_ip_neighbor_events_v2'.
@@ -808,7 +808,7 @@ func (m *WantIPNeighborEventsV2) Unmarshal(b []byte) error {
 	m.IP.Af = ip_types.AddressFamily(buf.DecodeUint8())
 	copy(m.IP.Un.XXX_UnionData[:], buf.DecodeBytes(16))
 	m.SwIfIndex = interface_types.InterfaceIndex(buf.DecodeUint32())
-	return nil
+	return buf.Err()
 }
 
 // WantIPNeighborEventsV2Reply defines message 'want_ip_neighbor_events_v2_reply'.
@@ -843,7 +843,7 @@ func (m *WantIPNeighborEventsV2Reply) Marshal(b []byte) ([]byte, error) {
 func (m *WantIPNeighborEventsV2Reply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
-	return nil
+	return buf.Err()
 }
 
 func init() { file_ip_neighbor_binapi_init() }
@@ -88,7 +88,7 @@ func (m *IpfixAllExporterDetails) Unmarshal(b []byte) error {
 	m.PathMtu = buf.DecodeUint32()
 	m.TemplateInterval = buf.DecodeUint32()
 	m.UDPChecksum = buf.DecodeBool()
-	return nil
+	return buf.Err()
 }
 
 // IpfixAllExporterGet defines message 'ipfix_all_exporter_get'.
@@ -121,7 +121,7 @@ func (m *IpfixAllExporterGet) Marshal(b []byte) ([]byte, error) {
 func (m *IpfixAllExporterGet) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Cursor = buf.DecodeUint32()
-	return nil
+	return buf.Err()
 }
 
 // IpfixAllExporterGetReply defines message 'ipfix_all_exporter_get_reply'.
@@ -158,7 +158,7 @@ func (m *IpfixAllExporterGetReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
 	m.Cursor = buf.DecodeUint32()
-	return nil
+	return buf.Err()
 }
 
 // IpfixClassifyStreamDetails defines message 'ipfix_classify_stream_details'.
@@ -195,7 +195,7 @@ func (m *IpfixClassifyStreamDetails) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.DomainID = buf.DecodeUint32()
 	m.SrcPort = buf.DecodeUint16()
-	return nil
+	return buf.Err()
 }
 
 // IpfixClassifyStreamDump defines message 'ipfix_classify_stream_dump'.
@@ -267,7 +267,7 @@ func (m *IpfixClassifyTableAddDel) Unmarshal(b []byte) error {
 	m.IPVersion = ip_types.AddressFamily(buf.DecodeUint8())
 	m.TransportProtocol = ip_types.IPProto(buf.DecodeUint8())
 	m.IsAdd = buf.DecodeBool()
-	return nil
+	return buf.Err()
 }
 
 // IpfixClassifyTableAddDelReply defines message 'ipfix_classify_table_add_del_reply'.
@@ -302,7 +302,7 @@ func (m *IpfixClassifyTableAddDelReply) Marshal(b []byte) ([]byte, error) {
 func (m *IpfixClassifyTableAddDelReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
-	return nil
+	return buf.Err()
 }
 
 // IpfixClassifyTableDetails defines message 'ipfix_classify_table_details'.
@@ -343,7 +343,7 @@ func (m *IpfixClassifyTableDetails) Unmarshal(b []byte) error {
 	m.TableID = buf.DecodeUint32()
 	m.IPVersion = ip_types.AddressFamily(buf.DecodeUint8())
 	m.TransportProtocol = ip_types.IPProto(buf.DecodeUint8())
-	return nil
+	return buf.Err()
 }
 
 // IpfixClassifyTableDump defines message 'ipfix_classify_table_dump'.
@@ -437,7 +437,7 @@ func (m *IpfixExporterCreateDelete) Unmarshal(b []byte) error {
 	m.PathMtu = buf.DecodeUint32()
 	m.TemplateInterval = buf.DecodeUint32()
 	m.UDPChecksum = buf.DecodeBool()
-	return nil
+	return buf.Err()
 }
 
 // IpfixExporterCreateDeleteReply defines message 'ipfix_exporter_create_delete_reply'.
@@ -476,7 +476,7 @@ func (m *IpfixExporterCreateDeleteReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
 	m.StatIndex = buf.DecodeUint32()
-	return nil
+	return buf.Err()
 }
 
 // IpfixExporterDetails defines message 'ipfix_exporter_details'.
@@ -539,7 +539,7 @@ func (m *IpfixExporterDetails) Unmarshal(b []byte) error {
 	m.PathMtu = buf.DecodeUint32()
 	m.TemplateInterval = buf.DecodeUint32()
 	m.UDPChecksum = buf.DecodeBool()
-	return nil
+	return buf.Err()
 }
 
 // IpfixExporterDump defines message 'ipfix_exporter_dump'.
@@ -626,7 +626,7 @@ func (m *IpfixFlushReply) Marshal(b []byte) ([]byte, error) {
 func (m *IpfixFlushReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
-	return nil
+	return buf.Err()
 }
 
 // SetIpfixClassifyStream defines message 'set_ipfix_classify_stream'.
@@ -663,7 +663,7 @@ func (m *SetIpfixClassifyStream) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.DomainID = buf.DecodeUint32()
 	m.SrcPort = buf.DecodeUint16()
-	return nil
+	return buf.Err()
 }
 
 // SetIpfixClassifyStreamReply defines message 'set_ipfix_classify_stream_reply'.
@@ -696,7 +696,7 @@ func (m *SetIpfixClassifyStreamReply) Marshal(b []byte) ([]byte, error) {
 func (m *SetIpfixClassifyStreamReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
-	return nil
+	return buf.Err()
 }
 
 // SetIpfixExporter defines message 'set_ipfix_exporter'.
@@ -759,7 +759,7 @@ func (m *SetIpfixExporter) Unmarshal(b []byte) error {
 	m.PathMtu = buf.DecodeUint32()
 	m.TemplateInterval = buf.DecodeUint32()
 	m.UDPChecksum = buf.DecodeBool()
-	return nil
+	return buf.Err()
 }
 
 // SetIpfixExporterReply defines message 'set_ipfix_exporter_reply'.
@@ -792,7 +792,7 @@ func (m *SetIpfixExporterReply) Marshal(b []byte) ([]byte, error) {
 func (m *SetIpfixExporterReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
-	return nil
+	return buf.Err()
 }
 
 func init() { file_ipfix_export_binapi_init() }
@@ -103,7 +103,7 @@ func (m *Ipip6rdAddTunnel) Unmarshal(b []byte) error {
 	copy(m.IP4Src[:], buf.DecodeBytes(4))
 	m.SecurityCheck = buf.DecodeBool()
 	m.TcTos = buf.DecodeUint8()
-	return nil
+	return buf.Err()
 }
 
 // Ipip6rdAddTunnelReply defines message 'ipip_6rd_add_tunnel_reply'.
@@ -140,7 +140,7 @@ func (m *Ipip6rdAddTunnelReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
 	m.SwIfIndex = interface_types.InterfaceIndex(buf.DecodeUint32())
-	return nil
+	return buf.Err()
 }
 
 // Ipip6rdDelTunnel defines message 'ipip_6rd_del_tunnel'.
@@ -173,7 +173,7 @@ func (m *Ipip6rdDelTunnel) Marshal(b []byte) ([]byte, error) {
 func (m *Ipip6rdDelTunnel) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.SwIfIndex = interface_types.InterfaceIndex(buf.DecodeUint32())
-	return nil
+	return buf.Err()
 }
 
 // Ipip6rdDelTunnelReply defines message 'ipip_6rd_del_tunnel_reply'.
@@ -206,7 +206,7 @@ func (m *Ipip6rdDelTunnelReply) Marshal(b []byte) ([]byte, error) {
 func (m *Ipip6rdDelTunnelReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
-	return nil
+	return buf.Err()
 }
 
 // IpipAddTunnel defines message 'ipip_add_tunnel'.
@@ -266,7 +266,7 @@ func (m *IpipAddTunnel) Unmarshal(b []byte) error {
 	m.Tunnel.Flags = tunnel_types.TunnelEncapDecapFlags(buf.DecodeUint8())
 	m.Tunnel.Mode = tunnel_types.TunnelMode(buf.DecodeUint8())
 	m.Tunnel.Dscp = ip_types.IPDscp(buf.DecodeUint8())
-	return nil
+	return buf.Err()
 }
 
 // IpipAddTunnelReply defines message 'ipip_add_tunnel_reply'.
@@ -303,7 +303,7 @@ func (m *IpipAddTunnelReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
 	m.SwIfIndex = interface_types.InterfaceIndex(buf.DecodeUint32())
-	return nil
+	return buf.Err()
 }
 
 // IpipDelTunnel defines message 'ipip_del_tunnel'.
@@ -336,7 +336,7 @@ func (m *IpipDelTunnel) Marshal(b []byte) ([]byte, error) {
 func (m *IpipDelTunnel) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.SwIfIndex = interface_types.InterfaceIndex(buf.DecodeUint32())
-	return nil
+	return buf.Err()
 }
 
 // IpipDelTunnelReply defines message 'ipip_del_tunnel_reply'.
@@ -369,7 +369,7 @@ func (m *IpipDelTunnelReply) Marshal(b []byte) ([]byte, error) {
 func (m *IpipDelTunnelReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
-	return nil
+	return buf.Err()
 }
 
 // IpipTunnelDetails defines message 'ipip_tunnel_details'.
@@ -429,7 +429,7 @@ func (m *IpipTunnelDetails) Unmarshal(b []byte) error {
 	m.Tunnel.Flags = tunnel_types.TunnelEncapDecapFlags(buf.DecodeUint8())
 	m.Tunnel.Mode = tunnel_types.TunnelMode(buf.DecodeUint8())
 	m.Tunnel.Dscp = ip_types.IPDscp(buf.DecodeUint8())
-	return nil
+	return buf.Err()
 }
 
 // IpipTunnelDump defines message 'ipip_tunnel_dump'.
@@ -462,7 +462,7 @@ func (m *IpipTunnelDump) Marshal(b []byte) ([]byte, error) {
 func (m *IpipTunnelDump) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.SwIfIndex = interface_types.InterfaceIndex(buf.DecodeUint32())
-	return nil
+	return buf.Err()
 }
 
 func init() { file_ipip_binapi_init() }
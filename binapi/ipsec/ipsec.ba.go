@@ -90,7 +90,7 @@ func (m *IpsecBackendDetails) Unmarshal(b []byte) error {
 	m.Protocol = ipsec_types.IpsecProto(buf.DecodeUint32())
 	m.Index = buf.DecodeUint8()
 	m.Active = buf.DecodeBool()
-	return nil
+	return buf.Err()
 }
 
 // IpsecBackendDump defines message 'ipsec_backend_dump'.
@@ -158,7 +158,7 @@ func (m *IpsecInterfaceAddDelSpd) Unmarshal(b []byte) error {
 	m.IsAdd = buf.DecodeBool()
 	m.SwIfIndex = interface_types.InterfaceIndex(buf.DecodeUint32())
 	m.SpdID = buf.DecodeUint32()
-	return nil
+	return buf.Err()
 }
 
 // IpsecInterfaceAddDelSpdReply defines message 'ipsec_interface_add_del_spd_reply'.
@@ -193,7 +193,7 @@ func (m *IpsecInterfaceAddDelSpdReply) Marshal(b []byte) ([]byte, error) {
 func (m *IpsecInterfaceAddDelSpdReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
-	return nil
+	return buf.Err()
 }
 
 // IpsecItfCreate defines message 'ipsec_itf_create'.
@@ -232,7 +232,7 @@ func (m *IpsecItfCreate) Unmarshal(b []byte) error {
 	m.Itf.UserInstance = buf.DecodeUint32()
 	m.Itf.Mode = tunnel_types.TunnelMode(buf.DecodeUint8())
 	m.Itf.SwIfIndex = interface_types.InterfaceIndex(buf.DecodeUint32())
-	return nil
+	return buf.Err()
 }
 
 // IpsecItfCreateReply defines message 'ipsec_itf_create_reply'.
@@ -269,7 +269,7 @@ func (m *IpsecItfCreateReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
 	m.SwIfIndex = interface_types.InterfaceIndex(buf.DecodeUint32())
-	return nil
+	return buf.Err()
 }
 
 // IpsecItfDelete defines message 'ipsec_itf_delete'.
@@ -302,7 +302,7 @@ func (m *IpsecItfDelete) Marshal(b []byte) ([]byte, error) {
 func (m *IpsecItfDelete) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.SwIfIndex = interface_types.InterfaceIndex(buf.DecodeUint32())
-	return nil
+	return buf.Err()
 }
 
 // IpsecItfDeleteReply defines message 'ipsec_itf_delete_reply'.
@@ -335,7 +335,7 @@ func (m *IpsecItfDeleteReply) Marshal(b []byte) ([]byte, error) {
 func (m *IpsecItfDeleteReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
-	return nil
+	return buf.Err()
 }
 
 // IpsecItfDetails defines message 'ipsec_itf_details'.
@@ -374,7 +374,7 @@ func (m *IpsecItfDetails) Unmarshal(b []byte) error {
 	m.Itf.UserInstance = buf.DecodeUint32()
 	m.Itf.Mode = tunnel_types.TunnelMode(buf.DecodeUint8())
 	m.Itf.SwIfIndex = interface_types.InterfaceIndex(buf.DecodeUint32())
-	return nil
+	return buf.Err()
 }
 
 // IpsecItfDump defines message 'ipsec_itf_dump'.
@@ -407,7 +407,7 @@ func (m *IpsecItfDump) Marshal(b []byte) ([]byte, error) {
 func (m *IpsecItfDump) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.SwIfIndex = interface_types.InterfaceIndex(buf.DecodeUint32())
-	return nil
+	return buf.Err()
 }
 
 // IpsecSaDetails defines message 'ipsec_sa_details'.
@@ -518,7 +518,7 @@ func (m *IpsecSaDetails) Unmarshal(b []byte) error {
 	m.LastSeqInbound = buf.DecodeUint64()
 	m.ReplayWindow = buf.DecodeUint64()
 	m.StatIndex = buf.DecodeUint32()
-	return nil
+	return buf.Err()
 }
 
 // IpsecSaDump defines message 'ipsec_sa_dump'.
@@ -552,7 +552,7 @@ func (m *IpsecSaDump) Marshal(b []byte) ([]byte, error) {
 func (m *IpsecSaDump) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.SaID = buf.DecodeUint32()
-	return nil
+	return buf.Err()
 }
 
 // IpsecSaV2Details defines message 'ipsec_sa_v2_details'.
@@ -668,7 +668,7 @@ func (m *IpsecSaV2Details) Unmarshal(b []byte) error {
 	m.LastSeqInbound = buf.DecodeUint64()
 	m.ReplayWindow = buf.DecodeUint64()
 	m.StatIndex = buf.DecodeUint32()
-	return nil
+	return buf.Err()
 }
 
 // IpsecSaV2Dump defines message 'ipsec_sa_v2_dump'.
@@ -701,7 +701,7 @@ func (m *IpsecSaV2Dump) Marshal(b []byte) ([]byte, error) {
 func (m *IpsecSaV2Dump) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.SaID = buf.DecodeUint32()
-	return nil
+	return buf.Err()
 }
 
 // IpsecSaV3Details defines message 'ipsec_sa_v3_details'.
@@ -828,7 +828,7 @@ func (m *IpsecSaV3Details) Unmarshal(b []byte) error {
 	m.LastSeqInbound = buf.DecodeUint64()
 	m.ReplayWindow = buf.DecodeUint64()
 	m.StatIndex = buf.DecodeUint32()
-	return nil
+	return buf.Err()
 }
 
 // IpsecSaV3Dump defines message 'ipsec_sa_v3_dump'.
@@ -861,7 +861,7 @@ func (m *IpsecSaV3Dump) Marshal(b []byte) ([]byte, error) {
 func (m *IpsecSaV3Dump) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.SaID = buf.DecodeUint32()
-	return nil
+	return buf.Err()
 }
 
 // IpsecSadEntryAdd defines message 'ipsec_sad_entry_add'.
@@ -968,7 +968,7 @@ func (m *IpsecSadEntryAdd) Unmarshal(b []byte) error {
 	m.Entry.Salt = buf.DecodeUint32()
 	m.Entry.UDPSrcPort = buf.DecodeUint16()
 	m.Entry.UDPDstPort = buf.DecodeUint16()
-	return nil
+	return buf.Err()
 }
 
 // IpsecSadEntryAddDel defines message 'ipsec_sad_entry_add_del'.
@@ -1059,7 +1059,7 @@ func (m *IpsecSadEntryAddDel) Unmarshal(b []byte) error {
 	m.Entry.Salt = buf.DecodeUint32()
 	m.Entry.UDPSrcPort = buf.DecodeUint16()
 	m.Entry.UDPDstPort = buf.DecodeUint16()
-	return nil
+	return buf.Err()
 }
 
 // IpsecSadEntryAddDelReply defines message 'ipsec_sad_entry_add_del_reply'.
@@ -1097,7 +1097,7 @@ func (m *IpsecSadEntryAddDelReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
 	m.StatIndex = buf.DecodeUint32()
-	return nil
+	return buf.Err()
 }
 
 // IpsecSadEntryAddDelV2 defines message 'ipsec_sad_entry_add_del_v2'.
@@ -1193,7 +1193,7 @@ func (m *IpsecSadEntryAddDelV2) Unmarshal(b []byte) error {
 	m.Entry.Salt = buf.DecodeUint32()
 	m.Entry.UDPSrcPort = buf.DecodeUint16()
 	m.Entry.UDPDstPort = buf.DecodeUint16()
-	return nil
+	return buf.Err()
 }
 
 // IpsecSadEntryAddDelV2Reply defines message 'ipsec_sad_entry_add_del_v2_reply'.
@@ -1230,7 +1230,7 @@ func (m *IpsecSadEntryAddDelV2Reply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
 	m.StatIndex = buf.DecodeUint32()
-	return nil
+	return buf.Err()
 }
 
 // IpsecSadEntryAddDelV3 defines message 'ipsec_sad_entry_add_del_v3'.
@@ -1341,7 +1341,7 @@ func (m *IpsecSadEntryAddDelV3) Unmarshal(b []byte) error {
 	m.Entry.Salt = buf.DecodeUint32()
 	m.Entry.UDPSrcPort = buf.DecodeUint16()
 	m.Entry.UDPDstPort = buf.DecodeUint16()
-	return nil
+	return buf.Err()
 }
 
 // IpsecSadEntryAddDelV3Reply defines message 'ipsec_sad_entry_add_del_v3_reply'.
@@ -1378,7 +1378,7 @@ func (m *IpsecSadEntryAddDelV3Reply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
 	m.StatIndex = buf.DecodeUint32()
-	return nil
+	return buf.Err()
 }
 
 // IpsecSadEntryAddReply defines message 'ipsec_sad_entry_add_reply'.
@@ -1415,7 +1415,7 @@ func (m *IpsecSadEntryAddReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
 	m.StatIndex = buf.DecodeUint32()
-	return nil
+	return buf.Err()
 }
 
 // IpsecSadEntryDel defines message 'ipsec_sad_entry_del'.
@@ -1448,7 +1448,7 @@ func (m *IpsecSadEntryDel) Marshal(b []byte) ([]byte, error) {
 func (m *IpsecSadEntryDel) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.ID = buf.DecodeUint32()
-	return nil
+	return buf.Err()
 }
 
 // IpsecSadEntryDelReply defines message 'ipsec_sad_entry_del_reply'.
@@ -1481,7 +1481,7 @@ func (m *IpsecSadEntryDelReply) Marshal(b []byte) ([]byte, error) {
 func (m *IpsecSadEntryDelReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
-	return nil
+	return buf.Err()
 }
 
 // IpsecSelectBackend defines message 'ipsec_select_backend'.
@@ -1518,7 +1518,7 @@ func (m *IpsecSelectBackend) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Protocol = ipsec_types.IpsecProto(buf.DecodeUint32())
 	m.Index = buf.DecodeUint8()
-	return nil
+	return buf.Err()
 }
 
 // IpsecSelectBackendReply defines message 'ipsec_select_backend_reply'.
@@ -1551,7 +1551,7 @@ func (m *IpsecSelectBackendReply) Marshal(b []byte) ([]byte, error) {
 func (m *IpsecSelectBackendReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
-	return nil
+	return buf.Err()
 }
 
 // IpsecSetAsyncMode defines message 'ipsec_set_async_mode'.
@@ -1584,7 +1584,7 @@ func (m *IpsecSetAsyncMode) Marshal(b []byte) ([]byte, error) {
 func (m *IpsecSetAsyncMode) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.AsyncEnable = buf.DecodeBool()
-	return nil
+	return buf.Err()
 }
 
 // IpsecSetAsyncModeReply defines message 'ipsec_set_async_mode_reply'.
@@ -1617,7 +1617,7 @@ func (m *IpsecSetAsyncModeReply) Marshal(b []byte) ([]byte, error) {
 func (m *IpsecSetAsyncModeReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
-	return nil
+	return buf.Err()
 }
 
 // IpsecSpdAddDel defines message 'ipsec_spd_add_del'.
@@ -1654,7 +1654,7 @@ func (m *IpsecSpdAddDel) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.IsAdd = buf.DecodeBool()
 	m.SpdID = buf.DecodeUint32()
-	return nil
+	return buf.Err()
 }
 
 // IpsecSpdAddDelReply defines message 'ipsec_spd_add_del_reply'.
@@ -1687,7 +1687,7 @@ func (m *IpsecSpdAddDelReply) Marshal(b []byte) ([]byte, error) {
 func (m *IpsecSpdAddDelReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
-	return nil
+	return buf.Err()
 }
 
 // IpsecSpdDetails defines message 'ipsec_spd_details'.
@@ -1771,7 +1771,7 @@ func (m *IpsecSpdDetails) Unmarshal(b []byte) error {
 	m.Entry.RemotePortStop = buf.DecodeUint16()
 	m.Entry.LocalPortStart = buf.DecodeUint16()
 	m.Entry.LocalPortStop = buf.DecodeUint16()
-	return nil
+	return buf.Err()
 }
 
 // IpsecSpdDump defines message 'ipsec_spd_dump'.
@@ -1808,7 +1808,7 @@ func (m *IpsecSpdDump) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.SpdID = buf.DecodeUint32()
 	m.SaID = buf.DecodeUint32()
-	return nil
+	return buf.Err()
 }
 
 // IpsecSpdEntryAddDel defines message 'ipsec_spd_entry_add_del'.
@@ -1897,7 +1897,7 @@ func (m *IpsecSpdEntryAddDel) Unmarshal(b []byte) error {
 	m.Entry.RemotePortStop = buf.DecodeUint16()
 	m.Entry.LocalPortStart = buf.DecodeUint16()
 	m.Entry.LocalPortStop = buf.DecodeUint16()
-	return nil
+	return buf.Err()
 }
 
 // IpsecSpdEntryAddDelReply defines message 'ipsec_spd_entry_add_del_reply'.
@@ -1934,7 +1934,7 @@ func (m *IpsecSpdEntryAddDelReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
 	m.StatIndex = buf.DecodeUint32()
-	return nil
+	return buf.Err()
 }
 
 // IpsecSpdEntryAddDelV2 defines message 'ipsec_spd_entry_add_del_v2'.
@@ -2022,7 +2022,7 @@ func (m *IpsecSpdEntryAddDelV2) Unmarshal(b []byte) error {
 	m.Entry.RemotePortStop = buf.DecodeUint16()
 	m.Entry.LocalPortStart = buf.DecodeUint16()
 	m.Entry.LocalPortStop = buf.DecodeUint16()
-	return nil
+	return buf.Err()
 }
 
 // IpsecSpdEntryAddDelV2Reply defines message 'ipsec_spd_entry_add_del_v2_reply'.
@@ -2059,7 +2059,7 @@ func (m *IpsecSpdEntryAddDelV2Reply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
 	m.StatIndex = buf.DecodeUint32()
-	return nil
+	return buf.Err()
 }
 
 // IpsecSpdInterfaceDetails defines message 'ipsec_spd_interface_details'.
@@ -2096,7 +2096,7 @@ func (m *IpsecSpdInterfaceDetails) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.SpdIndex = buf.DecodeUint32()
 	m.SwIfIndex = interface_types.InterfaceIndex(buf.DecodeUint32())
-	return nil
+	return buf.Err()
 }
 
 // IpsecSpdInterfaceDump defines message 'ipsec_spd_interface_dump'.
@@ -2133,7 +2133,7 @@ func (m *IpsecSpdInterfaceDump) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.SpdIndex = buf.DecodeUint32()
 	m.SpdIndexValid = buf.DecodeUint8()
-	return nil
+	return buf.Err()
 }
 
 // IpsecSpdsDetails defines message 'ipsec_spds_details'.
@@ -2170,7 +2170,7 @@ func (m *IpsecSpdsDetails) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.SpdID = buf.DecodeUint32()
 	m.Npolicies = buf.DecodeUint32()
-	return nil
+	return buf.Err()
 }
 
 // IpsecSpdsDump defines message 'ipsec_spds_dump'.
@@ -2237,7 +2237,7 @@ func (m *IpsecTunnelProtectDel) Unmarshal(b []byte) error {
 	m.SwIfIndex = interface_types.InterfaceIndex(buf.DecodeUint32())
 	m.Nh.Af = ip_types.AddressFamily(buf.DecodeUint8())
 	copy(m.Nh.Un.XXX_UnionData[:], buf.DecodeBytes(16))
-	return nil
+	return buf.Err()
 }
 
 // IpsecTunnelProtectDelReply defines message 'ipsec_tunnel_protect_del_reply'.
@@ -2270,7 +2270,7 @@ func (m *IpsecTunnelProtectDelReply) Marshal(b []byte) ([]byte, error) {
 func (m *IpsecTunnelProtectDelReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
-	return nil
+	return buf.Err()
 }
 
 // IpsecTunnelProtectDetails defines message 'ipsec_tunnel_protect_details'.
@@ -2323,11 +2323,11 @@ func (m *IpsecTunnelProtectDetails) Unmarshal(b []byte) error {
 	copy(m.Tun.Nh.Un.XXX_UnionData[:], buf.DecodeBytes(16))
 	m.Tun.SaOut = buf.DecodeUint32()
 	m.Tun.NSaIn = buf.DecodeUint8()
-	m.Tun.SaIn = make([]uint32, m.Tun.NSaIn)
+	m.Tun.SaIn = make([]uint32, buf.CheckArrayLen(int(m.Tun.NSaIn), 4))
 	for i := 0; i < len(m.Tun.SaIn); i++ {
 		m.Tun.SaIn[i] = buf.DecodeUint32()
 	}
-	return nil
+	return buf.Err()
 }
 
 // IpsecTunnelProtectDump defines message 'ipsec_tunnel_protect_dump'.
@@ -2360,7 +2360,7 @@ func (m *IpsecTunnelProtectDump) Marshal(b []byte) ([]byte, error) {
 func (m *IpsecTunnelProtectDump) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.SwIfIndex = interface_types.InterfaceIndex(buf.DecodeUint32())
-	return nil
+	return buf.Err()
 }
 
 // IpsecTunnelProtectUpdate defines message 'ipsec_tunnel_protect_update'.
@@ -2413,11 +2413,11 @@ func (m *IpsecTunnelProtectUpdate) Unmarshal(b []byte) error {
 	copy(m.Tunnel.Nh.Un.XXX_UnionData[:], buf.DecodeBytes(16))
 	m.Tunnel.SaOut = buf.DecodeUint32()
 	m.Tunnel.NSaIn = buf.DecodeUint8()
-	m.Tunnel.SaIn = make([]uint32, m.Tunnel.NSaIn)
+	m.Tunnel.SaIn = make([]uint32, buf.CheckArrayLen(int(m.Tunnel.NSaIn), 4))
 	for i := 0; i < len(m.Tunnel.SaIn); i++ {
 		m.Tunnel.SaIn[i] = buf.DecodeUint32()
 	}
-	return nil
+	return buf.Err()
 }
 
 // IpsecTunnelProtectUpdateReply defines message 'ipsec_tunnel_protect_update_reply'.
@@ -2452,7 +2452,7 @@ func (m *IpsecTunnelProtectUpdateReply) Marshal(b []byte) ([]byte, error) {
 func (m *IpsecTunnelProtectUpdateReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
-	return nil
+	return buf.Err()
 }
 
 func init() { file_ipsec_binapi_init() }
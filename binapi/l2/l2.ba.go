@@ -220,7 +220,7 @@ func (m *BdIPMacAddDel) Unmarshal(b []byte) error {
 	m.Entry.IP.Af = ip_types.AddressFamily(buf.DecodeUint8())
 	copy(m.Entry.IP.Un.XXX_UnionData[:], buf.DecodeBytes(16))
 	copy(m.Entry.Mac[:], buf.DecodeBytes(6))
-	return nil
+	return buf.Err()
 }
 
 // BdIPMacAddDelReply defines message 'bd_ip_mac_add_del_reply'.
@@ -253,7 +253,7 @@ func (m *BdIPMacAddDelReply) Marshal(b []byte) ([]byte, error) {
 func (m *BdIPMacAddDelReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
-	return nil
+	return buf.Err()
 }
 
 // BdIPMacDetails defines message 'bd_ip_mac_details'.
@@ -295,7 +295,7 @@ func (m *BdIPMacDetails) Unmarshal(b []byte) error {
 	m.Entry.IP.Af = ip_types.AddressFamily(buf.DecodeUint8())
 	copy(m.Entry.IP.Un.XXX_UnionData[:], buf.DecodeBytes(16))
 	copy(m.Entry.Mac[:], buf.DecodeBytes(6))
-	return nil
+	return buf.Err()
 }
 
 // BdIPMacDump defines message 'bd_ip_mac_dump'.
@@ -328,7 +328,7 @@ func (m *BdIPMacDump) Marshal(b []byte) ([]byte, error) {
 func (m *BdIPMacDump) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.BdID = buf.DecodeUint32()
-	return nil
+	return buf.Err()
 }
 
 // BdIPMacFlush defines message 'bd_ip_mac_flush'.
@@ -361,7 +361,7 @@ func (m *BdIPMacFlush) Marshal(b []byte) ([]byte, error) {
 func (m *BdIPMacFlush) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.BdID = buf.DecodeUint32()
-	return nil
+	return buf.Err()
 }
 
 // BdIPMacFlushReply defines message 'bd_ip_mac_flush_reply'.
@@ -394,7 +394,7 @@ func (m *BdIPMacFlushReply) Marshal(b []byte) ([]byte, error) {
 func (m *BdIPMacFlushReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
-	return nil
+	return buf.Err()
 }
 
 // BridgeDomainAddDel defines message 'bridge_domain_add_del'.
@@ -463,7 +463,7 @@ func (m *BridgeDomainAddDel) Unmarshal(b []byte) error {
 	m.MacAge = buf.DecodeUint8()
 	m.BdTag = buf.DecodeString(64)
 	m.IsAdd = buf.DecodeBool()
-	return nil
+	return buf.Err()
 }
 
 // BridgeDomainAddDelReply defines message 'bridge_domain_add_del_reply'.
@@ -496,7 +496,7 @@ func (m *BridgeDomainAddDelReply) Marshal(b []byte) ([]byte, error) {
 func (m *BridgeDomainAddDelReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
-	return nil
+	return buf.Err()
 }
 
 // BridgeDomainDetails defines message 'bridge_domain_details'.
@@ -593,13 +593,13 @@ func (m *BridgeDomainDetails) Unmarshal(b []byte) error {
 	m.BviSwIfIndex = interface_types.InterfaceIndex(buf.DecodeUint32())
 	m.UuFwdSwIfIndex = interface_types.InterfaceIndex(buf.DecodeUint32())
 	m.NSwIfs = buf.DecodeUint32()
-	m.SwIfDetails = make([]BridgeDomainSwIf, m.NSwIfs)
+	m.SwIfDetails = make([]BridgeDomainSwIf, buf.CheckArrayLen(int(m.NSwIfs), 1))
 	for j0 := 0; j0 < len(m.SwIfDetails); j0++ {
 		m.SwIfDetails[j0].Context = buf.DecodeUint32()
 		m.SwIfDetails[j0].SwIfIndex = interface_types.InterfaceIndex(buf.DecodeUint32())
 		m.SwIfDetails[j0].Shg = buf.DecodeUint8()
 	}
-	return nil
+	return buf.Err()
 }
 
 // BridgeDomainDump defines message 'bridge_domain_dump'.
@@ -636,7 +636,7 @@ func (m *BridgeDomainDump) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.BdID = buf.DecodeUint32()
 	m.SwIfIndex = interface_types.InterfaceIndex(buf.DecodeUint32())
-	return nil
+	return buf.Err()
 }
 
 // BridgeDomainSetDefaultLearnLimit defines message 'bridge_domain_set_default_learn_limit'.
@@ -671,7 +671,7 @@ func (m *BridgeDomainSetDefaultLearnLimit) Marshal(b []byte) ([]byte, error) {
 func (m *BridgeDomainSetDefaultLearnLimit) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.LearnLimit = buf.DecodeUint32()
-	return nil
+	return buf.Err()
 }
 
 // BridgeDomainSetDefaultLearnLimitReply defines message 'bridge_domain_set_default_learn_limit_reply'.
@@ -706,7 +706,7 @@ func (m *BridgeDomainSetDefaultLearnLimitReply) Marshal(b []byte) ([]byte, error
 func (m *BridgeDomainSetDefaultLearnLimitReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
-	return nil
+	return buf.Err()
 }
 
 // BridgeDomainSetLearnLimit defines message 'bridge_domain_set_learn_limit'.
@@ -743,7 +743,7 @@ func (m *BridgeDomainSetLearnLimit) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.BdID = buf.DecodeUint32()
 	m.LearnLimit = buf.DecodeUint32()
-	return nil
+	return buf.Err()
 }
 
 // BridgeDomainSetLearnLimitReply defines message 'bridge_domain_set_learn_limit_reply'.
@@ -778,7 +778,7 @@ func (m *BridgeDomainSetLearnLimitReply) Marshal(b []byte) ([]byte, error) {
 func (m *BridgeDomainSetLearnLimitReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
-	return nil
+	return buf.Err()
 }
 
 // BridgeDomainSetMacAge defines message 'bridge_domain_set_mac_age'.
@@ -815,7 +815,7 @@ func (m *BridgeDomainSetMacAge) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.BdID = buf.DecodeUint32()
 	m.MacAge = buf.DecodeUint8()
-	return nil
+	return buf.Err()
 }
 
 // BridgeDomainSetMacAgeReply defines message 'bridge_domain_set_mac_age_reply'.
@@ -848,7 +848,7 @@ func (m *BridgeDomainSetMacAgeReply) Marshal(b []byte) ([]byte, error) {
 func (m *BridgeDomainSetMacAgeReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
-	return nil
+	return buf.Err()
 }
 
 // BridgeFlags defines message 'bridge_flags'.
@@ -889,7 +889,7 @@ func (m *BridgeFlags) Unmarshal(b []byte) error {
 	m.BdID = buf.DecodeUint32()
 	m.IsSet = buf.DecodeBool()
 	m.Flags = BdFlags(buf.DecodeUint32())
-	return nil
+	return buf.Err()
 }
 
 // BridgeFlagsReply defines message 'bridge_flags_reply'.
@@ -926,7 +926,7 @@ func (m *BridgeFlagsReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
 	m.ResultingFeatureBitmap = buf.DecodeUint32()
-	return nil
+	return buf.Err()
 }
 
 // BviCreate defines message 'bvi_create'.
@@ -963,7 +963,7 @@ func (m *BviCreate) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	copy(m.Mac[:], buf.DecodeBytes(6))
 	m.UserInstance = buf.DecodeUint32()
-	return nil
+	return buf.Err()
 }
 
 // BviCreateReply defines message 'bvi_create_reply'.
@@ -1000,7 +1000,7 @@ func (m *BviCreateReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
 	m.SwIfIndex = interface_types.InterfaceIndex(buf.DecodeUint32())
-	return nil
+	return buf.Err()
 }
 
 // BviDelete defines message 'bvi_delete'.
@@ -1033,7 +1033,7 @@ func (m *BviDelete) Marshal(b []byte) ([]byte, error) {
 func (m *BviDelete) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.SwIfIndex = interface_types.InterfaceIndex(buf.DecodeUint32())
-	return nil
+	return buf.Err()
 }
 
 // BviDeleteReply defines message 'bvi_delete_reply'.
@@ -1066,7 +1066,7 @@ func (m *BviDeleteReply) Marshal(b []byte) ([]byte, error) {
 func (m *BviDeleteReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
-	return nil
+	return buf.Err()
 }
 
 // L2ArpTermEvent defines message 'l2_arp_term_event'.
@@ -1114,7 +1114,7 @@ func (m *L2ArpTermEvent) Unmarshal(b []byte) error {
 	copy(m.IP.Un.XXX_UnionData[:], buf.DecodeBytes(16))
 	m.SwIfIndex = interface_types.InterfaceIndex(buf.DecodeUint32())
 	copy(m.Mac[:], buf.DecodeBytes(6))
-	return nil
+	return buf.Err()
 }
 
 // L2FibClearTable defines message 'l2_fib_clear_table'.
@@ -1174,7 +1174,7 @@ func (m *L2FibClearTableReply) Marshal(b []byte) ([]byte, error) {
 func (m *L2FibClearTableReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
-	return nil
+	return buf.Err()
 }
 
 // L2FibTableDetails defines message 'l2_fib_table_details'.
@@ -1227,7 +1227,7 @@ func (m *L2FibTableDetails) Unmarshal(b []byte) error {
 	m.StaticMac = buf.DecodeBool()
 	m.FilterMac = buf.DecodeBool()
 	m.BviMac = buf.DecodeBool()
-	return nil
+	return buf.Err()
 }
 
 // L2FibTableDump defines message 'l2_fib_table_dump'.
@@ -1260,7 +1260,7 @@ func (m *L2FibTableDump) Marshal(b []byte) ([]byte, error) {
 func (m *L2FibTableDump) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.BdID = buf.DecodeUint32()
-	return nil
+	return buf.Err()
 }
 
 // L2Flags defines message 'l2_flags'.
@@ -1301,7 +1301,7 @@ func (m *L2Flags) Unmarshal(b []byte) error {
 	m.SwIfIndex = interface_types.InterfaceIndex(buf.DecodeUint32())
 	m.IsSet = buf.DecodeBool()
 	m.FeatureBitmap = buf.DecodeUint32()
-	return nil
+	return buf.Err()
 }
 
 // L2FlagsReply defines message 'l2_flags_reply'.
@@ -1338,7 +1338,7 @@ func (m *L2FlagsReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
 	m.ResultingFeatureBitmap = buf.DecodeUint32()
-	return nil
+	return buf.Err()
 }
 
 // L2InterfaceEfpFilter defines message 'l2_interface_efp_filter'.
@@ -1375,7 +1375,7 @@ func (m *L2InterfaceEfpFilter) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.SwIfIndex = interface_types.InterfaceIndex(buf.DecodeUint32())
 	m.EnableDisable = buf.DecodeBool()
-	return nil
+	return buf.Err()
 }
 
 // L2InterfaceEfpFilterReply defines message 'l2_interface_efp_filter_reply'.
@@ -1408,7 +1408,7 @@ func (m *L2InterfaceEfpFilterReply) Marshal(b []byte) ([]byte, error) {
 func (m *L2InterfaceEfpFilterReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
-	return nil
+	return buf.Err()
 }
 
 // L2InterfacePbbTagRewrite defines message 'l2_interface_pbb_tag_rewrite'.
@@ -1465,7 +1465,7 @@ func (m *L2InterfacePbbTagRewrite) Unmarshal(b []byte) error {
 	copy(m.BSmac[:], buf.DecodeBytes(6))
 	m.BVlanid = buf.DecodeUint16()
 	m.ISid = buf.DecodeUint32()
-	return nil
+	return buf.Err()
 }
 
 // L2InterfacePbbTagRewriteReply defines message 'l2_interface_pbb_tag_rewrite_reply'.
@@ -1500,7 +1500,7 @@ func (m *L2InterfacePbbTagRewriteReply) Marshal(b []byte) ([]byte, error) {
 func (m *L2InterfacePbbTagRewriteReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
-	return nil
+	return buf.Err()
 }
 
 // L2InterfaceVlanTagRewrite defines message 'l2_interface_vlan_tag_rewrite'.
@@ -1549,7 +1549,7 @@ func (m *L2InterfaceVlanTagRewrite) Unmarshal(b []byte) error {
 	m.PushDot1q = buf.DecodeUint32()
 	m.Tag1 = buf.DecodeUint32()
 	m.Tag2 = buf.DecodeUint32()
-	return nil
+	return buf.Err()
 }
 
 // L2InterfaceVlanTagRewriteReply defines message 'l2_interface_vlan_tag_rewrite_reply'.
@@ -1584,7 +1584,7 @@ func (m *L2InterfaceVlanTagRewriteReply) Marshal(b []byte) ([]byte, error) {
 func (m *L2InterfaceVlanTagRewriteReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
-	return nil
+	return buf.Err()
 }
 
 // L2MacsEvent defines message 'l2_macs_event'.
@@ -1643,14 +1643,14 @@ func (m *L2MacsEvent) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.PID = buf.DecodeUint32()
 	m.NMacs = buf.DecodeUint32()
-	m.Mac = make([]MacEntry, m.NMacs)
+	m.Mac = make([]MacEntry, buf.CheckArrayLen(int(m.NMacs), 1))
 	for j0 := 0; j0 < len(m.Mac); j0++ {
 		m.Mac[j0].SwIfIndex = interface_types.InterfaceIndex(buf.DecodeUint32())
 		copy(m.Mac[j0].MacAddr[:], buf.DecodeBytes(6))
 		m.Mac[j0].Action = MacEventAction(buf.DecodeUint32())
 		m.Mac[j0].Flags = buf.DecodeUint8()
 	}
-	return nil
+	return buf.Err()
 }
 
 // L2PatchAddDel defines message 'l2_patch_add_del'.
@@ -1691,7 +1691,7 @@ func (m *L2PatchAddDel) Unmarshal(b []byte) error {
 	m.RxSwIfIndex = interface_types.InterfaceIndex(buf.DecodeUint32())
 	m.TxSwIfIndex = interface_types.InterfaceIndex(buf.DecodeUint32())
 	m.IsAdd = buf.DecodeBool()
-	return nil
+	return buf.Err()
 }
 
 // L2PatchAddDelReply defines message 'l2_patch_add_del_reply'.
@@ -1724,7 +1724,7 @@ func (m *L2PatchAddDelReply) Marshal(b []byte) ([]byte, error) {
 func (m *L2PatchAddDelReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
-	return nil
+	return buf.Err()
 }
 
 // L2XconnectDetails defines message 'l2_xconnect_details'.
@@ -1761,7 +1761,7 @@ func (m *L2XconnectDetails) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.RxSwIfIndex = interface_types.InterfaceIndex(buf.DecodeUint32())
 	m.TxSwIfIndex = interface_types.InterfaceIndex(buf.DecodeUint32())
-	return nil
+	return buf.Err()
 }
 
 // L2XconnectDump defines message 'l2_xconnect_dump'.
@@ -1845,7 +1845,7 @@ func (m *L2fibAddDel) Unmarshal(b []byte) error {
 	m.StaticMac = buf.DecodeBool()
 	m.FilterMac = buf.DecodeBool()
 	m.BviMac = buf.DecodeBool()
-	return nil
+	return buf.Err()
 }
 
 // L2fibAddDelReply defines message 'l2fib_add_del_reply'.
@@ -1878,7 +1878,7 @@ func (m *L2fibAddDelReply) Marshal(b []byte) ([]byte, error) {
 func (m *L2fibAddDelReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
-	return nil
+	return buf.Err()
 }
 
 // L2fibFlushAll defines message 'l2fib_flush_all'.
@@ -1938,7 +1938,7 @@ func (m *L2fibFlushAllReply) Marshal(b []byte) ([]byte, error) {
 func (m *L2fibFlushAllReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
-	return nil
+	return buf.Err()
 }
 
 // L2fibFlushBd defines message 'l2fib_flush_bd'.
@@ -1971,7 +1971,7 @@ func (m *L2fibFlushBd) Marshal(b []byte) ([]byte, error) {
 func (m *L2fibFlushBd) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.BdID = buf.DecodeUint32()
-	return nil
+	return buf.Err()
 }
 
 // L2fibFlushBdReply defines message 'l2fib_flush_bd_reply'.
@@ -2004,7 +2004,7 @@ func (m *L2fibFlushBdReply) Marshal(b []byte) ([]byte, error) {
 func (m *L2fibFlushBdReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
-	return nil
+	return buf.Err()
 }
 
 // L2fibFlushInt defines message 'l2fib_flush_int'.
@@ -2037,7 +2037,7 @@ func (m *L2fibFlushInt) Marshal(b []byte) ([]byte, error) {
 func (m *L2fibFlushInt) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.SwIfIndex = interface_types.InterfaceIndex(buf.DecodeUint32())
-	return nil
+	return buf.Err()
 }
 
 // L2fibFlushIntReply defines message 'l2fib_flush_int_reply'.
@@ -2070,7 +2070,7 @@ func (m *L2fibFlushIntReply) Marshal(b []byte) ([]byte, error) {
 func (m *L2fibFlushIntReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
-	return nil
+	return buf.Err()
 }
 
 // L2fibSetScanDelay defines message 'l2fib_set_scan_delay'.
@@ -2103,7 +2103,7 @@ func (m *L2fibSetScanDelay) Marshal(b []byte) ([]byte, error) {
 func (m *L2fibSetScanDelay) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.ScanDelay = buf.DecodeUint16()
-	return nil
+	return buf.Err()
 }
 
 // L2fibSetScanDelayReply defines message 'l2fib_set_scan_delay_reply'.
@@ -2136,7 +2136,7 @@ func (m *L2fibSetScanDelayReply) Marshal(b []byte) ([]byte, error) {
 func (m *L2fibSetScanDelayReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
-	return nil
+	return buf.Err()
 }
 
 // SwInterfaceSetL2Bridge defines message 'sw_interface_set_l2_bridge'.
@@ -2185,7 +2185,7 @@ func (m *SwInterfaceSetL2Bridge) Unmarshal(b []byte) error {
 	m.PortType = L2PortType(buf.DecodeUint32())
 	m.Shg = buf.DecodeUint8()
 	m.Enable = buf.DecodeBool()
-	return nil
+	return buf.Err()
 }
 
 // SwInterfaceSetL2BridgeReply defines message 'sw_interface_set_l2_bridge_reply'.
@@ -2220,7 +2220,7 @@ func (m *SwInterfaceSetL2BridgeReply) Marshal(b []byte) ([]byte, error) {
 func (m *SwInterfaceSetL2BridgeReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
-	return nil
+	return buf.Err()
 }
 
 // SwInterfaceSetL2Xconnect defines message 'sw_interface_set_l2_xconnect'.
@@ -2261,7 +2261,7 @@ func (m *SwInterfaceSetL2Xconnect) Unmarshal(b []byte) error {
 	m.RxSwIfIndex = interface_types.InterfaceIndex(buf.DecodeUint32())
 	m.TxSwIfIndex = interface_types.InterfaceIndex(buf.DecodeUint32())
 	m.Enable = buf.DecodeBool()
-	return nil
+	return buf.Err()
 }
 
 // SwInterfaceSetL2XconnectReply defines message 'sw_interface_set_l2_xconnect_reply'.
@@ -2296,7 +2296,7 @@ func (m *SwInterfaceSetL2XconnectReply) Marshal(b []byte) ([]byte, error) {
 func (m *SwInterfaceSetL2XconnectReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
-	return nil
+	return buf.Err()
 }
 
 // SwInterfaceSetVpath defines message 'sw_interface_set_vpath'.
@@ -2333,7 +2333,7 @@ func (m *SwInterfaceSetVpath) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.SwIfIndex = interface_types.InterfaceIndex(buf.DecodeUint32())
 	m.Enable = buf.DecodeBool()
-	return nil
+	return buf.Err()
 }
 
 // SwInterfaceSetVpathReply defines message 'sw_interface_set_vpath_reply'.
@@ -2366,7 +2366,7 @@ func (m *SwInterfaceSetVpathReply) Marshal(b []byte) ([]byte, error) {
 func (m *SwInterfaceSetVpathReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
-	return nil
+	return buf.Err()
 }
 
 // WantL2ArpTermEvents defines message 'want_l2_arp_term_events'.
@@ -2403,7 +2403,7 @@ func (m *WantL2ArpTermEvents) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Enable = buf.DecodeBool()
 	m.PID = buf.DecodeUint32()
-	return nil
+	return buf.Err()
 }
 
 // WantL2ArpTermEventsReply defines message 'want_l2_arp_term_events_reply'.
@@ -2436,7 +2436,7 @@ func (m *WantL2ArpTermEventsReply) Marshal(b []byte) ([]byte, error) {
 func (m *WantL2ArpTermEventsReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
-	return nil
+	return buf.Err()
 }
 
 // WantL2MacsEvents defines message 'want_l2_macs_events'.
@@ -2486,7 +2486,7 @@ func (m *WantL2MacsEvents) Unmarshal(b []byte) error {
 	m.MaxMacsInEvent = buf.DecodeUint8()
 	m.EnableDisable = buf.DecodeBool()
 	m.PID = buf.DecodeUint32()
-	return nil
+	return buf.Err()
 }
 
 // WantL2MacsEvents2 defines message 'want_l2_macs_events2'.
@@ -2527,7 +2527,7 @@ func (m *WantL2MacsEvents2) Unmarshal(b []byte) error {
 	m.MaxMacsInEvent = buf.DecodeUint8()
 	m.EnableDisable = buf.DecodeBool()
 	m.PID = buf.DecodeUint32()
-	return nil
+	return buf.Err()
 }
 
 // WantL2MacsEvents2Reply defines message 'want_l2_macs_events2_reply'.
@@ -2560,7 +2560,7 @@ func (m *WantL2MacsEvents2Reply) Marshal(b []byte) ([]byte, error) {
 func (m *WantL2MacsEvents2Reply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
-	return nil
+	return buf.Err()
 }
 
 // WantL2MacsEventsReply defines message 'want_l2_macs_events_reply'.
@@ -2594,7 +2594,7 @@ func (m *WantL2MacsEventsReply) Marshal(b []byte) ([]byte, error) {
 func (m *WantL2MacsEventsReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
-	return nil
+	return buf.Err()
 }
 
 func init() { file_l2_binapi_init() }
@@ -127,7 +127,7 @@ func (m *L2tpv3CreateTunnel) Unmarshal(b []byte) error {
 	m.RemoteCookie = buf.DecodeUint64()
 	m.L2SublayerPresent = buf.DecodeBool()
 	m.EncapVrfID = buf.DecodeUint32()
-	return nil
+	return buf.Err()
 }
 
 // L2tpv3CreateTunnelReply defines message 'l2tpv3_create_tunnel_reply'.
@@ -164,7 +164,7 @@ func (m *L2tpv3CreateTunnelReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
 	m.SwIfIndex = interface_types.InterfaceIndex(buf.DecodeUint32())
-	return nil
+	return buf.Err()
 }
 
 // L2tpv3InterfaceEnableDisable defines message 'l2tpv3_interface_enable_disable'.
@@ -203,7 +203,7 @@ func (m *L2tpv3InterfaceEnableDisable) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.EnableDisable = buf.DecodeBool()
 	m.SwIfIndex = interface_types.InterfaceIndex(buf.DecodeUint32())
-	return nil
+	return buf.Err()
 }
 
 // L2tpv3InterfaceEnableDisableReply defines message 'l2tpv3_interface_enable_disable_reply'.
@@ -238,7 +238,7 @@ func (m *L2tpv3InterfaceEnableDisableReply) Marshal(b []byte) ([]byte, error) {
 func (m *L2tpv3InterfaceEnableDisableReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
-	return nil
+	return buf.Err()
 }
 
 // L2tpv3SetLookupKey defines message 'l2tpv3_set_lookup_key'.
@@ -271,7 +271,7 @@ func (m *L2tpv3SetLookupKey) Marshal(b []byte) ([]byte, error) {
 func (m *L2tpv3SetLookupKey) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Key = L2tLookupKey(buf.DecodeUint8())
-	return nil
+	return buf.Err()
 }
 
 // L2tpv3SetLookupKeyReply defines message 'l2tpv3_set_lookup_key_reply'.
@@ -304,7 +304,7 @@ func (m *L2tpv3SetLookupKeyReply) Marshal(b []byte) ([]byte, error) {
 func (m *L2tpv3SetLookupKeyReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
-	return nil
+	return buf.Err()
 }
 
 // L2tpv3SetTunnelCookies defines message 'l2tpv3_set_tunnel_cookies'.
@@ -345,7 +345,7 @@ func (m *L2tpv3SetTunnelCookies) Unmarshal(b []byte) error {
 	m.SwIfIndex = interface_types.InterfaceIndex(buf.DecodeUint32())
 	m.NewLocalCookie = buf.DecodeUint64()
 	m.NewRemoteCookie = buf.DecodeUint64()
-	return nil
+	return buf.Err()
 }
 
 // L2tpv3SetTunnelCookiesReply defines message 'l2tpv3_set_tunnel_cookies_reply'.
@@ -378,7 +378,7 @@ func (m *L2tpv3SetTunnelCookiesReply) Marshal(b []byte) ([]byte, error) {
 func (m *L2tpv3SetTunnelCookiesReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
-	return nil
+	return buf.Err()
 }
 
 // SwIfL2tpv3TunnelDetails defines message 'sw_if_l2tpv3_tunnel_details'.
@@ -458,7 +458,7 @@ func (m *SwIfL2tpv3TunnelDetails) Unmarshal(b []byte) error {
 	}
 	m.RemoteCookie = buf.DecodeUint64()
 	m.L2SublayerPresent = buf.DecodeBool()
-	return nil
+	return buf.Err()
 }
 
 // SwIfL2tpv3TunnelDump defines message 'sw_if_l2tpv3_tunnel_dump'.
@@ -73,7 +73,7 @@ func (m *L3xcDel) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.SwIfIndex = interface_types.InterfaceIndex(buf.DecodeUint32())
 	m.IsIP6 = buf.DecodeBool()
-	return nil
+	return buf.Err()
 }
 
 // L3xcDelReply defines message 'l3xc_del_reply'.
@@ -106,7 +106,7 @@ func (m *L3xcDelReply) Marshal(b []byte) ([]byte, error) {
 func (m *L3xcDelReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
-	return nil
+	return buf.Err()
 }
 
 // L3xcDetails defines message 'l3xc_details'.
@@ -196,7 +196,7 @@ func (m *L3xcDetails) Unmarshal(b []byte) error {
 	m.L3xc.SwIfIndex = interface_types.InterfaceIndex(buf.DecodeUint32())
 	m.L3xc.IsIP6 = buf.DecodeBool()
 	m.L3xc.NPaths = buf.DecodeUint8()
-	m.L3xc.Paths = make([]fib_types.FibPath, m.L3xc.NPaths)
+	m.L3xc.Paths = make([]fib_types.FibPath, buf.CheckArrayLen(int(m.L3xc.NPaths), 1))
 	for j1 := 0; j1 < len(m.L3xc.Paths); j1++ {
 		m.L3xc.Paths[j1].SwIfIndex = buf.DecodeUint32()
 		m.L3xc.Paths[j1].TableID = buf.DecodeUint32()
@@ -218,7 +218,7 @@ func (m *L3xcDetails) Unmarshal(b []byte) error {
 			m.L3xc.Paths[j1].LabelStack[j2].Exp = buf.DecodeUint8()
 		}
 	}
-	return nil
+	return buf.Err()
 }
 
 // L3xcDump defines message 'l3xc_dump'.
@@ -251,7 +251,7 @@ func (m *L3xcDump) Marshal(b []byte) ([]byte, error) {
 func (m *L3xcDump) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.SwIfIndex = interface_types.InterfaceIndex(buf.DecodeUint32())
-	return nil
+	return buf.Err()
 }
 
 // L3xcPluginGetVersion defines message 'l3xc_plugin_get_version'.
@@ -315,7 +315,7 @@ func (m *L3xcPluginGetVersionReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Major = buf.DecodeUint32()
 	m.Minor = buf.DecodeUint32()
-	return nil
+	return buf.Err()
 }
 
 // L3xcUpdate defines message 'l3xc_update'.
@@ -405,7 +405,7 @@ func (m *L3xcUpdate) Unmarshal(b []byte) error {
 	m.L3xc.SwIfIndex = interface_types.InterfaceIndex(buf.DecodeUint32())
 	m.L3xc.IsIP6 = buf.DecodeBool()
 	m.L3xc.NPaths = buf.DecodeUint8()
-	m.L3xc.Paths = make([]fib_types.FibPath, m.L3xc.NPaths)
+	m.L3xc.Paths = make([]fib_types.FibPath, buf.CheckArrayLen(int(m.L3xc.NPaths), 1))
 	for j1 := 0; j1 < len(m.L3xc.Paths); j1++ {
 		m.L3xc.Paths[j1].SwIfIndex = buf.DecodeUint32()
 		m.L3xc.Paths[j1].TableID = buf.DecodeUint32()
@@ -427,7 +427,7 @@ func (m *L3xcUpdate) Unmarshal(b []byte) error {
 			m.L3xc.Paths[j1].LabelStack[j2].Exp = buf.DecodeUint8()
 		}
 	}
-	return nil
+	return buf.Err()
 }
 
 // L3xcUpdateReply defines message 'l3xc_update_reply'.
@@ -464,7 +464,7 @@ func (m *L3xcUpdateReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
 	m.StatsIndex = buf.DecodeUint32()
-	return nil
+	return buf.Err()
 }
 
 func init() { file_l3xc_binapi_init() }
@@ -131,7 +131,7 @@ func (m *SwInterfaceLacpDetails) Unmarshal(b []byte) error {
 	m.PartnerPortPriority = buf.DecodeUint16()
 	m.PartnerPortNumber = buf.DecodeUint16()
 	m.PartnerState = buf.DecodeUint8()
-	return nil
+	return buf.Err()
 }
 
 // SwInterfaceLacpDump defines message 'sw_interface_lacp_dump'.
@@ -89,7 +89,7 @@ func (m *LbAddDelAs) Unmarshal(b []byte) error {
 	copy(m.AsAddress.Un.XXX_UnionData[:], buf.DecodeBytes(16))
 	m.IsDel = buf.DecodeBool()
 	m.IsFlush = buf.DecodeBool()
-	return nil
+	return buf.Err()
 }
 
 // LbAddDelAsReply defines message 'lb_add_del_as_reply'.
@@ -122,7 +122,7 @@ func (m *LbAddDelAsReply) Marshal(b []byte) ([]byte, error) {
 func (m *LbAddDelAsReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
-	return nil
+	return buf.Err()
 }
 
 // LbAddDelIntfNat4 defines message 'lb_add_del_intf_nat4'.
@@ -159,7 +159,7 @@ func (m *LbAddDelIntfNat4) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.IsAdd = buf.DecodeBool()
 	m.SwIfIndex = interface_types.InterfaceIndex(buf.DecodeUint32())
-	return nil
+	return buf.Err()
 }
 
 // LbAddDelIntfNat4Reply defines message 'lb_add_del_intf_nat4_reply'.
@@ -192,7 +192,7 @@ func (m *LbAddDelIntfNat4Reply) Marshal(b []byte) ([]byte, error) {
 func (m *LbAddDelIntfNat4Reply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
-	return nil
+	return buf.Err()
 }
 
 // LbAddDelIntfNat6 defines message 'lb_add_del_intf_nat6'.
@@ -229,7 +229,7 @@ func (m *LbAddDelIntfNat6) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.IsAdd = buf.DecodeBool()
 	m.SwIfIndex = interface_types.InterfaceIndex(buf.DecodeUint32())
-	return nil
+	return buf.Err()
 }
 
 // LbAddDelIntfNat6Reply defines message 'lb_add_del_intf_nat6_reply'.
@@ -262,7 +262,7 @@ func (m *LbAddDelIntfNat6Reply) Marshal(b []byte) ([]byte, error) {
 func (m *LbAddDelIntfNat6Reply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
-	return nil
+	return buf.Err()
 }
 
 // LbAddDelVip defines message 'lb_add_del_vip'.
@@ -337,7 +337,7 @@ func (m *LbAddDelVip) Unmarshal(b []byte) error {
 	m.NodePort = buf.DecodeUint16()
 	m.NewFlowsTableLength = buf.DecodeUint32()
 	m.IsDel = buf.DecodeBool()
-	return nil
+	return buf.Err()
 }
 
 // LbAddDelVipReply defines message 'lb_add_del_vip_reply'.
@@ -370,7 +370,7 @@ func (m *LbAddDelVipReply) Marshal(b []byte) ([]byte, error) {
 func (m *LbAddDelVipReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
-	return nil
+	return buf.Err()
 }
 
 // LbAsDetails defines message 'lb_as_details'.
@@ -430,7 +430,7 @@ func (m *LbAsDetails) Unmarshal(b []byte) error {
 	copy(m.AppSrv.Un.XXX_UnionData[:], buf.DecodeBytes(16))
 	m.Flags = buf.DecodeUint8()
 	m.InUseSince = buf.DecodeUint32()
-	return nil
+	return buf.Err()
 }
 
 // LbAsDump defines message 'lb_as_dump'.
@@ -477,7 +477,7 @@ func (m *LbAsDump) Unmarshal(b []byte) error {
 	m.Pfx.Len = buf.DecodeUint8()
 	m.Protocol = buf.DecodeUint8()
 	m.Port = buf.DecodeUint16()
-	return nil
+	return buf.Err()
 }
 
 // LbConf defines message 'lb_conf'.
@@ -522,7 +522,7 @@ func (m *LbConf) Unmarshal(b []byte) error {
 	copy(m.IP6SrcAddress[:], buf.DecodeBytes(16))
 	m.StickyBucketsPerCore = buf.DecodeUint32()
 	m.FlowTimeout = buf.DecodeUint32()
-	return nil
+	return buf.Err()
 }
 
 // LbConfReply defines message 'lb_conf_reply'.
@@ -555,7 +555,7 @@ func (m *LbConfReply) Marshal(b []byte) ([]byte, error) {
 func (m *LbConfReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
-	return nil
+	return buf.Err()
 }
 
 // LbFlushVip defines message 'lb_flush_vip'.
@@ -602,7 +602,7 @@ func (m *LbFlushVip) Unmarshal(b []byte) error {
 	m.Pfx.Len = buf.DecodeUint8()
 	m.Protocol = buf.DecodeUint8()
 	m.Port = buf.DecodeUint16()
-	return nil
+	return buf.Err()
 }
 
 // LbFlushVipReply defines message 'lb_flush_vip_reply'.
@@ -635,7 +635,7 @@ func (m *LbFlushVipReply) Marshal(b []byte) ([]byte, error) {
 func (m *LbFlushVipReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
-	return nil
+	return buf.Err()
 }
 
 // LbVipDetails defines message 'lb_vip_details'.
@@ -700,7 +700,7 @@ func (m *LbVipDetails) Unmarshal(b []byte) error {
 	m.SrvType = lb_types.LbSrvType(buf.DecodeUint32())
 	m.TargetPort = buf.DecodeUint16()
 	m.FlowTableLength = buf.DecodeUint16()
-	return nil
+	return buf.Err()
 }
 
 // LbVipDump defines message 'lb_vip_dump'.
@@ -754,7 +754,7 @@ func (m *LbVipDump) Unmarshal(b []byte) error {
 	m.PfxMatcher.Ge = buf.DecodeUint8()
 	m.Protocol = buf.DecodeUint8()
 	m.Port = buf.DecodeUint16()
-	return nil
+	return buf.Err()
 }
 
 func init() { file_lb_binapi_init() }
@@ -116,7 +116,7 @@ func (m *LcpDefaultNsGetReply) Marshal(b []byte) ([]byte, error) {
 func (m *LcpDefaultNsGetReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Netns = buf.DecodeString(32)
-	return nil
+	return buf.Err()
 }
 
 // LcpDefaultNsSet defines message 'lcp_default_ns_set'.
@@ -150,7 +150,7 @@ func (m *LcpDefaultNsSet) Marshal(b []byte) ([]byte, error) {
 func (m *LcpDefaultNsSet) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Netns = buf.DecodeString(32)
-	return nil
+	return buf.Err()
 }
 
 // LcpDefaultNsSetReply defines message 'lcp_default_ns_set_reply'.
@@ -184,7 +184,7 @@ func (m *LcpDefaultNsSetReply) Marshal(b []byte) ([]byte, error) {
 func (m *LcpDefaultNsSetReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
-	return nil
+	return buf.Err()
 }
 
 // LcpItfPairAddDel defines message 'lcp_itf_pair_add_del'.
@@ -234,7 +234,7 @@ func (m *LcpItfPairAddDel) Unmarshal(b []byte) error {
 	m.HostIfName = buf.DecodeString(16)
 	m.HostIfType = LcpItfHostType(buf.DecodeUint8())
 	m.Netns = buf.DecodeString(32)
-	return nil
+	return buf.Err()
 }
 
 // LcpItfPairAddDelReply defines message 'lcp_itf_pair_add_del_reply'.
@@ -268,7 +268,7 @@ func (m *LcpItfPairAddDelReply) Marshal(b []byte) ([]byte, error) {
 func (m *LcpItfPairAddDelReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
-	return nil
+	return buf.Err()
 }
 
 // LcpItfPairAddDelV2 defines message 'lcp_itf_pair_add_del_v2'.
@@ -318,7 +318,7 @@ func (m *LcpItfPairAddDelV2) Unmarshal(b []byte) error {
 	m.HostIfName = buf.DecodeString(16)
 	m.HostIfType = LcpItfHostType(buf.DecodeUint8())
 	m.Netns = buf.DecodeString(32)
-	return nil
+	return buf.Err()
 }
 
 // LcpItfPairAddDelV2Reply defines message 'lcp_itf_pair_add_del_v2_reply'.
@@ -355,7 +355,7 @@ func (m *LcpItfPairAddDelV2Reply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
 	m.HostSwIfIndex = interface_types.InterfaceIndex(buf.DecodeUint32())
-	return nil
+	return buf.Err()
 }
 
 // LcpItfPairDetails defines message 'lcp_itf_pair_details'.
@@ -409,7 +409,7 @@ func (m *LcpItfPairDetails) Unmarshal(b []byte) error {
 	m.HostIfName = buf.DecodeString(16)
 	m.HostIfType = LcpItfHostType(buf.DecodeUint8())
 	m.Netns = buf.DecodeString(32)
-	return nil
+	return buf.Err()
 }
 
 // LcpItfPairGet defines message 'lcp_itf_pair_get'.
@@ -442,7 +442,7 @@ func (m *LcpItfPairGet) Marshal(b []byte) ([]byte, error) {
 func (m *LcpItfPairGet) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Cursor = buf.DecodeUint32()
-	return nil
+	return buf.Err()
 }
 
 // LcpItfPairGetReply defines message 'lcp_itf_pair_get_reply'.
@@ -479,7 +479,7 @@ func (m *LcpItfPairGetReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
 	m.Cursor = buf.DecodeUint32()
-	return nil
+	return buf.Err()
 }
 
 // LcpItfPairReplaceBegin defines message 'lcp_itf_pair_replace_begin'.
@@ -541,7 +541,7 @@ func (m *LcpItfPairReplaceBeginReply) Marshal(b []byte) ([]byte, error) {
 func (m *LcpItfPairReplaceBeginReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
-	return nil
+	return buf.Err()
 }
 
 // LcpItfPairReplaceEnd defines message 'lcp_itf_pair_replace_end'.
@@ -601,7 +601,7 @@ func (m *LcpItfPairReplaceEndReply) Marshal(b []byte) ([]byte, error) {
 func (m *LcpItfPairReplaceEndReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
-	return nil
+	return buf.Err()
 }
 
 func init() { file_lcp_binapi_init() }
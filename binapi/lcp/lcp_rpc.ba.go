@@ -44,7 +44,7 @@ func (c *serviceClient) LcpDefaultNsSet(ctx context.Context, in *LcpDefaultNsSet
 	if err != nil {
 		return nil, err
 	}
-	return out, api.RetvalToVPPApiError(out.Retval)
+	return out, api.RetvalToRequestError(in, out.Retval)
 }
 
 func (c *serviceClient) LcpItfPairAddDel(ctx context.Context, in *LcpItfPairAddDel) (*LcpItfPairAddDelReply, error) {
@@ -53,7 +53,7 @@ func (c *serviceClient) LcpItfPairAddDel(ctx context.Context, in *LcpItfPairAddD
 	if err != nil {
 		return nil, err
 	}
-	return out, api.RetvalToVPPApiError(out.Retval)
+	return out, api.RetvalToRequestError(in, out.Retval)
 }
 
 func (c *serviceClient) LcpItfPairAddDelV2(ctx context.Context, in *LcpItfPairAddDelV2) (*LcpItfPairAddDelV2Reply, error) {
@@ -62,7 +62,7 @@ func (c *serviceClient) LcpItfPairAddDelV2(ctx context.Context, in *LcpItfPairAd
 	if err != nil {
 		return nil, err
 	}
-	return out, api.RetvalToVPPApiError(out.Retval)
+	return out, api.RetvalToRequestError(in, out.Retval)
 }
 
 func (c *serviceClient) LcpItfPairGet(ctx context.Context, in *LcpItfPairGet) (RPCService_LcpItfPairGetClient, error) {
@@ -95,7 +95,7 @@ func (c *serviceClient_LcpItfPairGetClient) Recv() (*LcpItfPairDetails, *LcpItfP
 	case *LcpItfPairDetails:
 		return m, nil, nil
 	case *LcpItfPairGetReply:
-		if err := api.RetvalToVPPApiError(m.Retval); err != nil {
+		if err := api.RetvalToRequestError((*LcpItfPairGet)(nil), m.Retval); err != nil {
 			return nil, nil, err
 		}
 		err = c.Stream.Close()
@@ -114,7 +114,7 @@ func (c *serviceClient) LcpItfPairReplaceBegin(ctx context.Context, in *LcpItfPa
 	if err != nil {
 		return nil, err
 	}
-	return out, api.RetvalToVPPApiError(out.Retval)
+	return out, api.RetvalToRequestError(in, out.Retval)
 }
 
 func (c *serviceClient) LcpItfPairReplaceEnd(ctx context.Context, in *LcpItfPairReplaceEnd) (*LcpItfPairReplaceEndReply, error) {
@@ -123,5 +123,5 @@ func (c *serviceClient) LcpItfPairReplaceEnd(ctx context.Context, in *LcpItfPair
 	if err != nil {
 		return nil, err
 	}
-	return out, api.RetvalToVPPApiError(out.Retval)
+	return out, api.RetvalToRequestError(in, out.Retval)
 }
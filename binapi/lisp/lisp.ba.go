@@ -119,7 +119,7 @@ func (m *LispAddDelAdjacency) Unmarshal(b []byte) error {
 	copy(m.Reid.Address.XXX_UnionData[:], buf.DecodeBytes(18))
 	m.Leid.Type = lisp_types.EidType(buf.DecodeUint8())
 	copy(m.Leid.Address.XXX_UnionData[:], buf.DecodeBytes(18))
-	return nil
+	return buf.Err()
 }
 
 // LispAddDelAdjacencyReply defines message 'lisp_add_del_adjacency_reply'.
@@ -152,7 +152,7 @@ func (m *LispAddDelAdjacencyReply) Marshal(b []byte) ([]byte, error) {
 func (m *LispAddDelAdjacencyReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
-	return nil
+	return buf.Err()
 }
 
 // LispAddDelLocalEid defines message 'lisp_add_del_local_eid'.
@@ -208,7 +208,7 @@ func (m *LispAddDelLocalEid) Unmarshal(b []byte) error {
 	m.Key.ID = lisp_types.HmacKeyID(buf.DecodeUint8())
 	m.Key.Key = make([]byte, 64)
 	copy(m.Key.Key, buf.DecodeBytes(len(m.Key.Key)))
-	return nil
+	return buf.Err()
 }
 
 // LispAddDelLocalEidReply defines message 'lisp_add_del_local_eid_reply'.
@@ -241,7 +241,7 @@ func (m *LispAddDelLocalEidReply) Marshal(b []byte) ([]byte, error) {
 func (m *LispAddDelLocalEidReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
-	return nil
+	return buf.Err()
 }
 
 // LispAddDelLocator defines message 'lisp_add_del_locator'.
@@ -290,7 +290,7 @@ func (m *LispAddDelLocator) Unmarshal(b []byte) error {
 	m.SwIfIndex = interface_types.InterfaceIndex(buf.DecodeUint32())
 	m.Priority = buf.DecodeUint8()
 	m.Weight = buf.DecodeUint8()
-	return nil
+	return buf.Err()
 }
 
 // LispAddDelLocatorReply defines message 'lisp_add_del_locator_reply'.
@@ -323,7 +323,7 @@ func (m *LispAddDelLocatorReply) Marshal(b []byte) ([]byte, error) {
 func (m *LispAddDelLocatorReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
-	return nil
+	return buf.Err()
 }
 
 // LispAddDelLocatorSet defines message 'lisp_add_del_locator_set'.
@@ -384,13 +384,13 @@ func (m *LispAddDelLocatorSet) Unmarshal(b []byte) error {
 	m.IsAdd = buf.DecodeBool()
 	m.LocatorSetName = buf.DecodeString(64)
 	m.LocatorNum = buf.DecodeUint32()
-	m.Locators = make([]lisp_types.LocalLocator, m.LocatorNum)
+	m.Locators = make([]lisp_types.LocalLocator, buf.CheckArrayLen(int(m.LocatorNum), 1))
 	for j0 := 0; j0 < len(m.Locators); j0++ {
 		m.Locators[j0].SwIfIndex = interface_types.InterfaceIndex(buf.DecodeUint32())
 		m.Locators[j0].Priority = buf.DecodeUint8()
 		m.Locators[j0].Weight = buf.DecodeUint8()
 	}
-	return nil
+	return buf.Err()
 }
 
 // LispAddDelLocatorSetReply defines message 'lisp_add_del_locator_set_reply'.
@@ -427,7 +427,7 @@ func (m *LispAddDelLocatorSetReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
 	m.LsIndex = buf.DecodeUint32()
-	return nil
+	return buf.Err()
 }
 
 // LispAddDelMapRequestItrRlocs defines message 'lisp_add_del_map_request_itr_rlocs'.
@@ -466,7 +466,7 @@ func (m *LispAddDelMapRequestItrRlocs) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.IsAdd = buf.DecodeBool()
 	m.LocatorSetName = buf.DecodeString(64)
-	return nil
+	return buf.Err()
 }
 
 // LispAddDelMapRequestItrRlocsReply defines message 'lisp_add_del_map_request_itr_rlocs_reply'.
@@ -501,7 +501,7 @@ func (m *LispAddDelMapRequestItrRlocsReply) Marshal(b []byte) ([]byte, error) {
 func (m *LispAddDelMapRequestItrRlocsReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
-	return nil
+	return buf.Err()
 }
 
 // LispAddDelMapResolver defines message 'lisp_add_del_map_resolver'.
@@ -541,7 +541,7 @@ func (m *LispAddDelMapResolver) Unmarshal(b []byte) error {
 	m.IsAdd = buf.DecodeBool()
 	m.IPAddress.Af = ip_types.AddressFamily(buf.DecodeUint8())
 	copy(m.IPAddress.Un.XXX_UnionData[:], buf.DecodeBytes(16))
-	return nil
+	return buf.Err()
 }
 
 // LispAddDelMapResolverReply defines message 'lisp_add_del_map_resolver_reply'.
@@ -574,7 +574,7 @@ func (m *LispAddDelMapResolverReply) Marshal(b []byte) ([]byte, error) {
 func (m *LispAddDelMapResolverReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
-	return nil
+	return buf.Err()
 }
 
 // LispAddDelMapServer defines message 'lisp_add_del_map_server'.
@@ -614,7 +614,7 @@ func (m *LispAddDelMapServer) Unmarshal(b []byte) error {
 	m.IsAdd = buf.DecodeBool()
 	m.IPAddress.Af = ip_types.AddressFamily(buf.DecodeUint8())
 	copy(m.IPAddress.Un.XXX_UnionData[:], buf.DecodeBytes(16))
-	return nil
+	return buf.Err()
 }
 
 // LispAddDelMapServerReply defines message 'lisp_add_del_map_server_reply'.
@@ -647,7 +647,7 @@ func (m *LispAddDelMapServerReply) Marshal(b []byte) ([]byte, error) {
 func (m *LispAddDelMapServerReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
-	return nil
+	return buf.Err()
 }
 
 // LispAddDelRemoteMapping defines message 'lisp_add_del_remote_mapping'.
@@ -736,14 +736,14 @@ func (m *LispAddDelRemoteMapping) Unmarshal(b []byte) error {
 	m.Seid.Type = lisp_types.EidType(buf.DecodeUint8())
 	copy(m.Seid.Address.XXX_UnionData[:], buf.DecodeBytes(18))
 	m.RlocNum = buf.DecodeUint32()
-	m.Rlocs = make([]lisp_types.RemoteLocator, m.RlocNum)
+	m.Rlocs = make([]lisp_types.RemoteLocator, buf.CheckArrayLen(int(m.RlocNum), 1))
 	for j0 := 0; j0 < len(m.Rlocs); j0++ {
 		m.Rlocs[j0].Priority = buf.DecodeUint8()
 		m.Rlocs[j0].Weight = buf.DecodeUint8()
 		m.Rlocs[j0].IPAddress.Af = ip_types.AddressFamily(buf.DecodeUint8())
 		copy(m.Rlocs[j0].IPAddress.Un.XXX_UnionData[:], buf.DecodeBytes(16))
 	}
-	return nil
+	return buf.Err()
 }
 
 // LispAddDelRemoteMappingReply defines message 'lisp_add_del_remote_mapping_reply'.
@@ -778,7 +778,7 @@ func (m *LispAddDelRemoteMappingReply) Marshal(b []byte) ([]byte, error) {
 func (m *LispAddDelRemoteMappingReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
-	return nil
+	return buf.Err()
 }
 
 // LispAdjacenciesGet defines message 'lisp_adjacencies_get'.
@@ -811,7 +811,7 @@ func (m *LispAdjacenciesGet) Marshal(b []byte) ([]byte, error) {
 func (m *LispAdjacenciesGet) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Vni = buf.DecodeUint32()
-	return nil
+	return buf.Err()
 }
 
 // LispAdjacenciesGetReply defines message 'lisp_adjacencies_get_reply'.
@@ -870,14 +870,14 @@ func (m *LispAdjacenciesGetReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
 	m.Count = buf.DecodeUint32()
-	m.Adjacencies = make([]LispAdjacency, m.Count)
+	m.Adjacencies = make([]LispAdjacency, buf.CheckArrayLen(int(m.Count), 1))
 	for j0 := 0; j0 < len(m.Adjacencies); j0++ {
 		m.Adjacencies[j0].Reid.Type = lisp_types.EidType(buf.DecodeUint8())
 		copy(m.Adjacencies[j0].Reid.Address.XXX_UnionData[:], buf.DecodeBytes(18))
 		m.Adjacencies[j0].Leid.Type = lisp_types.EidType(buf.DecodeUint8())
 		copy(m.Adjacencies[j0].Leid.Address.XXX_UnionData[:], buf.DecodeBytes(18))
 	}
-	return nil
+	return buf.Err()
 }
 
 // LispEidTableAddDelMap defines message 'lisp_eid_table_add_del_map'.
@@ -922,7 +922,7 @@ func (m *LispEidTableAddDelMap) Unmarshal(b []byte) error {
 	m.Vni = buf.DecodeUint32()
 	m.DpTable = buf.DecodeUint32()
 	m.IsL2 = buf.DecodeBool()
-	return nil
+	return buf.Err()
 }
 
 // LispEidTableAddDelMapReply defines message 'lisp_eid_table_add_del_map_reply'.
@@ -955,7 +955,7 @@ func (m *LispEidTableAddDelMapReply) Marshal(b []byte) ([]byte, error) {
 func (m *LispEidTableAddDelMapReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
-	return nil
+	return buf.Err()
 }
 
 // LispEidTableDetails defines message 'lisp_eid_table_details'.
@@ -1034,7 +1034,7 @@ func (m *LispEidTableDetails) Unmarshal(b []byte) error {
 	m.Key.ID = lisp_types.HmacKeyID(buf.DecodeUint8())
 	m.Key.Key = make([]byte, 64)
 	copy(m.Key.Key, buf.DecodeBytes(len(m.Key.Key)))
-	return nil
+	return buf.Err()
 }
 
 // LispEidTableDump defines message 'lisp_eid_table_dump'.
@@ -1086,7 +1086,7 @@ func (m *LispEidTableDump) Unmarshal(b []byte) error {
 	m.Eid.Type = lisp_types.EidType(buf.DecodeUint8())
 	copy(m.Eid.Address.XXX_UnionData[:], buf.DecodeBytes(18))
 	m.Filter = LispLocatorSetFilter(buf.DecodeUint8())
-	return nil
+	return buf.Err()
 }
 
 // LispEidTableMapDetails defines message 'lisp_eid_table_map_details'.
@@ -1123,7 +1123,7 @@ func (m *LispEidTableMapDetails) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Vni = buf.DecodeUint32()
 	m.DpTable = buf.DecodeUint32()
-	return nil
+	return buf.Err()
 }
 
 // LispEidTableMapDump defines message 'lisp_eid_table_map_dump'.
@@ -1156,7 +1156,7 @@ func (m *LispEidTableMapDump) Marshal(b []byte) ([]byte, error) {
 func (m *LispEidTableMapDump) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.IsL2 = buf.DecodeBool()
-	return nil
+	return buf.Err()
 }
 
 // LispEidTableVniDetails defines message 'lisp_eid_table_vni_details'.
@@ -1189,7 +1189,7 @@ func (m *LispEidTableVniDetails) Marshal(b []byte) ([]byte, error) {
 func (m *LispEidTableVniDetails) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Vni = buf.DecodeUint32()
-	return nil
+	return buf.Err()
 }
 
 // LispEidTableVniDump defines message 'lisp_eid_table_vni_dump'.
@@ -1249,7 +1249,7 @@ func (m *LispEnableDisable) Marshal(b []byte) ([]byte, error) {
 func (m *LispEnableDisable) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.IsEnable = buf.DecodeBool()
-	return nil
+	return buf.Err()
 }
 
 // LispEnableDisableReply defines message 'lisp_enable_disable_reply'.
@@ -1282,7 +1282,7 @@ func (m *LispEnableDisableReply) Marshal(b []byte) ([]byte, error) {
 func (m *LispEnableDisableReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
-	return nil
+	return buf.Err()
 }
 
 // LispGetMapRequestItrRlocs defines message 'lisp_get_map_request_itr_rlocs'.
@@ -1348,7 +1348,7 @@ func (m *LispGetMapRequestItrRlocsReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
 	m.LocatorSetName = buf.DecodeString(64)
-	return nil
+	return buf.Err()
 }
 
 // LispLocatorDetails defines message 'lisp_locator_details'.
@@ -1400,7 +1400,7 @@ func (m *LispLocatorDetails) Unmarshal(b []byte) error {
 	copy(m.IPAddress.Un.XXX_UnionData[:], buf.DecodeBytes(16))
 	m.Priority = buf.DecodeUint8()
 	m.Weight = buf.DecodeUint8()
-	return nil
+	return buf.Err()
 }
 
 // LispLocatorDump defines message 'lisp_locator_dump'.
@@ -1441,7 +1441,7 @@ func (m *LispLocatorDump) Unmarshal(b []byte) error {
 	m.LsIndex = buf.DecodeUint32()
 	m.LsName = buf.DecodeString(64)
 	m.IsIndexSet = buf.DecodeUint8()
-	return nil
+	return buf.Err()
 }
 
 // LispLocatorSetDetails defines message 'lisp_locator_set_details'.
@@ -1478,7 +1478,7 @@ func (m *LispLocatorSetDetails) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.LsIndex = buf.DecodeUint32()
 	m.LsName = buf.DecodeString(64)
-	return nil
+	return buf.Err()
 }
 
 // LispLocatorSetDump defines message 'lisp_locator_set_dump'.
@@ -1511,7 +1511,7 @@ func (m *LispLocatorSetDump) Marshal(b []byte) ([]byte, error) {
 func (m *LispLocatorSetDump) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Filter = LispLocatorSetFilter(buf.DecodeUint8())
-	return nil
+	return buf.Err()
 }
 
 // LispMapRegisterEnableDisable defines message 'lisp_map_register_enable_disable'.
@@ -1546,7 +1546,7 @@ func (m *LispMapRegisterEnableDisable) Marshal(b []byte) ([]byte, error) {
 func (m *LispMapRegisterEnableDisable) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.IsEnable = buf.DecodeBool()
-	return nil
+	return buf.Err()
 }
 
 // LispMapRegisterEnableDisableReply defines message 'lisp_map_register_enable_disable_reply'.
@@ -1581,7 +1581,7 @@ func (m *LispMapRegisterEnableDisableReply) Marshal(b []byte) ([]byte, error) {
 func (m *LispMapRegisterEnableDisableReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
-	return nil
+	return buf.Err()
 }
 
 // LispMapRequestMode defines message 'lisp_map_request_mode'.
@@ -1614,7 +1614,7 @@ func (m *LispMapRequestMode) Marshal(b []byte) ([]byte, error) {
 func (m *LispMapRequestMode) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.IsSrcDst = buf.DecodeBool()
-	return nil
+	return buf.Err()
 }
 
 // LispMapRequestModeReply defines message 'lisp_map_request_mode_reply'.
@@ -1647,7 +1647,7 @@ func (m *LispMapRequestModeReply) Marshal(b []byte) ([]byte, error) {
 func (m *LispMapRequestModeReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
-	return nil
+	return buf.Err()
 }
 
 // LispMapResolverDetails defines message 'lisp_map_resolver_details'.
@@ -1683,7 +1683,7 @@ func (m *LispMapResolverDetails) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.IPAddress.Af = ip_types.AddressFamily(buf.DecodeUint8())
 	copy(m.IPAddress.Un.XXX_UnionData[:], buf.DecodeBytes(16))
-	return nil
+	return buf.Err()
 }
 
 // LispMapResolverDump defines message 'lisp_map_resolver_dump'.
@@ -1746,7 +1746,7 @@ func (m *LispMapServerDetails) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.IPAddress.Af = ip_types.AddressFamily(buf.DecodeUint8())
 	copy(m.IPAddress.Un.XXX_UnionData[:], buf.DecodeBytes(16))
-	return nil
+	return buf.Err()
 }
 
 // LispMapServerDump defines message 'lisp_map_server_dump'.
@@ -1810,7 +1810,7 @@ func (m *LispPitrSetLocatorSet) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.IsAdd = buf.DecodeBool()
 	m.LsName = buf.DecodeString(64)
-	return nil
+	return buf.Err()
 }
 
 // LispPitrSetLocatorSetReply defines message 'lisp_pitr_set_locator_set_reply'.
@@ -1843,7 +1843,7 @@ func (m *LispPitrSetLocatorSetReply) Marshal(b []byte) ([]byte, error) {
 func (m *LispPitrSetLocatorSetReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
-	return nil
+	return buf.Err()
 }
 
 // LispRlocProbeEnableDisable defines message 'lisp_rloc_probe_enable_disable'.
@@ -1876,7 +1876,7 @@ func (m *LispRlocProbeEnableDisable) Marshal(b []byte) ([]byte, error) {
 func (m *LispRlocProbeEnableDisable) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.IsEnable = buf.DecodeBool()
-	return nil
+	return buf.Err()
 }
 
 // LispRlocProbeEnableDisableReply defines message 'lisp_rloc_probe_enable_disable_reply'.
@@ -1911,7 +1911,7 @@ func (m *LispRlocProbeEnableDisableReply) Marshal(b []byte) ([]byte, error) {
 func (m *LispRlocProbeEnableDisableReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
-	return nil
+	return buf.Err()
 }
 
 // LispUsePetr defines message 'lisp_use_petr'.
@@ -1951,7 +1951,7 @@ func (m *LispUsePetr) Unmarshal(b []byte) error {
 	m.IPAddress.Af = ip_types.AddressFamily(buf.DecodeUint8())
 	copy(m.IPAddress.Un.XXX_UnionData[:], buf.DecodeBytes(16))
 	m.IsAdd = buf.DecodeBool()
-	return nil
+	return buf.Err()
 }
 
 // LispUsePetrReply defines message 'lisp_use_petr_reply'.
@@ -1984,7 +1984,7 @@ func (m *LispUsePetrReply) Marshal(b []byte) ([]byte, error) {
 func (m *LispUsePetrReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
-	return nil
+	return buf.Err()
 }
 
 // ShowLispMapRegisterState defines message 'show_lisp_map_register_state'.
@@ -2050,7 +2050,7 @@ func (m *ShowLispMapRegisterStateReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
 	m.IsEnabled = buf.DecodeBool()
-	return nil
+	return buf.Err()
 }
 
 // ShowLispMapRequestMode defines message 'show_lisp_map_request_mode'.
@@ -2116,7 +2116,7 @@ func (m *ShowLispMapRequestModeReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
 	m.IsSrcDst = buf.DecodeBool()
-	return nil
+	return buf.Err()
 }
 
 // ShowLispPitr defines message 'show_lisp_pitr'.
@@ -2184,7 +2184,7 @@ func (m *ShowLispPitrReply) Unmarshal(b []byte) error {
 	m.Retval = buf.DecodeInt32()
 	m.IsEnabled = buf.DecodeBool()
 	m.LocatorSetName = buf.DecodeString(64)
-	return nil
+	return buf.Err()
 }
 
 // ShowLispRlocProbeState defines message 'show_lisp_rloc_probe_state'.
@@ -2250,7 +2250,7 @@ func (m *ShowLispRlocProbeStateReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
 	m.IsEnabled = buf.DecodeBool()
-	return nil
+	return buf.Err()
 }
 
 // ShowLispStatus defines message 'show_lisp_status'.
@@ -2318,7 +2318,7 @@ func (m *ShowLispStatusReply) Unmarshal(b []byte) error {
 	m.Retval = buf.DecodeInt32()
 	m.IsLispEnabled = buf.DecodeBool()
 	m.IsGpeEnabled = buf.DecodeBool()
-	return nil
+	return buf.Err()
 }
 
 // ShowLispUsePetr defines message 'show_lisp_use_petr'.
@@ -2389,7 +2389,7 @@ func (m *ShowLispUsePetrReply) Unmarshal(b []byte) error {
 	m.IsPetrEnable = buf.DecodeBool()
 	m.IPAddress.Af = ip_types.AddressFamily(buf.DecodeUint8())
 	copy(m.IPAddress.Un.XXX_UnionData[:], buf.DecodeBytes(16))
-	return nil
+	return buf.Err()
 }
 
 func init() { file_lisp_binapi_init() }
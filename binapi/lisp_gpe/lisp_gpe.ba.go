@@ -135,13 +135,13 @@ func (m *GpeAddDelFwdEntry) Unmarshal(b []byte) error {
 	m.DpTable = buf.DecodeUint32()
 	m.Action = buf.DecodeUint8()
 	m.LocNum = buf.DecodeUint32()
-	m.Locs = make([]GpeLocator, m.LocNum)
+	m.Locs = make([]GpeLocator, buf.CheckArrayLen(int(m.LocNum), 1))
 	for j0 := 0; j0 < len(m.Locs); j0++ {
 		m.Locs[j0].Weight = buf.DecodeUint8()
 		m.Locs[j0].Addr.Af = ip_types.AddressFamily(buf.DecodeUint8())
 		copy(m.Locs[j0].Addr.Un.XXX_UnionData[:], buf.DecodeBytes(16))
 	}
-	return nil
+	return buf.Err()
 }
 
 // GpeAddDelFwdEntryReply defines message 'gpe_add_del_fwd_entry_reply'.
@@ -178,7 +178,7 @@ func (m *GpeAddDelFwdEntryReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
 	m.FwdEntryIndex = buf.DecodeUint32()
-	return nil
+	return buf.Err()
 }
 
 // GpeAddDelIface defines message 'gpe_add_del_iface'.
@@ -223,7 +223,7 @@ func (m *GpeAddDelIface) Unmarshal(b []byte) error {
 	m.IsL2 = buf.DecodeBool()
 	m.DpTable = buf.DecodeUint32()
 	m.Vni = buf.DecodeUint32()
-	return nil
+	return buf.Err()
 }
 
 // GpeAddDelIfaceReply defines message 'gpe_add_del_iface_reply'.
@@ -256,7 +256,7 @@ func (m *GpeAddDelIfaceReply) Marshal(b []byte) ([]byte, error) {
 func (m *GpeAddDelIfaceReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
-	return nil
+	return buf.Err()
 }
 
 // GpeAddDelNativeFwdRpath defines message 'gpe_add_del_native_fwd_rpath'.
@@ -304,7 +304,7 @@ func (m *GpeAddDelNativeFwdRpath) Unmarshal(b []byte) error {
 	m.NhSwIfIndex = interface_types.InterfaceIndex(buf.DecodeUint32())
 	m.NhAddr.Af = ip_types.AddressFamily(buf.DecodeUint8())
 	copy(m.NhAddr.Un.XXX_UnionData[:], buf.DecodeBytes(16))
-	return nil
+	return buf.Err()
 }
 
 // GpeAddDelNativeFwdRpathReply defines message 'gpe_add_del_native_fwd_rpath_reply'.
@@ -339,7 +339,7 @@ func (m *GpeAddDelNativeFwdRpathReply) Marshal(b []byte) ([]byte, error) {
 func (m *GpeAddDelNativeFwdRpathReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
-	return nil
+	return buf.Err()
 }
 
 // GpeEnableDisable defines message 'gpe_enable_disable'.
@@ -372,7 +372,7 @@ func (m *GpeEnableDisable) Marshal(b []byte) ([]byte, error) {
 func (m *GpeEnableDisable) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.IsEnable = buf.DecodeBool()
-	return nil
+	return buf.Err()
 }
 
 // GpeEnableDisableReply defines message 'gpe_enable_disable_reply'.
@@ -405,7 +405,7 @@ func (m *GpeEnableDisableReply) Marshal(b []byte) ([]byte, error) {
 func (m *GpeEnableDisableReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
-	return nil
+	return buf.Err()
 }
 
 // GpeFwdEntriesGet defines message 'gpe_fwd_entries_get'.
@@ -438,7 +438,7 @@ func (m *GpeFwdEntriesGet) Marshal(b []byte) ([]byte, error) {
 func (m *GpeFwdEntriesGet) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Vni = buf.DecodeUint32()
-	return nil
+	return buf.Err()
 }
 
 // GpeFwdEntriesGetReply defines message 'gpe_fwd_entries_get_reply'.
@@ -505,7 +505,7 @@ func (m *GpeFwdEntriesGetReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
 	m.Count = buf.DecodeUint32()
-	m.Entries = make([]GpeFwdEntry, m.Count)
+	m.Entries = make([]GpeFwdEntry, buf.CheckArrayLen(int(m.Count), 1))
 	for j0 := 0; j0 < len(m.Entries); j0++ {
 		m.Entries[j0].FwdEntryIndex = buf.DecodeUint32()
 		m.Entries[j0].DpTable = buf.DecodeUint32()
@@ -516,7 +516,7 @@ func (m *GpeFwdEntriesGetReply) Unmarshal(b []byte) error {
 		m.Entries[j0].Vni = buf.DecodeUint32()
 		m.Entries[j0].Action = buf.DecodeUint8()
 	}
-	return nil
+	return buf.Err()
 }
 
 // GpeFwdEntryPathDetails defines message 'gpe_fwd_entry_path_details'.
@@ -565,7 +565,7 @@ func (m *GpeFwdEntryPathDetails) Unmarshal(b []byte) error {
 	m.RmtLoc.Weight = buf.DecodeUint8()
 	m.RmtLoc.Addr.Af = ip_types.AddressFamily(buf.DecodeUint8())
 	copy(m.RmtLoc.Addr.Un.XXX_UnionData[:], buf.DecodeBytes(16))
-	return nil
+	return buf.Err()
 }
 
 // GpeFwdEntryPathDump defines message 'gpe_fwd_entry_path_dump'.
@@ -598,7 +598,7 @@ func (m *GpeFwdEntryPathDump) Marshal(b []byte) ([]byte, error) {
 func (m *GpeFwdEntryPathDump) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.FwdEntryIndex = buf.DecodeUint32()
-	return nil
+	return buf.Err()
 }
 
 // GpeFwdEntryVnisGet defines message 'gpe_fwd_entry_vnis_get'.
@@ -671,11 +671,11 @@ func (m *GpeFwdEntryVnisGetReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
 	m.Count = buf.DecodeUint32()
-	m.Vnis = make([]uint32, m.Count)
+	m.Vnis = make([]uint32, buf.CheckArrayLen(int(m.Count), 4))
 	for i := 0; i < len(m.Vnis); i++ {
 		m.Vnis[i] = buf.DecodeUint32()
 	}
-	return nil
+	return buf.Err()
 }
 
 // GpeGetEncapMode defines message 'gpe_get_encap_mode'.
@@ -739,7 +739,7 @@ func (m *GpeGetEncapModeReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
 	m.EncapMode = buf.DecodeUint8()
-	return nil
+	return buf.Err()
 }
 
 // GpeNativeFwdRpathsGet defines message 'gpe_native_fwd_rpaths_get'.
@@ -772,7 +772,7 @@ func (m *GpeNativeFwdRpathsGet) Marshal(b []byte) ([]byte, error) {
 func (m *GpeNativeFwdRpathsGet) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.IsIP4 = buf.DecodeBool()
-	return nil
+	return buf.Err()
 }
 
 // GpeNativeFwdRpathsGetReply defines message 'gpe_native_fwd_rpaths_get_reply'.
@@ -831,14 +831,14 @@ func (m *GpeNativeFwdRpathsGetReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
 	m.Count = buf.DecodeUint32()
-	m.Entries = make([]GpeNativeFwdRpath, m.Count)
+	m.Entries = make([]GpeNativeFwdRpath, buf.CheckArrayLen(int(m.Count), 1))
 	for j0 := 0; j0 < len(m.Entries); j0++ {
 		m.Entries[j0].FibIndex = buf.DecodeUint32()
 		m.Entries[j0].NhSwIfIndex = interface_types.InterfaceIndex(buf.DecodeUint32())
 		m.Entries[j0].NhAddr.Af = ip_types.AddressFamily(buf.DecodeUint8())
 		copy(m.Entries[j0].NhAddr.Un.XXX_UnionData[:], buf.DecodeBytes(16))
 	}
-	return nil
+	return buf.Err()
 }
 
 // GpeSetEncapMode defines message 'gpe_set_encap_mode'.
@@ -871,7 +871,7 @@ func (m *GpeSetEncapMode) Marshal(b []byte) ([]byte, error) {
 func (m *GpeSetEncapMode) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.IsVxlan = buf.DecodeBool()
-	return nil
+	return buf.Err()
 }
 
 // GpeSetEncapModeReply defines message 'gpe_set_encap_mode_reply'.
@@ -904,7 +904,7 @@ func (m *GpeSetEncapModeReply) Marshal(b []byte) ([]byte, error) {
 func (m *GpeSetEncapModeReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
-	return nil
+	return buf.Err()
 }
 
 func init() { file_lisp_gpe_binapi_init() }
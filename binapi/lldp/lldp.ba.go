@@ -67,7 +67,7 @@ func (m *LldpConfig) Unmarshal(b []byte) error {
 	m.TxHold = buf.DecodeUint32()
 	m.TxInterval = buf.DecodeUint32()
 	m.SystemName = buf.DecodeString(0)
-	return nil
+	return buf.Err()
 }
 
 // LldpConfigReply defines message 'lldp_config_reply'.
@@ -100,7 +100,7 @@ func (m *LldpConfigReply) Marshal(b []byte) ([]byte, error) {
 func (m *LldpConfigReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
-	return nil
+	return buf.Err()
 }
 
 // SwInterfaceSetLldp defines message 'sw_interface_set_lldp'.
@@ -154,7 +154,7 @@ func (m *SwInterfaceSetLldp) Unmarshal(b []byte) error {
 	copy(m.MgmtOid, buf.DecodeBytes(len(m.MgmtOid)))
 	m.Enable = buf.DecodeBool()
 	m.PortDesc = buf.DecodeString(0)
-	return nil
+	return buf.Err()
 }
 
 // SwInterfaceSetLldpReply defines message 'sw_interface_set_lldp_reply'.
@@ -187,7 +187,7 @@ func (m *SwInterfaceSetLldpReply) Marshal(b []byte) ([]byte, error) {
 func (m *SwInterfaceSetLldpReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
-	return nil
+	return buf.Err()
 }
 
 func init() { file_lldp_binapi_init() }
@@ -122,12 +122,12 @@ func (m *MactimeAddDelRange) Unmarshal(b []byte) error {
 	copy(m.MacAddress[:], buf.DecodeBytes(6))
 	m.DeviceName = buf.DecodeString(64)
 	m.Count = buf.DecodeUint32()
-	m.Ranges = make([]TimeRange, m.Count)
+	m.Ranges = make([]TimeRange, buf.CheckArrayLen(int(m.Count), 1))
 	for j0 := 0; j0 < len(m.Ranges); j0++ {
 		m.Ranges[j0].Start = buf.DecodeFloat64()
 		m.Ranges[j0].End = buf.DecodeFloat64()
 	}
-	return nil
+	return buf.Err()
 }
 
 // MactimeAddDelRangeReply defines message 'mactime_add_del_range_reply'.
@@ -160,7 +160,7 @@ func (m *MactimeAddDelRangeReply) Marshal(b []byte) ([]byte, error) {
 func (m *MactimeAddDelRangeReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
-	return nil
+	return buf.Err()
 }
 
 // MactimeDetails defines message 'mactime_details'.
@@ -235,12 +235,12 @@ func (m *MactimeDetails) Unmarshal(b []byte) error {
 	m.Flags = buf.DecodeUint32()
 	m.DeviceName = buf.DecodeString(64)
 	m.Nranges = buf.DecodeUint32()
-	m.Ranges = make([]MactimeTimeRange, m.Nranges)
+	m.Ranges = make([]MactimeTimeRange, buf.CheckArrayLen(int(m.Nranges), 1))
 	for j0 := 0; j0 < len(m.Ranges); j0++ {
 		m.Ranges[j0].Start = buf.DecodeFloat64()
 		m.Ranges[j0].End = buf.DecodeFloat64()
 	}
-	return nil
+	return buf.Err()
 }
 
 // MactimeDump defines message 'mactime_dump'.
@@ -273,7 +273,7 @@ func (m *MactimeDump) Marshal(b []byte) ([]byte, error) {
 func (m *MactimeDump) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.MyTableEpoch = buf.DecodeUint32()
-	return nil
+	return buf.Err()
 }
 
 // MactimeDumpReply defines message 'mactime_dump_reply'.
@@ -310,7 +310,7 @@ func (m *MactimeDumpReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
 	m.TableEpoch = buf.DecodeUint32()
-	return nil
+	return buf.Err()
 }
 
 // MactimeEnableDisable defines message 'mactime_enable_disable'.
@@ -347,7 +347,7 @@ func (m *MactimeEnableDisable) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.EnableDisable = buf.DecodeBool()
 	m.SwIfIndex = interface_types.InterfaceIndex(buf.DecodeUint32())
-	return nil
+	return buf.Err()
 }
 
 // MactimeEnableDisableReply defines message 'mactime_enable_disable_reply'.
@@ -380,7 +380,7 @@ func (m *MactimeEnableDisableReply) Marshal(b []byte) ([]byte, error) {
 func (m *MactimeEnableDisableReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
-	return nil
+	return buf.Err()
 }
 
 func init() { file_mactime_binapi_init() }
@@ -71,7 +71,7 @@ func (m *MapAddDelRule) Unmarshal(b []byte) error {
 	m.IsAdd = buf.DecodeBool()
 	copy(m.IP6Dst[:], buf.DecodeBytes(16))
 	m.Psid = buf.DecodeUint16()
-	return nil
+	return buf.Err()
 }
 
 // MapAddDelRuleReply defines message 'map_add_del_rule_reply'.
@@ -104,7 +104,7 @@ func (m *MapAddDelRuleReply) Marshal(b []byte) ([]byte, error) {
 func (m *MapAddDelRuleReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
-	return nil
+	return buf.Err()
 }
 
 // MapAddDomain defines message 'map_add_domain'.
@@ -174,7 +174,7 @@ func (m *MapAddDomain) Unmarshal(b []byte) error {
 	m.PsidLength = buf.DecodeUint8()
 	m.Mtu = buf.DecodeUint16()
 	m.Tag = buf.DecodeString(64)
-	return nil
+	return buf.Err()
 }
 
 // MapAddDomainReply defines message 'map_add_domain_reply'.
@@ -211,7 +211,7 @@ func (m *MapAddDomainReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Index = buf.DecodeUint32()
 	m.Retval = buf.DecodeInt32()
-	return nil
+	return buf.Err()
 }
 
 // MapDelDomain defines message 'map_del_domain'.
@@ -244,7 +244,7 @@ func (m *MapDelDomain) Marshal(b []byte) ([]byte, error) {
 func (m *MapDelDomain) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Index = buf.DecodeUint32()
-	return nil
+	return buf.Err()
 }
 
 // MapDelDomainReply defines message 'map_del_domain_reply'.
@@ -277,7 +277,7 @@ func (m *MapDelDomainReply) Marshal(b []byte) ([]byte, error) {
 func (m *MapDelDomainReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
-	return nil
+	return buf.Err()
 }
 
 // MapDomainDetails defines message 'map_domain_details'.
@@ -355,7 +355,7 @@ func (m *MapDomainDetails) Unmarshal(b []byte) error {
 	m.Flags = buf.DecodeUint8()
 	m.Mtu = buf.DecodeUint16()
 	m.Tag = buf.DecodeString(64)
-	return nil
+	return buf.Err()
 }
 
 // MapDomainDump defines message 'map_domain_dump'.
@@ -416,7 +416,7 @@ func (m *MapDomainsGet) Marshal(b []byte) ([]byte, error) {
 func (m *MapDomainsGet) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Cursor = buf.DecodeUint32()
-	return nil
+	return buf.Err()
 }
 
 // MapDomainsGetReply defines message 'map_domains_get_reply'.
@@ -453,7 +453,7 @@ func (m *MapDomainsGetReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
 	m.Cursor = buf.DecodeUint32()
-	return nil
+	return buf.Err()
 }
 
 // MapIfEnableDisable defines message 'map_if_enable_disable'.
@@ -494,7 +494,7 @@ func (m *MapIfEnableDisable) Unmarshal(b []byte) error {
 	m.SwIfIndex = interface_types.InterfaceIndex(buf.DecodeUint32())
 	m.IsEnable = buf.DecodeBool()
 	m.IsTranslation = buf.DecodeBool()
-	return nil
+	return buf.Err()
 }
 
 // MapIfEnableDisableReply defines message 'map_if_enable_disable_reply'.
@@ -527,7 +527,7 @@ func (m *MapIfEnableDisableReply) Marshal(b []byte) ([]byte, error) {
 func (m *MapIfEnableDisableReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
-	return nil
+	return buf.Err()
 }
 
 // MapParamAddDelPreResolve defines message 'map_param_add_del_pre_resolve'.
@@ -568,7 +568,7 @@ func (m *MapParamAddDelPreResolve) Unmarshal(b []byte) error {
 	m.IsAdd = buf.DecodeBool()
 	copy(m.IP4NhAddress[:], buf.DecodeBytes(4))
 	copy(m.IP6NhAddress[:], buf.DecodeBytes(16))
-	return nil
+	return buf.Err()
 }
 
 // MapParamAddDelPreResolveReply defines message 'map_param_add_del_pre_resolve_reply'.
@@ -603,7 +603,7 @@ func (m *MapParamAddDelPreResolveReply) Marshal(b []byte) ([]byte, error) {
 func (m *MapParamAddDelPreResolveReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
-	return nil
+	return buf.Err()
 }
 
 // MapParamGet defines message 'map_param_get'.
@@ -719,7 +719,7 @@ func (m *MapParamGetReply) Unmarshal(b []byte) error {
 	m.SecCheckFragments = buf.DecodeBool()
 	m.TcCopy = buf.DecodeBool()
 	m.TcClass = buf.DecodeUint8()
-	return nil
+	return buf.Err()
 }
 
 // MapParamSetFragmentation defines message 'map_param_set_fragmentation'.
@@ -756,7 +756,7 @@ func (m *MapParamSetFragmentation) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Inner = buf.DecodeBool()
 	m.IgnoreDf = buf.DecodeBool()
-	return nil
+	return buf.Err()
 }
 
 // MapParamSetFragmentationReply defines message 'map_param_set_fragmentation_reply'.
@@ -791,7 +791,7 @@ func (m *MapParamSetFragmentationReply) Marshal(b []byte) ([]byte, error) {
 func (m *MapParamSetFragmentationReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
-	return nil
+	return buf.Err()
 }
 
 // MapParamSetICMP defines message 'map_param_set_icmp'.
@@ -824,7 +824,7 @@ func (m *MapParamSetICMP) Marshal(b []byte) ([]byte, error) {
 func (m *MapParamSetICMP) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	copy(m.IP4ErrRelaySrc[:], buf.DecodeBytes(4))
-	return nil
+	return buf.Err()
 }
 
 // MapParamSetICMP6 defines message 'map_param_set_icmp6'.
@@ -857,7 +857,7 @@ func (m *MapParamSetICMP6) Marshal(b []byte) ([]byte, error) {
 func (m *MapParamSetICMP6) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.EnableUnreachable = buf.DecodeBool()
-	return nil
+	return buf.Err()
 }
 
 // MapParamSetICMP6Reply defines message 'map_param_set_icmp6_reply'.
@@ -890,7 +890,7 @@ func (m *MapParamSetICMP6Reply) Marshal(b []byte) ([]byte, error) {
 func (m *MapParamSetICMP6Reply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
-	return nil
+	return buf.Err()
 }
 
 // MapParamSetICMPReply defines message 'map_param_set_icmp_reply'.
@@ -923,7 +923,7 @@ func (m *MapParamSetICMPReply) Marshal(b []byte) ([]byte, error) {
 func (m *MapParamSetICMPReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
-	return nil
+	return buf.Err()
 }
 
 // MapParamSetSecurityCheck defines message 'map_param_set_security_check'.
@@ -960,7 +960,7 @@ func (m *MapParamSetSecurityCheck) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Enable = buf.DecodeBool()
 	m.Fragments = buf.DecodeBool()
-	return nil
+	return buf.Err()
 }
 
 // MapParamSetSecurityCheckReply defines message 'map_param_set_security_check_reply'.
@@ -995,7 +995,7 @@ func (m *MapParamSetSecurityCheckReply) Marshal(b []byte) ([]byte, error) {
 func (m *MapParamSetSecurityCheckReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
-	return nil
+	return buf.Err()
 }
 
 // MapParamSetTCP defines message 'map_param_set_tcp'.
@@ -1028,7 +1028,7 @@ func (m *MapParamSetTCP) Marshal(b []byte) ([]byte, error) {
 func (m *MapParamSetTCP) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.TCPMss = buf.DecodeUint16()
-	return nil
+	return buf.Err()
 }
 
 // MapParamSetTCPReply defines message 'map_param_set_tcp_reply'.
@@ -1061,7 +1061,7 @@ func (m *MapParamSetTCPReply) Marshal(b []byte) ([]byte, error) {
 func (m *MapParamSetTCPReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
-	return nil
+	return buf.Err()
 }
 
 // MapParamSetTrafficClass defines message 'map_param_set_traffic_class'.
@@ -1098,7 +1098,7 @@ func (m *MapParamSetTrafficClass) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Copy = buf.DecodeBool()
 	m.TcClass = buf.DecodeUint8()
-	return nil
+	return buf.Err()
 }
 
 // MapParamSetTrafficClassReply defines message 'map_param_set_traffic_class_reply'.
@@ -1133,7 +1133,7 @@ func (m *MapParamSetTrafficClassReply) Marshal(b []byte) ([]byte, error) {
 func (m *MapParamSetTrafficClassReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
-	return nil
+	return buf.Err()
 }
 
 // MapRuleDetails defines message 'map_rule_details'.
@@ -1170,7 +1170,7 @@ func (m *MapRuleDetails) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	copy(m.IP6Dst[:], buf.DecodeBytes(16))
 	m.Psid = buf.DecodeUint16()
-	return nil
+	return buf.Err()
 }
 
 // MapRuleDump defines message 'map_rule_dump'.
@@ -1203,7 +1203,7 @@ func (m *MapRuleDump) Marshal(b []byte) ([]byte, error) {
 func (m *MapRuleDump) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.DomainIndex = buf.DecodeUint32()
-	return nil
+	return buf.Err()
 }
 
 // MapSummaryStats defines message 'map_summary_stats'.
@@ -1310,7 +1310,7 @@ func (m *MapSummaryStatsReply) Unmarshal(b []byte) error {
 	for i := 0; i < len(m.TotalSecurityCheck); i++ {
 		m.TotalSecurityCheck[i] = buf.DecodeUint64()
 	}
-	return nil
+	return buf.Err()
 }
 
 func init() { file_maps_binapi_init() }
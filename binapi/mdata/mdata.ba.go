@@ -59,7 +59,7 @@ func (m *MdataEnableDisable) Marshal(b []byte) ([]byte, error) {
 func (m *MdataEnableDisable) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.EnableDisable = buf.DecodeBool()
-	return nil
+	return buf.Err()
 }
 
 // MdataEnableDisableReply defines message 'mdata_enable_disable_reply'.
@@ -93,7 +93,7 @@ func (m *MdataEnableDisableReply) Marshal(b []byte) ([]byte, error) {
 func (m *MdataEnableDisableReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
-	return nil
+	return buf.Err()
 }
 
 func init() { file_mdata_binapi_init() }
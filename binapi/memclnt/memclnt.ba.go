@@ -125,14 +125,14 @@ func (m *APIVersionsReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
 	m.Count = buf.DecodeUint32()
-	m.APIVersions = make([]ModuleVersion, m.Count)
+	m.APIVersions = make([]ModuleVersion, buf.CheckArrayLen(int(m.Count), 1))
 	for j0 := 0; j0 < len(m.APIVersions); j0++ {
 		m.APIVersions[j0].Major = buf.DecodeUint32()
 		m.APIVersions[j0].Minor = buf.DecodeUint32()
 		m.APIVersions[j0].Patch = buf.DecodeUint32()
 		m.APIVersions[j0].Name = buf.DecodeString(64)
 	}
-	return nil
+	return buf.Err()
 }
 
 // ControlPing defines message 'control_ping'.
@@ -200,7 +200,7 @@ func (m *ControlPingReply) Unmarshal(b []byte) error {
 	m.Retval = buf.DecodeInt32()
 	m.ClientIndex = buf.DecodeUint32()
 	m.VpePID = buf.DecodeUint32()
-	return nil
+	return buf.Err()
 }
 
 // GetFirstMsgID defines message 'get_first_msg_id'.
@@ -233,7 +233,7 @@ func (m *GetFirstMsgID) Marshal(b []byte) ([]byte, error) {
 func (m *GetFirstMsgID) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Name = buf.DecodeString(64)
-	return nil
+	return buf.Err()
 }
 
 // GetFirstMsgIDReply defines message 'get_first_msg_id_reply'.
@@ -270,7 +270,7 @@ func (m *GetFirstMsgIDReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
 	m.FirstMsgID = buf.DecodeUint16()
-	return nil
+	return buf.Err()
 }
 
 // MemclntCreate defines message 'memclnt_create'.
@@ -324,7 +324,7 @@ func (m *MemclntCreate) Unmarshal(b []byte) error {
 	for i := 0; i < len(m.APIVersions); i++ {
 		m.APIVersions[i] = buf.DecodeUint32()
 	}
-	return nil
+	return buf.Err()
 }
 
 // MemclntCreateReply defines message 'memclnt_create_reply'.
@@ -369,7 +369,7 @@ func (m *MemclntCreateReply) Unmarshal(b []byte) error {
 	m.Handle = buf.DecodeUint64()
 	m.Index = buf.DecodeUint32()
 	m.MessageTable = buf.DecodeUint64()
-	return nil
+	return buf.Err()
 }
 
 // MemclntCreateV2 defines message 'memclnt_create_v2'.
@@ -427,7 +427,7 @@ func (m *MemclntCreateV2) Unmarshal(b []byte) error {
 		m.APIVersions[i] = buf.DecodeUint32()
 	}
 	m.Keepalive = buf.DecodeBool()
-	return nil
+	return buf.Err()
 }
 
 // MemclntCreateV2Reply defines message 'memclnt_create_v2_reply'.
@@ -472,7 +472,7 @@ func (m *MemclntCreateV2Reply) Unmarshal(b []byte) error {
 	m.Handle = buf.DecodeUint64()
 	m.Index = buf.DecodeUint32()
 	m.MessageTable = buf.DecodeUint64()
-	return nil
+	return buf.Err()
 }
 
 // MemclntDelete defines message 'memclnt_delete'.
@@ -513,7 +513,7 @@ func (m *MemclntDelete) Unmarshal(b []byte) error {
 	m.Index = buf.DecodeUint32()
 	m.Handle = buf.DecodeUint64()
 	m.DoCleanup = buf.DecodeBool()
-	return nil
+	return buf.Err()
 }
 
 // MemclntDeleteReply defines message 'memclnt_delete_reply'.
@@ -550,7 +550,7 @@ func (m *MemclntDeleteReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Response = buf.DecodeInt32()
 	m.Handle = buf.DecodeUint64()
-	return nil
+	return buf.Err()
 }
 
 // MemclntKeepalive defines message 'memclnt_keepalive'.
@@ -610,7 +610,7 @@ func (m *MemclntKeepaliveReply) Marshal(b []byte) ([]byte, error) {
 func (m *MemclntKeepaliveReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
-	return nil
+	return buf.Err()
 }
 
 // MemclntReadTimeout defines message 'memclnt_read_timeout'.
@@ -643,7 +643,7 @@ func (m *MemclntReadTimeout) Marshal(b []byte) ([]byte, error) {
 func (m *MemclntReadTimeout) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Dummy = buf.DecodeUint8()
-	return nil
+	return buf.Err()
 }
 
 // MemclntRxThreadSuspend defines message 'memclnt_rx_thread_suspend'.
@@ -676,7 +676,7 @@ func (m *MemclntRxThreadSuspend) Marshal(b []byte) ([]byte, error) {
 func (m *MemclntRxThreadSuspend) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Dummy = buf.DecodeUint8()
-	return nil
+	return buf.Err()
 }
 
 // RPCCall defines message 'rpc_call'.
@@ -728,9 +728,9 @@ func (m *RPCCall) Unmarshal(b []byte) error {
 	m.NeedBarrierSync = buf.DecodeUint8()
 	m.SendReply = buf.DecodeUint8()
 	m.DataLen = buf.DecodeUint32()
-	m.Data = make([]byte, m.DataLen)
+	m.Data = make([]byte, buf.CheckArrayLen(int(m.DataLen), 1))
 	copy(m.Data, buf.DecodeBytes(len(m.Data)))
-	return nil
+	return buf.Err()
 }
 
 // RPCCallReply defines message 'rpc_call_reply'.
@@ -763,7 +763,7 @@ func (m *RPCCallReply) Marshal(b []byte) ([]byte, error) {
 func (m *RPCCallReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
-	return nil
+	return buf.Err()
 }
 
 // RxThreadExit defines message 'rx_thread_exit'.
@@ -796,7 +796,7 @@ func (m *RxThreadExit) Marshal(b []byte) ([]byte, error) {
 func (m *RxThreadExit) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Dummy = buf.DecodeUint8()
-	return nil
+	return buf.Err()
 }
 
 // SockInitShm defines message 'sock_init_shm'.
@@ -842,11 +842,11 @@ func (m *SockInitShm) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.RequestedSize = buf.DecodeUint32()
 	m.Nitems = buf.DecodeUint8()
-	m.Configs = make([]uint64, m.Nitems)
+	m.Configs = make([]uint64, buf.CheckArrayLen(int(m.Nitems), 8))
 	for i := 0; i < len(m.Configs); i++ {
 		m.Configs[i] = buf.DecodeUint64()
 	}
-	return nil
+	return buf.Err()
 }
 
 // SockInitShmReply defines message 'sock_init_shm_reply'.
@@ -879,7 +879,7 @@ func (m *SockInitShmReply) Marshal(b []byte) ([]byte, error) {
 func (m *SockInitShmReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
-	return nil
+	return buf.Err()
 }
 
 // SockclntCreate defines message 'sockclnt_create'.
@@ -912,7 +912,7 @@ func (m *SockclntCreate) Marshal(b []byte) ([]byte, error) {
 func (m *SockclntCreate) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Name = buf.DecodeString(64)
-	return nil
+	return buf.Err()
 }
 
 // SockclntCreateReply defines message 'sockclnt_create_reply'.
@@ -971,12 +971,12 @@ func (m *SockclntCreateReply) Unmarshal(b []byte) error {
 	m.Response = buf.DecodeInt32()
 	m.Index = buf.DecodeUint32()
 	m.Count = buf.DecodeUint16()
-	m.MessageTable = make([]MessageTableEntry, m.Count)
+	m.MessageTable = make([]MessageTableEntry, buf.CheckArrayLen(int(m.Count), 1))
 	for j0 := 0; j0 < len(m.MessageTable); j0++ {
 		m.MessageTable[j0].Index = buf.DecodeUint16()
 		m.MessageTable[j0].Name = buf.DecodeString(64)
 	}
-	return nil
+	return buf.Err()
 }
 
 // SockclntDelete defines message 'sockclnt_delete'.
@@ -1009,7 +1009,7 @@ func (m *SockclntDelete) Marshal(b []byte) ([]byte, error) {
 func (m *SockclntDelete) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Index = buf.DecodeUint32()
-	return nil
+	return buf.Err()
 }
 
 // SockclntDeleteReply defines message 'sockclnt_delete_reply'.
@@ -1042,7 +1042,7 @@ func (m *SockclntDeleteReply) Marshal(b []byte) ([]byte, error) {
 func (m *SockclntDeleteReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Response = buf.DecodeInt32()
-	return nil
+	return buf.Err()
 }
 
 // TracePluginMsgIds defines message 'trace_plugin_msg_ids'.
@@ -1083,7 +1083,7 @@ func (m *TracePluginMsgIds) Unmarshal(b []byte) error {
 	m.PluginName = buf.DecodeString(128)
 	m.FirstMsgID = buf.DecodeUint16()
 	m.LastMsgID = buf.DecodeUint16()
-	return nil
+	return buf.Err()
 }
 
 func init() { file_memclnt_binapi_init() }
@@ -159,7 +159,7 @@ func (m *MemifCreate) Unmarshal(b []byte) error {
 	m.NoZeroCopy = buf.DecodeBool()
 	copy(m.HwAddr[:], buf.DecodeBytes(6))
 	m.Secret = buf.DecodeString(24)
-	return nil
+	return buf.Err()
 }
 
 // MemifCreateReply defines message 'memif_create_reply'.
@@ -196,7 +196,7 @@ func (m *MemifCreateReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
 	m.SwIfIndex = interface_types.InterfaceIndex(buf.DecodeUint32())
-	return nil
+	return buf.Err()
 }
 
 // MemifDelete defines message 'memif_delete'.
@@ -229,7 +229,7 @@ func (m *MemifDelete) Marshal(b []byte) ([]byte, error) {
 func (m *MemifDelete) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.SwIfIndex = interface_types.InterfaceIndex(buf.DecodeUint32())
-	return nil
+	return buf.Err()
 }
 
 // MemifDeleteReply defines message 'memif_delete_reply'.
@@ -262,7 +262,7 @@ func (m *MemifDeleteReply) Marshal(b []byte) ([]byte, error) {
 func (m *MemifDeleteReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
-	return nil
+	return buf.Err()
 }
 
 // MemifDetails defines message 'memif_details'.
@@ -335,7 +335,7 @@ func (m *MemifDetails) Unmarshal(b []byte) error {
 	m.BufferSize = buf.DecodeUint16()
 	m.Flags = interface_types.IfStatusFlags(buf.DecodeUint32())
 	m.IfName = buf.DecodeString(64)
-	return nil
+	return buf.Err()
 }
 
 // MemifDump defines message 'memif_dump'.
@@ -403,7 +403,7 @@ func (m *MemifSocketFilenameAddDel) Unmarshal(b []byte) error {
 	m.IsAdd = buf.DecodeBool()
 	m.SocketID = buf.DecodeUint32()
 	m.SocketFilename = buf.DecodeString(108)
-	return nil
+	return buf.Err()
 }
 
 // MemifSocketFilenameAddDelReply defines message 'memif_socket_filename_add_del_reply'.
@@ -438,7 +438,7 @@ func (m *MemifSocketFilenameAddDelReply) Marshal(b []byte) ([]byte, error) {
 func (m *MemifSocketFilenameAddDelReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
-	return nil
+	return buf.Err()
 }
 
 // MemifSocketFilenameDetails defines message 'memif_socket_filename_details'.
@@ -475,7 +475,7 @@ func (m *MemifSocketFilenameDetails) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.SocketID = buf.DecodeUint32()
 	m.SocketFilename = buf.DecodeString(108)
-	return nil
+	return buf.Err()
 }
 
 // MemifSocketFilenameDump defines message 'memif_socket_filename_dump'.
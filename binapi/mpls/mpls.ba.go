@@ -111,7 +111,7 @@ func (m *MplsIPBindUnbind) Unmarshal(b []byte) error {
 	m.MbPrefix.Address.Af = ip_types.AddressFamily(buf.DecodeUint8())
 	copy(m.MbPrefix.Address.Un.XXX_UnionData[:], buf.DecodeBytes(16))
 	m.MbPrefix.Len = buf.DecodeUint8()
-	return nil
+	return buf.Err()
 }
 
 // MplsIPBindUnbindReply defines message 'mpls_ip_bind_unbind_reply'.
@@ -144,7 +144,7 @@ func (m *MplsIPBindUnbindReply) Marshal(b []byte) ([]byte, error) {
 func (m *MplsIPBindUnbindReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
-	return nil
+	return buf.Err()
 }
 
 // MplsRouteAddDel defines message 'mpls_route_add_del'.
@@ -251,7 +251,7 @@ func (m *MplsRouteAddDel) Unmarshal(b []byte) error {
 	m.MrRoute.MrEosProto = buf.DecodeUint8()
 	m.MrRoute.MrIsMulticast = buf.DecodeBool()
 	m.MrRoute.MrNPaths = buf.DecodeUint8()
-	m.MrRoute.MrPaths = make([]fib_types.FibPath, m.MrRoute.MrNPaths)
+	m.MrRoute.MrPaths = make([]fib_types.FibPath, buf.CheckArrayLen(int(m.MrRoute.MrNPaths), 1))
 	for j1 := 0; j1 < len(m.MrRoute.MrPaths); j1++ {
 		m.MrRoute.MrPaths[j1].SwIfIndex = buf.DecodeUint32()
 		m.MrRoute.MrPaths[j1].TableID = buf.DecodeUint32()
@@ -273,7 +273,7 @@ func (m *MplsRouteAddDel) Unmarshal(b []byte) error {
 			m.MrRoute.MrPaths[j1].LabelStack[j2].Exp = buf.DecodeUint8()
 		}
 	}
-	return nil
+	return buf.Err()
 }
 
 // MplsRouteAddDelReply defines message 'mpls_route_add_del_reply'.
@@ -310,7 +310,7 @@ func (m *MplsRouteAddDelReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
 	m.StatsIndex = buf.DecodeUint32()
-	return nil
+	return buf.Err()
 }
 
 // MplsRouteDetails defines message 'mpls_route_details'.
@@ -409,7 +409,7 @@ func (m *MplsRouteDetails) Unmarshal(b []byte) error {
 	m.MrRoute.MrEosProto = buf.DecodeUint8()
 	m.MrRoute.MrIsMulticast = buf.DecodeBool()
 	m.MrRoute.MrNPaths = buf.DecodeUint8()
-	m.MrRoute.MrPaths = make([]fib_types.FibPath, m.MrRoute.MrNPaths)
+	m.MrRoute.MrPaths = make([]fib_types.FibPath, buf.CheckArrayLen(int(m.MrRoute.MrNPaths), 1))
 	for j1 := 0; j1 < len(m.MrRoute.MrPaths); j1++ {
 		m.MrRoute.MrPaths[j1].SwIfIndex = buf.DecodeUint32()
 		m.MrRoute.MrPaths[j1].TableID = buf.DecodeUint32()
@@ -431,7 +431,7 @@ func (m *MplsRouteDetails) Unmarshal(b []byte) error {
 			m.MrRoute.MrPaths[j1].LabelStack[j2].Exp = buf.DecodeUint8()
 		}
 	}
-	return nil
+	return buf.Err()
 }
 
 // MplsRouteDump defines message 'mpls_route_dump'.
@@ -467,7 +467,7 @@ func (m *MplsRouteDump) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Table.MtTableID = buf.DecodeUint32()
 	m.Table.MtName = buf.DecodeString(64)
-	return nil
+	return buf.Err()
 }
 
 // MplsTableAddDel defines message 'mpls_table_add_del'.
@@ -507,7 +507,7 @@ func (m *MplsTableAddDel) Unmarshal(b []byte) error {
 	m.MtIsAdd = buf.DecodeBool()
 	m.MtTable.MtTableID = buf.DecodeUint32()
 	m.MtTable.MtName = buf.DecodeString(64)
-	return nil
+	return buf.Err()
 }
 
 // MplsTableAddDelReply defines message 'mpls_table_add_del_reply'.
@@ -540,7 +540,7 @@ func (m *MplsTableAddDelReply) Marshal(b []byte) ([]byte, error) {
 func (m *MplsTableAddDelReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
-	return nil
+	return buf.Err()
 }
 
 // MplsTableDetails defines message 'mpls_table_details'.
@@ -576,7 +576,7 @@ func (m *MplsTableDetails) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.MtTable.MtTableID = buf.DecodeUint32()
 	m.MtTable.MtName = buf.DecodeString(64)
-	return nil
+	return buf.Err()
 }
 
 // MplsTableDump defines message 'mpls_table_dump'.
@@ -706,7 +706,7 @@ func (m *MplsTunnelAddDel) Unmarshal(b []byte) error {
 	m.MtTunnel.MtIsMulticast = buf.DecodeBool()
 	m.MtTunnel.MtTag = buf.DecodeString(64)
 	m.MtTunnel.MtNPaths = buf.DecodeUint8()
-	m.MtTunnel.MtPaths = make([]fib_types.FibPath, m.MtTunnel.MtNPaths)
+	m.MtTunnel.MtPaths = make([]fib_types.FibPath, buf.CheckArrayLen(int(m.MtTunnel.MtNPaths), 1))
 	for j1 := 0; j1 < len(m.MtTunnel.MtPaths); j1++ {
 		m.MtTunnel.MtPaths[j1].SwIfIndex = buf.DecodeUint32()
 		m.MtTunnel.MtPaths[j1].TableID = buf.DecodeUint32()
@@ -728,7 +728,7 @@ func (m *MplsTunnelAddDel) Unmarshal(b []byte) error {
 			m.MtTunnel.MtPaths[j1].LabelStack[j2].Exp = buf.DecodeUint8()
 		}
 	}
-	return nil
+	return buf.Err()
 }
 
 // MplsTunnelAddDelReply defines message 'mpls_tunnel_add_del_reply'.
@@ -769,7 +769,7 @@ func (m *MplsTunnelAddDelReply) Unmarshal(b []byte) error {
 	m.Retval = buf.DecodeInt32()
 	m.SwIfIndex = interface_types.InterfaceIndex(buf.DecodeUint32())
 	m.TunnelIndex = buf.DecodeUint32()
-	return nil
+	return buf.Err()
 }
 
 // MplsTunnelDetails defines message 'mpls_tunnel_details'.
@@ -868,7 +868,7 @@ func (m *MplsTunnelDetails) Unmarshal(b []byte) error {
 	m.MtTunnel.MtIsMulticast = buf.DecodeBool()
 	m.MtTunnel.MtTag = buf.DecodeString(64)
 	m.MtTunnel.MtNPaths = buf.DecodeUint8()
-	m.MtTunnel.MtPaths = make([]fib_types.FibPath, m.MtTunnel.MtNPaths)
+	m.MtTunnel.MtPaths = make([]fib_types.FibPath, buf.CheckArrayLen(int(m.MtTunnel.MtNPaths), 1))
 	for j1 := 0; j1 < len(m.MtTunnel.MtPaths); j1++ {
 		m.MtTunnel.MtPaths[j1].SwIfIndex = buf.DecodeUint32()
 		m.MtTunnel.MtPaths[j1].TableID = buf.DecodeUint32()
@@ -890,7 +890,7 @@ func (m *MplsTunnelDetails) Unmarshal(b []byte) error {
 			m.MtTunnel.MtPaths[j1].LabelStack[j2].Exp = buf.DecodeUint8()
 		}
 	}
-	return nil
+	return buf.Err()
 }
 
 // MplsTunnelDump defines message 'mpls_tunnel_dump'.
@@ -923,7 +923,7 @@ func (m *MplsTunnelDump) Marshal(b []byte) ([]byte, error) {
 func (m *MplsTunnelDump) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.SwIfIndex = interface_types.InterfaceIndex(buf.DecodeUint32())
-	return nil
+	return buf.Err()
 }
 
 // SwInterfaceSetMplsEnable defines message 'sw_interface_set_mpls_enable'.
@@ -960,7 +960,7 @@ func (m *SwInterfaceSetMplsEnable) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.SwIfIndex = interface_types.InterfaceIndex(buf.DecodeUint32())
 	m.Enable = buf.DecodeBool()
-	return nil
+	return buf.Err()
 }
 
 // SwInterfaceSetMplsEnableReply defines message 'sw_interface_set_mpls_enable_reply'.
@@ -995,7 +995,7 @@ func (m *SwInterfaceSetMplsEnableReply) Marshal(b []byte) ([]byte, error) {
 func (m *SwInterfaceSetMplsEnableReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
-	return nil
+	return buf.Err()
 }
 
 func init() { file_mpls_binapi_init() }
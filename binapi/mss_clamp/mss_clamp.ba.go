@@ -126,7 +126,7 @@ func (m *MssClampDetails) Unmarshal(b []byte) error {
 	m.IPv6Mss = buf.DecodeUint16()
 	m.IPv4Direction = MssClampDir(buf.DecodeUint8())
 	m.IPv6Direction = MssClampDir(buf.DecodeUint8())
-	return nil
+	return buf.Err()
 }
 
 // MssClampEnableDisable defines message 'mss_clamp_enable_disable'.
@@ -175,7 +175,7 @@ func (m *MssClampEnableDisable) Unmarshal(b []byte) error {
 	m.IPv6Mss = buf.DecodeUint16()
 	m.IPv4Direction = MssClampDir(buf.DecodeUint8())
 	m.IPv6Direction = MssClampDir(buf.DecodeUint8())
-	return nil
+	return buf.Err()
 }
 
 // MssClampEnableDisableReply defines message 'mss_clamp_enable_disable_reply'.
@@ -208,7 +208,7 @@ func (m *MssClampEnableDisableReply) Marshal(b []byte) ([]byte, error) {
 func (m *MssClampEnableDisableReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
-	return nil
+	return buf.Err()
 }
 
 // MssClampGet defines message 'mss_clamp_get'.
@@ -245,7 +245,7 @@ func (m *MssClampGet) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Cursor = buf.DecodeUint32()
 	m.SwIfIndex = interface_types.InterfaceIndex(buf.DecodeUint32())
-	return nil
+	return buf.Err()
 }
 
 // MssClampGetReply defines message 'mss_clamp_get_reply'.
@@ -282,7 +282,7 @@ func (m *MssClampGetReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
 	m.Cursor = buf.DecodeUint32()
-	return nil
+	return buf.Err()
 }
 
 func init() { file_mss_clamp_binapi_init() }
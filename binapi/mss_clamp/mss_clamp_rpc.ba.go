@@ -30,7 +30,7 @@ func (c *serviceClient) MssClampEnableDisable(ctx context.Context, in *MssClampE
 	if err != nil {
 		return nil, err
 	}
-	return out, api.RetvalToVPPApiError(out.Retval)
+	return out, api.RetvalToRequestError(in, out.Retval)
 }
 
 func (c *serviceClient) MssClampGet(ctx context.Context, in *MssClampGet) (RPCService_MssClampGetClient, error) {
@@ -63,7 +63,7 @@ func (c *serviceClient_MssClampGetClient) Recv() (*MssClampDetails, *MssClampGet
 	case *MssClampDetails:
 		return m, nil, nil
 	case *MssClampGetReply:
-		if err := api.RetvalToVPPApiError(m.Retval); err != nil {
+		if err := api.RetvalToRequestError((*MssClampGet)(nil), m.Retval); err != nil {
 			return nil, nil, err
 		}
 		err = c.Stream.Close()
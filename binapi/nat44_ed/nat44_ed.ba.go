@@ -143,7 +143,7 @@ func (m *Nat44AddDelAddressRange) Unmarshal(b []byte) error {
 	m.VrfID = buf.DecodeUint32()
 	m.IsAdd = buf.DecodeBool()
 	m.Flags = nat_types.NatConfigFlags(buf.DecodeUint8())
-	return nil
+	return buf.Err()
 }
 
 // Nat44AddDelAddressRangeReply defines message 'nat44_add_del_address_range_reply'.
@@ -178,7 +178,7 @@ func (m *Nat44AddDelAddressRangeReply) Marshal(b []byte) ([]byte, error) {
 func (m *Nat44AddDelAddressRangeReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
-	return nil
+	return buf.Err()
 }
 
 // Nat44AddDelIdentityMapping defines message 'nat44_add_del_identity_mapping'.
@@ -239,7 +239,7 @@ func (m *Nat44AddDelIdentityMapping) Unmarshal(b []byte) error {
 	m.SwIfIndex = interface_types.InterfaceIndex(buf.DecodeUint32())
 	m.VrfID = buf.DecodeUint32()
 	m.Tag = buf.DecodeString(64)
-	return nil
+	return buf.Err()
 }
 
 // Nat44AddDelIdentityMappingReply defines message 'nat44_add_del_identity_mapping_reply'.
@@ -274,7 +274,7 @@ func (m *Nat44AddDelIdentityMappingReply) Marshal(b []byte) ([]byte, error) {
 func (m *Nat44AddDelIdentityMappingReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
-	return nil
+	return buf.Err()
 }
 
 // Nat44AddDelInterfaceAddr defines message 'nat44_add_del_interface_addr'.
@@ -315,7 +315,7 @@ func (m *Nat44AddDelInterfaceAddr) Unmarshal(b []byte) error {
 	m.IsAdd = buf.DecodeBool()
 	m.SwIfIndex = interface_types.InterfaceIndex(buf.DecodeUint32())
 	m.Flags = nat_types.NatConfigFlags(buf.DecodeUint8())
-	return nil
+	return buf.Err()
 }
 
 // Nat44AddDelInterfaceAddrReply defines message 'nat44_add_del_interface_addr_reply'.
@@ -350,7 +350,7 @@ func (m *Nat44AddDelInterfaceAddrReply) Marshal(b []byte) ([]byte, error) {
 func (m *Nat44AddDelInterfaceAddrReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
-	return nil
+	return buf.Err()
 }
 
 // Nat44AddDelLbStaticMapping defines message 'nat44_add_del_lb_static_mapping'.
@@ -433,14 +433,14 @@ func (m *Nat44AddDelLbStaticMapping) Unmarshal(b []byte) error {
 	m.Affinity = buf.DecodeUint32()
 	m.Tag = buf.DecodeString(64)
 	m.LocalNum = buf.DecodeUint32()
-	m.Locals = make([]Nat44LbAddrPort, m.LocalNum)
+	m.Locals = make([]Nat44LbAddrPort, buf.CheckArrayLen(int(m.LocalNum), 1))
 	for j0 := 0; j0 < len(m.Locals); j0++ {
 		copy(m.Locals[j0].Addr[:], buf.DecodeBytes(4))
 		m.Locals[j0].Port = buf.DecodeUint16()
 		m.Locals[j0].Probability = buf.DecodeUint8()
 		m.Locals[j0].VrfID = buf.DecodeUint32()
 	}
-	return nil
+	return buf.Err()
 }
 
 // Nat44AddDelLbStaticMappingReply defines message 'nat44_add_del_lb_static_mapping_reply'.
@@ -475,7 +475,7 @@ func (m *Nat44AddDelLbStaticMappingReply) Marshal(b []byte) ([]byte, error) {
 func (m *Nat44AddDelLbStaticMappingReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
-	return nil
+	return buf.Err()
 }
 
 // Nat44AddDelStaticMapping defines message 'nat44_add_del_static_mapping'.
@@ -544,7 +544,7 @@ func (m *Nat44AddDelStaticMapping) Unmarshal(b []byte) error {
 	m.ExternalSwIfIndex = interface_types.InterfaceIndex(buf.DecodeUint32())
 	m.VrfID = buf.DecodeUint32()
 	m.Tag = buf.DecodeString(64)
-	return nil
+	return buf.Err()
 }
 
 // Nat44AddDelStaticMappingReply defines message 'nat44_add_del_static_mapping_reply'.
@@ -579,7 +579,7 @@ func (m *Nat44AddDelStaticMappingReply) Marshal(b []byte) ([]byte, error) {
 func (m *Nat44AddDelStaticMappingReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
-	return nil
+	return buf.Err()
 }
 
 // Nat44AddDelStaticMappingV2 defines message 'nat44_add_del_static_mapping_v2'.
@@ -656,7 +656,7 @@ func (m *Nat44AddDelStaticMappingV2) Unmarshal(b []byte) error {
 	m.ExternalSwIfIndex = interface_types.InterfaceIndex(buf.DecodeUint32())
 	m.VrfID = buf.DecodeUint32()
 	m.Tag = buf.DecodeString(64)
-	return nil
+	return buf.Err()
 }
 
 // Nat44AddDelStaticMappingV2Reply defines message 'nat44_add_del_static_mapping_v2_reply'.
@@ -691,7 +691,7 @@ func (m *Nat44AddDelStaticMappingV2Reply) Marshal(b []byte) ([]byte, error) {
 func (m *Nat44AddDelStaticMappingV2Reply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
-	return nil
+	return buf.Err()
 }
 
 // Nat44AddressDetails defines message 'nat44_address_details'.
@@ -732,7 +732,7 @@ func (m *Nat44AddressDetails) Unmarshal(b []byte) error {
 	copy(m.IPAddress[:], buf.DecodeBytes(4))
 	m.Flags = nat_types.NatConfigFlags(buf.DecodeUint8())
 	m.VrfID = buf.DecodeUint32()
-	return nil
+	return buf.Err()
 }
 
 // Nat44AddressDump defines message 'nat44_address_dump'.
@@ -816,7 +816,7 @@ func (m *Nat44DelSession) Unmarshal(b []byte) error {
 	m.Flags = nat_types.NatConfigFlags(buf.DecodeUint8())
 	copy(m.ExtHostAddress[:], buf.DecodeBytes(4))
 	m.ExtHostPort = buf.DecodeUint16()
-	return nil
+	return buf.Err()
 }
 
 // Nat44DelSessionReply defines message 'nat44_del_session_reply'.
@@ -849,7 +849,7 @@ func (m *Nat44DelSessionReply) Marshal(b []byte) ([]byte, error) {
 func (m *Nat44DelSessionReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
-	return nil
+	return buf.Err()
 }
 
 // Nat44DelUser defines message 'nat44_del_user'.
@@ -887,7 +887,7 @@ func (m *Nat44DelUser) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	copy(m.IPAddress[:], buf.DecodeBytes(4))
 	m.FibIndex = buf.DecodeUint32()
-	return nil
+	return buf.Err()
 }
 
 // Nat44DelUserReply defines message 'nat44_del_user_reply'.
@@ -921,7 +921,7 @@ func (m *Nat44DelUserReply) Marshal(b []byte) ([]byte, error) {
 func (m *Nat44DelUserReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
-	return nil
+	return buf.Err()
 }
 
 // Nat44EdAddDelOutputInterface defines message 'nat44_ed_add_del_output_interface'.
@@ -960,7 +960,7 @@ func (m *Nat44EdAddDelOutputInterface) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.IsAdd = buf.DecodeBool()
 	m.SwIfIndex = interface_types.InterfaceIndex(buf.DecodeUint32())
-	return nil
+	return buf.Err()
 }
 
 // Nat44EdAddDelOutputInterfaceReply defines message 'nat44_ed_add_del_output_interface_reply'.
@@ -995,7 +995,7 @@ func (m *Nat44EdAddDelOutputInterfaceReply) Marshal(b []byte) ([]byte, error) {
 func (m *Nat44EdAddDelOutputInterfaceReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
-	return nil
+	return buf.Err()
 }
 
 // Nat44EdAddDelVrfRoute defines message 'nat44_ed_add_del_vrf_route'.
@@ -1036,7 +1036,7 @@ func (m *Nat44EdAddDelVrfRoute) Unmarshal(b []byte) error {
 	m.TableVrfID = buf.DecodeUint32()
 	m.VrfID = buf.DecodeUint32()
 	m.IsAdd = buf.DecodeBool()
-	return nil
+	return buf.Err()
 }
 
 // Nat44EdAddDelVrfRouteReply defines message 'nat44_ed_add_del_vrf_route_reply'.
@@ -1069,7 +1069,7 @@ func (m *Nat44EdAddDelVrfRouteReply) Marshal(b []byte) ([]byte, error) {
 func (m *Nat44EdAddDelVrfRouteReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
-	return nil
+	return buf.Err()
 }
 
 // Nat44EdAddDelVrfTable defines message 'nat44_ed_add_del_vrf_table'.
@@ -1106,7 +1106,7 @@ func (m *Nat44EdAddDelVrfTable) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.TableVrfID = buf.DecodeUint32()
 	m.IsAdd = buf.DecodeBool()
-	return nil
+	return buf.Err()
 }
 
 // Nat44EdAddDelVrfTableReply defines message 'nat44_ed_add_del_vrf_table_reply'.
@@ -1139,7 +1139,7 @@ func (m *Nat44EdAddDelVrfTableReply) Marshal(b []byte) ([]byte, error) {
 func (m *Nat44EdAddDelVrfTableReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
-	return nil
+	return buf.Err()
 }
 
 // Nat44EdOutputInterfaceDetails defines message 'nat44_ed_output_interface_details'.
@@ -1174,7 +1174,7 @@ func (m *Nat44EdOutputInterfaceDetails) Marshal(b []byte) ([]byte, error) {
 func (m *Nat44EdOutputInterfaceDetails) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.SwIfIndex = interface_types.InterfaceIndex(buf.DecodeUint32())
-	return nil
+	return buf.Err()
 }
 
 // Nat44EdOutputInterfaceGet defines message 'nat44_ed_output_interface_get'.
@@ -1207,7 +1207,7 @@ func (m *Nat44EdOutputInterfaceGet) Marshal(b []byte) ([]byte, error) {
 func (m *Nat44EdOutputInterfaceGet) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Cursor = buf.DecodeUint32()
-	return nil
+	return buf.Err()
 }
 
 // Nat44EdOutputInterfaceGetReply defines message 'nat44_ed_output_interface_get_reply'.
@@ -1246,7 +1246,7 @@ func (m *Nat44EdOutputInterfaceGetReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
 	m.Cursor = buf.DecodeUint32()
-	return nil
+	return buf.Err()
 }
 
 // Nat44EdPluginEnableDisable defines message 'nat44_ed_plugin_enable_disable'.
@@ -1299,7 +1299,7 @@ func (m *Nat44EdPluginEnableDisable) Unmarshal(b []byte) error {
 	m.SessionMemory = buf.DecodeUint32()
 	m.Enable = buf.DecodeBool()
 	m.Flags = Nat44ConfigFlags(buf.DecodeUint8())
-	return nil
+	return buf.Err()
 }
 
 // Nat44EdPluginEnableDisableReply defines message 'nat44_ed_plugin_enable_disable_reply'.
@@ -1334,7 +1334,7 @@ func (m *Nat44EdPluginEnableDisableReply) Marshal(b []byte) ([]byte, error) {
 func (m *Nat44EdPluginEnableDisableReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
-	return nil
+	return buf.Err()
 }
 
 // Nat44EdSetFqOptions defines message 'nat44_ed_set_fq_options'.
@@ -1367,7 +1367,7 @@ func (m *Nat44EdSetFqOptions) Marshal(b []byte) ([]byte, error) {
 func (m *Nat44EdSetFqOptions) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.FrameQueueNelts = buf.DecodeUint32()
-	return nil
+	return buf.Err()
 }
 
 // Nat44EdSetFqOptionsReply defines message 'nat44_ed_set_fq_options_reply'.
@@ -1400,7 +1400,7 @@ func (m *Nat44EdSetFqOptionsReply) Marshal(b []byte) ([]byte, error) {
 func (m *Nat44EdSetFqOptionsReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
-	return nil
+	return buf.Err()
 }
 
 // Nat44EdShowFqOptions defines message 'nat44_ed_show_fq_options'.
@@ -1464,7 +1464,7 @@ func (m *Nat44EdShowFqOptionsReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
 	m.FrameQueueNelts = buf.DecodeUint32()
-	return nil
+	return buf.Err()
 }
 
 // Nat44EdVrfTablesDetails defines message 'nat44_ed_vrf_tables_details'.
@@ -1510,11 +1510,11 @@ func (m *Nat44EdVrfTablesDetails) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.TableVrfID = buf.DecodeUint32()
 	m.NVrfIds = buf.DecodeUint32()
-	m.VrfIds = make([]uint32, m.NVrfIds)
+	m.VrfIds = make([]uint32, buf.CheckArrayLen(int(m.NVrfIds), 4))
 	for i := 0; i < len(m.VrfIds); i++ {
 		m.VrfIds[i] = buf.DecodeUint32()
 	}
-	return nil
+	return buf.Err()
 }
 
 // Nat44EdVrfTablesDump defines message 'nat44_ed_vrf_tables_dump'.
@@ -1577,7 +1577,7 @@ func (m *Nat44ForwardingEnableDisable) Marshal(b []byte) ([]byte, error) {
 func (m *Nat44ForwardingEnableDisable) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Enable = buf.DecodeBool()
-	return nil
+	return buf.Err()
 }
 
 // Nat44ForwardingEnableDisableReply defines message 'nat44_forwarding_enable_disable_reply'.
@@ -1613,7 +1613,7 @@ func (m *Nat44ForwardingEnableDisableReply) Marshal(b []byte) ([]byte, error) {
 func (m *Nat44ForwardingEnableDisableReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
-	return nil
+	return buf.Err()
 }
 
 // Nat44IdentityMappingDetails defines message 'nat44_identity_mapping_details'.
@@ -1670,7 +1670,7 @@ func (m *Nat44IdentityMappingDetails) Unmarshal(b []byte) error {
 	m.SwIfIndex = interface_types.InterfaceIndex(buf.DecodeUint32())
 	m.VrfID = buf.DecodeUint32()
 	m.Tag = buf.DecodeString(64)
-	return nil
+	return buf.Err()
 }
 
 // Nat44IdentityMappingDump defines message 'nat44_identity_mapping_dump'.
@@ -1738,7 +1738,7 @@ func (m *Nat44InterfaceAddDelFeature) Unmarshal(b []byte) error {
 	m.IsAdd = buf.DecodeBool()
 	m.Flags = nat_types.NatConfigFlags(buf.DecodeUint8())
 	m.SwIfIndex = interface_types.InterfaceIndex(buf.DecodeUint32())
-	return nil
+	return buf.Err()
 }
 
 // Nat44InterfaceAddDelFeatureReply defines message 'nat44_interface_add_del_feature_reply'.
@@ -1773,7 +1773,7 @@ func (m *Nat44InterfaceAddDelFeatureReply) Marshal(b []byte) ([]byte, error) {
 func (m *Nat44InterfaceAddDelFeatureReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
-	return nil
+	return buf.Err()
 }
 
 // Nat44InterfaceAddrDetails defines message 'nat44_interface_addr_details'.
@@ -1810,7 +1810,7 @@ func (m *Nat44InterfaceAddrDetails) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.SwIfIndex = interface_types.InterfaceIndex(buf.DecodeUint32())
 	m.Flags = nat_types.NatConfigFlags(buf.DecodeUint8())
-	return nil
+	return buf.Err()
 }
 
 // Nat44InterfaceAddrDump defines message 'nat44_interface_addr_dump'.
@@ -1874,7 +1874,7 @@ func (m *Nat44InterfaceDetails) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Flags = nat_types.NatConfigFlags(buf.DecodeUint8())
 	m.SwIfIndex = interface_types.InterfaceIndex(buf.DecodeUint32())
-	return nil
+	return buf.Err()
 }
 
 // Nat44InterfaceDump defines message 'nat44_interface_dump'.
@@ -1961,7 +1961,7 @@ func (m *Nat44LbStaticMappingAddDelLocal) Unmarshal(b []byte) error {
 	m.Local.Port = buf.DecodeUint16()
 	m.Local.Probability = buf.DecodeUint8()
 	m.Local.VrfID = buf.DecodeUint32()
-	return nil
+	return buf.Err()
 }
 
 // Nat44LbStaticMappingAddDelLocalReply defines message 'nat44_lb_static_mapping_add_del_local_reply'.
@@ -1996,7 +1996,7 @@ func (m *Nat44LbStaticMappingAddDelLocalReply) Marshal(b []byte) ([]byte, error)
 func (m *Nat44LbStaticMappingAddDelLocalReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
-	return nil
+	return buf.Err()
 }
 
 // Nat44LbStaticMappingDetails defines message 'nat44_lb_static_mapping_details'.
@@ -2075,14 +2075,14 @@ func (m *Nat44LbStaticMappingDetails) Unmarshal(b []byte) error {
 	m.Affinity = buf.DecodeUint32()
 	m.Tag = buf.DecodeString(64)
 	m.LocalNum = buf.DecodeUint32()
-	m.Locals = make([]Nat44LbAddrPort, m.LocalNum)
+	m.Locals = make([]Nat44LbAddrPort, buf.CheckArrayLen(int(m.LocalNum), 1))
 	for j0 := 0; j0 < len(m.Locals); j0++ {
 		copy(m.Locals[j0].Addr[:], buf.DecodeBytes(4))
 		m.Locals[j0].Port = buf.DecodeUint16()
 		m.Locals[j0].Probability = buf.DecodeUint8()
 		m.Locals[j0].VrfID = buf.DecodeUint32()
 	}
-	return nil
+	return buf.Err()
 }
 
 // Nat44LbStaticMappingDump defines message 'nat44_lb_static_mapping_dump'.
@@ -2146,7 +2146,7 @@ func (m *Nat44SetSessionLimit) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.SessionLimit = buf.DecodeUint32()
 	m.VrfID = buf.DecodeUint32()
-	return nil
+	return buf.Err()
 }
 
 // Nat44SetSessionLimitReply defines message 'nat44_set_session_limit_reply'.
@@ -2179,7 +2179,7 @@ func (m *Nat44SetSessionLimitReply) Marshal(b []byte) ([]byte, error) {
 func (m *Nat44SetSessionLimitReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
-	return nil
+	return buf.Err()
 }
 
 // Nat44ShowRunningConfig defines message 'nat44_show_running_config'.
@@ -2296,7 +2296,7 @@ func (m *Nat44ShowRunningConfigReply) Unmarshal(b []byte) error {
 	m.Timeouts.ICMP = buf.DecodeUint32()
 	m.LogLevel = nat_types.NatLogLevel(buf.DecodeUint8())
 	m.Flags = Nat44ConfigFlags(buf.DecodeUint8())
-	return nil
+	return buf.Err()
 }
 
 // Nat44StaticMappingDetails defines message 'nat44_static_mapping_details'.
@@ -2361,7 +2361,7 @@ func (m *Nat44StaticMappingDetails) Unmarshal(b []byte) error {
 	m.ExternalSwIfIndex = interface_types.InterfaceIndex(buf.DecodeUint32())
 	m.VrfID = buf.DecodeUint32()
 	m.Tag = buf.DecodeString(64)
-	return nil
+	return buf.Err()
 }
 
 // Nat44StaticMappingDump defines message 'nat44_static_mapping_dump'.
@@ -2433,7 +2433,7 @@ func (m *Nat44UserDetails) Unmarshal(b []byte) error {
 	copy(m.IPAddress[:], buf.DecodeBytes(4))
 	m.Nsessions = buf.DecodeUint32()
 	m.Nstaticsessions = buf.DecodeUint32()
-	return nil
+	return buf.Err()
 }
 
 // Nat44UserDump defines message 'nat44_user_dump'.
@@ -2541,7 +2541,7 @@ func (m *Nat44UserSessionDetails) Unmarshal(b []byte) error {
 	m.ExtHostPort = buf.DecodeUint16()
 	copy(m.ExtHostNatAddress[:], buf.DecodeBytes(4))
 	m.ExtHostNatPort = buf.DecodeUint16()
-	return nil
+	return buf.Err()
 }
 
 // Nat44UserSessionDump defines message 'nat44_user_session_dump'.
@@ -2578,7 +2578,7 @@ func (m *Nat44UserSessionDump) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	copy(m.IPAddress[:], buf.DecodeBytes(4))
 	m.VrfID = buf.DecodeUint32()
-	return nil
+	return buf.Err()
 }
 
 // Nat44UserSessionV2Details defines message 'nat44_user_session_v2_details'.
@@ -2663,7 +2663,7 @@ func (m *Nat44UserSessionV2Details) Unmarshal(b []byte) error {
 	copy(m.ExtHostNatAddress[:], buf.DecodeBytes(4))
 	m.ExtHostNatPort = buf.DecodeUint16()
 	m.IsTimedOut = buf.DecodeBool()
-	return nil
+	return buf.Err()
 }
 
 // Nat44UserSessionV2Dump defines message 'nat44_user_session_v2_dump'.
@@ -2700,7 +2700,7 @@ func (m *Nat44UserSessionV2Dump) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	copy(m.IPAddress[:], buf.DecodeBytes(4))
 	m.VrfID = buf.DecodeUint32()
-	return nil
+	return buf.Err()
 }
 
 // NatGetAddrAndPortAllocAlg defines message 'nat_get_addr_and_port_alloc_alg'.
@@ -2788,7 +2788,7 @@ func (m *NatGetAddrAndPortAllocAlgReply) Unmarshal(b []byte) error {
 	m.Psid = buf.DecodeUint16()
 	m.StartPort = buf.DecodeUint16()
 	m.EndPort = buf.DecodeUint16()
-	return nil
+	return buf.Err()
 }
 
 // NatGetMssClamping defines message 'nat_get_mss_clamping'.
@@ -2856,7 +2856,7 @@ func (m *NatGetMssClampingReply) Unmarshal(b []byte) error {
 	m.Retval = buf.DecodeInt32()
 	m.MssValue = buf.DecodeUint16()
 	m.Enable = buf.DecodeBool()
-	return nil
+	return buf.Err()
 }
 
 // NatHaFlush defines message 'nat_ha_flush'.
@@ -2918,7 +2918,7 @@ func (m *NatHaFlushReply) Marshal(b []byte) ([]byte, error) {
 func (m *NatHaFlushReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
-	return nil
+	return buf.Err()
 }
 
 // NatHaGetFailover defines message 'nat_ha_get_failover'.
@@ -2992,7 +2992,7 @@ func (m *NatHaGetFailoverReply) Unmarshal(b []byte) error {
 	copy(m.IPAddress[:], buf.DecodeBytes(4))
 	m.Port = buf.DecodeUint16()
 	m.SessionRefreshInterval = buf.DecodeUint32()
-	return nil
+	return buf.Err()
 }
 
 // NatHaGetListener defines message 'nat_ha_get_listener'.
@@ -3066,7 +3066,7 @@ func (m *NatHaGetListenerReply) Unmarshal(b []byte) error {
 	copy(m.IPAddress[:], buf.DecodeBytes(4))
 	m.Port = buf.DecodeUint16()
 	m.PathMtu = buf.DecodeUint32()
-	return nil
+	return buf.Err()
 }
 
 // NatHaResync defines message 'nat_ha_resync'.
@@ -3104,7 +3104,7 @@ func (m *NatHaResync) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.WantResyncEvent = buf.DecodeUint8()
 	m.PID = buf.DecodeUint32()
-	return nil
+	return buf.Err()
 }
 
 // NatHaResyncCompletedEvent defines message 'nat_ha_resync_completed_event'.
@@ -3142,7 +3142,7 @@ func (m *NatHaResyncCompletedEvent) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.PID = buf.DecodeUint32()
 	m.MissedCount = buf.DecodeUint32()
-	return nil
+	return buf.Err()
 }
 
 // NatHaResyncReply defines message 'nat_ha_resync_reply'.
@@ -3176,7 +3176,7 @@ func (m *NatHaResyncReply) Marshal(b []byte) ([]byte, error) {
 func (m *NatHaResyncReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
-	return nil
+	return buf.Err()
 }
 
 // NatHaSetFailover defines message 'nat_ha_set_failover'.
@@ -3218,7 +3218,7 @@ func (m *NatHaSetFailover) Unmarshal(b []byte) error {
 	copy(m.IPAddress[:], buf.DecodeBytes(4))
 	m.Port = buf.DecodeUint16()
 	m.SessionRefreshInterval = buf.DecodeUint32()
-	return nil
+	return buf.Err()
 }
 
 // NatHaSetFailoverReply defines message 'nat_ha_set_failover_reply'.
@@ -3252,7 +3252,7 @@ func (m *NatHaSetFailoverReply) Marshal(b []byte) ([]byte, error) {
 func (m *NatHaSetFailoverReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
-	return nil
+	return buf.Err()
 }
 
 // NatHaSetListener defines message 'nat_ha_set_listener'.
@@ -3294,7 +3294,7 @@ func (m *NatHaSetListener) Unmarshal(b []byte) error {
 	copy(m.IPAddress[:], buf.DecodeBytes(4))
 	m.Port = buf.DecodeUint16()
 	m.PathMtu = buf.DecodeUint32()
-	return nil
+	return buf.Err()
 }
 
 // NatHaSetListenerReply defines message 'nat_ha_set_listener_reply'.
@@ -3328,7 +3328,7 @@ func (m *NatHaSetListenerReply) Marshal(b []byte) ([]byte, error) {
 func (m *NatHaSetListenerReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
-	return nil
+	return buf.Err()
 }
 
 // NatIpfixEnableDisable defines message 'nat_ipfix_enable_disable'.
@@ -3370,7 +3370,7 @@ func (m *NatIpfixEnableDisable) Unmarshal(b []byte) error {
 	m.DomainID = buf.DecodeUint32()
 	m.SrcPort = buf.DecodeUint16()
 	m.Enable = buf.DecodeBool()
-	return nil
+	return buf.Err()
 }
 
 // NatIpfixEnableDisableReply defines message 'nat_ipfix_enable_disable_reply'.
@@ -3404,7 +3404,7 @@ func (m *NatIpfixEnableDisableReply) Marshal(b []byte) ([]byte, error) {
 func (m *NatIpfixEnableDisableReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
-	return nil
+	return buf.Err()
 }
 
 // NatSetAddrAndPortAllocAlg defines message 'nat_set_addr_and_port_alloc_alg'.
@@ -3458,7 +3458,7 @@ func (m *NatSetAddrAndPortAllocAlg) Unmarshal(b []byte) error {
 	m.Psid = buf.DecodeUint16()
 	m.StartPort = buf.DecodeUint16()
 	m.EndPort = buf.DecodeUint16()
-	return nil
+	return buf.Err()
 }
 
 // NatSetAddrAndPortAllocAlgReply defines message 'nat_set_addr_and_port_alloc_alg_reply'.
@@ -3494,7 +3494,7 @@ func (m *NatSetAddrAndPortAllocAlgReply) Marshal(b []byte) ([]byte, error) {
 func (m *NatSetAddrAndPortAllocAlgReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
-	return nil
+	return buf.Err()
 }
 
 // NatSetMssClamping defines message 'nat_set_mss_clamping'.
@@ -3531,7 +3531,7 @@ func (m *NatSetMssClamping) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.MssValue = buf.DecodeUint16()
 	m.Enable = buf.DecodeBool()
-	return nil
+	return buf.Err()
 }
 
 // NatSetMssClampingReply defines message 'nat_set_mss_clamping_reply'.
@@ -3564,7 +3564,7 @@ func (m *NatSetMssClampingReply) Marshal(b []byte) ([]byte, error) {
 func (m *NatSetMssClampingReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
-	return nil
+	return buf.Err()
 }
 
 // NatSetTimeouts defines message 'nat_set_timeouts'.
@@ -3610,7 +3610,7 @@ func (m *NatSetTimeouts) Unmarshal(b []byte) error {
 	m.TCPEstablished = buf.DecodeUint32()
 	m.TCPTransitory = buf.DecodeUint32()
 	m.ICMP = buf.DecodeUint32()
-	return nil
+	return buf.Err()
 }
 
 // NatSetTimeoutsReply defines message 'nat_set_timeouts_reply'.
@@ -3644,7 +3644,7 @@ func (m *NatSetTimeoutsReply) Marshal(b []byte) ([]byte, error) {
 func (m *NatSetTimeoutsReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
-	return nil
+	return buf.Err()
 }
 
 // NatSetWorkers defines message 'nat_set_workers'.
@@ -3677,7 +3677,7 @@ func (m *NatSetWorkers) Marshal(b []byte) ([]byte, error) {
 func (m *NatSetWorkers) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.WorkerMask = buf.DecodeUint64()
-	return nil
+	return buf.Err()
 }
 
 // NatSetWorkersReply defines message 'nat_set_workers_reply'.
@@ -3710,7 +3710,7 @@ func (m *NatSetWorkersReply) Marshal(b []byte) ([]byte, error) {
 func (m *NatSetWorkersReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
-	return nil
+	return buf.Err()
 }
 
 // NatWorkerDetails defines message 'nat_worker_details'.
@@ -3751,7 +3751,7 @@ func (m *NatWorkerDetails) Unmarshal(b []byte) error {
 	m.WorkerIndex = buf.DecodeUint32()
 	m.LcoreID = buf.DecodeUint32()
 	m.Name = buf.DecodeString(64)
-	return nil
+	return buf.Err()
 }
 
 // NatWorkerDump defines message 'nat_worker_dump'.
@@ -140,7 +140,7 @@ func (m *Nat44EiAddDelAddressRange) Unmarshal(b []byte) error {
 	copy(m.LastIPAddress[:], buf.DecodeBytes(4))
 	m.VrfID = buf.DecodeUint32()
 	m.IsAdd = buf.DecodeBool()
-	return nil
+	return buf.Err()
 }
 
 // Nat44EiAddDelAddressRangeReply defines message 'nat44_ei_add_del_address_range_reply'.
@@ -176,7 +176,7 @@ func (m *Nat44EiAddDelAddressRangeReply) Marshal(b []byte) ([]byte, error) {
 func (m *Nat44EiAddDelAddressRangeReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
-	return nil
+	return buf.Err()
 }
 
 // Nat44EiAddDelIdentityMapping defines message 'nat44_ei_add_del_identity_mapping'.
@@ -240,7 +240,7 @@ func (m *Nat44EiAddDelIdentityMapping) Unmarshal(b []byte) error {
 	m.SwIfIndex = interface_types.InterfaceIndex(buf.DecodeUint32())
 	m.VrfID = buf.DecodeUint32()
 	m.Tag = buf.DecodeString(64)
-	return nil
+	return buf.Err()
 }
 
 // Nat44EiAddDelIdentityMappingReply defines message 'nat44_ei_add_del_identity_mapping_reply'.
@@ -276,7 +276,7 @@ func (m *Nat44EiAddDelIdentityMappingReply) Marshal(b []byte) ([]byte, error) {
 func (m *Nat44EiAddDelIdentityMappingReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
-	return nil
+	return buf.Err()
 }
 
 // Nat44EiAddDelInterfaceAddr defines message 'nat44_ei_add_del_interface_addr'.
@@ -318,7 +318,7 @@ func (m *Nat44EiAddDelInterfaceAddr) Unmarshal(b []byte) error {
 	m.IsAdd = buf.DecodeBool()
 	m.SwIfIndex = interface_types.InterfaceIndex(buf.DecodeUint32())
 	m.Flags = Nat44EiConfigFlags(buf.DecodeUint8())
-	return nil
+	return buf.Err()
 }
 
 // Nat44EiAddDelInterfaceAddrReply defines message 'nat44_ei_add_del_interface_addr_reply'.
@@ -354,7 +354,7 @@ func (m *Nat44EiAddDelInterfaceAddrReply) Marshal(b []byte) ([]byte, error) {
 func (m *Nat44EiAddDelInterfaceAddrReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
-	return nil
+	return buf.Err()
 }
 
 // Nat44EiAddDelOutputInterface defines message 'nat44_ei_add_del_output_interface'.
@@ -393,7 +393,7 @@ func (m *Nat44EiAddDelOutputInterface) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.IsAdd = buf.DecodeBool()
 	m.SwIfIndex = interface_types.InterfaceIndex(buf.DecodeUint32())
-	return nil
+	return buf.Err()
 }
 
 // Nat44EiAddDelOutputInterfaceReply defines message 'nat44_ei_add_del_output_interface_reply'.
@@ -428,7 +428,7 @@ func (m *Nat44EiAddDelOutputInterfaceReply) Marshal(b []byte) ([]byte, error) {
 func (m *Nat44EiAddDelOutputInterfaceReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
-	return nil
+	return buf.Err()
 }
 
 // Nat44EiAddDelStaticMapping defines message 'nat44_ei_add_del_static_mapping'.
@@ -498,7 +498,7 @@ func (m *Nat44EiAddDelStaticMapping) Unmarshal(b []byte) error {
 	m.ExternalSwIfIndex = interface_types.InterfaceIndex(buf.DecodeUint32())
 	m.VrfID = buf.DecodeUint32()
 	m.Tag = buf.DecodeString(64)
-	return nil
+	return buf.Err()
 }
 
 // Nat44EiAddDelStaticMappingReply defines message 'nat44_ei_add_del_static_mapping_reply'.
@@ -534,7 +534,7 @@ func (m *Nat44EiAddDelStaticMappingReply) Marshal(b []byte) ([]byte, error) {
 func (m *Nat44EiAddDelStaticMappingReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
-	return nil
+	return buf.Err()
 }
 
 // Nat44EiAddressDetails defines message 'nat44_ei_address_details'.
@@ -572,7 +572,7 @@ func (m *Nat44EiAddressDetails) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	copy(m.IPAddress[:], buf.DecodeBytes(4))
 	m.VrfID = buf.DecodeUint32()
-	return nil
+	return buf.Err()
 }
 
 // Nat44EiAddressDump defines message 'nat44_ei_address_dump'.
@@ -658,7 +658,7 @@ func (m *Nat44EiDelSession) Unmarshal(b []byte) error {
 	m.Flags = Nat44EiConfigFlags(buf.DecodeUint8())
 	copy(m.ExtHostAddress[:], buf.DecodeBytes(4))
 	m.ExtHostPort = buf.DecodeUint16()
-	return nil
+	return buf.Err()
 }
 
 // Nat44EiDelSessionReply defines message 'nat44_ei_del_session_reply'.
@@ -692,7 +692,7 @@ func (m *Nat44EiDelSessionReply) Marshal(b []byte) ([]byte, error) {
 func (m *Nat44EiDelSessionReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
-	return nil
+	return buf.Err()
 }
 
 // Nat44EiDelUser defines message 'nat44_ei_del_user'.
@@ -730,7 +730,7 @@ func (m *Nat44EiDelUser) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	copy(m.IPAddress[:], buf.DecodeBytes(4))
 	m.FibIndex = buf.DecodeUint32()
-	return nil
+	return buf.Err()
 }
 
 // Nat44EiDelUserReply defines message 'nat44_ei_del_user_reply'.
@@ -764,7 +764,7 @@ func (m *Nat44EiDelUserReply) Marshal(b []byte) ([]byte, error) {
 func (m *Nat44EiDelUserReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
-	return nil
+	return buf.Err()
 }
 
 // Nat44EiForwardingEnableDisable defines message 'nat44_ei_forwarding_enable_disable'.
@@ -800,7 +800,7 @@ func (m *Nat44EiForwardingEnableDisable) Marshal(b []byte) ([]byte, error) {
 func (m *Nat44EiForwardingEnableDisable) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Enable = buf.DecodeBool()
-	return nil
+	return buf.Err()
 }
 
 // Nat44EiForwardingEnableDisableReply defines message 'nat44_ei_forwarding_enable_disable_reply'.
@@ -836,7 +836,7 @@ func (m *Nat44EiForwardingEnableDisableReply) Marshal(b []byte) ([]byte, error)
 func (m *Nat44EiForwardingEnableDisableReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
-	return nil
+	return buf.Err()
 }
 
 // Nat44EiGetAddrAndPortAllocAlg defines message 'nat44_ei_get_addr_and_port_alloc_alg'.
@@ -926,7 +926,7 @@ func (m *Nat44EiGetAddrAndPortAllocAlgReply) Unmarshal(b []byte) error {
 	m.Psid = buf.DecodeUint16()
 	m.StartPort = buf.DecodeUint16()
 	m.EndPort = buf.DecodeUint16()
-	return nil
+	return buf.Err()
 }
 
 // Nat44EiGetMssClamping defines message 'nat44_ei_get_mss_clamping'.
@@ -996,7 +996,7 @@ func (m *Nat44EiGetMssClampingReply) Unmarshal(b []byte) error {
 	m.Retval = buf.DecodeInt32()
 	m.MssValue = buf.DecodeUint16()
 	m.Enable = buf.DecodeBool()
-	return nil
+	return buf.Err()
 }
 
 // Nat44EiHaFlush defines message 'nat44_ei_ha_flush'.
@@ -1058,7 +1058,7 @@ func (m *Nat44EiHaFlushReply) Marshal(b []byte) ([]byte, error) {
 func (m *Nat44EiHaFlushReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
-	return nil
+	return buf.Err()
 }
 
 // Nat44EiHaGetFailover defines message 'nat44_ei_ha_get_failover'.
@@ -1132,7 +1132,7 @@ func (m *Nat44EiHaGetFailoverReply) Unmarshal(b []byte) error {
 	copy(m.IPAddress[:], buf.DecodeBytes(4))
 	m.Port = buf.DecodeUint16()
 	m.SessionRefreshInterval = buf.DecodeUint32()
-	return nil
+	return buf.Err()
 }
 
 // Nat44EiHaGetListener defines message 'nat44_ei_ha_get_listener'.
@@ -1206,7 +1206,7 @@ func (m *Nat44EiHaGetListenerReply) Unmarshal(b []byte) error {
 	copy(m.IPAddress[:], buf.DecodeBytes(4))
 	m.Port = buf.DecodeUint16()
 	m.PathMtu = buf.DecodeUint32()
-	return nil
+	return buf.Err()
 }
 
 // Nat44EiHaResync defines message 'nat44_ei_ha_resync'.
@@ -1244,7 +1244,7 @@ func (m *Nat44EiHaResync) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.WantResyncEvent = buf.DecodeUint8()
 	m.PID = buf.DecodeUint32()
-	return nil
+	return buf.Err()
 }
 
 // Nat44EiHaResyncCompletedEvent defines message 'nat44_ei_ha_resync_completed_event'.
@@ -1284,7 +1284,7 @@ func (m *Nat44EiHaResyncCompletedEvent) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.PID = buf.DecodeUint32()
 	m.MissedCount = buf.DecodeUint32()
-	return nil
+	return buf.Err()
 }
 
 // Nat44EiHaResyncReply defines message 'nat44_ei_ha_resync_reply'.
@@ -1318,7 +1318,7 @@ func (m *Nat44EiHaResyncReply) Marshal(b []byte) ([]byte, error) {
 func (m *Nat44EiHaResyncReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
-	return nil
+	return buf.Err()
 }
 
 // Nat44EiHaSetFailover defines message 'nat44_ei_ha_set_failover'.
@@ -1360,7 +1360,7 @@ func (m *Nat44EiHaSetFailover) Unmarshal(b []byte) error {
 	copy(m.IPAddress[:], buf.DecodeBytes(4))
 	m.Port = buf.DecodeUint16()
 	m.SessionRefreshInterval = buf.DecodeUint32()
-	return nil
+	return buf.Err()
 }
 
 // Nat44EiHaSetFailoverReply defines message 'nat44_ei_ha_set_failover_reply'.
@@ -1394,7 +1394,7 @@ func (m *Nat44EiHaSetFailoverReply) Marshal(b []byte) ([]byte, error) {
 func (m *Nat44EiHaSetFailoverReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
-	return nil
+	return buf.Err()
 }
 
 // Nat44EiHaSetListener defines message 'nat44_ei_ha_set_listener'.
@@ -1436,7 +1436,7 @@ func (m *Nat44EiHaSetListener) Unmarshal(b []byte) error {
 	copy(m.IPAddress[:], buf.DecodeBytes(4))
 	m.Port = buf.DecodeUint16()
 	m.PathMtu = buf.DecodeUint32()
-	return nil
+	return buf.Err()
 }
 
 // Nat44EiHaSetListenerReply defines message 'nat44_ei_ha_set_listener_reply'.
@@ -1470,7 +1470,7 @@ func (m *Nat44EiHaSetListenerReply) Marshal(b []byte) ([]byte, error) {
 func (m *Nat44EiHaSetListenerReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
-	return nil
+	return buf.Err()
 }
 
 // Nat44EiIdentityMappingDetails defines message 'nat44_ei_identity_mapping_details'.
@@ -1530,7 +1530,7 @@ func (m *Nat44EiIdentityMappingDetails) Unmarshal(b []byte) error {
 	m.SwIfIndex = interface_types.InterfaceIndex(buf.DecodeUint32())
 	m.VrfID = buf.DecodeUint32()
 	m.Tag = buf.DecodeString(64)
-	return nil
+	return buf.Err()
 }
 
 // Nat44EiIdentityMappingDump defines message 'nat44_ei_identity_mapping_dump'.
@@ -1602,7 +1602,7 @@ func (m *Nat44EiInterfaceAddDelFeature) Unmarshal(b []byte) error {
 	m.IsAdd = buf.DecodeBool()
 	m.Flags = Nat44EiConfigFlags(buf.DecodeUint8())
 	m.SwIfIndex = interface_types.InterfaceIndex(buf.DecodeUint32())
-	return nil
+	return buf.Err()
 }
 
 // Nat44EiInterfaceAddDelFeatureReply defines message 'nat44_ei_interface_add_del_feature_reply'.
@@ -1638,7 +1638,7 @@ func (m *Nat44EiInterfaceAddDelFeatureReply) Marshal(b []byte) ([]byte, error) {
 func (m *Nat44EiInterfaceAddDelFeatureReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
-	return nil
+	return buf.Err()
 }
 
 // Nat44EiInterfaceAddDelOutputFeature defines message 'nat44_ei_interface_add_del_output_feature'.
@@ -1682,7 +1682,7 @@ func (m *Nat44EiInterfaceAddDelOutputFeature) Unmarshal(b []byte) error {
 	m.IsAdd = buf.DecodeBool()
 	m.Flags = Nat44EiConfigFlags(buf.DecodeUint8())
 	m.SwIfIndex = interface_types.InterfaceIndex(buf.DecodeUint32())
-	return nil
+	return buf.Err()
 }
 
 // Nat44EiInterfaceAddDelOutputFeatureReply defines message 'nat44_ei_interface_add_del_output_feature_reply'.
@@ -1720,7 +1720,7 @@ func (m *Nat44EiInterfaceAddDelOutputFeatureReply) Marshal(b []byte) ([]byte, er
 func (m *Nat44EiInterfaceAddDelOutputFeatureReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
-	return nil
+	return buf.Err()
 }
 
 // Nat44EiInterfaceAddrDetails defines message 'nat44_ei_interface_addr_details'.
@@ -1754,7 +1754,7 @@ func (m *Nat44EiInterfaceAddrDetails) Marshal(b []byte) ([]byte, error) {
 func (m *Nat44EiInterfaceAddrDetails) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.SwIfIndex = interface_types.InterfaceIndex(buf.DecodeUint32())
-	return nil
+	return buf.Err()
 }
 
 // Nat44EiInterfaceAddrDump defines message 'nat44_ei_interface_addr_dump'.
@@ -1820,7 +1820,7 @@ func (m *Nat44EiInterfaceDetails) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Flags = Nat44EiConfigFlags(buf.DecodeUint8())
 	m.SwIfIndex = interface_types.InterfaceIndex(buf.DecodeUint32())
-	return nil
+	return buf.Err()
 }
 
 // Nat44EiInterfaceDump defines message 'nat44_ei_interface_dump'.
@@ -1888,7 +1888,7 @@ func (m *Nat44EiInterfaceOutputFeatureDetails) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Flags = Nat44EiConfigFlags(buf.DecodeUint8())
 	m.SwIfIndex = interface_types.InterfaceIndex(buf.DecodeUint32())
-	return nil
+	return buf.Err()
 }
 
 // Nat44EiInterfaceOutputFeatureDump defines message 'nat44_ei_interface_output_feature_dump'.
@@ -1960,7 +1960,7 @@ func (m *Nat44EiIpfixEnableDisable) Unmarshal(b []byte) error {
 	m.DomainID = buf.DecodeUint32()
 	m.SrcPort = buf.DecodeUint16()
 	m.Enable = buf.DecodeBool()
-	return nil
+	return buf.Err()
 }
 
 // Nat44EiIpfixEnableDisableReply defines message 'nat44_ei_ipfix_enable_disable_reply'.
@@ -1996,7 +1996,7 @@ func (m *Nat44EiIpfixEnableDisableReply) Marshal(b []byte) ([]byte, error) {
 func (m *Nat44EiIpfixEnableDisableReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
-	return nil
+	return buf.Err()
 }
 
 // Nat44EiOutputInterfaceDetails defines message 'nat44_ei_output_interface_details'.
@@ -2031,7 +2031,7 @@ func (m *Nat44EiOutputInterfaceDetails) Marshal(b []byte) ([]byte, error) {
 func (m *Nat44EiOutputInterfaceDetails) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.SwIfIndex = interface_types.InterfaceIndex(buf.DecodeUint32())
-	return nil
+	return buf.Err()
 }
 
 // Nat44EiOutputInterfaceGet defines message 'nat44_ei_output_interface_get'.
@@ -2064,7 +2064,7 @@ func (m *Nat44EiOutputInterfaceGet) Marshal(b []byte) ([]byte, error) {
 func (m *Nat44EiOutputInterfaceGet) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Cursor = buf.DecodeUint32()
-	return nil
+	return buf.Err()
 }
 
 // Nat44EiOutputInterfaceGetReply defines message 'nat44_ei_output_interface_get_reply'.
@@ -2103,7 +2103,7 @@ func (m *Nat44EiOutputInterfaceGetReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
 	m.Cursor = buf.DecodeUint32()
-	return nil
+	return buf.Err()
 }
 
 // Nat44EiPluginEnableDisable defines message 'nat44_ei_plugin_enable_disable'.
@@ -2169,7 +2169,7 @@ func (m *Nat44EiPluginEnableDisable) Unmarshal(b []byte) error {
 	m.UserSessions = buf.DecodeUint32()
 	m.Enable = buf.DecodeBool()
 	m.Flags = Nat44EiConfigFlags(buf.DecodeUint8())
-	return nil
+	return buf.Err()
 }
 
 // Nat44EiPluginEnableDisableReply defines message 'nat44_ei_plugin_enable_disable_reply'.
@@ -2205,7 +2205,7 @@ func (m *Nat44EiPluginEnableDisableReply) Marshal(b []byte) ([]byte, error) {
 func (m *Nat44EiPluginEnableDisableReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
-	return nil
+	return buf.Err()
 }
 
 // Nat44EiSetAddrAndPortAllocAlg defines message 'nat44_ei_set_addr_and_port_alloc_alg'.
@@ -2261,7 +2261,7 @@ func (m *Nat44EiSetAddrAndPortAllocAlg) Unmarshal(b []byte) error {
 	m.Psid = buf.DecodeUint16()
 	m.StartPort = buf.DecodeUint16()
 	m.EndPort = buf.DecodeUint16()
-	return nil
+	return buf.Err()
 }
 
 // Nat44EiSetAddrAndPortAllocAlgReply defines message 'nat44_ei_set_addr_and_port_alloc_alg_reply'.
@@ -2297,7 +2297,7 @@ func (m *Nat44EiSetAddrAndPortAllocAlgReply) Marshal(b []byte) ([]byte, error) {
 func (m *Nat44EiSetAddrAndPortAllocAlgReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
-	return nil
+	return buf.Err()
 }
 
 // Nat44EiSetFqOptions defines message 'nat44_ei_set_fq_options'.
@@ -2331,7 +2331,7 @@ func (m *Nat44EiSetFqOptions) Marshal(b []byte) ([]byte, error) {
 func (m *Nat44EiSetFqOptions) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.FrameQueueNelts = buf.DecodeUint32()
-	return nil
+	return buf.Err()
 }
 
 // Nat44EiSetFqOptionsReply defines message 'nat44_ei_set_fq_options_reply'.
@@ -2365,7 +2365,7 @@ func (m *Nat44EiSetFqOptionsReply) Marshal(b []byte) ([]byte, error) {
 func (m *Nat44EiSetFqOptionsReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
-	return nil
+	return buf.Err()
 }
 
 // Nat44EiSetLogLevel defines message 'nat44_ei_set_log_level'.
@@ -2399,7 +2399,7 @@ func (m *Nat44EiSetLogLevel) Marshal(b []byte) ([]byte, error) {
 func (m *Nat44EiSetLogLevel) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.LogLevel = nat_types.NatLogLevel(buf.DecodeUint8())
-	return nil
+	return buf.Err()
 }
 
 // Nat44EiSetLogLevelReply defines message 'nat44_ei_set_log_level_reply'.
@@ -2433,7 +2433,7 @@ func (m *Nat44EiSetLogLevelReply) Marshal(b []byte) ([]byte, error) {
 func (m *Nat44EiSetLogLevelReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
-	return nil
+	return buf.Err()
 }
 
 // Nat44EiSetMssClamping defines message 'nat44_ei_set_mss_clamping'.
@@ -2471,7 +2471,7 @@ func (m *Nat44EiSetMssClamping) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.MssValue = buf.DecodeUint16()
 	m.Enable = buf.DecodeBool()
-	return nil
+	return buf.Err()
 }
 
 // Nat44EiSetMssClampingReply defines message 'nat44_ei_set_mss_clamping_reply'.
@@ -2505,7 +2505,7 @@ func (m *Nat44EiSetMssClampingReply) Marshal(b []byte) ([]byte, error) {
 func (m *Nat44EiSetMssClampingReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
-	return nil
+	return buf.Err()
 }
 
 // Nat44EiSetTimeouts defines message 'nat44_ei_set_timeouts'.
@@ -2551,7 +2551,7 @@ func (m *Nat44EiSetTimeouts) Unmarshal(b []byte) error {
 	m.TCPEstablished = buf.DecodeUint32()
 	m.TCPTransitory = buf.DecodeUint32()
 	m.ICMP = buf.DecodeUint32()
-	return nil
+	return buf.Err()
 }
 
 // Nat44EiSetTimeoutsReply defines message 'nat44_ei_set_timeouts_reply'.
@@ -2585,7 +2585,7 @@ func (m *Nat44EiSetTimeoutsReply) Marshal(b []byte) ([]byte, error) {
 func (m *Nat44EiSetTimeoutsReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
-	return nil
+	return buf.Err()
 }
 
 // Nat44EiSetWorkers defines message 'nat44_ei_set_workers'.
@@ -2619,7 +2619,7 @@ func (m *Nat44EiSetWorkers) Marshal(b []byte) ([]byte, error) {
 func (m *Nat44EiSetWorkers) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.WorkerMask = buf.DecodeUint64()
-	return nil
+	return buf.Err()
 }
 
 // Nat44EiSetWorkersReply defines message 'nat44_ei_set_workers_reply'.
@@ -2653,7 +2653,7 @@ func (m *Nat44EiSetWorkersReply) Marshal(b []byte) ([]byte, error) {
 func (m *Nat44EiSetWorkersReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
-	return nil
+	return buf.Err()
 }
 
 // Nat44EiShowFqOptions defines message 'nat44_ei_show_fq_options'.
@@ -2719,7 +2719,7 @@ func (m *Nat44EiShowFqOptionsReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
 	m.FrameQueueNelts = buf.DecodeUint32()
-	return nil
+	return buf.Err()
 }
 
 // Nat44EiShowRunningConfig defines message 'nat44_ei_show_running_config'.
@@ -2840,7 +2840,7 @@ func (m *Nat44EiShowRunningConfigReply) Unmarshal(b []byte) error {
 	m.Timeouts.ICMP = buf.DecodeUint32()
 	m.LogLevel = nat_types.NatLogLevel(buf.DecodeUint8())
 	m.Flags = Nat44EiConfigFlags(buf.DecodeUint8())
-	return nil
+	return buf.Err()
 }
 
 // Nat44EiStaticMappingDetails defines message 'nat44_ei_static_mapping_details'.
@@ -2906,7 +2906,7 @@ func (m *Nat44EiStaticMappingDetails) Unmarshal(b []byte) error {
 	m.ExternalSwIfIndex = interface_types.InterfaceIndex(buf.DecodeUint32())
 	m.VrfID = buf.DecodeUint32()
 	m.Tag = buf.DecodeString(64)
-	return nil
+	return buf.Err()
 }
 
 // Nat44EiStaticMappingDump defines message 'nat44_ei_static_mapping_dump'.
@@ -2980,7 +2980,7 @@ func (m *Nat44EiUserDetails) Unmarshal(b []byte) error {
 	copy(m.IPAddress[:], buf.DecodeBytes(4))
 	m.Nsessions = buf.DecodeUint32()
 	m.Nstaticsessions = buf.DecodeUint32()
-	return nil
+	return buf.Err()
 }
 
 // Nat44EiUserDump defines message 'nat44_ei_user_dump'.
@@ -3082,7 +3082,7 @@ func (m *Nat44EiUserSessionDetails) Unmarshal(b []byte) error {
 	m.TotalPkts = buf.DecodeUint32()
 	copy(m.ExtHostAddress[:], buf.DecodeBytes(4))
 	m.ExtHostPort = buf.DecodeUint16()
-	return nil
+	return buf.Err()
 }
 
 // Nat44EiUserSessionDump defines message 'nat44_ei_user_session_dump'.
@@ -3120,7 +3120,7 @@ func (m *Nat44EiUserSessionDump) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	copy(m.IPAddress[:], buf.DecodeBytes(4))
 	m.VrfID = buf.DecodeUint32()
-	return nil
+	return buf.Err()
 }
 
 // Nat44EiWorkerDetails defines message 'nat44_ei_worker_details'.
@@ -3162,7 +3162,7 @@ func (m *Nat44EiWorkerDetails) Unmarshal(b []byte) error {
 	m.WorkerIndex = buf.DecodeUint32()
 	m.LcoreID = buf.DecodeUint32()
 	m.Name = buf.DecodeString(64)
-	return nil
+	return buf.Err()
 }
 
 // Nat44EiWorkerDump defines message 'nat44_ei_worker_dump'.
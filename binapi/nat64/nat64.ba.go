@@ -68,7 +68,7 @@ func (m *Nat64AddDelInterface) Unmarshal(b []byte) error {
 	m.IsAdd = buf.DecodeBool()
 	m.Flags = nat_types.NatConfigFlags(buf.DecodeUint8())
 	m.SwIfIndex = interface_types.InterfaceIndex(buf.DecodeUint32())
-	return nil
+	return buf.Err()
 }
 
 // Nat64AddDelInterfaceAddr defines message 'nat64_add_del_interface_addr'.
@@ -105,7 +105,7 @@ func (m *Nat64AddDelInterfaceAddr) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.IsAdd = buf.DecodeBool()
 	m.SwIfIndex = interface_types.InterfaceIndex(buf.DecodeUint32())
-	return nil
+	return buf.Err()
 }
 
 // Nat64AddDelInterfaceAddrReply defines message 'nat64_add_del_interface_addr_reply'.
@@ -140,7 +140,7 @@ func (m *Nat64AddDelInterfaceAddrReply) Marshal(b []byte) ([]byte, error) {
 func (m *Nat64AddDelInterfaceAddrReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
-	return nil
+	return buf.Err()
 }
 
 // Nat64AddDelInterfaceReply defines message 'nat64_add_del_interface_reply'.
@@ -173,7 +173,7 @@ func (m *Nat64AddDelInterfaceReply) Marshal(b []byte) ([]byte, error) {
 func (m *Nat64AddDelInterfaceReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
-	return nil
+	return buf.Err()
 }
 
 // Nat64AddDelPoolAddrRange defines message 'nat64_add_del_pool_addr_range'.
@@ -218,7 +218,7 @@ func (m *Nat64AddDelPoolAddrRange) Unmarshal(b []byte) error {
 	copy(m.EndAddr[:], buf.DecodeBytes(4))
 	m.VrfID = buf.DecodeUint32()
 	m.IsAdd = buf.DecodeBool()
-	return nil
+	return buf.Err()
 }
 
 // Nat64AddDelPoolAddrRangeReply defines message 'nat64_add_del_pool_addr_range_reply'.
@@ -253,7 +253,7 @@ func (m *Nat64AddDelPoolAddrRangeReply) Marshal(b []byte) ([]byte, error) {
 func (m *Nat64AddDelPoolAddrRangeReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
-	return nil
+	return buf.Err()
 }
 
 // Nat64AddDelPrefix defines message 'nat64_add_del_prefix'.
@@ -297,7 +297,7 @@ func (m *Nat64AddDelPrefix) Unmarshal(b []byte) error {
 	m.Prefix.Len = buf.DecodeUint8()
 	m.VrfID = buf.DecodeUint32()
 	m.IsAdd = buf.DecodeBool()
-	return nil
+	return buf.Err()
 }
 
 // Nat64AddDelPrefixReply defines message 'nat64_add_del_prefix_reply'.
@@ -330,7 +330,7 @@ func (m *Nat64AddDelPrefixReply) Marshal(b []byte) ([]byte, error) {
 func (m *Nat64AddDelPrefixReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
-	return nil
+	return buf.Err()
 }
 
 // Nat64AddDelStaticBib defines message 'nat64_add_del_static_bib'.
@@ -387,7 +387,7 @@ func (m *Nat64AddDelStaticBib) Unmarshal(b []byte) error {
 	m.VrfID = buf.DecodeUint32()
 	m.Proto = buf.DecodeUint8()
 	m.IsAdd = buf.DecodeBool()
-	return nil
+	return buf.Err()
 }
 
 // Nat64AddDelStaticBibReply defines message 'nat64_add_del_static_bib_reply'.
@@ -420,7 +420,7 @@ func (m *Nat64AddDelStaticBibReply) Marshal(b []byte) ([]byte, error) {
 func (m *Nat64AddDelStaticBibReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
-	return nil
+	return buf.Err()
 }
 
 // Nat64BibDetails defines message 'nat64_bib_details'.
@@ -481,7 +481,7 @@ func (m *Nat64BibDetails) Unmarshal(b []byte) error {
 	m.Proto = buf.DecodeUint8()
 	m.Flags = nat_types.NatConfigFlags(buf.DecodeUint8())
 	m.SesNum = buf.DecodeUint32()
-	return nil
+	return buf.Err()
 }
 
 // Nat64BibDump defines message 'nat64_bib_dump'.
@@ -514,7 +514,7 @@ func (m *Nat64BibDump) Marshal(b []byte) ([]byte, error) {
 func (m *Nat64BibDump) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Proto = buf.DecodeUint8()
-	return nil
+	return buf.Err()
 }
 
 // Nat64GetTimeouts defines message 'nat64_get_timeouts'.
@@ -590,7 +590,7 @@ func (m *Nat64GetTimeoutsReply) Unmarshal(b []byte) error {
 	m.TCPEstablished = buf.DecodeUint32()
 	m.TCPTransitory = buf.DecodeUint32()
 	m.ICMP = buf.DecodeUint32()
-	return nil
+	return buf.Err()
 }
 
 // Nat64InterfaceDetails defines message 'nat64_interface_details'.
@@ -627,7 +627,7 @@ func (m *Nat64InterfaceDetails) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Flags = nat_types.NatConfigFlags(buf.DecodeUint8())
 	m.SwIfIndex = interface_types.InterfaceIndex(buf.DecodeUint32())
-	return nil
+	return buf.Err()
 }
 
 // Nat64InterfaceDump defines message 'nat64_interface_dump'.
@@ -704,7 +704,7 @@ func (m *Nat64PluginEnableDisable) Unmarshal(b []byte) error {
 	m.StBuckets = buf.DecodeUint32()
 	m.StMemorySize = buf.DecodeUint32()
 	m.Enable = buf.DecodeBool()
-	return nil
+	return buf.Err()
 }
 
 // Nat64PluginEnableDisableReply defines message 'nat64_plugin_enable_disable_reply'.
@@ -740,7 +740,7 @@ func (m *Nat64PluginEnableDisableReply) Marshal(b []byte) ([]byte, error) {
 func (m *Nat64PluginEnableDisableReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
-	return nil
+	return buf.Err()
 }
 
 // Nat64PoolAddrDetails defines message 'nat64_pool_addr_details'.
@@ -777,7 +777,7 @@ func (m *Nat64PoolAddrDetails) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	copy(m.Address[:], buf.DecodeBytes(4))
 	m.VrfID = buf.DecodeUint32()
-	return nil
+	return buf.Err()
 }
 
 // Nat64PoolAddrDump defines message 'nat64_pool_addr_dump'.
@@ -844,7 +844,7 @@ func (m *Nat64PrefixDetails) Unmarshal(b []byte) error {
 	copy(m.Prefix.Address[:], buf.DecodeBytes(16))
 	m.Prefix.Len = buf.DecodeUint8()
 	m.VrfID = buf.DecodeUint32()
-	return nil
+	return buf.Err()
 }
 
 // Nat64PrefixDump defines message 'nat64_prefix_dump'.
@@ -916,7 +916,7 @@ func (m *Nat64SetTimeouts) Unmarshal(b []byte) error {
 	m.TCPEstablished = buf.DecodeUint32()
 	m.TCPTransitory = buf.DecodeUint32()
 	m.ICMP = buf.DecodeUint32()
-	return nil
+	return buf.Err()
 }
 
 // Nat64SetTimeoutsReply defines message 'nat64_set_timeouts_reply'.
@@ -949,7 +949,7 @@ func (m *Nat64SetTimeoutsReply) Marshal(b []byte) ([]byte, error) {
 func (m *Nat64SetTimeoutsReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
-	return nil
+	return buf.Err()
 }
 
 // Nat64StDetails defines message 'nat64_st_details'.
@@ -1014,7 +1014,7 @@ func (m *Nat64StDetails) Unmarshal(b []byte) error {
 	m.RPort = buf.DecodeUint16()
 	m.VrfID = buf.DecodeUint32()
 	m.Proto = buf.DecodeUint8()
-	return nil
+	return buf.Err()
 }
 
 // Nat64StDump defines message 'nat64_st_dump'.
@@ -1047,7 +1047,7 @@ func (m *Nat64StDump) Marshal(b []byte) ([]byte, error) {
 func (m *Nat64StDump) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Proto = buf.DecodeUint8()
-	return nil
+	return buf.Err()
 }
 
 func init() { file_nat64_binapi_init() }
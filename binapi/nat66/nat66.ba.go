@@ -68,7 +68,7 @@ func (m *Nat66AddDelInterface) Unmarshal(b []byte) error {
 	m.IsAdd = buf.DecodeBool()
 	m.Flags = nat_types.NatConfigFlags(buf.DecodeUint8())
 	m.SwIfIndex = interface_types.InterfaceIndex(buf.DecodeUint32())
-	return nil
+	return buf.Err()
 }
 
 // Nat66AddDelInterfaceReply defines message 'nat66_add_del_interface_reply'.
@@ -101,7 +101,7 @@ func (m *Nat66AddDelInterfaceReply) Marshal(b []byte) ([]byte, error) {
 func (m *Nat66AddDelInterfaceReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
-	return nil
+	return buf.Err()
 }
 
 // Nat66AddDelStaticMapping defines message 'nat66_add_del_static_mapping'.
@@ -146,7 +146,7 @@ func (m *Nat66AddDelStaticMapping) Unmarshal(b []byte) error {
 	copy(m.LocalIPAddress[:], buf.DecodeBytes(16))
 	copy(m.ExternalIPAddress[:], buf.DecodeBytes(16))
 	m.VrfID = buf.DecodeUint32()
-	return nil
+	return buf.Err()
 }
 
 // Nat66AddDelStaticMappingReply defines message 'nat66_add_del_static_mapping_reply'.
@@ -181,7 +181,7 @@ func (m *Nat66AddDelStaticMappingReply) Marshal(b []byte) ([]byte, error) {
 func (m *Nat66AddDelStaticMappingReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
-	return nil
+	return buf.Err()
 }
 
 // Nat66InterfaceDetails defines message 'nat66_interface_details'.
@@ -218,7 +218,7 @@ func (m *Nat66InterfaceDetails) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Flags = nat_types.NatConfigFlags(buf.DecodeUint8())
 	m.SwIfIndex = interface_types.InterfaceIndex(buf.DecodeUint32())
-	return nil
+	return buf.Err()
 }
 
 // Nat66InterfaceDump defines message 'nat66_interface_dump'.
@@ -282,7 +282,7 @@ func (m *Nat66PluginEnableDisable) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.OutsideVrf = buf.DecodeUint32()
 	m.Enable = buf.DecodeBool()
-	return nil
+	return buf.Err()
 }
 
 // Nat66PluginEnableDisableReply defines message 'nat66_plugin_enable_disable_reply'.
@@ -317,7 +317,7 @@ func (m *Nat66PluginEnableDisableReply) Marshal(b []byte) ([]byte, error) {
 func (m *Nat66PluginEnableDisableReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
-	return nil
+	return buf.Err()
 }
 
 // Nat66StaticMappingDetails defines message 'nat66_static_mapping_details'.
@@ -366,7 +366,7 @@ func (m *Nat66StaticMappingDetails) Unmarshal(b []byte) error {
 	m.VrfID = buf.DecodeUint32()
 	m.TotalBytes = buf.DecodeUint64()
 	m.TotalPkts = buf.DecodeUint64()
-	return nil
+	return buf.Err()
 }
 
 // Nat66StaticMappingDump defines message 'nat66_static_mapping_dump'.
@@ -107,7 +107,7 @@ func (m *NshAddDelEntry) Unmarshal(b []byte) error {
 	m.TlvLength = buf.DecodeUint8()
 	m.Tlv = make([]byte, 248)
 	copy(m.Tlv, buf.DecodeBytes(len(m.Tlv)))
-	return nil
+	return buf.Err()
 }
 
 // NshAddDelEntryReply defines message 'nsh_add_del_entry_reply'.
@@ -144,7 +144,7 @@ func (m *NshAddDelEntryReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
 	m.EntryIndex = buf.DecodeUint32()
-	return nil
+	return buf.Err()
 }
 
 // NshAddDelMap defines message 'nsh_add_del_map'.
@@ -201,7 +201,7 @@ func (m *NshAddDelMap) Unmarshal(b []byte) error {
 	m.SwIfIndex = interface_types.InterfaceIndex(buf.DecodeUint32())
 	m.RxSwIfIndex = interface_types.InterfaceIndex(buf.DecodeUint32())
 	m.NextNode = buf.DecodeUint32()
-	return nil
+	return buf.Err()
 }
 
 // NshAddDelMapReply defines message 'nsh_add_del_map_reply'.
@@ -238,7 +238,7 @@ func (m *NshAddDelMapReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
 	m.MapIndex = buf.DecodeUint32()
-	return nil
+	return buf.Err()
 }
 
 // NshEntryDetails defines message 'nsh_entry_details'.
@@ -320,7 +320,7 @@ func (m *NshEntryDetails) Unmarshal(b []byte) error {
 	m.TlvLength = buf.DecodeUint8()
 	m.Tlv = make([]byte, 248)
 	copy(m.Tlv, buf.DecodeBytes(len(m.Tlv)))
-	return nil
+	return buf.Err()
 }
 
 // NshEntryDump defines message 'nsh_entry_dump'.
@@ -353,7 +353,7 @@ func (m *NshEntryDump) Marshal(b []byte) ([]byte, error) {
 func (m *NshEntryDump) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.EntryIndex = buf.DecodeUint32()
-	return nil
+	return buf.Err()
 }
 
 // NshMapDetails defines message 'nsh_map_details'.
@@ -410,7 +410,7 @@ func (m *NshMapDetails) Unmarshal(b []byte) error {
 	m.SwIfIndex = interface_types.InterfaceIndex(buf.DecodeUint32())
 	m.RxSwIfIndex = interface_types.InterfaceIndex(buf.DecodeUint32())
 	m.NextNode = buf.DecodeUint32()
-	return nil
+	return buf.Err()
 }
 
 // NshMapDump defines message 'nsh_map_dump'.
@@ -443,7 +443,7 @@ func (m *NshMapDump) Marshal(b []byte) ([]byte, error) {
 func (m *NshMapDump) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.MapIndex = buf.DecodeUint32()
-	return nil
+	return buf.Err()
 }
 
 func init() { file_nsh_binapi_init() }
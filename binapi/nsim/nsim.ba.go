@@ -71,7 +71,7 @@ func (m *NsimConfigure) Unmarshal(b []byte) error {
 	m.AveragePacketSize = buf.DecodeUint32()
 	m.BandwidthInBitsPerSecond = buf.DecodeUint64()
 	m.PacketsPerDrop = buf.DecodeUint32()
-	return nil
+	return buf.Err()
 }
 
 // NsimConfigure2 defines message 'nsim_configure2'.
@@ -120,7 +120,7 @@ func (m *NsimConfigure2) Unmarshal(b []byte) error {
 	m.BandwidthInBitsPerSecond = buf.DecodeUint64()
 	m.PacketsPerDrop = buf.DecodeUint32()
 	m.PacketsPerReorder = buf.DecodeUint32()
-	return nil
+	return buf.Err()
 }
 
 // NsimConfigure2Reply defines message 'nsim_configure2_reply'.
@@ -153,7 +153,7 @@ func (m *NsimConfigure2Reply) Marshal(b []byte) ([]byte, error) {
 func (m *NsimConfigure2Reply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
-	return nil
+	return buf.Err()
 }
 
 // NsimConfigureReply defines message 'nsim_configure_reply'.
@@ -187,7 +187,7 @@ func (m *NsimConfigureReply) Marshal(b []byte) ([]byte, error) {
 func (m *NsimConfigureReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
-	return nil
+	return buf.Err()
 }
 
 // NsimCrossConnectEnableDisable defines message 'nsim_cross_connect_enable_disable'.
@@ -230,7 +230,7 @@ func (m *NsimCrossConnectEnableDisable) Unmarshal(b []byte) error {
 	m.EnableDisable = buf.DecodeBool()
 	m.SwIfIndex0 = interface_types.InterfaceIndex(buf.DecodeUint32())
 	m.SwIfIndex1 = interface_types.InterfaceIndex(buf.DecodeUint32())
-	return nil
+	return buf.Err()
 }
 
 // NsimCrossConnectEnableDisableReply defines message 'nsim_cross_connect_enable_disable_reply'.
@@ -265,7 +265,7 @@ func (m *NsimCrossConnectEnableDisableReply) Marshal(b []byte) ([]byte, error) {
 func (m *NsimCrossConnectEnableDisableReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
-	return nil
+	return buf.Err()
 }
 
 // NsimOutputFeatureEnableDisable defines message 'nsim_output_feature_enable_disable'.
@@ -304,7 +304,7 @@ func (m *NsimOutputFeatureEnableDisable) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.EnableDisable = buf.DecodeBool()
 	m.SwIfIndex = interface_types.InterfaceIndex(buf.DecodeUint32())
-	return nil
+	return buf.Err()
 }
 
 // NsimOutputFeatureEnableDisableReply defines message 'nsim_output_feature_enable_disable_reply'.
@@ -339,7 +339,7 @@ func (m *NsimOutputFeatureEnableDisableReply) Marshal(b []byte) ([]byte, error)
 func (m *NsimOutputFeatureEnableDisableReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
-	return nil
+	return buf.Err()
 }
 
 func init() { file_nsim_binapi_init() }
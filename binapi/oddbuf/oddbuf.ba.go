@@ -63,7 +63,7 @@ func (m *OddbufEnableDisable) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.EnableDisable = buf.DecodeBool()
 	m.SwIfIndex = interface_types.InterfaceIndex(buf.DecodeUint32())
-	return nil
+	return buf.Err()
 }
 
 // OddbufEnableDisableReply defines message 'oddbuf_enable_disable_reply'.
@@ -97,7 +97,7 @@ func (m *OddbufEnableDisableReply) Marshal(b []byte) ([]byte, error) {
 func (m *OddbufEnableDisableReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
-	return nil
+	return buf.Err()
 }
 
 func init() { file_oddbuf_binapi_init() }
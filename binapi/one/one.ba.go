@@ -158,7 +158,7 @@ func (m *OneAddDelAdjacency) Unmarshal(b []byte) error {
 	copy(m.Reid.Address.XXX_UnionData[:], buf.DecodeBytes(18))
 	m.Leid.Type = lisp_types.EidType(buf.DecodeUint8())
 	copy(m.Leid.Address.XXX_UnionData[:], buf.DecodeBytes(18))
-	return nil
+	return buf.Err()
 }
 
 // OneAddDelAdjacencyReply defines message 'one_add_del_adjacency_reply'.
@@ -191,7 +191,7 @@ func (m *OneAddDelAdjacencyReply) Marshal(b []byte) ([]byte, error) {
 func (m *OneAddDelAdjacencyReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
-	return nil
+	return buf.Err()
 }
 
 // OneAddDelL2ArpEntry defines message 'one_add_del_l2_arp_entry'.
@@ -235,7 +235,7 @@ func (m *OneAddDelL2ArpEntry) Unmarshal(b []byte) error {
 	m.Bd = buf.DecodeUint32()
 	copy(m.Entry.Mac[:], buf.DecodeBytes(6))
 	copy(m.Entry.IP4[:], buf.DecodeBytes(4))
-	return nil
+	return buf.Err()
 }
 
 // OneAddDelL2ArpEntryReply defines message 'one_add_del_l2_arp_entry_reply'.
@@ -268,7 +268,7 @@ func (m *OneAddDelL2ArpEntryReply) Marshal(b []byte) ([]byte, error) {
 func (m *OneAddDelL2ArpEntryReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
-	return nil
+	return buf.Err()
 }
 
 // OneAddDelLocalEid defines message 'one_add_del_local_eid'.
@@ -324,7 +324,7 @@ func (m *OneAddDelLocalEid) Unmarshal(b []byte) error {
 	m.Key.ID = lisp_types.HmacKeyID(buf.DecodeUint8())
 	m.Key.Key = make([]byte, 64)
 	copy(m.Key.Key, buf.DecodeBytes(len(m.Key.Key)))
-	return nil
+	return buf.Err()
 }
 
 // OneAddDelLocalEidReply defines message 'one_add_del_local_eid_reply'.
@@ -357,7 +357,7 @@ func (m *OneAddDelLocalEidReply) Marshal(b []byte) ([]byte, error) {
 func (m *OneAddDelLocalEidReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
-	return nil
+	return buf.Err()
 }
 
 // OneAddDelLocator defines message 'one_add_del_locator'.
@@ -406,7 +406,7 @@ func (m *OneAddDelLocator) Unmarshal(b []byte) error {
 	m.SwIfIndex = interface_types.InterfaceIndex(buf.DecodeUint32())
 	m.Priority = buf.DecodeUint8()
 	m.Weight = buf.DecodeUint8()
-	return nil
+	return buf.Err()
 }
 
 // OneAddDelLocatorReply defines message 'one_add_del_locator_reply'.
@@ -439,7 +439,7 @@ func (m *OneAddDelLocatorReply) Marshal(b []byte) ([]byte, error) {
 func (m *OneAddDelLocatorReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
-	return nil
+	return buf.Err()
 }
 
 // OneAddDelLocatorSet defines message 'one_add_del_locator_set'.
@@ -500,13 +500,13 @@ func (m *OneAddDelLocatorSet) Unmarshal(b []byte) error {
 	m.IsAdd = buf.DecodeBool()
 	m.LocatorSetName = buf.DecodeString(64)
 	m.LocatorNum = buf.DecodeUint32()
-	m.Locators = make([]lisp_types.LocalLocator, m.LocatorNum)
+	m.Locators = make([]lisp_types.LocalLocator, buf.CheckArrayLen(int(m.LocatorNum), 1))
 	for j0 := 0; j0 < len(m.Locators); j0++ {
 		m.Locators[j0].SwIfIndex = interface_types.InterfaceIndex(buf.DecodeUint32())
 		m.Locators[j0].Priority = buf.DecodeUint8()
 		m.Locators[j0].Weight = buf.DecodeUint8()
 	}
-	return nil
+	return buf.Err()
 }
 
 // OneAddDelLocatorSetReply defines message 'one_add_del_locator_set_reply'.
@@ -543,7 +543,7 @@ func (m *OneAddDelLocatorSetReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
 	m.LsIndex = buf.DecodeUint32()
-	return nil
+	return buf.Err()
 }
 
 // OneAddDelMapRequestItrRlocs defines message 'one_add_del_map_request_itr_rlocs'.
@@ -582,7 +582,7 @@ func (m *OneAddDelMapRequestItrRlocs) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.IsAdd = buf.DecodeBool()
 	m.LocatorSetName = buf.DecodeString(64)
-	return nil
+	return buf.Err()
 }
 
 // OneAddDelMapRequestItrRlocsReply defines message 'one_add_del_map_request_itr_rlocs_reply'.
@@ -617,7 +617,7 @@ func (m *OneAddDelMapRequestItrRlocsReply) Marshal(b []byte) ([]byte, error) {
 func (m *OneAddDelMapRequestItrRlocsReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
-	return nil
+	return buf.Err()
 }
 
 // OneAddDelMapResolver defines message 'one_add_del_map_resolver'.
@@ -657,7 +657,7 @@ func (m *OneAddDelMapResolver) Unmarshal(b []byte) error {
 	m.IsAdd = buf.DecodeBool()
 	m.IPAddress.Af = ip_types.AddressFamily(buf.DecodeUint8())
 	copy(m.IPAddress.Un.XXX_UnionData[:], buf.DecodeBytes(16))
-	return nil
+	return buf.Err()
 }
 
 // OneAddDelMapResolverReply defines message 'one_add_del_map_resolver_reply'.
@@ -690,7 +690,7 @@ func (m *OneAddDelMapResolverReply) Marshal(b []byte) ([]byte, error) {
 func (m *OneAddDelMapResolverReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
-	return nil
+	return buf.Err()
 }
 
 // OneAddDelMapServer defines message 'one_add_del_map_server'.
@@ -730,7 +730,7 @@ func (m *OneAddDelMapServer) Unmarshal(b []byte) error {
 	m.IsAdd = buf.DecodeBool()
 	m.IPAddress.Af = ip_types.AddressFamily(buf.DecodeUint8())
 	copy(m.IPAddress.Un.XXX_UnionData[:], buf.DecodeBytes(16))
-	return nil
+	return buf.Err()
 }
 
 // OneAddDelMapServerReply defines message 'one_add_del_map_server_reply'.
@@ -763,7 +763,7 @@ func (m *OneAddDelMapServerReply) Marshal(b []byte) ([]byte, error) {
 func (m *OneAddDelMapServerReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
-	return nil
+	return buf.Err()
 }
 
 // OneAddDelNdpEntry defines message 'one_add_del_ndp_entry'.
@@ -807,7 +807,7 @@ func (m *OneAddDelNdpEntry) Unmarshal(b []byte) error {
 	m.Bd = buf.DecodeUint32()
 	copy(m.Entry.Mac[:], buf.DecodeBytes(6))
 	copy(m.Entry.IP6[:], buf.DecodeBytes(16))
-	return nil
+	return buf.Err()
 }
 
 // OneAddDelNdpEntryReply defines message 'one_add_del_ndp_entry_reply'.
@@ -840,7 +840,7 @@ func (m *OneAddDelNdpEntryReply) Marshal(b []byte) ([]byte, error) {
 func (m *OneAddDelNdpEntryReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
-	return nil
+	return buf.Err()
 }
 
 // OneAddDelRemoteMapping defines message 'one_add_del_remote_mapping'.
@@ -929,14 +929,14 @@ func (m *OneAddDelRemoteMapping) Unmarshal(b []byte) error {
 	m.Seid.Type = lisp_types.EidType(buf.DecodeUint8())
 	copy(m.Seid.Address.XXX_UnionData[:], buf.DecodeBytes(18))
 	m.RlocNum = buf.DecodeUint32()
-	m.Rlocs = make([]lisp_types.RemoteLocator, m.RlocNum)
+	m.Rlocs = make([]lisp_types.RemoteLocator, buf.CheckArrayLen(int(m.RlocNum), 1))
 	for j0 := 0; j0 < len(m.Rlocs); j0++ {
 		m.Rlocs[j0].Priority = buf.DecodeUint8()
 		m.Rlocs[j0].Weight = buf.DecodeUint8()
 		m.Rlocs[j0].IPAddress.Af = ip_types.AddressFamily(buf.DecodeUint8())
 		copy(m.Rlocs[j0].IPAddress.Un.XXX_UnionData[:], buf.DecodeBytes(16))
 	}
-	return nil
+	return buf.Err()
 }
 
 // OneAddDelRemoteMappingReply defines message 'one_add_del_remote_mapping_reply'.
@@ -971,7 +971,7 @@ func (m *OneAddDelRemoteMappingReply) Marshal(b []byte) ([]byte, error) {
 func (m *OneAddDelRemoteMappingReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
-	return nil
+	return buf.Err()
 }
 
 // OneAdjacenciesGet defines message 'one_adjacencies_get'.
@@ -1004,7 +1004,7 @@ func (m *OneAdjacenciesGet) Marshal(b []byte) ([]byte, error) {
 func (m *OneAdjacenciesGet) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Vni = buf.DecodeUint32()
-	return nil
+	return buf.Err()
 }
 
 // OneAdjacenciesGetReply defines message 'one_adjacencies_get_reply'.
@@ -1063,14 +1063,14 @@ func (m *OneAdjacenciesGetReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
 	m.Count = buf.DecodeUint32()
-	m.Adjacencies = make([]OneAdjacency, m.Count)
+	m.Adjacencies = make([]OneAdjacency, buf.CheckArrayLen(int(m.Count), 1))
 	for j0 := 0; j0 < len(m.Adjacencies); j0++ {
 		m.Adjacencies[j0].Reid.Type = lisp_types.EidType(buf.DecodeUint8())
 		copy(m.Adjacencies[j0].Reid.Address.XXX_UnionData[:], buf.DecodeBytes(18))
 		m.Adjacencies[j0].Leid.Type = lisp_types.EidType(buf.DecodeUint8())
 		copy(m.Adjacencies[j0].Leid.Address.XXX_UnionData[:], buf.DecodeBytes(18))
 	}
-	return nil
+	return buf.Err()
 }
 
 // OneEidTableAddDelMap defines message 'one_eid_table_add_del_map'.
@@ -1115,7 +1115,7 @@ func (m *OneEidTableAddDelMap) Unmarshal(b []byte) error {
 	m.Vni = buf.DecodeUint32()
 	m.DpTable = buf.DecodeUint32()
 	m.IsL2 = buf.DecodeBool()
-	return nil
+	return buf.Err()
 }
 
 // OneEidTableAddDelMapReply defines message 'one_eid_table_add_del_map_reply'.
@@ -1148,7 +1148,7 @@ func (m *OneEidTableAddDelMapReply) Marshal(b []byte) ([]byte, error) {
 func (m *OneEidTableAddDelMapReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
-	return nil
+	return buf.Err()
 }
 
 // OneEidTableDetails defines message 'one_eid_table_details'.
@@ -1227,7 +1227,7 @@ func (m *OneEidTableDetails) Unmarshal(b []byte) error {
 	m.Key.ID = lisp_types.HmacKeyID(buf.DecodeUint8())
 	m.Key.Key = make([]byte, 64)
 	copy(m.Key.Key, buf.DecodeBytes(len(m.Key.Key)))
-	return nil
+	return buf.Err()
 }
 
 // OneEidTableDump defines message 'one_eid_table_dump'.
@@ -1275,7 +1275,7 @@ func (m *OneEidTableDump) Unmarshal(b []byte) error {
 	m.Eid.Type = lisp_types.EidType(buf.DecodeUint8())
 	copy(m.Eid.Address.XXX_UnionData[:], buf.DecodeBytes(18))
 	m.Filter = OneFilter(buf.DecodeUint32())
-	return nil
+	return buf.Err()
 }
 
 // OneEidTableMapDetails defines message 'one_eid_table_map_details'.
@@ -1312,7 +1312,7 @@ func (m *OneEidTableMapDetails) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Vni = buf.DecodeUint32()
 	m.DpTable = buf.DecodeUint32()
-	return nil
+	return buf.Err()
 }
 
 // OneEidTableMapDump defines message 'one_eid_table_map_dump'.
@@ -1345,7 +1345,7 @@ func (m *OneEidTableMapDump) Marshal(b []byte) ([]byte, error) {
 func (m *OneEidTableMapDump) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.IsL2 = buf.DecodeBool()
-	return nil
+	return buf.Err()
 }
 
 // OneEidTableVniDetails defines message 'one_eid_table_vni_details'.
@@ -1378,7 +1378,7 @@ func (m *OneEidTableVniDetails) Marshal(b []byte) ([]byte, error) {
 func (m *OneEidTableVniDetails) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Vni = buf.DecodeUint32()
-	return nil
+	return buf.Err()
 }
 
 // OneEidTableVniDump defines message 'one_eid_table_vni_dump'.
@@ -1438,7 +1438,7 @@ func (m *OneEnableDisable) Marshal(b []byte) ([]byte, error) {
 func (m *OneEnableDisable) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.IsEnable = buf.DecodeBool()
-	return nil
+	return buf.Err()
 }
 
 // OneEnableDisablePetrMode defines message 'one_enable_disable_petr_mode'.
@@ -1471,7 +1471,7 @@ func (m *OneEnableDisablePetrMode) Marshal(b []byte) ([]byte, error) {
 func (m *OneEnableDisablePetrMode) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.IsEnable = buf.DecodeBool()
-	return nil
+	return buf.Err()
 }
 
 // OneEnableDisablePetrModeReply defines message 'one_enable_disable_petr_mode_reply'.
@@ -1506,7 +1506,7 @@ func (m *OneEnableDisablePetrModeReply) Marshal(b []byte) ([]byte, error) {
 func (m *OneEnableDisablePetrModeReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
-	return nil
+	return buf.Err()
 }
 
 // OneEnableDisablePitrMode defines message 'one_enable_disable_pitr_mode'.
@@ -1539,7 +1539,7 @@ func (m *OneEnableDisablePitrMode) Marshal(b []byte) ([]byte, error) {
 func (m *OneEnableDisablePitrMode) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.IsEnable = buf.DecodeBool()
-	return nil
+	return buf.Err()
 }
 
 // OneEnableDisablePitrModeReply defines message 'one_enable_disable_pitr_mode_reply'.
@@ -1574,7 +1574,7 @@ func (m *OneEnableDisablePitrModeReply) Marshal(b []byte) ([]byte, error) {
 func (m *OneEnableDisablePitrModeReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
-	return nil
+	return buf.Err()
 }
 
 // OneEnableDisableReply defines message 'one_enable_disable_reply'.
@@ -1607,7 +1607,7 @@ func (m *OneEnableDisableReply) Marshal(b []byte) ([]byte, error) {
 func (m *OneEnableDisableReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
-	return nil
+	return buf.Err()
 }
 
 // OneEnableDisableXtrMode defines message 'one_enable_disable_xtr_mode'.
@@ -1640,7 +1640,7 @@ func (m *OneEnableDisableXtrMode) Marshal(b []byte) ([]byte, error) {
 func (m *OneEnableDisableXtrMode) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.IsEnable = buf.DecodeBool()
-	return nil
+	return buf.Err()
 }
 
 // OneEnableDisableXtrModeReply defines message 'one_enable_disable_xtr_mode_reply'.
@@ -1675,7 +1675,7 @@ func (m *OneEnableDisableXtrModeReply) Marshal(b []byte) ([]byte, error) {
 func (m *OneEnableDisableXtrModeReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
-	return nil
+	return buf.Err()
 }
 
 // OneGetMapRequestItrRlocs defines message 'one_get_map_request_itr_rlocs'.
@@ -1741,7 +1741,7 @@ func (m *OneGetMapRequestItrRlocsReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
 	m.LocatorSetName = buf.DecodeString(64)
-	return nil
+	return buf.Err()
 }
 
 // OneGetTransportProtocol defines message 'one_get_transport_protocol'.
@@ -1807,7 +1807,7 @@ func (m *OneGetTransportProtocolReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
 	m.Protocol = buf.DecodeUint8()
-	return nil
+	return buf.Err()
 }
 
 // OneL2ArpBdGet defines message 'one_l2_arp_bd_get'.
@@ -1880,11 +1880,11 @@ func (m *OneL2ArpBdGetReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
 	m.Count = buf.DecodeUint32()
-	m.BridgeDomains = make([]uint32, m.Count)
+	m.BridgeDomains = make([]uint32, buf.CheckArrayLen(int(m.Count), 4))
 	for i := 0; i < len(m.BridgeDomains); i++ {
 		m.BridgeDomains[i] = buf.DecodeUint32()
 	}
-	return nil
+	return buf.Err()
 }
 
 // OneL2ArpEntriesGet defines message 'one_l2_arp_entries_get'.
@@ -1917,7 +1917,7 @@ func (m *OneL2ArpEntriesGet) Marshal(b []byte) ([]byte, error) {
 func (m *OneL2ArpEntriesGet) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Bd = buf.DecodeUint32()
-	return nil
+	return buf.Err()
 }
 
 // OneL2ArpEntriesGetReply defines message 'one_l2_arp_entries_get_reply'.
@@ -1972,12 +1972,12 @@ func (m *OneL2ArpEntriesGetReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
 	m.Count = buf.DecodeUint32()
-	m.Entries = make([]OneL2ArpEntry, m.Count)
+	m.Entries = make([]OneL2ArpEntry, buf.CheckArrayLen(int(m.Count), 1))
 	for j0 := 0; j0 < len(m.Entries); j0++ {
 		copy(m.Entries[j0].Mac[:], buf.DecodeBytes(6))
 		copy(m.Entries[j0].IP4[:], buf.DecodeBytes(4))
 	}
-	return nil
+	return buf.Err()
 }
 
 // OneLocatorDetails defines message 'one_locator_details'.
@@ -2029,7 +2029,7 @@ func (m *OneLocatorDetails) Unmarshal(b []byte) error {
 	copy(m.IPAddress.Un.XXX_UnionData[:], buf.DecodeBytes(16))
 	m.Priority = buf.DecodeUint8()
 	m.Weight = buf.DecodeUint8()
-	return nil
+	return buf.Err()
 }
 
 // OneLocatorDump defines message 'one_locator_dump'.
@@ -2070,7 +2070,7 @@ func (m *OneLocatorDump) Unmarshal(b []byte) error {
 	m.LsIndex = buf.DecodeUint32()
 	m.LsName = buf.DecodeString(64)
 	m.IsIndexSet = buf.DecodeBool()
-	return nil
+	return buf.Err()
 }
 
 // OneLocatorSetDetails defines message 'one_locator_set_details'.
@@ -2107,7 +2107,7 @@ func (m *OneLocatorSetDetails) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.LsIndex = buf.DecodeUint32()
 	m.LsName = buf.DecodeString(64)
-	return nil
+	return buf.Err()
 }
 
 // OneLocatorSetDump defines message 'one_locator_set_dump'.
@@ -2140,7 +2140,7 @@ func (m *OneLocatorSetDump) Marshal(b []byte) ([]byte, error) {
 func (m *OneLocatorSetDump) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Filter = OneFilter(buf.DecodeUint32())
-	return nil
+	return buf.Err()
 }
 
 // OneMapRegisterEnableDisable defines message 'one_map_register_enable_disable'.
@@ -2173,7 +2173,7 @@ func (m *OneMapRegisterEnableDisable) Marshal(b []byte) ([]byte, error) {
 func (m *OneMapRegisterEnableDisable) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.IsEnable = buf.DecodeBool()
-	return nil
+	return buf.Err()
 }
 
 // OneMapRegisterEnableDisableReply defines message 'one_map_register_enable_disable_reply'.
@@ -2208,7 +2208,7 @@ func (m *OneMapRegisterEnableDisableReply) Marshal(b []byte) ([]byte, error) {
 func (m *OneMapRegisterEnableDisableReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
-	return nil
+	return buf.Err()
 }
 
 // OneMapRegisterFallbackThreshold defines message 'one_map_register_fallback_threshold'.
@@ -2243,7 +2243,7 @@ func (m *OneMapRegisterFallbackThreshold) Marshal(b []byte) ([]byte, error) {
 func (m *OneMapRegisterFallbackThreshold) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Value = buf.DecodeUint32()
-	return nil
+	return buf.Err()
 }
 
 // OneMapRegisterFallbackThresholdReply defines message 'one_map_register_fallback_threshold_reply'.
@@ -2278,7 +2278,7 @@ func (m *OneMapRegisterFallbackThresholdReply) Marshal(b []byte) ([]byte, error)
 func (m *OneMapRegisterFallbackThresholdReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
-	return nil
+	return buf.Err()
 }
 
 // OneMapRegisterSetTTL defines message 'one_map_register_set_ttl'.
@@ -2311,7 +2311,7 @@ func (m *OneMapRegisterSetTTL) Marshal(b []byte) ([]byte, error) {
 func (m *OneMapRegisterSetTTL) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.TTL = buf.DecodeUint32()
-	return nil
+	return buf.Err()
 }
 
 // OneMapRegisterSetTTLReply defines message 'one_map_register_set_ttl_reply'.
@@ -2344,7 +2344,7 @@ func (m *OneMapRegisterSetTTLReply) Marshal(b []byte) ([]byte, error) {
 func (m *OneMapRegisterSetTTLReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
-	return nil
+	return buf.Err()
 }
 
 // OneMapRequestMode defines message 'one_map_request_mode'.
@@ -2377,7 +2377,7 @@ func (m *OneMapRequestMode) Marshal(b []byte) ([]byte, error) {
 func (m *OneMapRequestMode) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Mode = OneMapMode(buf.DecodeUint32())
-	return nil
+	return buf.Err()
 }
 
 // OneMapRequestModeReply defines message 'one_map_request_mode_reply'.
@@ -2410,7 +2410,7 @@ func (m *OneMapRequestModeReply) Marshal(b []byte) ([]byte, error) {
 func (m *OneMapRequestModeReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
-	return nil
+	return buf.Err()
 }
 
 // OneMapResolverDetails defines message 'one_map_resolver_details'.
@@ -2446,7 +2446,7 @@ func (m *OneMapResolverDetails) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.IPAddress.Af = ip_types.AddressFamily(buf.DecodeUint8())
 	copy(m.IPAddress.Un.XXX_UnionData[:], buf.DecodeBytes(16))
-	return nil
+	return buf.Err()
 }
 
 // OneMapResolverDump defines message 'one_map_resolver_dump'.
@@ -2509,7 +2509,7 @@ func (m *OneMapServerDetails) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.IPAddress.Af = ip_types.AddressFamily(buf.DecodeUint8())
 	copy(m.IPAddress.Un.XXX_UnionData[:], buf.DecodeBytes(16))
-	return nil
+	return buf.Err()
 }
 
 // OneMapServerDump defines message 'one_map_server_dump'.
@@ -2609,11 +2609,11 @@ func (m *OneNdpBdGetReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
 	m.Count = buf.DecodeUint32()
-	m.BridgeDomains = make([]uint32, m.Count)
+	m.BridgeDomains = make([]uint32, buf.CheckArrayLen(int(m.Count), 4))
 	for i := 0; i < len(m.BridgeDomains); i++ {
 		m.BridgeDomains[i] = buf.DecodeUint32()
 	}
-	return nil
+	return buf.Err()
 }
 
 // OneNdpEntriesGet defines message 'one_ndp_entries_get'.
@@ -2646,7 +2646,7 @@ func (m *OneNdpEntriesGet) Marshal(b []byte) ([]byte, error) {
 func (m *OneNdpEntriesGet) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Bd = buf.DecodeUint32()
-	return nil
+	return buf.Err()
 }
 
 // OneNdpEntriesGetReply defines message 'one_ndp_entries_get_reply'.
@@ -2701,12 +2701,12 @@ func (m *OneNdpEntriesGetReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
 	m.Count = buf.DecodeUint32()
-	m.Entries = make([]OneNdpEntry, m.Count)
+	m.Entries = make([]OneNdpEntry, buf.CheckArrayLen(int(m.Count), 1))
 	for j0 := 0; j0 < len(m.Entries); j0++ {
 		copy(m.Entries[j0].Mac[:], buf.DecodeBytes(6))
 		copy(m.Entries[j0].IP6[:], buf.DecodeBytes(16))
 	}
-	return nil
+	return buf.Err()
 }
 
 // OneNshSetLocatorSet defines message 'one_nsh_set_locator_set'.
@@ -2743,7 +2743,7 @@ func (m *OneNshSetLocatorSet) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.IsAdd = buf.DecodeBool()
 	m.LsName = buf.DecodeString(64)
-	return nil
+	return buf.Err()
 }
 
 // OneNshSetLocatorSetReply defines message 'one_nsh_set_locator_set_reply'.
@@ -2776,7 +2776,7 @@ func (m *OneNshSetLocatorSetReply) Marshal(b []byte) ([]byte, error) {
 func (m *OneNshSetLocatorSetReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
-	return nil
+	return buf.Err()
 }
 
 // OnePitrSetLocatorSet defines message 'one_pitr_set_locator_set'.
@@ -2813,7 +2813,7 @@ func (m *OnePitrSetLocatorSet) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.IsAdd = buf.DecodeBool()
 	m.LsName = buf.DecodeString(64)
-	return nil
+	return buf.Err()
 }
 
 // OnePitrSetLocatorSetReply defines message 'one_pitr_set_locator_set_reply'.
@@ -2846,7 +2846,7 @@ func (m *OnePitrSetLocatorSetReply) Marshal(b []byte) ([]byte, error) {
 func (m *OnePitrSetLocatorSetReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
-	return nil
+	return buf.Err()
 }
 
 // OneRlocProbeEnableDisable defines message 'one_rloc_probe_enable_disable'.
@@ -2879,7 +2879,7 @@ func (m *OneRlocProbeEnableDisable) Marshal(b []byte) ([]byte, error) {
 func (m *OneRlocProbeEnableDisable) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.IsEnable = buf.DecodeBool()
-	return nil
+	return buf.Err()
 }
 
 // OneRlocProbeEnableDisableReply defines message 'one_rloc_probe_enable_disable_reply'.
@@ -2914,7 +2914,7 @@ func (m *OneRlocProbeEnableDisableReply) Marshal(b []byte) ([]byte, error) {
 func (m *OneRlocProbeEnableDisableReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
-	return nil
+	return buf.Err()
 }
 
 // OneSetTransportProtocol defines message 'one_set_transport_protocol'.
@@ -2947,7 +2947,7 @@ func (m *OneSetTransportProtocol) Marshal(b []byte) ([]byte, error) {
 func (m *OneSetTransportProtocol) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Protocol = buf.DecodeUint8()
-	return nil
+	return buf.Err()
 }
 
 // OneSetTransportProtocolReply defines message 'one_set_transport_protocol_reply'.
@@ -2982,7 +2982,7 @@ func (m *OneSetTransportProtocolReply) Marshal(b []byte) ([]byte, error) {
 func (m *OneSetTransportProtocolReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
-	return nil
+	return buf.Err()
 }
 
 // OneShowPetrMode defines message 'one_show_petr_mode'.
@@ -3046,7 +3046,7 @@ func (m *OneShowPetrModeReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
 	m.IsEnable = buf.DecodeBool()
-	return nil
+	return buf.Err()
 }
 
 // OneShowPitrMode defines message 'one_show_pitr_mode'.
@@ -3110,7 +3110,7 @@ func (m *OneShowPitrModeReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
 	m.IsEnable = buf.DecodeBool()
-	return nil
+	return buf.Err()
 }
 
 // OneShowXtrMode defines message 'one_show_xtr_mode'.
@@ -3174,7 +3174,7 @@ func (m *OneShowXtrModeReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
 	m.IsEnable = buf.DecodeBool()
-	return nil
+	return buf.Err()
 }
 
 // OneStatsDetails defines message 'one_stats_details'.
@@ -3243,7 +3243,7 @@ func (m *OneStatsDetails) Unmarshal(b []byte) error {
 	copy(m.Lloc.Un.XXX_UnionData[:], buf.DecodeBytes(16))
 	m.PktCount = buf.DecodeUint32()
 	m.Bytes = buf.DecodeUint32()
-	return nil
+	return buf.Err()
 }
 
 // OneStatsDump defines message 'one_stats_dump'.
@@ -3303,7 +3303,7 @@ func (m *OneStatsEnableDisable) Marshal(b []byte) ([]byte, error) {
 func (m *OneStatsEnableDisable) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.IsEnable = buf.DecodeBool()
-	return nil
+	return buf.Err()
 }
 
 // OneStatsEnableDisableReply defines message 'one_stats_enable_disable_reply'.
@@ -3336,7 +3336,7 @@ func (m *OneStatsEnableDisableReply) Marshal(b []byte) ([]byte, error) {
 func (m *OneStatsEnableDisableReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
-	return nil
+	return buf.Err()
 }
 
 // OneStatsFlush defines message 'one_stats_flush'.
@@ -3396,7 +3396,7 @@ func (m *OneStatsFlushReply) Marshal(b []byte) ([]byte, error) {
 func (m *OneStatsFlushReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
-	return nil
+	return buf.Err()
 }
 
 // OneUsePetr defines message 'one_use_petr'.
@@ -3436,7 +3436,7 @@ func (m *OneUsePetr) Unmarshal(b []byte) error {
 	m.IPAddress.Af = ip_types.AddressFamily(buf.DecodeUint8())
 	copy(m.IPAddress.Un.XXX_UnionData[:], buf.DecodeBytes(16))
 	m.IsAdd = buf.DecodeBool()
-	return nil
+	return buf.Err()
 }
 
 // OneUsePetrReply defines message 'one_use_petr_reply'.
@@ -3469,7 +3469,7 @@ func (m *OneUsePetrReply) Marshal(b []byte) ([]byte, error) {
 func (m *OneUsePetrReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
-	return nil
+	return buf.Err()
 }
 
 // ShowOneMapRegisterFallbackThreshold defines message 'show_one_map_register_fallback_threshold'.
@@ -3539,7 +3539,7 @@ func (m *ShowOneMapRegisterFallbackThresholdReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
 	m.Value = buf.DecodeUint32()
-	return nil
+	return buf.Err()
 }
 
 // ShowOneMapRegisterState defines message 'show_one_map_register_state'.
@@ -3605,7 +3605,7 @@ func (m *ShowOneMapRegisterStateReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
 	m.IsEnable = buf.DecodeBool()
-	return nil
+	return buf.Err()
 }
 
 // ShowOneMapRegisterTTL defines message 'show_one_map_register_ttl'.
@@ -3669,7 +3669,7 @@ func (m *ShowOneMapRegisterTTLReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
 	m.TTL = buf.DecodeUint32()
-	return nil
+	return buf.Err()
 }
 
 // ShowOneMapRequestMode defines message 'show_one_map_request_mode'.
@@ -3733,7 +3733,7 @@ func (m *ShowOneMapRequestModeReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
 	m.Mode = OneMapMode(buf.DecodeUint32())
-	return nil
+	return buf.Err()
 }
 
 // ShowOneNshMapping defines message 'show_one_nsh_mapping'.
@@ -3801,7 +3801,7 @@ func (m *ShowOneNshMappingReply) Unmarshal(b []byte) error {
 	m.Retval = buf.DecodeInt32()
 	m.IsSet = buf.DecodeBool()
 	m.LocatorSetName = buf.DecodeString(64)
-	return nil
+	return buf.Err()
 }
 
 // ShowOnePitr defines message 'show_one_pitr'.
@@ -3869,7 +3869,7 @@ func (m *ShowOnePitrReply) Unmarshal(b []byte) error {
 	m.Retval = buf.DecodeInt32()
 	m.Status = buf.DecodeBool()
 	m.LocatorSetName = buf.DecodeString(64)
-	return nil
+	return buf.Err()
 }
 
 // ShowOneRlocProbeState defines message 'show_one_rloc_probe_state'.
@@ -3933,7 +3933,7 @@ func (m *ShowOneRlocProbeStateReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
 	m.IsEnable = buf.DecodeBool()
-	return nil
+	return buf.Err()
 }
 
 // ShowOneStatsEnableDisable defines message 'show_one_stats_enable_disable'.
@@ -3999,7 +3999,7 @@ func (m *ShowOneStatsEnableDisableReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
 	m.IsEnable = buf.DecodeBool()
-	return nil
+	return buf.Err()
 }
 
 // ShowOneStatus defines message 'show_one_status'.
@@ -4067,7 +4067,7 @@ func (m *ShowOneStatusReply) Unmarshal(b []byte) error {
 	m.Retval = buf.DecodeInt32()
 	m.FeatureStatus = buf.DecodeBool()
 	m.GpeStatus = buf.DecodeBool()
-	return nil
+	return buf.Err()
 }
 
 // ShowOneUsePetr defines message 'show_one_use_petr'.
@@ -4138,7 +4138,7 @@ func (m *ShowOneUsePetrReply) Unmarshal(b []byte) error {
 	m.Status = buf.DecodeBool()
 	m.IPAddress.Af = ip_types.AddressFamily(buf.DecodeUint8())
 	copy(m.IPAddress.Un.XXX_UnionData[:], buf.DecodeBytes(16))
-	return nil
+	return buf.Err()
 }
 
 func init() { file_one_binapi_init() }
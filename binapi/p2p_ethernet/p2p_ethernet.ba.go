@@ -67,7 +67,7 @@ func (m *P2pEthernetAdd) Unmarshal(b []byte) error {
 	m.ParentIfIndex = interface_types.InterfaceIndex(buf.DecodeUint32())
 	m.SubifID = buf.DecodeUint32()
 	copy(m.RemoteMac[:], buf.DecodeBytes(6))
-	return nil
+	return buf.Err()
 }
 
 // P2pEthernetAddReply defines message 'p2p_ethernet_add_reply'.
@@ -104,7 +104,7 @@ func (m *P2pEthernetAddReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
 	m.SwIfIndex = interface_types.InterfaceIndex(buf.DecodeUint32())
-	return nil
+	return buf.Err()
 }
 
 // P2pEthernetDel defines message 'p2p_ethernet_del'.
@@ -141,7 +141,7 @@ func (m *P2pEthernetDel) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.ParentIfIndex = interface_types.InterfaceIndex(buf.DecodeUint32())
 	copy(m.RemoteMac[:], buf.DecodeBytes(6))
-	return nil
+	return buf.Err()
 }
 
 // P2pEthernetDelReply defines message 'p2p_ethernet_del_reply'.
@@ -174,7 +174,7 @@ func (m *P2pEthernetDelReply) Marshal(b []byte) ([]byte, error) {
 func (m *P2pEthernetDelReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
-	return nil
+	return buf.Err()
 }
 
 func init() { file_p2p_ethernet_binapi_init() }
@@ -103,7 +103,7 @@ func (m *PgCapture) Unmarshal(b []byte) error {
 	m.IsEnabled = buf.DecodeBool()
 	m.Count = buf.DecodeUint32()
 	m.PcapFileName = buf.DecodeString(0)
-	return nil
+	return buf.Err()
 }
 
 // PgCaptureReply defines message 'pg_capture_reply'.
@@ -136,7 +136,7 @@ func (m *PgCaptureReply) Marshal(b []byte) ([]byte, error) {
 func (m *PgCaptureReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
-	return nil
+	return buf.Err()
 }
 
 // PgCreateInterface defines message 'pg_create_interface'.
@@ -177,7 +177,7 @@ func (m *PgCreateInterface) Unmarshal(b []byte) error {
 	m.InterfaceID = interface_types.InterfaceIndex(buf.DecodeUint32())
 	m.GsoEnabled = buf.DecodeBool()
 	m.GsoSize = buf.DecodeUint32()
-	return nil
+	return buf.Err()
 }
 
 // PgCreateInterfaceReply defines message 'pg_create_interface_reply'.
@@ -214,7 +214,7 @@ func (m *PgCreateInterfaceReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
 	m.SwIfIndex = interface_types.InterfaceIndex(buf.DecodeUint32())
-	return nil
+	return buf.Err()
 }
 
 // PgCreateInterfaceV2 defines message 'pg_create_interface_v2'.
@@ -259,7 +259,7 @@ func (m *PgCreateInterfaceV2) Unmarshal(b []byte) error {
 	m.GsoEnabled = buf.DecodeBool()
 	m.GsoSize = buf.DecodeUint32()
 	m.Mode = PgInterfaceMode(buf.DecodeUint8())
-	return nil
+	return buf.Err()
 }
 
 // PgCreateInterfaceV2Reply defines message 'pg_create_interface_v2_reply'.
@@ -296,7 +296,7 @@ func (m *PgCreateInterfaceV2Reply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
 	m.SwIfIndex = interface_types.InterfaceIndex(buf.DecodeUint32())
-	return nil
+	return buf.Err()
 }
 
 // PgEnableDisable defines message 'pg_enable_disable'.
@@ -333,7 +333,7 @@ func (m *PgEnableDisable) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.IsEnabled = buf.DecodeBool()
 	m.StreamName = buf.DecodeString(0)
-	return nil
+	return buf.Err()
 }
 
 // PgEnableDisableReply defines message 'pg_enable_disable_reply'.
@@ -366,7 +366,7 @@ func (m *PgEnableDisableReply) Marshal(b []byte) ([]byte, error) {
 func (m *PgEnableDisableReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
-	return nil
+	return buf.Err()
 }
 
 // PgInterfaceEnableDisableCoalesce defines message 'pg_interface_enable_disable_coalesce'.
@@ -406,7 +406,7 @@ func (m *PgInterfaceEnableDisableCoalesce) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.SwIfIndex = interface_types.InterfaceIndex(buf.DecodeUint32())
 	m.CoalesceEnabled = buf.DecodeBool()
-	return nil
+	return buf.Err()
 }
 
 // PgInterfaceEnableDisableCoalesceReply defines message 'pg_interface_enable_disable_coalesce_reply'.
@@ -442,7 +442,7 @@ func (m *PgInterfaceEnableDisableCoalesceReply) Marshal(b []byte) ([]byte, error
 func (m *PgInterfaceEnableDisableCoalesceReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
-	return nil
+	return buf.Err()
 }
 
 func init() { file_pg_binapi_init() }
@@ -62,7 +62,7 @@ func (m *PipeCreate) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.IsSpecified = buf.DecodeBool()
 	m.UserInstance = buf.DecodeUint32()
-	return nil
+	return buf.Err()
 }
 
 // PipeCreateReply defines message 'pipe_create_reply'.
@@ -109,7 +109,7 @@ func (m *PipeCreateReply) Unmarshal(b []byte) error {
 	for j0 := 0; j0 < 2; j0++ {
 		m.PipeSwIfIndex[j0] = interface_types.InterfaceIndex(buf.DecodeUint32())
 	}
-	return nil
+	return buf.Err()
 }
 
 // PipeDelete defines message 'pipe_delete'.
@@ -142,7 +142,7 @@ func (m *PipeDelete) Marshal(b []byte) ([]byte, error) {
 func (m *PipeDelete) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.SwIfIndex = interface_types.InterfaceIndex(buf.DecodeUint32())
-	return nil
+	return buf.Err()
 }
 
 // PipeDeleteReply defines message 'pipe_delete_reply'.
@@ -175,7 +175,7 @@ func (m *PipeDeleteReply) Marshal(b []byte) ([]byte, error) {
 func (m *PipeDeleteReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
-	return nil
+	return buf.Err()
 }
 
 // PipeDetails defines message 'pipe_details'.
@@ -222,7 +222,7 @@ func (m *PipeDetails) Unmarshal(b []byte) error {
 		m.PipeSwIfIndex[j0] = interface_types.InterfaceIndex(buf.DecodeUint32())
 	}
 	m.Instance = buf.DecodeUint32()
-	return nil
+	return buf.Err()
 }
 
 // PipeDump defines message 'pipe_dump'.
@@ -198,7 +198,7 @@ func (m *PnatBindingAdd) Unmarshal(b []byte) error {
 	m.Rewrite.FromOffset = buf.DecodeUint8()
 	m.Rewrite.ToOffset = buf.DecodeUint8()
 	m.Rewrite.ClearOffset = buf.DecodeUint8()
-	return nil
+	return buf.Err()
 }
 
 // PnatBindingAddReply defines message 'pnat_binding_add_reply'.
@@ -236,7 +236,7 @@ func (m *PnatBindingAddReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
 	m.BindingIndex = buf.DecodeUint32()
-	return nil
+	return buf.Err()
 }
 
 // PnatBindingAddV2 defines message 'pnat_binding_add_v2'.
@@ -310,7 +310,7 @@ func (m *PnatBindingAddV2) Unmarshal(b []byte) error {
 	m.Rewrite.FromOffset = buf.DecodeUint8()
 	m.Rewrite.ToOffset = buf.DecodeUint8()
 	m.Rewrite.ClearOffset = buf.DecodeUint8()
-	return nil
+	return buf.Err()
 }
 
 // PnatBindingAddV2Reply defines message 'pnat_binding_add_v2_reply'.
@@ -348,7 +348,7 @@ func (m *PnatBindingAddV2Reply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
 	m.BindingIndex = buf.DecodeUint32()
-	return nil
+	return buf.Err()
 }
 
 // PnatBindingAttach defines message 'pnat_binding_attach'.
@@ -390,7 +390,7 @@ func (m *PnatBindingAttach) Unmarshal(b []byte) error {
 	m.SwIfIndex = interface_types.InterfaceIndex(buf.DecodeUint32())
 	m.Attachment = PnatAttachmentPoint(buf.DecodeUint32())
 	m.BindingIndex = buf.DecodeUint32()
-	return nil
+	return buf.Err()
 }
 
 // PnatBindingAttachReply defines message 'pnat_binding_attach_reply'.
@@ -424,7 +424,7 @@ func (m *PnatBindingAttachReply) Marshal(b []byte) ([]byte, error) {
 func (m *PnatBindingAttachReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
-	return nil
+	return buf.Err()
 }
 
 // PnatBindingDel defines message 'pnat_binding_del'.
@@ -458,7 +458,7 @@ func (m *PnatBindingDel) Marshal(b []byte) ([]byte, error) {
 func (m *PnatBindingDel) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.BindingIndex = buf.DecodeUint32()
-	return nil
+	return buf.Err()
 }
 
 // PnatBindingDelReply defines message 'pnat_binding_del_reply'.
@@ -492,7 +492,7 @@ func (m *PnatBindingDelReply) Marshal(b []byte) ([]byte, error) {
 func (m *PnatBindingDelReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
-	return nil
+	return buf.Err()
 }
 
 // PnatBindingDetach defines message 'pnat_binding_detach'.
@@ -534,7 +534,7 @@ func (m *PnatBindingDetach) Unmarshal(b []byte) error {
 	m.SwIfIndex = interface_types.InterfaceIndex(buf.DecodeUint32())
 	m.Attachment = PnatAttachmentPoint(buf.DecodeUint32())
 	m.BindingIndex = buf.DecodeUint32()
-	return nil
+	return buf.Err()
 }
 
 // PnatBindingDetachReply defines message 'pnat_binding_detach_reply'.
@@ -568,7 +568,7 @@ func (m *PnatBindingDetachReply) Marshal(b []byte) ([]byte, error) {
 func (m *PnatBindingDetachReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
-	return nil
+	return buf.Err()
 }
 
 // PnatBindingsDetails defines message 'pnat_bindings_details'.
@@ -642,7 +642,7 @@ func (m *PnatBindingsDetails) Unmarshal(b []byte) error {
 	m.Rewrite.FromOffset = buf.DecodeUint8()
 	m.Rewrite.ToOffset = buf.DecodeUint8()
 	m.Rewrite.ClearOffset = buf.DecodeUint8()
-	return nil
+	return buf.Err()
 }
 
 // PnatBindingsGet defines message 'pnat_bindings_get'.
@@ -676,7 +676,7 @@ func (m *PnatBindingsGet) Marshal(b []byte) ([]byte, error) {
 func (m *PnatBindingsGet) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Cursor = buf.DecodeUint32()
-	return nil
+	return buf.Err()
 }
 
 // PnatBindingsGetReply defines message 'pnat_bindings_get_reply'.
@@ -714,7 +714,7 @@ func (m *PnatBindingsGetReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
 	m.Cursor = buf.DecodeUint32()
-	return nil
+	return buf.Err()
 }
 
 // PnatInterfacesDetails defines message 'pnat_interfaces_details'.
@@ -771,7 +771,7 @@ func (m *PnatInterfacesDetails) Unmarshal(b []byte) error {
 	for j0 := 0; j0 < 2; j0++ {
 		m.LookupMask[j0] = PnatMask(buf.DecodeUint32())
 	}
-	return nil
+	return buf.Err()
 }
 
 // PnatInterfacesGet defines message 'pnat_interfaces_get'.
@@ -805,7 +805,7 @@ func (m *PnatInterfacesGet) Marshal(b []byte) ([]byte, error) {
 func (m *PnatInterfacesGet) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Cursor = buf.DecodeUint32()
-	return nil
+	return buf.Err()
 }
 
 // PnatInterfacesGetReply defines message 'pnat_interfaces_get_reply'.
@@ -843,7 +843,7 @@ func (m *PnatInterfacesGetReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
 	m.Cursor = buf.DecodeUint32()
-	return nil
+	return buf.Err()
 }
 
 func init() { file_pnat_binapi_init() }
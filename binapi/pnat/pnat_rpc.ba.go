@@ -35,7 +35,7 @@ func (c *serviceClient) PnatBindingAdd(ctx context.Context, in *PnatBindingAdd)
 	if err != nil {
 		return nil, err
 	}
-	return out, api.RetvalToVPPApiError(out.Retval)
+	return out, api.RetvalToRequestError(in, out.Retval)
 }
 
 func (c *serviceClient) PnatBindingAddV2(ctx context.Context, in *PnatBindingAddV2) (*PnatBindingAddV2Reply, error) {
@@ -44,7 +44,7 @@ func (c *serviceClient) PnatBindingAddV2(ctx context.Context, in *PnatBindingAdd
 	if err != nil {
 		return nil, err
 	}
-	return out, api.RetvalToVPPApiError(out.Retval)
+	return out, api.RetvalToRequestError(in, out.Retval)
 }
 
 func (c *serviceClient) PnatBindingAttach(ctx context.Context, in *PnatBindingAttach) (*PnatBindingAttachReply, error) {
@@ -53,7 +53,7 @@ func (c *serviceClient) PnatBindingAttach(ctx context.Context, in *PnatBindingAt
 	if err != nil {
 		return nil, err
 	}
-	return out, api.RetvalToVPPApiError(out.Retval)
+	return out, api.RetvalToRequestError(in, out.Retval)
 }
 
 func (c *serviceClient) PnatBindingDel(ctx context.Context, in *PnatBindingDel) (*PnatBindingDelReply, error) {
@@ -62,7 +62,7 @@ func (c *serviceClient) PnatBindingDel(ctx context.Context, in *PnatBindingDel)
 	if err != nil {
 		return nil, err
 	}
-	return out, api.RetvalToVPPApiError(out.Retval)
+	return out, api.RetvalToRequestError(in, out.Retval)
 }
 
 func (c *serviceClient) PnatBindingDetach(ctx context.Context, in *PnatBindingDetach) (*PnatBindingDetachReply, error) {
@@ -71,7 +71,7 @@ func (c *serviceClient) PnatBindingDetach(ctx context.Context, in *PnatBindingDe
 	if err != nil {
 		return nil, err
 	}
-	return out, api.RetvalToVPPApiError(out.Retval)
+	return out, api.RetvalToRequestError(in, out.Retval)
 }
 
 func (c *serviceClient) PnatBindingsGet(ctx context.Context, in *PnatBindingsGet) (RPCService_PnatBindingsGetClient, error) {
@@ -104,7 +104,7 @@ func (c *serviceClient_PnatBindingsGetClient) Recv() (*PnatBindingsDetails, *Pna
 	case *PnatBindingsDetails:
 		return m, nil, nil
 	case *PnatBindingsGetReply:
-		if err := api.RetvalToVPPApiError(m.Retval); err != nil {
+		if err := api.RetvalToRequestError((*PnatBindingsGet)(nil), m.Retval); err != nil {
 			return nil, nil, err
 		}
 		err = c.Stream.Close()
@@ -147,7 +147,7 @@ func (c *serviceClient_PnatInterfacesGetClient) Recv() (*PnatInterfacesDetails,
 	case *PnatInterfacesDetails:
 		return m, nil, nil
 	case *PnatInterfacesGetReply:
-		if err := api.RetvalToVPPApiError(m.Retval); err != nil {
+		if err := api.RetvalToRequestError((*PnatInterfacesGet)(nil), m.Retval); err != nil {
 			return nil, nil, err
 		}
 		err = c.Stream.Close()
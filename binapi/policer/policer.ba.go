@@ -116,7 +116,7 @@ func (m *PolicerAddDel) Unmarshal(b []byte) error {
 	m.ExceedAction.Dscp = buf.DecodeUint8()
 	m.ViolateAction.Type = policer_types.Sse2QosActionType(buf.DecodeUint8())
 	m.ViolateAction.Dscp = buf.DecodeUint8()
-	return nil
+	return buf.Err()
 }
 
 // PolicerAddDelReply defines message 'policer_add_del_reply'.
@@ -153,7 +153,7 @@ func (m *PolicerAddDelReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
 	m.PolicerIndex = buf.DecodeUint32()
-	return nil
+	return buf.Err()
 }
 
 // PolicerBind defines message 'policer_bind'.
@@ -194,7 +194,7 @@ func (m *PolicerBind) Unmarshal(b []byte) error {
 	m.Name = buf.DecodeString(64)
 	m.WorkerIndex = buf.DecodeUint32()
 	m.BindEnable = buf.DecodeBool()
-	return nil
+	return buf.Err()
 }
 
 // PolicerBindReply defines message 'policer_bind_reply'.
@@ -227,7 +227,7 @@ func (m *PolicerBindReply) Marshal(b []byte) ([]byte, error) {
 func (m *PolicerBindReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
-	return nil
+	return buf.Err()
 }
 
 // PolicerDetails defines message 'policer_details'.
@@ -349,7 +349,7 @@ func (m *PolicerDetails) Unmarshal(b []byte) error {
 	m.ExtendedLimit = buf.DecodeUint32()
 	m.ExtendedBucket = buf.DecodeUint32()
 	m.LastUpdateTime = buf.DecodeUint64()
-	return nil
+	return buf.Err()
 }
 
 // PolicerDump defines message 'policer_dump'.
@@ -386,7 +386,7 @@ func (m *PolicerDump) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.MatchNameValid = buf.DecodeBool()
 	m.MatchName = buf.DecodeString(64)
-	return nil
+	return buf.Err()
 }
 
 // PolicerInput defines message 'policer_input'.
@@ -427,7 +427,7 @@ func (m *PolicerInput) Unmarshal(b []byte) error {
 	m.Name = buf.DecodeString(64)
 	m.SwIfIndex = interface_types.InterfaceIndex(buf.DecodeUint32())
 	m.Apply = buf.DecodeBool()
-	return nil
+	return buf.Err()
 }
 
 // PolicerInputReply defines message 'policer_input_reply'.
@@ -460,7 +460,7 @@ func (m *PolicerInputReply) Marshal(b []byte) ([]byte, error) {
 func (m *PolicerInputReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
-	return nil
+	return buf.Err()
 }
 
 // PolicerOutput defines message 'policer_output'.
@@ -501,7 +501,7 @@ func (m *PolicerOutput) Unmarshal(b []byte) error {
 	m.Name = buf.DecodeString(64)
 	m.SwIfIndex = interface_types.InterfaceIndex(buf.DecodeUint32())
 	m.Apply = buf.DecodeBool()
-	return nil
+	return buf.Err()
 }
 
 // PolicerOutputReply defines message 'policer_output_reply'.
@@ -534,7 +534,7 @@ func (m *PolicerOutputReply) Marshal(b []byte) ([]byte, error) {
 func (m *PolicerOutputReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
-	return nil
+	return buf.Err()
 }
 
 func init() { file_policer_binapi_init() }
@@ -61,7 +61,7 @@ func (m *PotProfileActivate) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.ID = buf.DecodeUint8()
 	m.ListName = buf.DecodeString(0)
-	return nil
+	return buf.Err()
 }
 
 // PotProfileActivateReply defines message 'pot_profile_activate_reply'.
@@ -94,7 +94,7 @@ func (m *PotProfileActivateReply) Marshal(b []byte) ([]byte, error) {
 func (m *PotProfileActivateReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
-	return nil
+	return buf.Err()
 }
 
 // PotProfileAdd defines message 'pot_profile_add'.
@@ -159,7 +159,7 @@ func (m *PotProfileAdd) Unmarshal(b []byte) error {
 	m.Lpc = buf.DecodeUint64()
 	m.PolynomialPublic = buf.DecodeUint64()
 	m.ListName = buf.DecodeString(0)
-	return nil
+	return buf.Err()
 }
 
 // PotProfileAddReply defines message 'pot_profile_add_reply'.
@@ -192,7 +192,7 @@ func (m *PotProfileAddReply) Marshal(b []byte) ([]byte, error) {
 func (m *PotProfileAddReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
-	return nil
+	return buf.Err()
 }
 
 // PotProfileDel defines message 'pot_profile_del'.
@@ -225,7 +225,7 @@ func (m *PotProfileDel) Marshal(b []byte) ([]byte, error) {
 func (m *PotProfileDel) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.ListName = buf.DecodeString(0)
-	return nil
+	return buf.Err()
 }
 
 // PotProfileDelReply defines message 'pot_profile_del_reply'.
@@ -258,7 +258,7 @@ func (m *PotProfileDelReply) Marshal(b []byte) ([]byte, error) {
 func (m *PotProfileDelReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
-	return nil
+	return buf.Err()
 }
 
 // PotProfileShowConfigDetails defines message 'pot_profile_show_config_details'.
@@ -323,7 +323,7 @@ func (m *PotProfileShowConfigDetails) Unmarshal(b []byte) error {
 	m.BitMask = buf.DecodeUint64()
 	m.Lpc = buf.DecodeUint64()
 	m.PolynomialPublic = buf.DecodeUint64()
-	return nil
+	return buf.Err()
 }
 
 // PotProfileShowConfigDump defines message 'pot_profile_show_config_dump'.
@@ -356,7 +356,7 @@ func (m *PotProfileShowConfigDump) Marshal(b []byte) ([]byte, error) {
 func (m *PotProfileShowConfigDump) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.ID = buf.DecodeUint8()
-	return nil
+	return buf.Err()
 }
 
 func init() { file_pot_binapi_init() }
@@ -64,7 +64,7 @@ func (m *PppoeAddDelCp) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.SwIfIndex = interface_types.InterfaceIndex(buf.DecodeUint32())
 	m.IsAdd = buf.DecodeUint8()
-	return nil
+	return buf.Err()
 }
 
 // PppoeAddDelCpReply defines message 'pppoe_add_del_cp_reply'.
@@ -97,7 +97,7 @@ func (m *PppoeAddDelCpReply) Marshal(b []byte) ([]byte, error) {
 func (m *PppoeAddDelCpReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
-	return nil
+	return buf.Err()
 }
 
 // PppoeAddDelSession defines message 'pppoe_add_del_session'.
@@ -149,7 +149,7 @@ func (m *PppoeAddDelSession) Unmarshal(b []byte) error {
 	copy(m.ClientIP.Un.XXX_UnionData[:], buf.DecodeBytes(16))
 	m.DecapVrfID = buf.DecodeUint32()
 	copy(m.ClientMac[:], buf.DecodeBytes(6))
-	return nil
+	return buf.Err()
 }
 
 // PppoeAddDelSessionReply defines message 'pppoe_add_del_session_reply'.
@@ -186,7 +186,7 @@ func (m *PppoeAddDelSessionReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
 	m.SwIfIndex = interface_types.InterfaceIndex(buf.DecodeUint32())
-	return nil
+	return buf.Err()
 }
 
 // PppoeSessionDetails defines message 'pppoe_session_details'.
@@ -246,7 +246,7 @@ func (m *PppoeSessionDetails) Unmarshal(b []byte) error {
 	m.DecapVrfID = buf.DecodeUint32()
 	copy(m.LocalMac[:], buf.DecodeBytes(6))
 	copy(m.ClientMac[:], buf.DecodeBytes(6))
-	return nil
+	return buf.Err()
 }
 
 // PppoeSessionDump defines message 'pppoe_session_dump'.
@@ -279,7 +279,7 @@ func (m *PppoeSessionDump) Marshal(b []byte) ([]byte, error) {
 func (m *PppoeSessionDump) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.SwIfIndex = interface_types.InterfaceIndex(buf.DecodeUint32())
-	return nil
+	return buf.Err()
 }
 
 func init() { file_pppoe_binapi_init() }
@@ -183,7 +183,7 @@ func (m *PuntReasonDetails) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Reason.ID = buf.DecodeUint32()
 	m.Reason.Name = buf.DecodeString(0)
-	return nil
+	return buf.Err()
 }
 
 // PuntReasonDump defines message 'punt_reason_dump'.
@@ -219,7 +219,7 @@ func (m *PuntReasonDump) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Reason.ID = buf.DecodeUint32()
 	m.Reason.Name = buf.DecodeString(0)
-	return nil
+	return buf.Err()
 }
 
 // PuntSocketDeregister defines message 'punt_socket_deregister'.
@@ -255,7 +255,7 @@ func (m *PuntSocketDeregister) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Punt.Type = PuntType(buf.DecodeUint32())
 	copy(m.Punt.Punt.XXX_UnionData[:], buf.DecodeBytes(4))
-	return nil
+	return buf.Err()
 }
 
 // PuntSocketDeregisterReply defines message 'punt_socket_deregister_reply'.
@@ -288,7 +288,7 @@ func (m *PuntSocketDeregisterReply) Marshal(b []byte) ([]byte, error) {
 func (m *PuntSocketDeregisterReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
-	return nil
+	return buf.Err()
 }
 
 // PuntSocketDetails defines message 'punt_socket_details'.
@@ -328,7 +328,7 @@ func (m *PuntSocketDetails) Unmarshal(b []byte) error {
 	m.Punt.Type = PuntType(buf.DecodeUint32())
 	copy(m.Punt.Punt.XXX_UnionData[:], buf.DecodeBytes(4))
 	m.Pathname = buf.DecodeString(108)
-	return nil
+	return buf.Err()
 }
 
 // PuntSocketDump defines message 'punt_socket_dump'.
@@ -361,7 +361,7 @@ func (m *PuntSocketDump) Marshal(b []byte) ([]byte, error) {
 func (m *PuntSocketDump) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Type = PuntType(buf.DecodeUint32())
-	return nil
+	return buf.Err()
 }
 
 // PuntSocketRegister defines message 'punt_socket_register'.
@@ -405,7 +405,7 @@ func (m *PuntSocketRegister) Unmarshal(b []byte) error {
 	m.Punt.Type = PuntType(buf.DecodeUint32())
 	copy(m.Punt.Punt.XXX_UnionData[:], buf.DecodeBytes(4))
 	m.Pathname = buf.DecodeString(108)
-	return nil
+	return buf.Err()
 }
 
 // PuntSocketRegisterReply defines message 'punt_socket_register_reply'.
@@ -442,7 +442,7 @@ func (m *PuntSocketRegisterReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
 	m.Pathname = buf.DecodeString(108)
-	return nil
+	return buf.Err()
 }
 
 // SetPunt defines message 'set_punt'.
@@ -482,7 +482,7 @@ func (m *SetPunt) Unmarshal(b []byte) error {
 	m.IsAdd = buf.DecodeBool()
 	m.Punt.Type = PuntType(buf.DecodeUint32())
 	copy(m.Punt.Punt.XXX_UnionData[:], buf.DecodeBytes(4))
-	return nil
+	return buf.Err()
 }
 
 // SetPuntReply defines message 'set_punt_reply'.
@@ -515,7 +515,7 @@ func (m *SetPuntReply) Marshal(b []byte) ([]byte, error) {
 func (m *SetPuntReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
-	return nil
+	return buf.Err()
 }
 
 func init() { file_punt_binapi_init() }
@@ -127,7 +127,7 @@ func (m *QosEgressMapDelete) Marshal(b []byte) ([]byte, error) {
 func (m *QosEgressMapDelete) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.ID = buf.DecodeUint32()
-	return nil
+	return buf.Err()
 }
 
 // QosEgressMapDeleteReply defines message 'qos_egress_map_delete_reply'.
@@ -160,7 +160,7 @@ func (m *QosEgressMapDeleteReply) Marshal(b []byte) ([]byte, error) {
 func (m *QosEgressMapDeleteReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
-	return nil
+	return buf.Err()
 }
 
 // QosEgressMapDetails defines message 'qos_egress_map_details'.
@@ -203,7 +203,7 @@ func (m *QosEgressMapDetails) Unmarshal(b []byte) error {
 		m.Map.Rows[j1].Outputs = make([]byte, 256)
 		copy(m.Map.Rows[j1].Outputs, buf.DecodeBytes(len(m.Map.Rows[j1].Outputs)))
 	}
-	return nil
+	return buf.Err()
 }
 
 // QosEgressMapDump defines message 'qos_egress_map_dump'.
@@ -273,7 +273,7 @@ func (m *QosEgressMapUpdate) Unmarshal(b []byte) error {
 		m.Map.Rows[j1].Outputs = make([]byte, 256)
 		copy(m.Map.Rows[j1].Outputs, buf.DecodeBytes(len(m.Map.Rows[j1].Outputs)))
 	}
-	return nil
+	return buf.Err()
 }
 
 // QosEgressMapUpdateReply defines message 'qos_egress_map_update_reply'.
@@ -306,7 +306,7 @@ func (m *QosEgressMapUpdateReply) Marshal(b []byte) ([]byte, error) {
 func (m *QosEgressMapUpdateReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
-	return nil
+	return buf.Err()
 }
 
 // QosMarkDetails defines message 'qos_mark_details'.
@@ -345,7 +345,7 @@ func (m *QosMarkDetails) Unmarshal(b []byte) error {
 	m.Mark.SwIfIndex = buf.DecodeUint32()
 	m.Mark.MapID = buf.DecodeUint32()
 	m.Mark.OutputSource = QosSource(buf.DecodeUint8())
-	return nil
+	return buf.Err()
 }
 
 // QosMarkDetailsReply defines message 'qos_mark_details_reply'.
@@ -378,7 +378,7 @@ func (m *QosMarkDetailsReply) Marshal(b []byte) ([]byte, error) {
 func (m *QosMarkDetailsReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
-	return nil
+	return buf.Err()
 }
 
 // QosMarkDump defines message 'qos_mark_dump'.
@@ -411,7 +411,7 @@ func (m *QosMarkDump) Marshal(b []byte) ([]byte, error) {
 func (m *QosMarkDump) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.SwIfIndex = interface_types.InterfaceIndex(buf.DecodeUint32())
-	return nil
+	return buf.Err()
 }
 
 // QosMarkEnableDisable defines message 'qos_mark_enable_disable'.
@@ -454,7 +454,7 @@ func (m *QosMarkEnableDisable) Unmarshal(b []byte) error {
 	m.Mark.SwIfIndex = buf.DecodeUint32()
 	m.Mark.MapID = buf.DecodeUint32()
 	m.Mark.OutputSource = QosSource(buf.DecodeUint8())
-	return nil
+	return buf.Err()
 }
 
 // QosMarkEnableDisableReply defines message 'qos_mark_enable_disable_reply'.
@@ -487,7 +487,7 @@ func (m *QosMarkEnableDisableReply) Marshal(b []byte) ([]byte, error) {
 func (m *QosMarkEnableDisableReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
-	return nil
+	return buf.Err()
 }
 
 // QosRecordDetails defines message 'qos_record_details'.
@@ -523,7 +523,7 @@ func (m *QosRecordDetails) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Record.SwIfIndex = interface_types.InterfaceIndex(buf.DecodeUint32())
 	m.Record.InputSource = QosSource(buf.DecodeUint8())
-	return nil
+	return buf.Err()
 }
 
 // QosRecordDump defines message 'qos_record_dump'.
@@ -590,7 +590,7 @@ func (m *QosRecordEnableDisable) Unmarshal(b []byte) error {
 	m.Enable = buf.DecodeBool()
 	m.Record.SwIfIndex = interface_types.InterfaceIndex(buf.DecodeUint32())
 	m.Record.InputSource = QosSource(buf.DecodeUint8())
-	return nil
+	return buf.Err()
 }
 
 // QosRecordEnableDisableReply defines message 'qos_record_enable_disable_reply'.
@@ -623,7 +623,7 @@ func (m *QosRecordEnableDisableReply) Marshal(b []byte) ([]byte, error) {
 func (m *QosRecordEnableDisableReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
-	return nil
+	return buf.Err()
 }
 
 // QosStoreDetails defines message 'qos_store_details'.
@@ -662,7 +662,7 @@ func (m *QosStoreDetails) Unmarshal(b []byte) error {
 	m.Store.SwIfIndex = interface_types.InterfaceIndex(buf.DecodeUint32())
 	m.Store.InputSource = QosSource(buf.DecodeUint8())
 	m.Store.Value = buf.DecodeUint8()
-	return nil
+	return buf.Err()
 }
 
 // QosStoreDump defines message 'qos_store_dump'.
@@ -732,7 +732,7 @@ func (m *QosStoreEnableDisable) Unmarshal(b []byte) error {
 	m.Store.SwIfIndex = interface_types.InterfaceIndex(buf.DecodeUint32())
 	m.Store.InputSource = QosSource(buf.DecodeUint8())
 	m.Store.Value = buf.DecodeUint8()
-	return nil
+	return buf.Err()
 }
 
 // QosStoreEnableDisableReply defines message 'qos_store_enable_disable_reply'.
@@ -765,7 +765,7 @@ func (m *QosStoreEnableDisableReply) Marshal(b []byte) ([]byte, error) {
 func (m *QosStoreEnableDisableReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
-	return nil
+	return buf.Err()
 }
 
 func init() { file_qos_binapi_init() }
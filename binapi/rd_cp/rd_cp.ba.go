@@ -66,7 +66,7 @@ func (m *IP6NdAddressAutoconfig) Unmarshal(b []byte) error {
 	m.SwIfIndex = interface_types.InterfaceIndex(buf.DecodeUint32())
 	m.Enable = buf.DecodeBool()
 	m.InstallDefaultRoutes = buf.DecodeBool()
-	return nil
+	return buf.Err()
 }
 
 // IP6NdAddressAutoconfigReply defines message 'ip6_nd_address_autoconfig_reply'.
@@ -99,7 +99,7 @@ func (m *IP6NdAddressAutoconfigReply) Marshal(b []byte) ([]byte, error) {
 func (m *IP6NdAddressAutoconfigReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
-	return nil
+	return buf.Err()
 }
 
 func init() { file_rd_cp_binapi_init() }
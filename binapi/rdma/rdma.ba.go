@@ -178,7 +178,7 @@ func (m *RdmaCreate) Unmarshal(b []byte) error {
 	m.RxqSize = buf.DecodeUint16()
 	m.TxqSize = buf.DecodeUint16()
 	m.Mode = RdmaMode(buf.DecodeUint32())
-	return nil
+	return buf.Err()
 }
 
 // RdmaCreateReply defines message 'rdma_create_reply'.
@@ -215,7 +215,7 @@ func (m *RdmaCreateReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
 	m.SwIfIndex = interface_types.InterfaceIndex(buf.DecodeUint32())
-	return nil
+	return buf.Err()
 }
 
 // RdmaCreateV2 defines message 'rdma_create_v2'.
@@ -277,7 +277,7 @@ func (m *RdmaCreateV2) Unmarshal(b []byte) error {
 	m.Mode = RdmaMode(buf.DecodeUint32())
 	m.NoMultiSeg = buf.DecodeBool()
 	m.MaxPktlen = buf.DecodeUint16()
-	return nil
+	return buf.Err()
 }
 
 // RdmaCreateV2Reply defines message 'rdma_create_v2_reply'.
@@ -314,7 +314,7 @@ func (m *RdmaCreateV2Reply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
 	m.SwIfIndex = interface_types.InterfaceIndex(buf.DecodeUint32())
-	return nil
+	return buf.Err()
 }
 
 // RdmaCreateV3 defines message 'rdma_create_v3'.
@@ -383,7 +383,7 @@ func (m *RdmaCreateV3) Unmarshal(b []byte) error {
 	m.MaxPktlen = buf.DecodeUint16()
 	m.Rss4 = RdmaRss4(buf.DecodeUint32())
 	m.Rss6 = RdmaRss6(buf.DecodeUint32())
-	return nil
+	return buf.Err()
 }
 
 // RdmaCreateV3Reply defines message 'rdma_create_v3_reply'.
@@ -420,7 +420,7 @@ func (m *RdmaCreateV3Reply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
 	m.SwIfIndex = interface_types.InterfaceIndex(buf.DecodeUint32())
-	return nil
+	return buf.Err()
 }
 
 // RdmaDelete defines message 'rdma_delete'.
@@ -453,7 +453,7 @@ func (m *RdmaDelete) Marshal(b []byte) ([]byte, error) {
 func (m *RdmaDelete) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.SwIfIndex = interface_types.InterfaceIndex(buf.DecodeUint32())
-	return nil
+	return buf.Err()
 }
 
 // RdmaDeleteReply defines message 'rdma_delete_reply'.
@@ -486,7 +486,7 @@ func (m *RdmaDeleteReply) Marshal(b []byte) ([]byte, error) {
 func (m *RdmaDeleteReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
-	return nil
+	return buf.Err()
 }
 
 func init() { file_rdma_binapi_init() }
@@ -135,9 +135,9 @@ func (m *AppAddCertKeyPair) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.CertLen = buf.DecodeUint16()
 	m.CertkeyLen = buf.DecodeUint16()
-	m.Certkey = make([]byte, m.CertkeyLen)
+	m.Certkey = make([]byte, buf.CheckArrayLen(int(m.CertkeyLen), 1))
 	copy(m.Certkey, buf.DecodeBytes(len(m.Certkey)))
-	return nil
+	return buf.Err()
 }
 
 // AppAddCertKeyPairReply defines message 'app_add_cert_key_pair_reply'.
@@ -174,7 +174,7 @@ func (m *AppAddCertKeyPairReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
 	m.Index = buf.DecodeUint32()
-	return nil
+	return buf.Err()
 }
 
 // AppAttach defines message 'app_attach'.
@@ -220,7 +220,7 @@ func (m *AppAttach) Unmarshal(b []byte) error {
 		m.Options[i] = buf.DecodeUint64()
 	}
 	m.NamespaceID = buf.DecodeString(0)
-	return nil
+	return buf.Err()
 }
 
 // AppAttachReply defines message 'app_attach_reply'.
@@ -289,7 +289,7 @@ func (m *AppAttachReply) Unmarshal(b []byte) error {
 	m.SegmentSize = buf.DecodeUint32()
 	m.SegmentHandle = buf.DecodeUint64()
 	m.SegmentName = buf.DecodeString(0)
-	return nil
+	return buf.Err()
 }
 
 // AppDelCertKeyPair defines message 'app_del_cert_key_pair'.
@@ -322,7 +322,7 @@ func (m *AppDelCertKeyPair) Marshal(b []byte) ([]byte, error) {
 func (m *AppDelCertKeyPair) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Index = buf.DecodeUint32()
-	return nil
+	return buf.Err()
 }
 
 // AppDelCertKeyPairReply defines message 'app_del_cert_key_pair_reply'.
@@ -355,7 +355,7 @@ func (m *AppDelCertKeyPairReply) Marshal(b []byte) ([]byte, error) {
 func (m *AppDelCertKeyPairReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
-	return nil
+	return buf.Err()
 }
 
 // AppNamespaceAddDel defines message 'app_namespace_add_del'.
@@ -405,7 +405,7 @@ func (m *AppNamespaceAddDel) Unmarshal(b []byte) error {
 	m.IP4FibID = buf.DecodeUint32()
 	m.IP6FibID = buf.DecodeUint32()
 	m.NamespaceID = buf.DecodeString(0)
-	return nil
+	return buf.Err()
 }
 
 // AppNamespaceAddDelReply defines message 'app_namespace_add_del_reply'.
@@ -443,7 +443,7 @@ func (m *AppNamespaceAddDelReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
 	m.AppnsIndex = buf.DecodeUint32()
-	return nil
+	return buf.Err()
 }
 
 // AppNamespaceAddDelV2 defines message 'app_namespace_add_del_v2'.
@@ -496,7 +496,7 @@ func (m *AppNamespaceAddDelV2) Unmarshal(b []byte) error {
 	m.IP6FibID = buf.DecodeUint32()
 	m.NamespaceID = buf.DecodeString(64)
 	m.Netns = buf.DecodeString(64)
-	return nil
+	return buf.Err()
 }
 
 // AppNamespaceAddDelV2Reply defines message 'app_namespace_add_del_v2_reply'.
@@ -533,7 +533,7 @@ func (m *AppNamespaceAddDelV2Reply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
 	m.AppnsIndex = buf.DecodeUint32()
-	return nil
+	return buf.Err()
 }
 
 // AppNamespaceAddDelV3 defines message 'app_namespace_add_del_v3'.
@@ -594,7 +594,7 @@ func (m *AppNamespaceAddDelV3) Unmarshal(b []byte) error {
 	m.NamespaceID = buf.DecodeString(64)
 	m.Netns = buf.DecodeString(64)
 	m.SockName = buf.DecodeString(0)
-	return nil
+	return buf.Err()
 }
 
 // AppNamespaceAddDelV3Reply defines message 'app_namespace_add_del_v3_reply'.
@@ -631,7 +631,7 @@ func (m *AppNamespaceAddDelV3Reply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
 	m.AppnsIndex = buf.DecodeUint32()
-	return nil
+	return buf.Err()
 }
 
 // AppWorkerAddDel defines message 'app_worker_add_del'.
@@ -672,7 +672,7 @@ func (m *AppWorkerAddDel) Unmarshal(b []byte) error {
 	m.AppIndex = buf.DecodeUint32()
 	m.WrkIndex = buf.DecodeUint32()
 	m.IsAdd = buf.DecodeBool()
-	return nil
+	return buf.Err()
 }
 
 // AppWorkerAddDelReply defines message 'app_worker_add_del_reply'.
@@ -733,7 +733,7 @@ func (m *AppWorkerAddDelReply) Unmarshal(b []byte) error {
 	m.SegmentHandle = buf.DecodeUint64()
 	m.IsAdd = buf.DecodeBool()
 	m.SegmentName = buf.DecodeString(0)
-	return nil
+	return buf.Err()
 }
 
 // ApplicationDetach defines message 'application_detach'.
@@ -793,7 +793,7 @@ func (m *ApplicationDetachReply) Marshal(b []byte) ([]byte, error) {
 func (m *ApplicationDetachReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
-	return nil
+	return buf.Err()
 }
 
 // ApplicationTLSCertAdd defines message 'application_tls_cert_add'.
@@ -834,9 +834,9 @@ func (m *ApplicationTLSCertAdd) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.AppIndex = buf.DecodeUint32()
 	m.CertLen = buf.DecodeUint16()
-	m.Cert = make([]byte, m.CertLen)
+	m.Cert = make([]byte, buf.CheckArrayLen(int(m.CertLen), 1))
 	copy(m.Cert, buf.DecodeBytes(len(m.Cert)))
-	return nil
+	return buf.Err()
 }
 
 // ApplicationTLSCertAddReply defines message 'application_tls_cert_add_reply'.
@@ -870,7 +870,7 @@ func (m *ApplicationTLSCertAddReply) Marshal(b []byte) ([]byte, error) {
 func (m *ApplicationTLSCertAddReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
-	return nil
+	return buf.Err()
 }
 
 // ApplicationTLSKeyAdd defines message 'application_tls_key_add'.
@@ -911,9 +911,9 @@ func (m *ApplicationTLSKeyAdd) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.AppIndex = buf.DecodeUint32()
 	m.KeyLen = buf.DecodeUint16()
-	m.Key = make([]byte, m.KeyLen)
+	m.Key = make([]byte, buf.CheckArrayLen(int(m.KeyLen), 1))
 	copy(m.Key, buf.DecodeBytes(len(m.Key)))
-	return nil
+	return buf.Err()
 }
 
 // ApplicationTLSKeyAddReply defines message 'application_tls_key_add_reply'.
@@ -947,7 +947,7 @@ func (m *ApplicationTLSKeyAddReply) Marshal(b []byte) ([]byte, error) {
 func (m *ApplicationTLSKeyAddReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
-	return nil
+	return buf.Err()
 }
 
 // SessionEnableDisable defines message 'session_enable_disable'.
@@ -980,7 +980,7 @@ func (m *SessionEnableDisable) Marshal(b []byte) ([]byte, error) {
 func (m *SessionEnableDisable) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.IsEnable = buf.DecodeBool()
-	return nil
+	return buf.Err()
 }
 
 // SessionEnableDisableReply defines message 'session_enable_disable_reply'.
@@ -1013,7 +1013,7 @@ func (m *SessionEnableDisableReply) Marshal(b []byte) ([]byte, error) {
 func (m *SessionEnableDisableReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
-	return nil
+	return buf.Err()
 }
 
 // SessionRuleAddDel defines message 'session_rule_add_del'.
@@ -1094,7 +1094,7 @@ func (m *SessionRuleAddDel) Unmarshal(b []byte) error {
 	m.AppnsIndex = buf.DecodeUint32()
 	m.Scope = SessionRuleScope(buf.DecodeUint32())
 	m.Tag = buf.DecodeString(64)
-	return nil
+	return buf.Err()
 }
 
 // SessionRuleAddDelReply defines message 'session_rule_add_del_reply'.
@@ -1127,7 +1127,7 @@ func (m *SessionRuleAddDelReply) Marshal(b []byte) ([]byte, error) {
 func (m *SessionRuleAddDelReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
-	return nil
+	return buf.Err()
 }
 
 // SessionRulesDetails defines message 'session_rules_details'.
@@ -1204,7 +1204,7 @@ func (m *SessionRulesDetails) Unmarshal(b []byte) error {
 	m.AppnsIndex = buf.DecodeUint32()
 	m.Scope = SessionRuleScope(buf.DecodeUint32())
 	m.Tag = buf.DecodeString(64)
-	return nil
+	return buf.Err()
 }
 
 // SessionRulesDump defines message 'session_rules_dump'.
@@ -1264,7 +1264,7 @@ func (m *SessionSapiEnableDisable) Marshal(b []byte) ([]byte, error) {
 func (m *SessionSapiEnableDisable) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.IsEnable = buf.DecodeBool()
-	return nil
+	return buf.Err()
 }
 
 // SessionSapiEnableDisableReply defines message 'session_sapi_enable_disable_reply'.
@@ -1299,7 +1299,7 @@ func (m *SessionSapiEnableDisableReply) Marshal(b []byte) ([]byte, error) {
 func (m *SessionSapiEnableDisableReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
-	return nil
+	return buf.Err()
 }
 
 func init() { file_session_binapi_init() }
@@ -106,7 +106,7 @@ func (m *SwInterfaceSpanDetails) Unmarshal(b []byte) error {
 	m.SwIfIndexTo = interface_types.InterfaceIndex(buf.DecodeUint32())
 	m.State = SpanState(buf.DecodeUint32())
 	m.IsL2 = buf.DecodeBool()
-	return nil
+	return buf.Err()
 }
 
 // SwInterfaceSpanDump defines message 'sw_interface_span_dump'.
@@ -139,7 +139,7 @@ func (m *SwInterfaceSpanDump) Marshal(b []byte) ([]byte, error) {
 func (m *SwInterfaceSpanDump) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.IsL2 = buf.DecodeBool()
-	return nil
+	return buf.Err()
 }
 
 // SwInterfaceSpanEnableDisable defines message 'sw_interface_span_enable_disable'.
@@ -186,7 +186,7 @@ func (m *SwInterfaceSpanEnableDisable) Unmarshal(b []byte) error {
 	m.SwIfIndexTo = interface_types.InterfaceIndex(buf.DecodeUint32())
 	m.State = SpanState(buf.DecodeUint32())
 	m.IsL2 = buf.DecodeBool()
-	return nil
+	return buf.Err()
 }
 
 // SwInterfaceSpanEnableDisableReply defines message 'sw_interface_span_enable_disable_reply'.
@@ -221,7 +221,7 @@ func (m *SwInterfaceSpanEnableDisableReply) Marshal(b []byte) ([]byte, error) {
 func (m *SwInterfaceSpanEnableDisableReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
-	return nil
+	return buf.Err()
 }
 
 func init() { file_span_binapi_init() }
@@ -107,7 +107,7 @@ func (m *SrLocalsidAddDel) Unmarshal(b []byte) error {
 	m.FibTable = buf.DecodeUint32()
 	m.NhAddr.Af = ip_types.AddressFamily(buf.DecodeUint8())
 	copy(m.NhAddr.Un.XXX_UnionData[:], buf.DecodeBytes(16))
-	return nil
+	return buf.Err()
 }
 
 // SrLocalsidAddDelReply defines message 'sr_localsid_add_del_reply'.
@@ -140,7 +140,7 @@ func (m *SrLocalsidAddDelReply) Marshal(b []byte) ([]byte, error) {
 func (m *SrLocalsidAddDelReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
-	return nil
+	return buf.Err()
 }
 
 // SrLocalsidsDetails defines message 'sr_localsids_details'.
@@ -200,7 +200,7 @@ func (m *SrLocalsidsDetails) Unmarshal(b []byte) error {
 	m.XconnectNhAddr.Af = ip_types.AddressFamily(buf.DecodeUint8())
 	copy(m.XconnectNhAddr.Un.XXX_UnionData[:], buf.DecodeBytes(16))
 	m.XconnectIfaceOrVrfTable = buf.DecodeUint32()
-	return nil
+	return buf.Err()
 }
 
 // SrLocalsidsDump defines message 'sr_localsids_dump'.
@@ -300,7 +300,7 @@ func (m *SrPoliciesDetails) Unmarshal(b []byte) error {
 	m.IsEncap = buf.DecodeBool()
 	m.FibTable = buf.DecodeUint32()
 	m.NumSidLists = buf.DecodeUint8()
-	m.SidLists = make([]Srv6SidList, m.NumSidLists)
+	m.SidLists = make([]Srv6SidList, buf.CheckArrayLen(int(m.NumSidLists), 1))
 	for j0 := 0; j0 < len(m.SidLists); j0++ {
 		m.SidLists[j0].NumSids = buf.DecodeUint8()
 		m.SidLists[j0].Weight = buf.DecodeUint32()
@@ -308,7 +308,7 @@ func (m *SrPoliciesDetails) Unmarshal(b []byte) error {
 			copy(m.SidLists[j0].Sids[j1][:], buf.DecodeBytes(16))
 		}
 	}
-	return nil
+	return buf.Err()
 }
 
 // SrPoliciesDump defines message 'sr_policies_dump'.
@@ -413,7 +413,7 @@ func (m *SrPoliciesWithSlIndexDetails) Unmarshal(b []byte) error {
 	m.IsEncap = buf.DecodeBool()
 	m.FibTable = buf.DecodeUint32()
 	m.NumSidLists = buf.DecodeUint8()
-	m.SidLists = make([]Srv6SidListWithSlIndex, m.NumSidLists)
+	m.SidLists = make([]Srv6SidListWithSlIndex, buf.CheckArrayLen(int(m.NumSidLists), 1))
 	for j0 := 0; j0 < len(m.SidLists); j0++ {
 		m.SidLists[j0].NumSids = buf.DecodeUint8()
 		m.SidLists[j0].Weight = buf.DecodeUint32()
@@ -422,7 +422,7 @@ func (m *SrPoliciesWithSlIndexDetails) Unmarshal(b []byte) error {
 			copy(m.SidLists[j0].Sids[j1][:], buf.DecodeBytes(16))
 		}
 	}
-	return nil
+	return buf.Err()
 }
 
 // SrPoliciesWithSlIndexDump defines message 'sr_policies_with_sl_index_dump'.
@@ -515,7 +515,7 @@ func (m *SrPolicyAdd) Unmarshal(b []byte) error {
 	for j1 := 0; j1 < 16; j1++ {
 		copy(m.Sids.Sids[j1][:], buf.DecodeBytes(16))
 	}
-	return nil
+	return buf.Err()
 }
 
 // SrPolicyAddReply defines message 'sr_policy_add_reply'.
@@ -548,7 +548,7 @@ func (m *SrPolicyAddReply) Marshal(b []byte) ([]byte, error) {
 func (m *SrPolicyAddReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
-	return nil
+	return buf.Err()
 }
 
 // SrPolicyDel defines message 'sr_policy_del'.
@@ -585,7 +585,7 @@ func (m *SrPolicyDel) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	copy(m.BsidAddr[:], buf.DecodeBytes(16))
 	m.SrPolicyIndex = buf.DecodeUint32()
-	return nil
+	return buf.Err()
 }
 
 // SrPolicyDelReply defines message 'sr_policy_del_reply'.
@@ -618,7 +618,7 @@ func (m *SrPolicyDelReply) Marshal(b []byte) ([]byte, error) {
 func (m *SrPolicyDelReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
-	return nil
+	return buf.Err()
 }
 
 // SrPolicyMod defines message 'sr_policy_mod'.
@@ -687,7 +687,7 @@ func (m *SrPolicyMod) Unmarshal(b []byte) error {
 	for j1 := 0; j1 < 16; j1++ {
 		copy(m.Sids.Sids[j1][:], buf.DecodeBytes(16))
 	}
-	return nil
+	return buf.Err()
 }
 
 // SrPolicyModReply defines message 'sr_policy_mod_reply'.
@@ -720,7 +720,7 @@ func (m *SrPolicyModReply) Marshal(b []byte) ([]byte, error) {
 func (m *SrPolicyModReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
-	return nil
+	return buf.Err()
 }
 
 // SrSetEncapHopLimit defines message 'sr_set_encap_hop_limit'.
@@ -753,7 +753,7 @@ func (m *SrSetEncapHopLimit) Marshal(b []byte) ([]byte, error) {
 func (m *SrSetEncapHopLimit) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.HopLimit = buf.DecodeUint8()
-	return nil
+	return buf.Err()
 }
 
 // SrSetEncapHopLimitReply defines message 'sr_set_encap_hop_limit_reply'.
@@ -786,7 +786,7 @@ func (m *SrSetEncapHopLimitReply) Marshal(b []byte) ([]byte, error) {
 func (m *SrSetEncapHopLimitReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
-	return nil
+	return buf.Err()
 }
 
 // SrSetEncapSource defines message 'sr_set_encap_source'.
@@ -819,7 +819,7 @@ func (m *SrSetEncapSource) Marshal(b []byte) ([]byte, error) {
 func (m *SrSetEncapSource) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	copy(m.EncapsSource[:], buf.DecodeBytes(16))
-	return nil
+	return buf.Err()
 }
 
 // SrSetEncapSourceReply defines message 'sr_set_encap_source_reply'.
@@ -852,7 +852,7 @@ func (m *SrSetEncapSourceReply) Marshal(b []byte) ([]byte, error) {
 func (m *SrSetEncapSourceReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
-	return nil
+	return buf.Err()
 }
 
 // SrSteeringAddDel defines message 'sr_steering_add_del'.
@@ -915,7 +915,7 @@ func (m *SrSteeringAddDel) Unmarshal(b []byte) error {
 	m.Prefix.Len = buf.DecodeUint8()
 	m.SwIfIndex = interface_types.InterfaceIndex(buf.DecodeUint32())
 	m.TrafficType = sr_types.SrSteer(buf.DecodeUint8())
-	return nil
+	return buf.Err()
 }
 
 // SrSteeringAddDelReply defines message 'sr_steering_add_del_reply'.
@@ -948,7 +948,7 @@ func (m *SrSteeringAddDelReply) Marshal(b []byte) ([]byte, error) {
 func (m *SrSteeringAddDelReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
-	return nil
+	return buf.Err()
 }
 
 // SrSteeringPolDetails defines message 'sr_steering_pol_details'.
@@ -1003,7 +1003,7 @@ func (m *SrSteeringPolDetails) Unmarshal(b []byte) error {
 	m.Prefix.Len = buf.DecodeUint8()
 	m.SwIfIndex = interface_types.InterfaceIndex(buf.DecodeUint32())
 	copy(m.Bsid[:], buf.DecodeBytes(16))
-	return nil
+	return buf.Err()
 }
 
 // SrSteeringPolDump defines message 'sr_steering_pol_dump'.
@@ -81,11 +81,11 @@ func (m *SrMplsPolicyAdd) Unmarshal(b []byte) error {
 	m.Weight = buf.DecodeUint32()
 	m.IsSpray = buf.DecodeBool()
 	m.NSegments = buf.DecodeUint8()
-	m.Segments = make([]uint32, m.NSegments)
+	m.Segments = make([]uint32, buf.CheckArrayLen(int(m.NSegments), 4))
 	for i := 0; i < len(m.Segments); i++ {
 		m.Segments[i] = buf.DecodeUint32()
 	}
-	return nil
+	return buf.Err()
 }
 
 // SrMplsPolicyAddReply defines message 'sr_mpls_policy_add_reply'.
@@ -118,7 +118,7 @@ func (m *SrMplsPolicyAddReply) Marshal(b []byte) ([]byte, error) {
 func (m *SrMplsPolicyAddReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
-	return nil
+	return buf.Err()
 }
 
 // SrMplsPolicyAssignEndpointColor defines message 'sr_mpls_policy_assign_endpoint_color'.
@@ -164,7 +164,7 @@ func (m *SrMplsPolicyAssignEndpointColor) Unmarshal(b []byte) error {
 	m.Endpoint.Af = ip_types.AddressFamily(buf.DecodeUint8())
 	copy(m.Endpoint.Un.XXX_UnionData[:], buf.DecodeBytes(16))
 	m.Color = buf.DecodeUint32()
-	return nil
+	return buf.Err()
 }
 
 // SrMplsPolicyAssignEndpointColorReply defines message 'sr_mpls_policy_assign_endpoint_color_reply'.
@@ -199,7 +199,7 @@ func (m *SrMplsPolicyAssignEndpointColorReply) Marshal(b []byte) ([]byte, error)
 func (m *SrMplsPolicyAssignEndpointColorReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
-	return nil
+	return buf.Err()
 }
 
 // SrMplsPolicyDel defines message 'sr_mpls_policy_del'.
@@ -232,7 +232,7 @@ func (m *SrMplsPolicyDel) Marshal(b []byte) ([]byte, error) {
 func (m *SrMplsPolicyDel) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Bsid = buf.DecodeUint32()
-	return nil
+	return buf.Err()
 }
 
 // SrMplsPolicyDelReply defines message 'sr_mpls_policy_del_reply'.
@@ -265,7 +265,7 @@ func (m *SrMplsPolicyDelReply) Marshal(b []byte) ([]byte, error) {
 func (m *SrMplsPolicyDelReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
-	return nil
+	return buf.Err()
 }
 
 // SrMplsPolicyMod defines message 'sr_mpls_policy_mod'.
@@ -323,11 +323,11 @@ func (m *SrMplsPolicyMod) Unmarshal(b []byte) error {
 	m.SlIndex = buf.DecodeUint32()
 	m.Weight = buf.DecodeUint32()
 	m.NSegments = buf.DecodeUint8()
-	m.Segments = make([]uint32, m.NSegments)
+	m.Segments = make([]uint32, buf.CheckArrayLen(int(m.NSegments), 4))
 	for i := 0; i < len(m.Segments); i++ {
 		m.Segments[i] = buf.DecodeUint32()
 	}
-	return nil
+	return buf.Err()
 }
 
 // SrMplsPolicyModReply defines message 'sr_mpls_policy_mod_reply'.
@@ -360,7 +360,7 @@ func (m *SrMplsPolicyModReply) Marshal(b []byte) ([]byte, error) {
 func (m *SrMplsPolicyModReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
-	return nil
+	return buf.Err()
 }
 
 // SrMplsSteeringAddDel defines message 'sr_mpls_steering_add_del'.
@@ -434,7 +434,7 @@ func (m *SrMplsSteeringAddDel) Unmarshal(b []byte) error {
 	m.Color = buf.DecodeUint32()
 	m.CoBits = buf.DecodeUint8()
 	m.VPNLabel = buf.DecodeUint32()
-	return nil
+	return buf.Err()
 }
 
 // SrMplsSteeringAddDelReply defines message 'sr_mpls_steering_add_del_reply'.
@@ -467,7 +467,7 @@ func (m *SrMplsSteeringAddDelReply) Marshal(b []byte) ([]byte, error) {
 func (m *SrMplsSteeringAddDelReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
-	return nil
+	return buf.Err()
 }
 
 func init() { file_sr_mpls_binapi_init() }
@@ -70,7 +70,7 @@ func (m *StnAddDelRule) Unmarshal(b []byte) error {
 	copy(m.IPAddress.Un.XXX_UnionData[:], buf.DecodeBytes(16))
 	m.SwIfIndex = interface_types.InterfaceIndex(buf.DecodeUint32())
 	m.IsAdd = buf.DecodeBool()
-	return nil
+	return buf.Err()
 }
 
 // StnAddDelRuleReply defines message 'stn_add_del_rule_reply'.
@@ -103,7 +103,7 @@ func (m *StnAddDelRuleReply) Marshal(b []byte) ([]byte, error) {
 func (m *StnAddDelRuleReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
-	return nil
+	return buf.Err()
 }
 
 // StnRulesDetails defines message 'stn_rules_details'.
@@ -143,7 +143,7 @@ func (m *StnRulesDetails) Unmarshal(b []byte) error {
 	m.IPAddress.Af = ip_types.AddressFamily(buf.DecodeUint8())
 	copy(m.IPAddress.Un.XXX_UnionData[:], buf.DecodeBytes(16))
 	m.SwIfIndex = interface_types.InterfaceIndex(buf.DecodeUint32())
-	return nil
+	return buf.Err()
 }
 
 // StnRulesDump defines message 'stn_rules_dump'.
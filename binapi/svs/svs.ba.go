@@ -67,7 +67,7 @@ func (m *SvsDetails) Unmarshal(b []byte) error {
 	m.TableID = buf.DecodeUint32()
 	m.SwIfIndex = interface_types.InterfaceIndex(buf.DecodeUint32())
 	m.Af = ip_types.AddressFamily(buf.DecodeUint8())
-	return nil
+	return buf.Err()
 }
 
 // SvsDump defines message 'svs_dump'.
@@ -139,7 +139,7 @@ func (m *SvsEnableDisable) Unmarshal(b []byte) error {
 	m.Af = ip_types.AddressFamily(buf.DecodeUint8())
 	m.TableID = buf.DecodeUint32()
 	m.SwIfIndex = interface_types.InterfaceIndex(buf.DecodeUint32())
-	return nil
+	return buf.Err()
 }
 
 // SvsEnableDisableReply defines message 'svs_enable_disable_reply'.
@@ -172,7 +172,7 @@ func (m *SvsEnableDisableReply) Marshal(b []byte) ([]byte, error) {
 func (m *SvsEnableDisableReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
-	return nil
+	return buf.Err()
 }
 
 // SvsPluginGetVersion defines message 'svs_plugin_get_version'.
@@ -236,7 +236,7 @@ func (m *SvsPluginGetVersionReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Major = buf.DecodeUint32()
 	m.Minor = buf.DecodeUint32()
-	return nil
+	return buf.Err()
 }
 
 // SvsRouteAddDel defines message 'svs_route_add_del'.
@@ -287,7 +287,7 @@ func (m *SvsRouteAddDel) Unmarshal(b []byte) error {
 	m.Prefix.Len = buf.DecodeUint8()
 	m.TableID = buf.DecodeUint32()
 	m.SourceTableID = buf.DecodeUint32()
-	return nil
+	return buf.Err()
 }
 
 // SvsRouteAddDelReply defines message 'svs_route_add_del_reply'.
@@ -320,7 +320,7 @@ func (m *SvsRouteAddDelReply) Marshal(b []byte) ([]byte, error) {
 func (m *SvsRouteAddDelReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
-	return nil
+	return buf.Err()
 }
 
 // SvsTableAddDel defines message 'svs_table_add_del'.
@@ -361,7 +361,7 @@ func (m *SvsTableAddDel) Unmarshal(b []byte) error {
 	m.IsAdd = buf.DecodeBool()
 	m.Af = ip_types.AddressFamily(buf.DecodeUint8())
 	m.TableID = buf.DecodeUint32()
-	return nil
+	return buf.Err()
 }
 
 // SvsTableAddDelReply defines message 'svs_table_add_del_reply'.
@@ -394,7 +394,7 @@ func (m *SvsTableAddDelReply) Marshal(b []byte) ([]byte, error) {
 func (m *SvsTableAddDelReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
-	return nil
+	return buf.Err()
 }
 
 func init() { file_svs_binapi_init() }
@@ -137,7 +137,7 @@ func (m *SyslogGetFilterReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
 	m.Severity = SyslogSeverity(buf.DecodeUint32())
-	return nil
+	return buf.Err()
 }
 
 // SyslogGetSender defines message 'syslog_get_sender'.
@@ -217,7 +217,7 @@ func (m *SyslogGetSenderReply) Unmarshal(b []byte) error {
 	m.CollectorPort = buf.DecodeUint16()
 	m.VrfID = buf.DecodeUint32()
 	m.MaxMsgSize = buf.DecodeUint32()
-	return nil
+	return buf.Err()
 }
 
 // SyslogSetFilter defines message 'syslog_set_filter'.
@@ -250,7 +250,7 @@ func (m *SyslogSetFilter) Marshal(b []byte) ([]byte, error) {
 func (m *SyslogSetFilter) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Severity = SyslogSeverity(buf.DecodeUint32())
-	return nil
+	return buf.Err()
 }
 
 // SyslogSetFilterReply defines message 'syslog_set_filter_reply'.
@@ -283,7 +283,7 @@ func (m *SyslogSetFilterReply) Marshal(b []byte) ([]byte, error) {
 func (m *SyslogSetFilterReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
-	return nil
+	return buf.Err()
 }
 
 // SyslogSetSender defines message 'syslog_set_sender'.
@@ -332,7 +332,7 @@ func (m *SyslogSetSender) Unmarshal(b []byte) error {
 	m.CollectorPort = buf.DecodeUint16()
 	m.VrfID = buf.DecodeUint32()
 	m.MaxMsgSize = buf.DecodeUint32()
-	return nil
+	return buf.Err()
 }
 
 // SyslogSetSenderReply defines message 'syslog_set_sender_reply'.
@@ -365,7 +365,7 @@ func (m *SyslogSetSenderReply) Marshal(b []byte) ([]byte, error) {
 func (m *SyslogSetSenderReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
-	return nil
+	return buf.Err()
 }
 
 func init() { file_syslog_binapi_init() }
@@ -181,7 +181,7 @@ func (m *SwInterfaceTapV2Details) Unmarshal(b []byte) error {
 	m.HostIfName = buf.DecodeString(64)
 	m.HostNamespace = buf.DecodeString(64)
 	m.HostBridge = buf.DecodeString(64)
-	return nil
+	return buf.Err()
 }
 
 // SwInterfaceTapV2Dump defines message 'sw_interface_tap_v2_dump'.
@@ -214,7 +214,7 @@ func (m *SwInterfaceTapV2Dump) Marshal(b []byte) ([]byte, error) {
 func (m *SwInterfaceTapV2Dump) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.SwIfIndex = interface_types.InterfaceIndex(buf.DecodeUint32())
-	return nil
+	return buf.Err()
 }
 
 // TapCreateV2 defines message 'tap_create_v2'.
@@ -353,7 +353,7 @@ func (m *TapCreateV2) Unmarshal(b []byte) error {
 	m.HostBridgeSet = buf.DecodeBool()
 	m.HostBridge = buf.DecodeString(64)
 	m.Tag = buf.DecodeString(0)
-	return nil
+	return buf.Err()
 }
 
 // TapCreateV2Reply defines message 'tap_create_v2_reply'.
@@ -390,7 +390,7 @@ func (m *TapCreateV2Reply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
 	m.SwIfIndex = interface_types.InterfaceIndex(buf.DecodeUint32())
-	return nil
+	return buf.Err()
 }
 
 // TapCreateV3 defines message 'tap_create_v3'.
@@ -533,7 +533,7 @@ func (m *TapCreateV3) Unmarshal(b []byte) error {
 	m.HostBridgeSet = buf.DecodeBool()
 	m.HostBridge = buf.DecodeString(64)
 	m.Tag = buf.DecodeString(0)
-	return nil
+	return buf.Err()
 }
 
 // TapCreateV3Reply defines message 'tap_create_v3_reply'.
@@ -570,7 +570,7 @@ func (m *TapCreateV3Reply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
 	m.SwIfIndex = interface_types.InterfaceIndex(buf.DecodeUint32())
-	return nil
+	return buf.Err()
 }
 
 // TapDeleteV2 defines message 'tap_delete_v2'.
@@ -603,7 +603,7 @@ func (m *TapDeleteV2) Marshal(b []byte) ([]byte, error) {
 func (m *TapDeleteV2) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.SwIfIndex = interface_types.InterfaceIndex(buf.DecodeUint32())
-	return nil
+	return buf.Err()
 }
 
 // TapDeleteV2Reply defines message 'tap_delete_v2_reply'.
@@ -636,7 +636,7 @@ func (m *TapDeleteV2Reply) Marshal(b []byte) ([]byte, error) {
 func (m *TapDeleteV2Reply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
-	return nil
+	return buf.Err()
 }
 
 func init() { file_tapv2_binapi_init() }
@@ -72,7 +72,7 @@ func (m *TCPConfigureSrcAddresses) Unmarshal(b []byte) error {
 	copy(m.FirstAddress.Un.XXX_UnionData[:], buf.DecodeBytes(16))
 	m.LastAddress.Af = ip_types.AddressFamily(buf.DecodeUint8())
 	copy(m.LastAddress.Un.XXX_UnionData[:], buf.DecodeBytes(16))
-	return nil
+	return buf.Err()
 }
 
 // TCPConfigureSrcAddressesReply defines message 'tcp_configure_src_addresses_reply'.
@@ -107,7 +107,7 @@ func (m *TCPConfigureSrcAddressesReply) Marshal(b []byte) ([]byte, error) {
 func (m *TCPConfigureSrcAddressesReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
-	return nil
+	return buf.Err()
 }
 
 func init() { file_tcp_binapi_init() }
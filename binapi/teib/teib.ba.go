@@ -83,7 +83,7 @@ func (m *TeibDetails) Unmarshal(b []byte) error {
 	m.Entry.Nh.Af = ip_types.AddressFamily(buf.DecodeUint8())
 	copy(m.Entry.Nh.Un.XXX_UnionData[:], buf.DecodeBytes(16))
 	m.Entry.NhTableID = buf.DecodeUint32()
-	return nil
+	return buf.Err()
 }
 
 // TeibDump defines message 'teib_dump'.
@@ -162,7 +162,7 @@ func (m *TeibEntryAddDel) Unmarshal(b []byte) error {
 	m.Entry.Nh.Af = ip_types.AddressFamily(buf.DecodeUint8())
 	copy(m.Entry.Nh.Un.XXX_UnionData[:], buf.DecodeBytes(16))
 	m.Entry.NhTableID = buf.DecodeUint32()
-	return nil
+	return buf.Err()
 }
 
 // TeibEntryAddDelReply defines message 'teib_entry_add_del_reply'.
@@ -195,7 +195,7 @@ func (m *TeibEntryAddDelReply) Marshal(b []byte) ([]byte, error) {
 func (m *TeibEntryAddDelReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
-	return nil
+	return buf.Err()
 }
 
 func init() { file_teib_binapi_init() }
@@ -74,5 +74,5 @@ func (c *serviceClient) TeibEntryAddDel(ctx context.Context, in *TeibEntryAddDel
 	if err != nil {
 		return nil, err
 	}
-	return out, api.RetvalToVPPApiError(out.Retval)
+	return out, api.RetvalToRequestError(in, out.Retval)
 }
@@ -72,7 +72,7 @@ func (m *TLSOpensslSetEngine) Unmarshal(b []byte) error {
 	copy(m.Algorithm, buf.DecodeBytes(len(m.Algorithm)))
 	m.Ciphers = make([]byte, 64)
 	copy(m.Ciphers, buf.DecodeBytes(len(m.Ciphers)))
-	return nil
+	return buf.Err()
 }
 
 // TLSOpensslSetEngineReply defines message 'tls_openssl_set_engine_reply'.
@@ -105,7 +105,7 @@ func (m *TLSOpensslSetEngineReply) Marshal(b []byte) ([]byte, error) {
 func (m *TLSOpensslSetEngineReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
-	return nil
+	return buf.Err()
 }
 
 func init() { file_tls_openssl_binapi_init() }
@@ -73,7 +73,7 @@ func (m *TraceProfileAdd) Unmarshal(b []byte) error {
 	m.TraceTsp = buf.DecodeUint8()
 	m.NodeID = buf.DecodeUint32()
 	m.AppData = buf.DecodeUint32()
-	return nil
+	return buf.Err()
 }
 
 // TraceProfileAddReply defines message 'trace_profile_add_reply'.
@@ -106,7 +106,7 @@ func (m *TraceProfileAddReply) Marshal(b []byte) ([]byte, error) {
 func (m *TraceProfileAddReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
-	return nil
+	return buf.Err()
 }
 
 // TraceProfileDel defines message 'trace_profile_del'.
@@ -166,7 +166,7 @@ func (m *TraceProfileDelReply) Marshal(b []byte) ([]byte, error) {
 func (m *TraceProfileDelReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
-	return nil
+	return buf.Err()
 }
 
 // TraceProfileShowConfig defines message 'trace_profile_show_config'.
@@ -246,7 +246,7 @@ func (m *TraceProfileShowConfigReply) Unmarshal(b []byte) error {
 	m.TraceTsp = buf.DecodeUint8()
 	m.NodeID = buf.DecodeUint32()
 	m.AppData = buf.DecodeUint32()
-	return nil
+	return buf.Err()
 }
 
 func init() { file_trace_binapi_init() }
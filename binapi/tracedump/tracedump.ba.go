@@ -113,7 +113,7 @@ func (m *TraceCapturePackets) Unmarshal(b []byte) error {
 	m.UseFilter = buf.DecodeBool()
 	m.Verbose = buf.DecodeBool()
 	m.PreCaptureClear = buf.DecodeBool()
-	return nil
+	return buf.Err()
 }
 
 // TraceCapturePacketsReply defines message 'trace_capture_packets_reply'.
@@ -147,7 +147,7 @@ func (m *TraceCapturePacketsReply) Marshal(b []byte) ([]byte, error) {
 func (m *TraceCapturePacketsReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
-	return nil
+	return buf.Err()
 }
 
 // TraceClearCapture defines message 'trace_clear_capture'.
@@ -209,7 +209,7 @@ func (m *TraceClearCaptureReply) Marshal(b []byte) ([]byte, error) {
 func (m *TraceClearCaptureReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
-	return nil
+	return buf.Err()
 }
 
 // TraceDetails defines message 'trace_details'.
@@ -267,7 +267,7 @@ func (m *TraceDetails) Unmarshal(b []byte) error {
 	m.Done = buf.DecodeUint8()
 	m.PacketNumber = buf.DecodeUint32()
 	m.TraceData = buf.DecodeString(0)
-	return nil
+	return buf.Err()
 }
 
 // TraceDump defines message 'trace_dump'.
@@ -313,7 +313,7 @@ func (m *TraceDump) Unmarshal(b []byte) error {
 	m.ThreadID = buf.DecodeUint32()
 	m.Position = buf.DecodeUint32()
 	m.MaxRecords = buf.DecodeUint32()
-	return nil
+	return buf.Err()
 }
 
 // TraceDumpReply defines message 'trace_dump_reply'.
@@ -371,7 +371,7 @@ func (m *TraceDumpReply) Unmarshal(b []byte) error {
 	m.MoreThreads = buf.DecodeUint8()
 	m.FlushOnly = buf.DecodeUint8()
 	m.Done = buf.DecodeUint8()
-	return nil
+	return buf.Err()
 }
 
 // TraceSetFilters defines message 'trace_set_filters'.
@@ -417,7 +417,7 @@ func (m *TraceSetFilters) Unmarshal(b []byte) error {
 	m.Count = buf.DecodeUint32()
 	m.NodeIndex = buf.DecodeUint32()
 	m.ClassifierTableIndex = buf.DecodeUint32()
-	return nil
+	return buf.Err()
 }
 
 // TraceSetFiltersReply defines message 'trace_set_filters_reply'.
@@ -451,7 +451,7 @@ func (m *TraceSetFiltersReply) Marshal(b []byte) ([]byte, error) {
 func (m *TraceSetFiltersReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
-	return nil
+	return buf.Err()
 }
 
 func init() { file_tracedump_binapi_init() }
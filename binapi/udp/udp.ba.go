@@ -119,7 +119,7 @@ func (m *UDPDecapAddDel) Unmarshal(b []byte) error {
 	m.UDPDecap.IsIP4 = buf.DecodeUint8()
 	m.UDPDecap.Port = buf.DecodeUint16()
 	m.UDPDecap.NextProto = UDPDecapNextProto(buf.DecodeUint32())
-	return nil
+	return buf.Err()
 }
 
 // UDPDecapAddDelReply defines message 'udp_decap_add_del_reply'.
@@ -152,7 +152,7 @@ func (m *UDPDecapAddDelReply) Marshal(b []byte) ([]byte, error) {
 func (m *UDPDecapAddDelReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
-	return nil
+	return buf.Err()
 }
 
 // UDPEncapAdd defines message 'udp_encap_add'.
@@ -206,7 +206,7 @@ func (m *UDPEncapAdd) Unmarshal(b []byte) error {
 	m.UDPEncap.DstIP.Af = ip_types.AddressFamily(buf.DecodeUint8())
 	copy(m.UDPEncap.DstIP.Un.XXX_UnionData[:], buf.DecodeBytes(16))
 	m.UDPEncap.ID = buf.DecodeUint32()
-	return nil
+	return buf.Err()
 }
 
 // UDPEncapAddReply defines message 'udp_encap_add_reply'.
@@ -243,7 +243,7 @@ func (m *UDPEncapAddReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
 	m.ID = buf.DecodeUint32()
-	return nil
+	return buf.Err()
 }
 
 // UDPEncapDel defines message 'udp_encap_del'.
@@ -276,7 +276,7 @@ func (m *UDPEncapDel) Marshal(b []byte) ([]byte, error) {
 func (m *UDPEncapDel) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.ID = buf.DecodeUint32()
-	return nil
+	return buf.Err()
 }
 
 // UDPEncapDelReply defines message 'udp_encap_del_reply'.
@@ -309,7 +309,7 @@ func (m *UDPEncapDelReply) Marshal(b []byte) ([]byte, error) {
 func (m *UDPEncapDelReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
-	return nil
+	return buf.Err()
 }
 
 // UDPEncapDetails defines message 'udp_encap_details'.
@@ -363,7 +363,7 @@ func (m *UDPEncapDetails) Unmarshal(b []byte) error {
 	m.UDPEncap.DstIP.Af = ip_types.AddressFamily(buf.DecodeUint8())
 	copy(m.UDPEncap.DstIP.Un.XXX_UnionData[:], buf.DecodeBytes(16))
 	m.UDPEncap.ID = buf.DecodeUint32()
-	return nil
+	return buf.Err()
 }
 
 // UDPEncapDump defines message 'udp_encap_dump'.
@@ -101,7 +101,7 @@ func (m *UDPPingAddDel) Unmarshal(b []byte) error {
 	m.FaultDet = buf.DecodeUint8()
 	m.Reserve = make([]byte, 3)
 	copy(m.Reserve, buf.DecodeBytes(len(m.Reserve)))
-	return nil
+	return buf.Err()
 }
 
 // UDPPingAddDelReply defines message 'udp_ping_add_del_reply'.
@@ -134,7 +134,7 @@ func (m *UDPPingAddDelReply) Marshal(b []byte) ([]byte, error) {
 func (m *UDPPingAddDelReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
-	return nil
+	return buf.Err()
 }
 
 // UDPPingExport defines message 'udp_ping_export'.
@@ -167,7 +167,7 @@ func (m *UDPPingExport) Marshal(b []byte) ([]byte, error) {
 func (m *UDPPingExport) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Enable = buf.DecodeBool()
-	return nil
+	return buf.Err()
 }
 
 // UDPPingExportReply defines message 'udp_ping_export_reply'.
@@ -200,7 +200,7 @@ func (m *UDPPingExportReply) Marshal(b []byte) ([]byte, error) {
 func (m *UDPPingExportReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
-	return nil
+	return buf.Err()
 }
 
 func init() { file_udp_ping_binapi_init() }
@@ -105,7 +105,7 @@ func (m *UrpfUpdate) Unmarshal(b []byte) error {
 	m.Mode = UrpfMode(buf.DecodeUint8())
 	m.Af = ip_types.AddressFamily(buf.DecodeUint8())
 	m.SwIfIndex = interface_types.InterfaceIndex(buf.DecodeUint32())
-	return nil
+	return buf.Err()
 }
 
 // UrpfUpdateReply defines message 'urpf_update_reply'.
@@ -138,7 +138,7 @@ func (m *UrpfUpdateReply) Marshal(b []byte) ([]byte, error) {
 func (m *UrpfUpdateReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
-	return nil
+	return buf.Err()
 }
 
 func init() { file_urpf_binapi_init() }
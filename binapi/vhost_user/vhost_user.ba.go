@@ -101,7 +101,7 @@ func (m *CreateVhostUserIf) Unmarshal(b []byte) error {
 	m.UseCustomMac = buf.DecodeBool()
 	copy(m.MacAddress[:], buf.DecodeBytes(6))
 	m.Tag = buf.DecodeString(64)
-	return nil
+	return buf.Err()
 }
 
 // CreateVhostUserIfReply defines message 'create_vhost_user_if_reply'.
@@ -139,7 +139,7 @@ func (m *CreateVhostUserIfReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
 	m.SwIfIndex = interface_types.InterfaceIndex(buf.DecodeUint32())
-	return nil
+	return buf.Err()
 }
 
 // CreateVhostUserIfV2 defines message 'create_vhost_user_if_v2'.
@@ -216,7 +216,7 @@ func (m *CreateVhostUserIfV2) Unmarshal(b []byte) error {
 	m.UseCustomMac = buf.DecodeBool()
 	copy(m.MacAddress[:], buf.DecodeBytes(6))
 	m.Tag = buf.DecodeString(64)
-	return nil
+	return buf.Err()
 }
 
 // CreateVhostUserIfV2Reply defines message 'create_vhost_user_if_v2_reply'.
@@ -253,7 +253,7 @@ func (m *CreateVhostUserIfV2Reply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
 	m.SwIfIndex = interface_types.InterfaceIndex(buf.DecodeUint32())
-	return nil
+	return buf.Err()
 }
 
 // DeleteVhostUserIf defines message 'delete_vhost_user_if'.
@@ -286,7 +286,7 @@ func (m *DeleteVhostUserIf) Marshal(b []byte) ([]byte, error) {
 func (m *DeleteVhostUserIf) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.SwIfIndex = interface_types.InterfaceIndex(buf.DecodeUint32())
-	return nil
+	return buf.Err()
 }
 
 // DeleteVhostUserIfReply defines message 'delete_vhost_user_if_reply'.
@@ -319,7 +319,7 @@ func (m *DeleteVhostUserIfReply) Marshal(b []byte) ([]byte, error) {
 func (m *DeleteVhostUserIfReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
-	return nil
+	return buf.Err()
 }
 
 // ModifyVhostUserIf defines message 'modify_vhost_user_if'.
@@ -377,7 +377,7 @@ func (m *ModifyVhostUserIf) Unmarshal(b []byte) error {
 	m.EnableGso = buf.DecodeBool()
 	m.EnablePacked = buf.DecodeBool()
 	m.CustomDevInstance = buf.DecodeUint32()
-	return nil
+	return buf.Err()
 }
 
 // ModifyVhostUserIfReply defines message 'modify_vhost_user_if_reply'.
@@ -411,7 +411,7 @@ func (m *ModifyVhostUserIfReply) Marshal(b []byte) ([]byte, error) {
 func (m *ModifyVhostUserIfReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
-	return nil
+	return buf.Err()
 }
 
 // ModifyVhostUserIfV2 defines message 'modify_vhost_user_if_v2'.
@@ -472,7 +472,7 @@ func (m *ModifyVhostUserIfV2) Unmarshal(b []byte) error {
 	m.EnablePacked = buf.DecodeBool()
 	m.EnableEventIdx = buf.DecodeBool()
 	m.CustomDevInstance = buf.DecodeUint32()
-	return nil
+	return buf.Err()
 }
 
 // ModifyVhostUserIfV2Reply defines message 'modify_vhost_user_if_v2_reply'.
@@ -505,7 +505,7 @@ func (m *ModifyVhostUserIfV2Reply) Marshal(b []byte) ([]byte, error) {
 func (m *ModifyVhostUserIfV2Reply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
-	return nil
+	return buf.Err()
 }
 
 // SwInterfaceVhostUserDetails defines message 'sw_interface_vhost_user_details'.
@@ -570,7 +570,7 @@ func (m *SwInterfaceVhostUserDetails) Unmarshal(b []byte) error {
 	m.SockFilename = buf.DecodeString(256)
 	m.NumRegions = buf.DecodeUint32()
 	m.SockErrno = buf.DecodeInt32()
-	return nil
+	return buf.Err()
 }
 
 // SwInterfaceVhostUserDump defines message 'sw_interface_vhost_user_dump'.
@@ -603,7 +603,7 @@ func (m *SwInterfaceVhostUserDump) Marshal(b []byte) ([]byte, error) {
 func (m *SwInterfaceVhostUserDump) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.SwIfIndex = interface_types.InterfaceIndex(buf.DecodeUint32())
-	return nil
+	return buf.Err()
 }
 
 func init() { file_vhost_user_binapi_init() }
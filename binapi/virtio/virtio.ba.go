@@ -150,7 +150,7 @@ func (m *SwInterfaceVirtioPciDetails) Unmarshal(b []byte) error {
 	m.TxRingSz = buf.DecodeUint16()
 	m.RxRingSz = buf.DecodeUint16()
 	m.Features = buf.DecodeUint64()
-	return nil
+	return buf.Err()
 }
 
 // SwInterfaceVirtioPciDump defines message 'sw_interface_virtio_pci_dump'.
@@ -240,7 +240,7 @@ func (m *VirtioPciCreate) Unmarshal(b []byte) error {
 	m.GsoEnabled = buf.DecodeBool()
 	m.ChecksumOffloadEnabled = buf.DecodeBool()
 	m.Features = buf.DecodeUint64()
-	return nil
+	return buf.Err()
 }
 
 // VirtioPciCreateReply defines message 'virtio_pci_create_reply'.
@@ -278,7 +278,7 @@ func (m *VirtioPciCreateReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
 	m.SwIfIndex = interface_types.InterfaceIndex(buf.DecodeUint32())
-	return nil
+	return buf.Err()
 }
 
 // VirtioPciCreateV2 defines message 'virtio_pci_create_v2'.
@@ -336,7 +336,7 @@ func (m *VirtioPciCreateV2) Unmarshal(b []byte) error {
 	copy(m.MacAddress[:], buf.DecodeBytes(6))
 	m.VirtioFlags = VirtioFlags(buf.DecodeUint32())
 	m.Features = buf.DecodeUint64()
-	return nil
+	return buf.Err()
 }
 
 // VirtioPciCreateV2Reply defines message 'virtio_pci_create_v2_reply'.
@@ -373,7 +373,7 @@ func (m *VirtioPciCreateV2Reply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
 	m.SwIfIndex = interface_types.InterfaceIndex(buf.DecodeUint32())
-	return nil
+	return buf.Err()
 }
 
 // VirtioPciDelete defines message 'virtio_pci_delete'.
@@ -406,7 +406,7 @@ func (m *VirtioPciDelete) Marshal(b []byte) ([]byte, error) {
 func (m *VirtioPciDelete) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.SwIfIndex = interface_types.InterfaceIndex(buf.DecodeUint32())
-	return nil
+	return buf.Err()
 }
 
 // VirtioPciDeleteReply defines message 'virtio_pci_delete_reply'.
@@ -439,7 +439,7 @@ func (m *VirtioPciDeleteReply) Marshal(b []byte) ([]byte, error) {
 func (m *VirtioPciDeleteReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
-	return nil
+	return buf.Err()
 }
 
 func init() { file_virtio_binapi_init() }
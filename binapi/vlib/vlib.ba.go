@@ -73,7 +73,7 @@ func (m *AddNodeNext) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.NodeName = buf.DecodeString(64)
 	m.NextName = buf.DecodeString(64)
-	return nil
+	return buf.Err()
 }
 
 // AddNodeNextReply defines message 'add_node_next_reply'.
@@ -110,7 +110,7 @@ func (m *AddNodeNextReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
 	m.NextIndex = buf.DecodeUint32()
-	return nil
+	return buf.Err()
 }
 
 // Cli defines message 'cli'.
@@ -143,7 +143,7 @@ func (m *Cli) Marshal(b []byte) ([]byte, error) {
 func (m *Cli) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.CmdInShmem = buf.DecodeUint64()
-	return nil
+	return buf.Err()
 }
 
 // CliInband defines message 'cli_inband'.
@@ -176,7 +176,7 @@ func (m *CliInband) Marshal(b []byte) ([]byte, error) {
 func (m *CliInband) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Cmd = buf.DecodeString(0)
-	return nil
+	return buf.Err()
 }
 
 // CliInbandReply defines message 'cli_inband_reply'.
@@ -213,7 +213,7 @@ func (m *CliInbandReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
 	m.Reply = buf.DecodeString(0)
-	return nil
+	return buf.Err()
 }
 
 // CliReply defines message 'cli_reply'.
@@ -250,7 +250,7 @@ func (m *CliReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
 	m.ReplyInShmem = buf.DecodeUint64()
-	return nil
+	return buf.Err()
 }
 
 // GetF64EndianValue defines message 'get_f64_endian_value'.
@@ -283,7 +283,7 @@ func (m *GetF64EndianValue) Marshal(b []byte) ([]byte, error) {
 func (m *GetF64EndianValue) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.F64One = buf.DecodeFloat64()
-	return nil
+	return buf.Err()
 }
 
 // GetF64EndianValueReply defines message 'get_f64_endian_value_reply'.
@@ -320,7 +320,7 @@ func (m *GetF64EndianValueReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeUint32()
 	m.F64OneResult = buf.DecodeFloat64()
-	return nil
+	return buf.Err()
 }
 
 // GetF64IncrementByOne defines message 'get_f64_increment_by_one'.
@@ -353,7 +353,7 @@ func (m *GetF64IncrementByOne) Marshal(b []byte) ([]byte, error) {
 func (m *GetF64IncrementByOne) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.F64Value = buf.DecodeFloat64()
-	return nil
+	return buf.Err()
 }
 
 // GetF64IncrementByOneReply defines message 'get_f64_increment_by_one_reply'.
@@ -390,7 +390,7 @@ func (m *GetF64IncrementByOneReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeUint32()
 	m.F64Value = buf.DecodeFloat64()
-	return nil
+	return buf.Err()
 }
 
 // GetNextIndex defines message 'get_next_index'.
@@ -427,7 +427,7 @@ func (m *GetNextIndex) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.NodeName = buf.DecodeString(64)
 	m.NextName = buf.DecodeString(64)
-	return nil
+	return buf.Err()
 }
 
 // GetNextIndexReply defines message 'get_next_index_reply'.
@@ -464,7 +464,7 @@ func (m *GetNextIndexReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
 	m.NextIndex = buf.DecodeUint32()
-	return nil
+	return buf.Err()
 }
 
 // GetNodeGraph defines message 'get_node_graph'.
@@ -528,7 +528,7 @@ func (m *GetNodeGraphReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
 	m.ReplyInShmem = buf.DecodeUint64()
-	return nil
+	return buf.Err()
 }
 
 // GetNodeIndex defines message 'get_node_index'.
@@ -561,7 +561,7 @@ func (m *GetNodeIndex) Marshal(b []byte) ([]byte, error) {
 func (m *GetNodeIndex) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.NodeName = buf.DecodeString(64)
-	return nil
+	return buf.Err()
 }
 
 // GetNodeIndexReply defines message 'get_node_index_reply'.
@@ -598,7 +598,7 @@ func (m *GetNodeIndexReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
 	m.NodeIndex = buf.DecodeUint32()
-	return nil
+	return buf.Err()
 }
 
 // ShowThreads defines message 'show_threads'.
@@ -690,7 +690,7 @@ func (m *ShowThreadsReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
 	m.Count = buf.DecodeUint32()
-	m.ThreadData = make([]ThreadData, m.Count)
+	m.ThreadData = make([]ThreadData, buf.CheckArrayLen(int(m.Count), 1))
 	for j0 := 0; j0 < len(m.ThreadData); j0++ {
 		m.ThreadData[j0].ID = buf.DecodeUint32()
 		m.ThreadData[j0].Name = buf.DecodeString(64)
@@ -700,7 +700,7 @@ func (m *ShowThreadsReply) Unmarshal(b []byte) error {
 		m.ThreadData[j0].Core = buf.DecodeUint32()
 		m.ThreadData[j0].CPUSocket = buf.DecodeUint32()
 	}
-	return nil
+	return buf.Err()
 }
 
 func init() { file_vlib_binapi_init() }
@@ -173,7 +173,7 @@ func (m *SwVmxnet3InterfaceDetails) Unmarshal(b []byte) error {
 		m.TxList[j0].TxProduce = buf.DecodeUint16()
 		m.TxList[j0].TxConsume = buf.DecodeUint16()
 	}
-	return nil
+	return buf.Err()
 }
 
 // SwVmxnet3InterfaceDump defines message 'sw_vmxnet3_interface_dump'.
@@ -206,7 +206,7 @@ func (m *SwVmxnet3InterfaceDump) Marshal(b []byte) ([]byte, error) {
 func (m *SwVmxnet3InterfaceDump) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.SwIfIndex = interface_types.InterfaceIndex(buf.DecodeUint32())
-	return nil
+	return buf.Err()
 }
 
 // Vmxnet3Create defines message 'vmxnet3_create'.
@@ -267,7 +267,7 @@ func (m *Vmxnet3Create) Unmarshal(b []byte) error {
 	m.TxqNum = buf.DecodeUint16()
 	m.Bind = buf.DecodeUint8()
 	m.EnableGso = buf.DecodeBool()
-	return nil
+	return buf.Err()
 }
 
 // Vmxnet3CreateReply defines message 'vmxnet3_create_reply'.
@@ -304,7 +304,7 @@ func (m *Vmxnet3CreateReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
 	m.SwIfIndex = interface_types.InterfaceIndex(buf.DecodeUint32())
-	return nil
+	return buf.Err()
 }
 
 // Vmxnet3Delete defines message 'vmxnet3_delete'.
@@ -337,7 +337,7 @@ func (m *Vmxnet3Delete) Marshal(b []byte) ([]byte, error) {
 func (m *Vmxnet3Delete) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.SwIfIndex = interface_types.InterfaceIndex(buf.DecodeUint32())
-	return nil
+	return buf.Err()
 }
 
 // Vmxnet3DeleteReply defines message 'vmxnet3_delete_reply'.
@@ -370,7 +370,7 @@ func (m *Vmxnet3DeleteReply) Marshal(b []byte) ([]byte, error) {
 func (m *Vmxnet3DeleteReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
-	return nil
+	return buf.Err()
 }
 
 // Vmxnet3Details defines message 'vmxnet3_details'.
@@ -499,7 +499,7 @@ func (m *Vmxnet3Details) Unmarshal(b []byte) error {
 		m.TxList[j0].TxProduce = buf.DecodeUint16()
 		m.TxList[j0].TxConsume = buf.DecodeUint16()
 	}
-	return nil
+	return buf.Err()
 }
 
 // Vmxnet3Dump defines message 'vmxnet3_dump'.
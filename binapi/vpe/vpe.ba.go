@@ -70,7 +70,7 @@ func (m *LogDetails) Unmarshal(b []byte) error {
 	m.Level = vpe_types.LogLevel(buf.DecodeUint32())
 	m.MsgClass = buf.DecodeString(32)
 	m.Message = buf.DecodeString(256)
-	return nil
+	return buf.Err()
 }
 
 // LogDump defines message 'log_dump'.
@@ -103,7 +103,7 @@ func (m *LogDump) Marshal(b []byte) ([]byte, error) {
 func (m *LogDump) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.StartTimestamp = vpe_types.Timestamp(buf.DecodeFloat64())
-	return nil
+	return buf.Err()
 }
 
 // ShowVersion defines message 'show_version'.
@@ -179,7 +179,7 @@ func (m *ShowVersionReply) Unmarshal(b []byte) error {
 	m.Version = buf.DecodeString(32)
 	m.BuildDate = buf.DecodeString(32)
 	m.BuildDirectory = buf.DecodeString(256)
-	return nil
+	return buf.Err()
 }
 
 // ShowVpeSystemTime defines message 'show_vpe_system_time'.
@@ -243,7 +243,7 @@ func (m *ShowVpeSystemTimeReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
 	m.VpeSystemTime = vpe_types.Timestamp(buf.DecodeFloat64())
-	return nil
+	return buf.Err()
 }
 
 func init() { file_vpe_binapi_init() }
@@ -229,12 +229,12 @@ func (m *VrrpVrAddDel) Unmarshal(b []byte) error {
 	m.Interval = buf.DecodeUint16()
 	m.Flags = VrrpVrFlags(buf.DecodeUint32())
 	m.NAddrs = buf.DecodeUint8()
-	m.Addrs = make([]ip_types.Address, m.NAddrs)
+	m.Addrs = make([]ip_types.Address, buf.CheckArrayLen(int(m.NAddrs), 1))
 	for j0 := 0; j0 < len(m.Addrs); j0++ {
 		m.Addrs[j0].Af = ip_types.AddressFamily(buf.DecodeUint8())
 		copy(m.Addrs[j0].Un.XXX_UnionData[:], buf.DecodeBytes(16))
 	}
-	return nil
+	return buf.Err()
 }
 
 // VrrpVrAddDelReply defines message 'vrrp_vr_add_del_reply'.
@@ -267,7 +267,7 @@ func (m *VrrpVrAddDelReply) Marshal(b []byte) ([]byte, error) {
 func (m *VrrpVrAddDelReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
-	return nil
+	return buf.Err()
 }
 
 // VrrpVrDel defines message 'vrrp_vr_del'.
@@ -300,7 +300,7 @@ func (m *VrrpVrDel) Marshal(b []byte) ([]byte, error) {
 func (m *VrrpVrDel) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.VrrpIndex = buf.DecodeUint32()
-	return nil
+	return buf.Err()
 }
 
 // VrrpVrDelReply defines message 'vrrp_vr_del_reply'.
@@ -333,7 +333,7 @@ func (m *VrrpVrDelReply) Marshal(b []byte) ([]byte, error) {
 func (m *VrrpVrDelReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
-	return nil
+	return buf.Err()
 }
 
 // VrrpVrDetails defines message 'vrrp_vr_details'.
@@ -422,12 +422,12 @@ func (m *VrrpVrDetails) Unmarshal(b []byte) error {
 	m.Runtime.Tracking.InterfacesDec = buf.DecodeUint32()
 	m.Runtime.Tracking.Priority = buf.DecodeUint8()
 	m.NAddrs = buf.DecodeUint8()
-	m.Addrs = make([]ip_types.Address, m.NAddrs)
+	m.Addrs = make([]ip_types.Address, buf.CheckArrayLen(int(m.NAddrs), 1))
 	for j0 := 0; j0 < len(m.Addrs); j0++ {
 		m.Addrs[j0].Af = ip_types.AddressFamily(buf.DecodeUint8())
 		copy(m.Addrs[j0].Un.XXX_UnionData[:], buf.DecodeBytes(16))
 	}
-	return nil
+	return buf.Err()
 }
 
 // VrrpVrDump defines message 'vrrp_vr_dump'.
@@ -460,7 +460,7 @@ func (m *VrrpVrDump) Marshal(b []byte) ([]byte, error) {
 func (m *VrrpVrDump) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.SwIfIndex = interface_types.InterfaceIndex(buf.DecodeUint32())
-	return nil
+	return buf.Err()
 }
 
 // VrrpVrEvent defines message 'vrrp_vr_event'.
@@ -511,7 +511,7 @@ func (m *VrrpVrEvent) Unmarshal(b []byte) error {
 	m.Vr.IsIPv6 = buf.DecodeUint8()
 	m.OldState = VrrpVrState(buf.DecodeUint32())
 	m.NewState = VrrpVrState(buf.DecodeUint32())
-	return nil
+	return buf.Err()
 }
 
 // VrrpVrPeerDetails defines message 'vrrp_vr_peer_details'.
@@ -574,12 +574,12 @@ func (m *VrrpVrPeerDetails) Unmarshal(b []byte) error {
 	m.VrID = buf.DecodeUint8()
 	m.IsIPv6 = buf.DecodeUint8()
 	m.NPeerAddrs = buf.DecodeUint8()
-	m.PeerAddrs = make([]ip_types.Address, m.NPeerAddrs)
+	m.PeerAddrs = make([]ip_types.Address, buf.CheckArrayLen(int(m.NPeerAddrs), 1))
 	for j0 := 0; j0 < len(m.PeerAddrs); j0++ {
 		m.PeerAddrs[j0].Af = ip_types.AddressFamily(buf.DecodeUint8())
 		copy(m.PeerAddrs[j0].Un.XXX_UnionData[:], buf.DecodeBytes(16))
 	}
-	return nil
+	return buf.Err()
 }
 
 // VrrpVrPeerDump defines message 'vrrp_vr_peer_dump'.
@@ -620,7 +620,7 @@ func (m *VrrpVrPeerDump) Unmarshal(b []byte) error {
 	m.SwIfIndex = interface_types.InterfaceIndex(buf.DecodeUint32())
 	m.IsIPv6 = buf.DecodeUint8()
 	m.VrID = buf.DecodeUint8()
-	return nil
+	return buf.Err()
 }
 
 // VrrpVrSetPeers defines message 'vrrp_vr_set_peers'.
@@ -683,12 +683,12 @@ func (m *VrrpVrSetPeers) Unmarshal(b []byte) error {
 	m.VrID = buf.DecodeUint8()
 	m.IsIPv6 = buf.DecodeUint8()
 	m.NAddrs = buf.DecodeUint8()
-	m.Addrs = make([]ip_types.Address, m.NAddrs)
+	m.Addrs = make([]ip_types.Address, buf.CheckArrayLen(int(m.NAddrs), 1))
 	for j0 := 0; j0 < len(m.Addrs); j0++ {
 		m.Addrs[j0].Af = ip_types.AddressFamily(buf.DecodeUint8())
 		copy(m.Addrs[j0].Un.XXX_UnionData[:], buf.DecodeBytes(16))
 	}
-	return nil
+	return buf.Err()
 }
 
 // VrrpVrSetPeersReply defines message 'vrrp_vr_set_peers_reply'.
@@ -721,7 +721,7 @@ func (m *VrrpVrSetPeersReply) Marshal(b []byte) ([]byte, error) {
 func (m *VrrpVrSetPeersReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
-	return nil
+	return buf.Err()
 }
 
 // VrrpVrStartStop defines message 'vrrp_vr_start_stop'.
@@ -766,7 +766,7 @@ func (m *VrrpVrStartStop) Unmarshal(b []byte) error {
 	m.VrID = buf.DecodeUint8()
 	m.IsIPv6 = buf.DecodeUint8()
 	m.IsStart = buf.DecodeUint8()
-	return nil
+	return buf.Err()
 }
 
 // VrrpVrStartStopReply defines message 'vrrp_vr_start_stop_reply'.
@@ -799,7 +799,7 @@ func (m *VrrpVrStartStopReply) Marshal(b []byte) ([]byte, error) {
 func (m *VrrpVrStartStopReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
-	return nil
+	return buf.Err()
 }
 
 // VrrpVrTrackIfAddDel defines message 'vrrp_vr_track_if_add_del'.
@@ -866,12 +866,12 @@ func (m *VrrpVrTrackIfAddDel) Unmarshal(b []byte) error {
 	m.VrID = buf.DecodeUint8()
 	m.IsAdd = buf.DecodeUint8()
 	m.NIfs = buf.DecodeUint8()
-	m.Ifs = make([]VrrpVrTrackIf, m.NIfs)
+	m.Ifs = make([]VrrpVrTrackIf, buf.CheckArrayLen(int(m.NIfs), 1))
 	for j0 := 0; j0 < len(m.Ifs); j0++ {
 		m.Ifs[j0].SwIfIndex = interface_types.InterfaceIndex(buf.DecodeUint32())
 		m.Ifs[j0].Priority = buf.DecodeUint8()
 	}
-	return nil
+	return buf.Err()
 }
 
 // VrrpVrTrackIfAddDelReply defines message 'vrrp_vr_track_if_add_del_reply'.
@@ -904,7 +904,7 @@ func (m *VrrpVrTrackIfAddDelReply) Marshal(b []byte) ([]byte, error) {
 func (m *VrrpVrTrackIfAddDelReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
-	return nil
+	return buf.Err()
 }
 
 // VrrpVrTrackIfDetails defines message 'vrrp_vr_track_if_details'.
@@ -967,12 +967,12 @@ func (m *VrrpVrTrackIfDetails) Unmarshal(b []byte) error {
 	m.VrID = buf.DecodeUint8()
 	m.IsIPv6 = buf.DecodeUint8()
 	m.NIfs = buf.DecodeUint8()
-	m.Ifs = make([]VrrpVrTrackIf, m.NIfs)
+	m.Ifs = make([]VrrpVrTrackIf, buf.CheckArrayLen(int(m.NIfs), 1))
 	for j0 := 0; j0 < len(m.Ifs); j0++ {
 		m.Ifs[j0].SwIfIndex = interface_types.InterfaceIndex(buf.DecodeUint32())
 		m.Ifs[j0].Priority = buf.DecodeUint8()
 	}
-	return nil
+	return buf.Err()
 }
 
 // VrrpVrTrackIfDump defines message 'vrrp_vr_track_if_dump'.
@@ -1017,7 +1017,7 @@ func (m *VrrpVrTrackIfDump) Unmarshal(b []byte) error {
 	m.IsIPv6 = buf.DecodeUint8()
 	m.VrID = buf.DecodeUint8()
 	m.DumpAll = buf.DecodeUint8()
-	return nil
+	return buf.Err()
 }
 
 // VrrpVrUpdate defines message 'vrrp_vr_update'.
@@ -1092,12 +1092,12 @@ func (m *VrrpVrUpdate) Unmarshal(b []byte) error {
 	m.Interval = buf.DecodeUint16()
 	m.Flags = VrrpVrFlags(buf.DecodeUint32())
 	m.NAddrs = buf.DecodeUint8()
-	m.Addrs = make([]ip_types.Address, m.NAddrs)
+	m.Addrs = make([]ip_types.Address, buf.CheckArrayLen(int(m.NAddrs), 1))
 	for j0 := 0; j0 < len(m.Addrs); j0++ {
 		m.Addrs[j0].Af = ip_types.AddressFamily(buf.DecodeUint8())
 		copy(m.Addrs[j0].Un.XXX_UnionData[:], buf.DecodeBytes(16))
 	}
-	return nil
+	return buf.Err()
 }
 
 // VrrpVrUpdateReply defines message 'vrrp_vr_update_reply'.
@@ -1134,7 +1134,7 @@ func (m *VrrpVrUpdateReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
 	m.VrrpIndex = buf.DecodeUint32()
-	return nil
+	return buf.Err()
 }
 
 // WantVrrpVrEvents defines message 'want_vrrp_vr_events'.
@@ -1171,7 +1171,7 @@ func (m *WantVrrpVrEvents) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.EnableDisable = buf.DecodeBool()
 	m.PID = buf.DecodeUint32()
-	return nil
+	return buf.Err()
 }
 
 // WantVrrpVrEventsReply defines message 'want_vrrp_vr_events_reply'.
@@ -1204,7 +1204,7 @@ func (m *WantVrrpVrEventsReply) Marshal(b []byte) ([]byte, error) {
 func (m *WantVrrpVrEventsReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
-	return nil
+	return buf.Err()
 }
 
 func init() { file_vrrp_binapi_init() }
@@ -67,7 +67,7 @@ func (m *SwInterfaceSetVxlanBypass) Unmarshal(b []byte) error {
 	m.SwIfIndex = interface_types.InterfaceIndex(buf.DecodeUint32())
 	m.IsIPv6 = buf.DecodeBool()
 	m.Enable = buf.DecodeBool()
-	return nil
+	return buf.Err()
 }
 
 // SwInterfaceSetVxlanBypassReply defines message 'sw_interface_set_vxlan_bypass_reply'.
@@ -102,7 +102,7 @@ func (m *SwInterfaceSetVxlanBypassReply) Marshal(b []byte) ([]byte, error) {
 func (m *SwInterfaceSetVxlanBypassReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
-	return nil
+	return buf.Err()
 }
 
 // VxlanAddDelTunnel defines message 'vxlan_add_del_tunnel'.
@@ -169,7 +169,7 @@ func (m *VxlanAddDelTunnel) Unmarshal(b []byte) error {
 	m.EncapVrfID = buf.DecodeUint32()
 	m.DecapNextIndex = buf.DecodeUint32()
 	m.Vni = buf.DecodeUint32()
-	return nil
+	return buf.Err()
 }
 
 // VxlanAddDelTunnelReply defines message 'vxlan_add_del_tunnel_reply'.
@@ -206,7 +206,7 @@ func (m *VxlanAddDelTunnelReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
 	m.SwIfIndex = interface_types.InterfaceIndex(buf.DecodeUint32())
-	return nil
+	return buf.Err()
 }
 
 // VxlanAddDelTunnelV2 defines message 'vxlan_add_del_tunnel_v2'.
@@ -281,7 +281,7 @@ func (m *VxlanAddDelTunnelV2) Unmarshal(b []byte) error {
 	m.EncapVrfID = buf.DecodeUint32()
 	m.DecapNextIndex = buf.DecodeUint32()
 	m.Vni = buf.DecodeUint32()
-	return nil
+	return buf.Err()
 }
 
 // VxlanAddDelTunnelV2Reply defines message 'vxlan_add_del_tunnel_v2_reply'.
@@ -318,7 +318,7 @@ func (m *VxlanAddDelTunnelV2Reply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
 	m.SwIfIndex = interface_types.InterfaceIndex(buf.DecodeUint32())
-	return nil
+	return buf.Err()
 }
 
 // VxlanAddDelTunnelV3 defines message 'vxlan_add_del_tunnel_v3'.
@@ -397,7 +397,7 @@ func (m *VxlanAddDelTunnelV3) Unmarshal(b []byte) error {
 	m.DecapNextIndex = buf.DecodeUint32()
 	m.Vni = buf.DecodeUint32()
 	m.IsL3 = buf.DecodeBool()
-	return nil
+	return buf.Err()
 }
 
 // VxlanAddDelTunnelV3Reply defines message 'vxlan_add_del_tunnel_v3_reply'.
@@ -434,7 +434,7 @@ func (m *VxlanAddDelTunnelV3Reply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
 	m.SwIfIndex = interface_types.InterfaceIndex(buf.DecodeUint32())
-	return nil
+	return buf.Err()
 }
 
 // VxlanOffloadRx defines message 'vxlan_offload_rx'.
@@ -475,7 +475,7 @@ func (m *VxlanOffloadRx) Unmarshal(b []byte) error {
 	m.HwIfIndex = interface_types.InterfaceIndex(buf.DecodeUint32())
 	m.SwIfIndex = interface_types.InterfaceIndex(buf.DecodeUint32())
 	m.Enable = buf.DecodeBool()
-	return nil
+	return buf.Err()
 }
 
 // VxlanOffloadRxReply defines message 'vxlan_offload_rx_reply'.
@@ -508,7 +508,7 @@ func (m *VxlanOffloadRxReply) Marshal(b []byte) ([]byte, error) {
 func (m *VxlanOffloadRxReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
-	return nil
+	return buf.Err()
 }
 
 // VxlanTunnelDetails defines message 'vxlan_tunnel_details'.
@@ -575,7 +575,7 @@ func (m *VxlanTunnelDetails) Unmarshal(b []byte) error {
 	m.EncapVrfID = buf.DecodeUint32()
 	m.DecapNextIndex = buf.DecodeUint32()
 	m.Vni = buf.DecodeUint32()
-	return nil
+	return buf.Err()
 }
 
 // VxlanTunnelDump defines message 'vxlan_tunnel_dump'.
@@ -608,7 +608,7 @@ func (m *VxlanTunnelDump) Marshal(b []byte) ([]byte, error) {
 func (m *VxlanTunnelDump) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.SwIfIndex = interface_types.InterfaceIndex(buf.DecodeUint32())
-	return nil
+	return buf.Err()
 }
 
 // VxlanTunnelV2Details defines message 'vxlan_tunnel_v2_details'.
@@ -683,7 +683,7 @@ func (m *VxlanTunnelV2Details) Unmarshal(b []byte) error {
 	m.EncapVrfID = buf.DecodeUint32()
 	m.DecapNextIndex = buf.DecodeUint32()
 	m.Vni = buf.DecodeUint32()
-	return nil
+	return buf.Err()
 }
 
 // VxlanTunnelV2Dump defines message 'vxlan_tunnel_v2_dump'.
@@ -716,7 +716,7 @@ func (m *VxlanTunnelV2Dump) Marshal(b []byte) ([]byte, error) {
 func (m *VxlanTunnelV2Dump) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.SwIfIndex = interface_types.InterfaceIndex(buf.DecodeUint32())
-	return nil
+	return buf.Err()
 }
 
 func init() { file_vxlan_binapi_init() }
@@ -69,7 +69,7 @@ func (m *SwInterfaceSetVxlanGpeBypass) Unmarshal(b []byte) error {
 	m.SwIfIndex = interface_types.InterfaceIndex(buf.DecodeUint32())
 	m.IsIPv6 = buf.DecodeBool()
 	m.Enable = buf.DecodeBool()
-	return nil
+	return buf.Err()
 }
 
 // SwInterfaceSetVxlanGpeBypassReply defines message 'sw_interface_set_vxlan_gpe_bypass_reply'.
@@ -104,7 +104,7 @@ func (m *SwInterfaceSetVxlanGpeBypassReply) Marshal(b []byte) ([]byte, error) {
 func (m *SwInterfaceSetVxlanGpeBypassReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
-	return nil
+	return buf.Err()
 }
 
 // VxlanGpeAddDelTunnel defines message 'vxlan_gpe_add_del_tunnel'.
@@ -171,7 +171,7 @@ func (m *VxlanGpeAddDelTunnel) Unmarshal(b []byte) error {
 	m.Protocol = ip_types.IPProto(buf.DecodeUint8())
 	m.Vni = buf.DecodeUint32()
 	m.IsAdd = buf.DecodeBool()
-	return nil
+	return buf.Err()
 }
 
 // VxlanGpeAddDelTunnelReply defines message 'vxlan_gpe_add_del_tunnel_reply'.
@@ -208,7 +208,7 @@ func (m *VxlanGpeAddDelTunnelReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
 	m.SwIfIndex = interface_types.InterfaceIndex(buf.DecodeUint32())
-	return nil
+	return buf.Err()
 }
 
 // VxlanGpeAddDelTunnelV2 defines message 'vxlan_gpe_add_del_tunnel_v2'.
@@ -283,7 +283,7 @@ func (m *VxlanGpeAddDelTunnelV2) Unmarshal(b []byte) error {
 	m.Protocol = ip_types.IPProto(buf.DecodeUint8())
 	m.Vni = buf.DecodeUint32()
 	m.IsAdd = buf.DecodeBool()
-	return nil
+	return buf.Err()
 }
 
 // VxlanGpeAddDelTunnelV2Reply defines message 'vxlan_gpe_add_del_tunnel_v2_reply'.
@@ -322,7 +322,7 @@ func (m *VxlanGpeAddDelTunnelV2Reply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
 	m.SwIfIndex = interface_types.InterfaceIndex(buf.DecodeUint32())
-	return nil
+	return buf.Err()
 }
 
 // VxlanGpeTunnelDetails defines message 'vxlan_gpe_tunnel_details'.
@@ -393,7 +393,7 @@ func (m *VxlanGpeTunnelDetails) Unmarshal(b []byte) error {
 	m.EncapVrfID = buf.DecodeUint32()
 	m.DecapVrfID = buf.DecodeUint32()
 	m.IsIPv6 = buf.DecodeBool()
-	return nil
+	return buf.Err()
 }
 
 // VxlanGpeTunnelDump defines message 'vxlan_gpe_tunnel_dump'.
@@ -426,7 +426,7 @@ func (m *VxlanGpeTunnelDump) Marshal(b []byte) ([]byte, error) {
 func (m *VxlanGpeTunnelDump) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.SwIfIndex = interface_types.InterfaceIndex(buf.DecodeUint32())
-	return nil
+	return buf.Err()
 }
 
 // VxlanGpeTunnelV2Details defines message 'vxlan_gpe_tunnel_v2_details'.
@@ -505,7 +505,7 @@ func (m *VxlanGpeTunnelV2Details) Unmarshal(b []byte) error {
 	m.EncapVrfID = buf.DecodeUint32()
 	m.DecapVrfID = buf.DecodeUint32()
 	m.IsIPv6 = buf.DecodeBool()
-	return nil
+	return buf.Err()
 }
 
 // VxlanGpeTunnelV2Dump defines message 'vxlan_gpe_tunnel_v2_dump'.
@@ -538,7 +538,7 @@ func (m *VxlanGpeTunnelV2Dump) Marshal(b []byte) ([]byte, error) {
 func (m *VxlanGpeTunnelV2Dump) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.SwIfIndex = interface_types.InterfaceIndex(buf.DecodeUint32())
-	return nil
+	return buf.Err()
 }
 
 func init() { file_vxlan_gpe_binapi_init() }
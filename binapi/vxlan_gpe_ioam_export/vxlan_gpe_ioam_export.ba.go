@@ -68,7 +68,7 @@ func (m *VxlanGpeIoamExportEnableDisable) Unmarshal(b []byte) error {
 	m.IsDisable = buf.DecodeBool()
 	copy(m.CollectorAddress[:], buf.DecodeBytes(4))
 	copy(m.SrcAddress[:], buf.DecodeBytes(4))
-	return nil
+	return buf.Err()
 }
 
 // VxlanGpeIoamExportEnableDisableReply defines message 'vxlan_gpe_ioam_export_enable_disable_reply'.
@@ -103,7 +103,7 @@ func (m *VxlanGpeIoamExportEnableDisableReply) Marshal(b []byte) ([]byte, error)
 func (m *VxlanGpeIoamExportEnableDisableReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
-	return nil
+	return buf.Err()
 }
 
 func init() { file_vxlan_gpe_ioam_export_binapi_init() }
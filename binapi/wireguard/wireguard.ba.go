@@ -146,7 +146,7 @@ func (m *WantWireguardPeerEvents) Unmarshal(b []byte) error {
 	m.PeerIndex = buf.DecodeUint32()
 	m.EnableDisable = buf.DecodeUint32()
 	m.PID = buf.DecodeUint32()
-	return nil
+	return buf.Err()
 }
 
 // WantWireguardPeerEventsReply defines message 'want_wireguard_peer_events_reply'.
@@ -182,7 +182,7 @@ func (m *WantWireguardPeerEventsReply) Marshal(b []byte) ([]byte, error) {
 func (m *WantWireguardPeerEventsReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
-	return nil
+	return buf.Err()
 }
 
 // WgSetAsyncMode defines message 'wg_set_async_mode'.
@@ -216,7 +216,7 @@ func (m *WgSetAsyncMode) Marshal(b []byte) ([]byte, error) {
 func (m *WgSetAsyncMode) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.AsyncEnable = buf.DecodeBool()
-	return nil
+	return buf.Err()
 }
 
 // WgSetAsyncModeReply defines message 'wg_set_async_mode_reply'.
@@ -250,7 +250,7 @@ func (m *WgSetAsyncModeReply) Marshal(b []byte) ([]byte, error) {
 func (m *WgSetAsyncModeReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
-	return nil
+	return buf.Err()
 }
 
 // WireguardInterfaceCreate defines message 'wireguard_interface_create'.
@@ -308,7 +308,7 @@ func (m *WireguardInterfaceCreate) Unmarshal(b []byte) error {
 	m.Interface.SrcIP.Af = ip_types.AddressFamily(buf.DecodeUint8())
 	copy(m.Interface.SrcIP.Un.XXX_UnionData[:], buf.DecodeBytes(16))
 	m.GenerateKey = buf.DecodeBool()
-	return nil
+	return buf.Err()
 }
 
 // WireguardInterfaceCreateReply defines message 'wireguard_interface_create_reply'.
@@ -348,7 +348,7 @@ func (m *WireguardInterfaceCreateReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
 	m.SwIfIndex = interface_types.InterfaceIndex(buf.DecodeUint32())
-	return nil
+	return buf.Err()
 }
 
 // WireguardInterfaceDelete defines message 'wireguard_interface_delete'.
@@ -382,7 +382,7 @@ func (m *WireguardInterfaceDelete) Marshal(b []byte) ([]byte, error) {
 func (m *WireguardInterfaceDelete) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.SwIfIndex = interface_types.InterfaceIndex(buf.DecodeUint32())
-	return nil
+	return buf.Err()
 }
 
 // WireguardInterfaceDeleteReply defines message 'wireguard_interface_delete_reply'.
@@ -418,7 +418,7 @@ func (m *WireguardInterfaceDeleteReply) Marshal(b []byte) ([]byte, error) {
 func (m *WireguardInterfaceDeleteReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
-	return nil
+	return buf.Err()
 }
 
 // WireguardInterfaceDetails defines message 'wireguard_interface_details'.
@@ -472,7 +472,7 @@ func (m *WireguardInterfaceDetails) Unmarshal(b []byte) error {
 	m.Interface.Port = buf.DecodeUint16()
 	m.Interface.SrcIP.Af = ip_types.AddressFamily(buf.DecodeUint8())
 	copy(m.Interface.SrcIP.Un.XXX_UnionData[:], buf.DecodeBytes(16))
-	return nil
+	return buf.Err()
 }
 
 // WireguardInterfaceDump defines message 'wireguard_interface_dump'.
@@ -510,7 +510,7 @@ func (m *WireguardInterfaceDump) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.ShowPrivateKey = buf.DecodeBool()
 	m.SwIfIndex = interface_types.InterfaceIndex(buf.DecodeUint32())
-	return nil
+	return buf.Err()
 }
 
 // WireguardPeerAdd defines message 'wireguard_peer_add'.
@@ -591,13 +591,13 @@ func (m *WireguardPeerAdd) Unmarshal(b []byte) error {
 	m.Peer.SwIfIndex = interface_types.InterfaceIndex(buf.DecodeUint32())
 	m.Peer.Flags = WireguardPeerFlags(buf.DecodeUint8())
 	m.Peer.NAllowedIps = buf.DecodeUint8()
-	m.Peer.AllowedIps = make([]ip_types.Prefix, m.Peer.NAllowedIps)
+	m.Peer.AllowedIps = make([]ip_types.Prefix, buf.CheckArrayLen(int(m.Peer.NAllowedIps), 1))
 	for j1 := 0; j1 < len(m.Peer.AllowedIps); j1++ {
 		m.Peer.AllowedIps[j1].Address.Af = ip_types.AddressFamily(buf.DecodeUint8())
 		copy(m.Peer.AllowedIps[j1].Address.Un.XXX_UnionData[:], buf.DecodeBytes(16))
 		m.Peer.AllowedIps[j1].Len = buf.DecodeUint8()
 	}
-	return nil
+	return buf.Err()
 }
 
 // WireguardPeerAddReply defines message 'wireguard_peer_add_reply'.
@@ -635,7 +635,7 @@ func (m *WireguardPeerAddReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
 	m.PeerIndex = buf.DecodeUint32()
-	return nil
+	return buf.Err()
 }
 
 // WireguardPeerEvent defines message 'wireguard_peer_event'.
@@ -677,7 +677,7 @@ func (m *WireguardPeerEvent) Unmarshal(b []byte) error {
 	m.PID = buf.DecodeUint32()
 	m.PeerIndex = buf.DecodeUint32()
 	m.Flags = WireguardPeerFlags(buf.DecodeUint8())
-	return nil
+	return buf.Err()
 }
 
 // WireguardPeerRemove defines message 'wireguard_peer_remove'.
@@ -711,7 +711,7 @@ func (m *WireguardPeerRemove) Marshal(b []byte) ([]byte, error) {
 func (m *WireguardPeerRemove) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.PeerIndex = buf.DecodeUint32()
-	return nil
+	return buf.Err()
 }
 
 // WireguardPeerRemoveReply defines message 'wireguard_peer_remove_reply'.
@@ -745,7 +745,7 @@ func (m *WireguardPeerRemoveReply) Marshal(b []byte) ([]byte, error) {
 func (m *WireguardPeerRemoveReply) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.Retval = buf.DecodeInt32()
-	return nil
+	return buf.Err()
 }
 
 // WireguardPeersDetails defines message 'wireguard_peers_details'.
@@ -826,13 +826,13 @@ func (m *WireguardPeersDetails) Unmarshal(b []byte) error {
 	m.Peer.SwIfIndex = interface_types.InterfaceIndex(buf.DecodeUint32())
 	m.Peer.Flags = WireguardPeerFlags(buf.DecodeUint8())
 	m.Peer.NAllowedIps = buf.DecodeUint8()
-	m.Peer.AllowedIps = make([]ip_types.Prefix, m.Peer.NAllowedIps)
+	m.Peer.AllowedIps = make([]ip_types.Prefix, buf.CheckArrayLen(int(m.Peer.NAllowedIps), 1))
 	for j1 := 0; j1 < len(m.Peer.AllowedIps); j1++ {
 		m.Peer.AllowedIps[j1].Address.Af = ip_types.AddressFamily(buf.DecodeUint8())
 		copy(m.Peer.AllowedIps[j1].Address.Un.XXX_UnionData[:], buf.DecodeBytes(16))
 		m.Peer.AllowedIps[j1].Len = buf.DecodeUint8()
 	}
-	return nil
+	return buf.Err()
 }
 
 // WireguardPeersDump defines message 'wireguard_peers_dump'.
@@ -866,7 +866,7 @@ func (m *WireguardPeersDump) Marshal(b []byte) ([]byte, error) {
 func (m *WireguardPeersDump) Unmarshal(b []byte) error {
 	buf := codec.NewBuffer(b)
 	m.PeerIndex = buf.DecodeUint32()
-	return nil
+	return buf.Err()
 }
 
 func init() { file_wireguard_binapi_init() }
@@ -0,0 +1,61 @@
+//  Copyright (c) 2023 Cisco and/or its affiliates.
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at:
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package binapigen
+
+import (
+	"path"
+)
+
+// AllPackageName is the name of the package generated by the all plugin.
+const AllPackageName = "all"
+
+func init() {
+	addPlugin(&Plugin{
+		Name:        AllPackageName,
+		GenerateAll: GenerateAll,
+	})
+}
+
+// GenerateAll generates the package importing all the generated packages,
+// so all their messages are registered by importing this single package
+// (e.g. by tests iterating over the registered messages).
+func GenerateAll(gen *Generator) []*GenFile {
+	var files []*File
+	for _, file := range gen.Files {
+		if file.Generate {
+			files = append(files, file)
+		}
+	}
+	if len(files) == 0 {
+		return nil
+	}
+
+	logf("----------------------------")
+	logf(" Generate All - %d packages", len(files))
+	logf("----------------------------")
+
+	filename := path.Join(gen.opts.OutputDir, AllPackageName, AllPackageName+generatedFilenameSuffix)
+	g := gen.NewGenFile(filename, nil)
+
+	genCodeGeneratedComment(g)
+	g.P()
+	g.P("// Package ", AllPackageName, " imports all the generated binapi packages.")
+	g.P("package ", AllPackageName)
+	for _, file := range files {
+		g.Import(file.GoImportPath)
+	}
+
+	return []*GenFile{g}
+}
@@ -0,0 +1,45 @@
+//  Copyright (c) 2023 Cisco and/or its affiliates.
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at:
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package binapigen
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+
+	"go.fd.io/govpp/binapigen/vppapi"
+)
+
+func TestGenerateAll(t *testing.T) {
+	RegisterTestingT(t)
+
+	var apifiles []*vppapi.File
+	for _, name := range []string{"acl", "af_packet"} {
+		apifile, err := vppapi.ParseFile("vppapi/testdata/" + name + ".api.json")
+		Expect(err).ShouldNot(HaveOccurred())
+		apifiles = append(apifiles, apifile)
+	}
+	gen, err := New(Options{OutputDir: "binapi", ImportPrefix: "example.com/binapi"}, &VppInput{ApiFiles: apifiles})
+	Expect(err).ShouldNot(HaveOccurred())
+
+	files := GenerateAll(gen)
+	Expect(files).To(HaveLen(1))
+	Expect(files[0].filename).To(Equal("binapi/all/all.ba.go"))
+	content, err := files[0].Content()
+	Expect(err).ShouldNot(HaveOccurred())
+	Expect(string(content)).To(ContainSubstring("package all"))
+	Expect(string(content)).To(ContainSubstring(`_ "example.com/binapi/acl"`))
+	Expect(string(content)).To(ContainSubstring(`_ "example.com/binapi/af_packet"`))
+}
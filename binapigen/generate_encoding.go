@@ -279,12 +279,15 @@ func encodeBaseType(g *GenFile, typ, orig, name string, length int, sizefrom str
 func genMessageMethodUnmarshal(g *GenFile, name string, fields []*Field) {
 	g.P("func (m *", name, ") Unmarshal(b []byte) error {")
 
-	if len(fields) > 0 {
-		g.P("buf := ", govppCodecPkg.Ident("NewBuffer"), "(b)")
-		decodeFields(g, fields, "m", 0)
+	if len(fields) == 0 {
+		g.P("return nil")
+		g.P("}")
+		return
 	}
 
-	g.P("return nil")
+	g.P("buf := ", govppCodecPkg.Ident("NewBuffer"), "(b)")
+	decodeFields(g, fields, "m", 0)
+	g.P("return buf.Err()")
 	g.P("}")
 }
 
@@ -316,7 +319,7 @@ func decodeField(g *GenFile, field *Field, name string, getFieldName func(string
 		if field.Length > 0 {
 			g.P("for ", index, " := 0; ", index, " < ", field.Length, ";", index, "++ {")
 		} else if field.SizeFrom != "" {
-			g.P(name, " = make(", getFieldType(g, field), ", ", sizeFromName, ")")
+			g.P(name, " = make(", getFieldType(g, field), ", buf.CheckArrayLen(int(", sizeFromName, "), 1))")
 			g.P("for ", index, " := 0; ", index, " < len(", name, ");", index, "++ {")
 		}
 		name = fmt.Sprintf("%s[%s]", name, index)
@@ -362,6 +365,9 @@ func decodeBaseType(g *GenFile, typ, orig, name string, length int, sizefrom str
 		case sizefrom != "":
 			size = sizefrom
 		}
+		if alloc && sizefrom != "" {
+			size = fmt.Sprintf("buf.CheckArrayLen(int(%s), %d)", sizefrom, BaseTypeSizes[typ])
+		}
 		switch typ {
 		case U8:
 			if alloc {
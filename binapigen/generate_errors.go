@@ -0,0 +1,127 @@
+//  Copyright (c) 2023 Cisco and/or its affiliates.
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at:
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package binapigen
+
+import (
+	"path"
+	"strconv"
+	"strings"
+)
+
+func init() {
+	RegisterPlugin("errors", GenerateErrors)
+}
+
+// GenerateErrors generates registration of the error codes defined by the
+// file, so the retvals returned by the plugin are described and classified
+// by api.RequestError for the requests of the generated package. The error
+// codes are entries of the enums with name ending with "_error" or "_errno",
+// the entry value is used as the retval.
+func GenerateErrors(gen *Generator, file *File) *GenFile {
+	var enums []*Enum
+	for _, enum := range file.Enums {
+		if isErrorEnum(enum) {
+			enums = append(enums, enum)
+		}
+	}
+	if len(enums) == 0 {
+		return nil
+	}
+
+	logf("----------------------------")
+	logf(" Generate Errors - %s", file.Desc.Name)
+	logf("----------------------------")
+
+	filename := path.Join(file.FilenamePrefix, file.Desc.Name+"_errors"+generatedFilenameSuffix)
+	g := gen.NewGenFile(filename, file)
+
+	// file header
+	genCodeGeneratedComment(g)
+	g.P()
+	g.P("package ", file.PackageName)
+	g.P()
+
+	g.P("func init() {")
+	for _, enum := range enums {
+		for _, entry := range enum.Entries {
+			retval := int32(entry.Value)
+			if retval == 0 {
+				continue
+			}
+			var category interface{} = 0
+			if c := errorCategory(entry.Name); c != "" {
+				category = govppApiPkg.Ident(c)
+			}
+			g.P(govppApiPkg.Ident("RegisterVPPApiError"), "(", strconv.Quote(string(file.GoImportPath)), ", ", retval, ", ", strconv.Quote(entry.Name), ", ", category, ")")
+		}
+	}
+	g.P("}")
+
+	return g
+}
+
+func isErrorEnum(enum *Enum) bool {
+	if enum.IsFlag {
+		return false
+	}
+	return strings.HasSuffix(enum.Name, "_error") || strings.HasSuffix(enum.Name, "_errno")
+}
+
+// errorCategories maps the keywords in the names of the error codes to the
+// error categories of api package. The keywords are matched against whole
+// "_"-separated words of the name and the first match is used, so the
+// negative forms (e.g. NOT_EXIST) must precede the positive ones (EXISTS).
+var errorCategories = []struct {
+	keyword  string
+	category string
+}{
+	{"NOT_FOUND", "ErrNotFound"},
+	{"NO_SUCH", "ErrNotFound"},
+	{"DOESNT_EXIST", "ErrNotFound"},
+	{"NOT_EXIST", "ErrNotFound"},
+	{"NOT_EXISTS", "ErrNotFound"},
+	{"NONEXISTENT", "ErrNotFound"},
+	{"NON_EXISTENT", "ErrNotFound"},
+	{"ENOENT", "ErrNotFound"},
+	{"ALREADY", "ErrAlreadyExists"},
+	{"EXIST", "ErrAlreadyExists"},
+	{"EXISTS", "ErrAlreadyExists"},
+	{"IN_USE", "ErrAlreadyExists"},
+	{"EINUSE", "ErrAlreadyExists"},
+	{"INVALID", "ErrInvalidArgument"},
+	{"EINVAL", "ErrInvalidArgument"},
+	{"UNSUPPORTED", "ErrUnsupported"},
+	{"NOT_SUPPORTED", "ErrUnsupported"},
+	{"NOTSUPP", "ErrUnsupported"},
+	{"UNIMPLEMENTED", "ErrUnsupported"},
+	{"BUSY", "ErrRetryable"},
+	{"EAGAIN", "ErrRetryable"},
+	{"QUEUE_FULL", "ErrRetryable"},
+	{"NOT_READY", "ErrRetryable"},
+	{"IN_PROGRESS", "ErrRetryable"},
+}
+
+// errorCategory returns name of the error category for the error code or empty
+// string if the code is not classified.
+func errorCategory(name string) string {
+	// surround the words with "_" to match the keywords only as whole words
+	name = "_" + strings.ToUpper(name) + "_"
+	for _, c := range errorCategories {
+		if strings.Contains(name, "_"+c.keyword+"_") {
+			return c.category
+		}
+	}
+	return ""
+}
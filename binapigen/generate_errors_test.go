@@ -0,0 +1,102 @@
+//  Copyright (c) 2023 Cisco and/or its affiliates.
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at:
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package binapigen
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+
+	"go.fd.io/govpp/binapigen/vppapi"
+)
+
+func TestGenerateErrors(t *testing.T) {
+	RegisterTestingT(t)
+
+	apifile := &vppapi.File{
+		Name: "foo",
+		Path: "foo.api.json",
+		EnumTypes: []vppapi.EnumType{
+			{
+				Name: "foo_error",
+				Type: "i32",
+				Entries: []vppapi.EnumEntry{
+					{Name: "FOO_API_OK", Value: 0},
+					{Name: "FOO_API_ERROR_NO_SUCH_POOL", Value: uint32(0xfffffc18)}, // -1000
+					{Name: "FOO_API_ERROR_POOL_EXISTS", Value: uint32(0xfffffc17)},  // -1001
+					{Name: "FOO_API_ERROR_INTERNAL", Value: uint32(0xfffffc16)},     // -1002
+				},
+			},
+			{
+				Name:    "foo_mode",
+				Type:    "u8",
+				Entries: []vppapi.EnumEntry{{Name: "FOO_MODE_INVALID", Value: 1}},
+			},
+		},
+	}
+	gen, err := New(Options{ImportPrefix: "example.com/binapi"}, &VppInput{ApiFiles: []*vppapi.File{apifile}})
+	Expect(err).ShouldNot(HaveOccurred())
+
+	g := GenerateErrors(gen, gen.Files[0])
+	Expect(g).ToNot(BeNil())
+	content, err := g.Content()
+	Expect(err).ShouldNot(HaveOccurred())
+	Expect(string(content)).To(ContainSubstring(`api.RegisterVPPApiError("example.com/binapi/foo", -1000, "FOO_API_ERROR_NO_SUCH_POOL", api.ErrNotFound)`))
+	Expect(string(content)).To(ContainSubstring(`api.RegisterVPPApiError("example.com/binapi/foo", -1001, "FOO_API_ERROR_POOL_EXISTS", api.ErrAlreadyExists)`))
+	Expect(string(content)).To(ContainSubstring(`api.RegisterVPPApiError("example.com/binapi/foo", -1002, "FOO_API_ERROR_INTERNAL", 0)`))
+	Expect(string(content)).ToNot(ContainSubstring("FOO_API_OK"))
+	Expect(string(content)).ToNot(ContainSubstring("FOO_MODE_INVALID"))
+}
+
+func TestErrorCategory(t *testing.T) {
+	tests := []struct {
+		name     string
+		category string
+	}{
+		{"FOO_API_ERROR_NO_SUCH_POOL", "ErrNotFound"},
+		{"FOO_API_ERROR_POOL_NOT_FOUND", "ErrNotFound"},
+		{"FOO_API_ERROR_POOL_DOESNT_EXIST", "ErrNotFound"},
+		{"FOO_API_ERROR_POOL_NONEXISTENT", "ErrNotFound"},
+		{"FOO_API_ERROR_NON_EXISTENT_POOL", "ErrNotFound"},
+		{"FOO_API_ERROR_POOL_NOT_EXISTS", "ErrNotFound"},
+		{"FOO_API_ERROR_POOL_EXISTS", "ErrAlreadyExists"},
+		{"FOO_API_ERROR_POOL_ALREADY_CREATED", "ErrAlreadyExists"},
+		{"FOO_API_ERROR_POOL_IN_USE", "ErrAlreadyExists"},
+		{"FOO_API_ERROR_INVALID_VALUE", "ErrInvalidArgument"},
+		{"foo_api_error_invalid_value", "ErrInvalidArgument"},
+		{"FOO_API_ERROR_FEATURE_NOT_SUPPORTED", "ErrUnsupported"},
+		{"FOO_API_ERROR_POOL_BUSY", "ErrRetryable"},
+		{"FOO_API_ERROR_INVALIDATED_CACHE_EXISTENCE", ""},
+		{"FOO_API_ERROR_BUSYBOX", ""},
+		{"FOO_API_ERROR_INTERNAL", ""},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			RegisterTestingT(t)
+			Expect(errorCategory(test.name)).To(Equal(test.category))
+		})
+	}
+}
+
+func TestGenerateErrorsNoErrorEnums(t *testing.T) {
+	RegisterTestingT(t)
+
+	apifile, err := vppapi.ParseFile("vppapi/testdata/acl.api.json")
+	Expect(err).ShouldNot(HaveOccurred())
+	gen, err := New(Options{ImportPrefix: "example.com/binapi"}, &VppInput{ApiFiles: []*vppapi.File{apifile}})
+	Expect(err).ShouldNot(HaveOccurred())
+
+	Expect(GenerateErrors(gen, gen.Files[0])).To(BeNil())
+}
@@ -169,11 +169,11 @@ func genService(g *GenFile, svc *Service) {
 			g.P("	case *", msgReply.GoIdent, ":")
 			if msgReply != msgControlPingReply {
 				if retvalField := getRetvalField(msgReply); retvalField != nil {
-					s := fmt.Sprint("(m.", retvalField.GoName, ")")
+					s := fmt.Sprint("m.", retvalField.GoName)
 					if fieldType := getFieldType(g, retvalField); fieldType != "int32" {
-						s = fmt.Sprint("(int32(m.", retvalField.GoName, "))")
+						s = fmt.Sprint("int32(m.", retvalField.GoName, ")")
 					}
-					g.P("if err := ", govppApiPkg.Ident("RetvalToVPPApiError"), s, "; err != nil {")
+					g.P("if err := ", govppApiPkg.Ident("RetvalToRequestError"), "((*", rpc.MsgRequest.GoIdent, ")(nil), ", s, "); err != nil {")
 					if msgReply != msgControlPingReply {
 						g.P("	return nil, nil, err")
 					} else {
@@ -206,9 +206,9 @@ func genService(g *GenFile, svc *Service) {
 			g.P("if err != nil { return nil, err }")
 			if retvalField := getRetvalField(rpc.MsgReply); retvalField != nil {
 				if fieldType := getFieldType(g, retvalField); fieldType == "int32" {
-					g.P("return out, ", govppApiPkg.Ident("RetvalToVPPApiError"), "(out.", retvalField.GoName, ")")
+					g.P("return out, ", govppApiPkg.Ident("RetvalToRequestError"), "(in, out.", retvalField.GoName, ")")
 				} else {
-					g.P("return out, ", govppApiPkg.Ident("RetvalToVPPApiError"), "(int32(out.", retvalField.GoName, "))")
+					g.P("return out, ", govppApiPkg.Ident("RetvalToRequestError"), "(in, int32(out.", retvalField.GoName, "))")
 				}
 			} else {
 				g.P("return out, nil")
@@ -0,0 +1,82 @@
+//  Copyright (c) 2023 Cisco and/or its affiliates.
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at:
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package binapigen
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+
+	"go.fd.io/govpp/binapigen/vppapi"
+)
+
+func testRequest(name string, fields ...vppapi.Field) vppapi.Message {
+	return vppapi.Message{
+		Name: name,
+		CRC:  "0x00000001",
+		Fields: append([]vppapi.Field{
+			{Name: "_vl_msg_id", Type: "u16"},
+			{Name: "client_index", Type: "u32"},
+			{Name: "context", Type: "u32"},
+		}, fields...),
+	}
+}
+
+func testReply(name string, fields ...vppapi.Field) vppapi.Message {
+	return vppapi.Message{
+		Name: name,
+		CRC:  "0x00000002",
+		Fields: append([]vppapi.Field{
+			{Name: "_vl_msg_id", Type: "u16"},
+			{Name: "context", Type: "u32"},
+		}, fields...),
+	}
+}
+
+func TestGenerateRPCRequestErrors(t *testing.T) {
+	RegisterTestingT(t)
+
+	retval := vppapi.Field{Name: "retval", Type: "i32"}
+	apifile := &vppapi.File{
+		Name: "foo",
+		Path: "foo.api.json",
+		Messages: []vppapi.Message{
+			testRequest("control_ping"),
+			testReply("control_ping_reply", retval),
+			testRequest("foo_add"),
+			testReply("foo_add_reply", retval),
+			testRequest("foo_get"),
+			testReply("foo_get_reply", retval),
+			testReply("foo_details"),
+		},
+		Service: &vppapi.Service{
+			RPCs: []vppapi.RPC{
+				{Request: "control_ping", Reply: "control_ping_reply"},
+				{Request: "foo_add", Reply: "foo_add_reply"},
+				{Request: "foo_get", Reply: "foo_get_reply", Stream: true, StreamMsg: "foo_details"},
+			},
+		},
+	}
+	gen, err := New(Options{ImportPrefix: "example.com/binapi"}, &VppInput{ApiFiles: []*vppapi.File{apifile}})
+	Expect(err).ShouldNot(HaveOccurred())
+
+	g := GenerateRPC(gen, gen.Files[0])
+	Expect(g).ToNot(BeNil())
+	content, err := g.Content()
+	Expect(err).ShouldNot(HaveOccurred())
+	Expect(string(content)).To(ContainSubstring(`return out, api.RetvalToRequestError(in, out.Retval)`))
+	Expect(string(content)).To(ContainSubstring(`if err := api.RetvalToRequestError((*FooGet)(nil), m.Retval); err != nil {`))
+	Expect(string(content)).NotTo(ContainSubstring(`RetvalToVPPApiError`))
+}
@@ -0,0 +1,162 @@
+//  Copyright (c) 2023 Cisco and/or its affiliates.
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at:
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+// The vpp-forwarder exposes the local VPP binary API socket over TCP or TLS.
+// The connections are forwarded as they are, so the socketclient adapter
+// connecting to tcp:// or tls:// target speaks the same protocol as with
+// the local socket:
+//
+//	vpp-forwarder -listen :7879 -tls-cert server.crt -tls-key server.key -tls-client-ca ca.crt
+//
+// The forwarder refuses to start without TLS and client certificate
+// verification, unless the -insecure flag is given.
+//
+//	client := socketclient.NewVppClient("tls://vpp-host:7879")
+//	client.SetTLSConfig(tlsConfig)
+package main
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"log"
+	"net"
+	"os"
+	"sync"
+	"time"
+
+	"go.fd.io/govpp/adapter/socketclient"
+)
+
+var (
+	binapiSocket = flag.String("binapi-socket", socketclient.DefaultSocketName, "Path to VPP binapi socket")
+	listenAddr   = flag.String("listen", "localhost:7879", "Address on which the socket is exposed")
+	tlsCert      = flag.String("tls-cert", "", "Server certificate file")
+	tlsKey       = flag.String("tls-key", "", "Server private key file")
+	tlsClientCA  = flag.String("tls-client-ca", "", "CA certificate file for verifying required client certificates")
+	insecure     = flag.Bool("insecure", false, "Allow exposing the socket without TLS or without client certificate verification")
+)
+
+func main() {
+	flag.Parse()
+
+	listener, err := listen()
+	if err != nil {
+		log.Fatalln("listening failed:", err)
+	}
+	log.Printf("forwarding %s to %s", listener.Addr(), *binapiSocket)
+
+	serve(listener)
+}
+
+// serve accepts the connections until the listener is closed. Accept errors
+// (e.g. too many open files) are retried with increasing delay.
+func serve(listener net.Listener) {
+	var delay time.Duration
+	for {
+		conn, err := listener.Accept()
+		if errors.Is(err, net.ErrClosed) {
+			return
+		} else if err != nil {
+			if delay == 0 {
+				delay = 5 * time.Millisecond
+			} else if delay *= 2; delay > time.Second {
+				delay = time.Second
+			}
+			log.Printf("accepting connection failed: %v, retrying in %v", err, delay)
+			time.Sleep(delay)
+			continue
+		}
+		delay = 0
+		go forward(conn)
+	}
+}
+
+func listen() (net.Listener, error) {
+	if *tlsCert == "" && *tlsKey == "" {
+		if !*insecure {
+			return nil, errors.New("TLS is required, set -tls-cert, -tls-key and -tls-client-ca or allow plaintext with -insecure")
+		}
+		log.Printf("WARNING: TLS is disabled, the VPP API is exposed without authentication")
+		return net.Listen("tcp", *listenAddr)
+	}
+	if *tlsCert == "" || *tlsKey == "" {
+		return nil, errors.New("both -tls-cert and -tls-key must be set")
+	}
+	if *tlsClientCA == "" && !*insecure {
+		return nil, errors.New("client authentication is required, set -tls-client-ca or allow any client with -insecure")
+	}
+	cert, err := tls.LoadX509KeyPair(*tlsCert, *tlsKey)
+	if err != nil {
+		return nil, err
+	}
+	config := &tls.Config{
+		Certificates: []tls.Certificate{cert},
+		MinVersion:   tls.VersionTLS12,
+	}
+	if *tlsClientCA != "" {
+		caCert, err := os.ReadFile(*tlsClientCA)
+		if err != nil {
+			return nil, err
+		}
+		config.ClientCAs = x509.NewCertPool()
+		if !config.ClientCAs.AppendCertsFromPEM(caCert) {
+			return nil, fmt.Errorf("no CA certificate found in %s", *tlsClientCA)
+		}
+		config.ClientAuth = tls.RequireAndVerifyClientCert
+	} else {
+		log.Printf("WARNING: client certificates are not verified, the VPP API is exposed without authentication")
+	}
+	return tls.Listen("tcp", *listenAddr, config)
+}
+
+// forward copies the data between the remote connection and a new connection
+// to the VPP API socket until either of them is closed.
+func forward(remote net.Conn) {
+	defer remote.Close()
+
+	local, err := net.Dial("unix", *binapiSocket)
+	if err != nil {
+		log.Printf("connecting to %s for %s failed: %v", *binapiSocket, remote.RemoteAddr(), err)
+		return
+	}
+	defer local.Close()
+
+	log.Printf("client %s connected", remote.RemoteAddr())
+	defer log.Printf("client %s disconnected", remote.RemoteAddr())
+
+	var once sync.Once
+	closeBoth := func() {
+		once.Do(func() {
+			_ = remote.Close()
+			_ = local.Close()
+		})
+	}
+	var wg sync.WaitGroup
+	wg.Add(2)
+	go func() {
+		defer wg.Done()
+		defer closeBoth()
+		_, _ = io.Copy(local, remote)
+	}()
+	go func() {
+		defer wg.Done()
+		defer closeBoth()
+		_, _ = io.Copy(remote, local)
+	}()
+	wg.Wait()
+}
@@ -0,0 +1,54 @@
+//  Copyright (c) 2023 Cisco and/or its affiliates.
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at:
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package codec
+
+import (
+	"sync"
+)
+
+const (
+	defaultBufferSize = 256
+	// maxPooledBufferSize limits the size of buffers kept in the pool,
+	// so that a few large messages do not retain a lot of memory.
+	maxPooledBufferSize = 64 * 1024
+)
+
+var bufferPool = sync.Pool{
+	New: func() interface{} {
+		b := make([]byte, 0, defaultBufferSize)
+		return &b
+	},
+}
+
+// GetBuffer returns an empty buffer from the pool for encoding the messages
+// with EncodeMsgTo. The buffer should be returned by PutBuffer once the
+// encoded data is not used anymore.
+//
+//	buf := codec.GetBuffer()
+//	defer codec.PutBuffer(buf)
+//	*buf, err = codec.EncodeMsgTo((*buf)[:0], msg, msgID)
+func GetBuffer() *[]byte {
+	b := bufferPool.Get().(*[]byte)
+	*b = (*b)[:0]
+	return b
+}
+
+// PutBuffer returns the buffer to the pool.
+func PutBuffer(b *[]byte) {
+	if b == nil || cap(*b) > maxPooledBufferSize {
+		return
+	}
+	bufferPool.Put(b)
+}
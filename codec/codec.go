@@ -17,13 +17,31 @@ package codec
 import (
 	"bytes"
 	"encoding/binary"
+	"errors"
+	"fmt"
 	"math"
 )
 
+var (
+	// ErrShortBuffer is returned when the data ends before the decoded value.
+	ErrShortBuffer = errors.New("short buffer")
+	// ErrArrayTooLong is returned when the length of variable-length array
+	// exceeds the remaining data.
+	ErrArrayTooLong = errors.New("array length exceeds remaining data")
+	// ErrInvalidString is returned when the length of variable-length string
+	// exceeds the remaining data.
+	ErrInvalidString = errors.New("string length exceeds remaining data")
+)
+
 // Buffer provides buffer for encoding and decoding data on wire.
+//
+// The decoding methods check the bounds of the data. When the data is too
+// short, the decoding error is recorded, the method returns zero value and
+// so do all the following decoding calls. The error is returned by Err.
 type Buffer struct {
 	buf []byte
 	pos int
+	err error
 }
 
 // NewBuffer creates new buffer using b as data.
@@ -38,6 +56,38 @@ func (b *Buffer) Bytes() []byte {
 	return b.buf[:b.pos]
 }
 
+// Err returns the first error occurred during decoding.
+func (b *Buffer) Err() error {
+	return b.err
+}
+
+// decode reports whether n bytes can be decoded, otherwise it records the error.
+func (b *Buffer) decode(n int) bool {
+	if b.err != nil {
+		return false
+	}
+	if n < 0 || n > len(b.buf)-b.pos {
+		b.err = fmt.Errorf("%w: need %d bytes at offset %d, have %d", ErrShortBuffer, n, b.pos, len(b.buf)-b.pos)
+		return false
+	}
+	return true
+}
+
+// CheckArrayLen returns the length of variable-length array if the remaining
+// data can contain length elements of at least elemSize bytes, otherwise it
+// records the error and returns 0. It is supposed to be called before
+// allocating the decoded array.
+func (b *Buffer) CheckArrayLen(length int, elemSize int) int {
+	if b.err != nil {
+		return 0
+	}
+	if length < 0 || (elemSize > 0 && length > (len(b.buf)-b.pos)/elemSize) {
+		b.err = fmt.Errorf("%w: %d elements at offset %d, have %d bytes", ErrArrayTooLong, length, b.pos, len(b.buf)-b.pos)
+		return 0
+	}
+	return length
+}
+
 func (b *Buffer) EncodeBytes(v []byte, length int) {
 	if length == 0 {
 		length = len(v)
@@ -47,6 +97,9 @@ func (b *Buffer) EncodeBytes(v []byte, length int) {
 }
 
 func (b *Buffer) DecodeBytes(length int) []byte {
+	if !b.decode(length) {
+		return nil
+	}
 	v := b.buf[b.pos : b.pos+length]
 	b.pos += length
 	return v
@@ -62,6 +115,9 @@ func (b *Buffer) EncodeBool(v bool) {
 }
 
 func (b *Buffer) DecodeBool() bool {
+	if !b.decode(1) {
+		return false
+	}
 	v := b.buf[b.pos] != 0
 	b.pos += 1
 	return v
@@ -73,6 +129,9 @@ func (b *Buffer) EncodeUint8(v uint8) {
 }
 
 func (b *Buffer) DecodeUint8() uint8 {
+	if !b.decode(1) {
+		return 0
+	}
 	v := uint8(b.buf[b.pos])
 	b.pos += 1
 	return v
@@ -84,6 +143,9 @@ func (b *Buffer) EncodeUint16(v uint16) {
 }
 
 func (b *Buffer) DecodeUint16() uint16 {
+	if !b.decode(2) {
+		return 0
+	}
 	v := binary.BigEndian.Uint16(b.buf[b.pos : b.pos+2])
 	b.pos += 2
 	return v
@@ -95,6 +157,9 @@ func (b *Buffer) EncodeUint32(v uint32) {
 }
 
 func (b *Buffer) DecodeUint32() uint32 {
+	if !b.decode(4) {
+		return 0
+	}
 	v := binary.BigEndian.Uint32(b.buf[b.pos : b.pos+4])
 	b.pos += 4
 	return v
@@ -106,6 +171,9 @@ func (b *Buffer) EncodeUint64(v uint64) {
 }
 
 func (b *Buffer) DecodeUint64() uint64 {
+	if !b.decode(8) {
+		return 0
+	}
 	v := binary.BigEndian.Uint64(b.buf[b.pos : b.pos+8])
 	b.pos += 8
 	return v
@@ -117,6 +185,9 @@ func (b *Buffer) EncodeInt8(v int8) {
 }
 
 func (b *Buffer) DecodeInt8() int8 {
+	if !b.decode(1) {
+		return 0
+	}
 	v := int8(b.buf[b.pos])
 	b.pos += 1
 	return v
@@ -128,6 +199,9 @@ func (b *Buffer) EncodeInt16(v int16) {
 }
 
 func (b *Buffer) DecodeInt16() int16 {
+	if !b.decode(2) {
+		return 0
+	}
 	v := int16(binary.BigEndian.Uint16(b.buf[b.pos : b.pos+2]))
 	b.pos += 2
 	return v
@@ -139,6 +213,9 @@ func (b *Buffer) EncodeInt32(v int32) {
 }
 
 func (b *Buffer) DecodeInt32() int32 {
+	if !b.decode(4) {
+		return 0
+	}
 	v := int32(binary.BigEndian.Uint32(b.buf[b.pos : b.pos+4]))
 	b.pos += 4
 	return v
@@ -150,6 +227,9 @@ func (b *Buffer) EncodeInt64(v int64) {
 }
 
 func (b *Buffer) DecodeInt64() int64 {
+	if !b.decode(8) {
+		return 0
+	}
 	v := int64(binary.BigEndian.Uint64(b.buf[b.pos : b.pos+8]))
 	b.pos += 8
 	return v
@@ -161,6 +241,9 @@ func (b *Buffer) EncodeFloat64(v float64) {
 }
 
 func (b *Buffer) DecodeFloat64() float64 {
+	if !b.decode(8) {
+		return 0
+	}
 	v := math.Float64frombits(binary.LittleEndian.Uint64(b.buf[b.pos : b.pos+8]))
 	b.pos += 8
 	return v
@@ -179,8 +262,18 @@ func (b *Buffer) DecodeString(length int) string {
 	var v []byte
 	if length == 0 {
 		length = int(b.DecodeUint32())
+		if b.err != nil {
+			return ""
+		}
+		if length > len(b.buf)-b.pos {
+			b.err = fmt.Errorf("%w: %d bytes at offset %d, have %d", ErrInvalidString, length, b.pos, len(b.buf)-b.pos)
+			return ""
+		}
 		v = b.buf[b.pos : b.pos+length]
 	} else {
+		if !b.decode(length) {
+			return ""
+		}
 		v = b.buf[b.pos : b.pos+length]
 		if nul := bytes.Index(v, []byte{0x00}); nul >= 0 {
 			v = v[:nul]
@@ -0,0 +1,67 @@
+//  Copyright (c) 2023 Cisco and/or its affiliates.
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at:
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package codec_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+
+	interfaces "go.fd.io/govpp/binapi/interface"
+	"go.fd.io/govpp/binapi/memclnt"
+	"go.fd.io/govpp/codec"
+)
+
+func TestBufferDecodeErrors(t *testing.T) {
+	RegisterTestingT(t)
+
+	buf := codec.NewBuffer([]byte{0x01, 0x02, 0x03})
+	Expect(buf.DecodeUint16()).To(BeEquivalentTo(0x0102))
+	Expect(buf.Err()).ShouldNot(HaveOccurred())
+	Expect(buf.DecodeUint32()).To(BeZero())
+	Expect(buf.Err()).To(MatchError(codec.ErrShortBuffer))
+	// following calls fail even if the data would be sufficient
+	Expect(buf.DecodeUint8()).To(BeZero())
+
+	buf = codec.NewBuffer([]byte{0x00, 0x00, 0x00, 0x10, 'a', 'b'})
+	Expect(buf.DecodeString(0)).To(BeEmpty())
+	Expect(buf.Err()).To(MatchError(codec.ErrInvalidString))
+
+	buf = codec.NewBuffer(make([]byte, 8))
+	Expect(buf.CheckArrayLen(2, 4)).To(Equal(2))
+	Expect(buf.Err()).ShouldNot(HaveOccurred())
+	Expect(buf.CheckArrayLen(3, 4)).To(BeZero())
+	Expect(buf.Err()).To(MatchError(codec.ErrArrayTooLong))
+}
+
+func TestUnmarshalErrors(t *testing.T) {
+	RegisterTestingT(t)
+
+	data, err := (&interfaces.SwInterfaceDetails{InterfaceName: "loop0"}).Marshal(nil)
+	Expect(err).ShouldNot(HaveOccurred())
+	for _, n := range []int{0, 10, len(data) - 1} {
+		err := new(interfaces.SwInterfaceDetails).Unmarshal(data[:n])
+		Expect(err).To(MatchError(codec.ErrShortBuffer), "unmarshaling %d bytes", n)
+	}
+
+	// count of the message table entries exceeding the data
+	data, err = (&memclnt.SockclntCreateReply{
+		MessageTable: []memclnt.MessageTableEntry{{Index: 1, Name: "control_ping_51077d14"}},
+	}).Marshal(nil)
+	Expect(err).ShouldNot(HaveOccurred())
+	data[8], data[9] = 0xff, 0xff
+	err = new(memclnt.SockclntCreateReply).Unmarshal(data)
+	Expect(err).To(MatchError(codec.ErrArrayTooLong))
+}
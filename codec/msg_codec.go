@@ -27,6 +27,9 @@ var DefaultCodec = new(MsgCodec)
 func EncodeMsg(msg api.Message, msgID uint16) (data []byte, err error) {
 	return DefaultCodec.EncodeMsg(msg, msgID)
 }
+func EncodeMsgTo(buf []byte, msg api.Message, msgID uint16) (data []byte, err error) {
+	return DefaultCodec.EncodeMsgTo(buf, msg, msgID)
+}
 func DecodeMsg(data []byte, msg api.Message) (err error) {
 	return DefaultCodec.DecodeMsg(data, msg)
 }
@@ -38,7 +41,14 @@ func DecodeMsgContext(data []byte, msgType api.MessageType) (context uint32, err
 // binary format as accepted by VPP.
 type MsgCodec struct{}
 
-func (*MsgCodec) EncodeMsg(msg api.Message, msgID uint16) (data []byte, err error) {
+func (c *MsgCodec) EncodeMsg(msg api.Message, msgID uint16) (data []byte, err error) {
+	return c.EncodeMsgTo(nil, msg, msgID)
+}
+
+// EncodeMsgTo encodes the message appending it to buf and returns the extended
+// buffer. It does not allocate if buf has enough capacity, which allows reusing
+// the buffers, e.g. the ones from GetBuffer.
+func (*MsgCodec) EncodeMsgTo(buf []byte, msg api.Message, msgID uint16) (data []byte, err error) {
 	if msg == nil {
 		return nil, errors.New("nil message passed in")
 	}
@@ -62,17 +72,28 @@ func (*MsgCodec) EncodeMsg(msg api.Message, msgID uint16) (data []byte, err erro
 	size := marshaller.Size()
 	offset := getOffset(msg)
 
+	start := len(buf)
+	end := start + offset + size
+	if cap(buf) < end {
+		grown := make([]byte, start, end)
+		copy(grown, buf)
+		buf = grown
+	}
+	b := buf[start:end]
+	// the reused buffer may contain data of previous message
+	for i := range b {
+		b[i] = 0
+	}
+
 	// encode msg ID
-	b := make([]byte, size+offset)
 	b[0] = byte(msgID >> 8)
 	b[1] = byte(msgID)
 
-	data, err = marshaller.Marshal(b[offset:])
-	if err != nil {
+	if _, err := marshaller.Marshal(b[offset:]); err != nil {
 		return nil, err
 	}
 
-	return b[0:len(b):len(b)], nil
+	return buf[:end], nil
 }
 
 func (*MsgCodec) DecodeMsg(data []byte, msg api.Message) (err error) {
@@ -80,7 +101,8 @@ func (*MsgCodec) DecodeMsg(data []byte, msg api.Message) (err error) {
 		return errors.New("nil message passed in")
 	}
 
-	// try to recover panic which might possibly occur
+	// generated messages check bounds, but custom unmarshalers and the
+	// reflection-based wrapper may still panic on malformed data
 	defer func() {
 		if r := recover(); r != nil {
 			var ok bool
@@ -97,10 +119,14 @@ func (*MsgCodec) DecodeMsg(data []byte, msg api.Message) (err error) {
 	}
 
 	offset := getOffset(msg)
+	if len(data) < offset {
+		return fmt.Errorf("decoding message %s failed: %w: header needs %d bytes, have %d",
+			msg.GetMessageName(), ErrShortBuffer, offset, len(data))
+	}
 
 	err = marshaller.Unmarshal(data[offset:])
 	if err != nil {
-		return err
+		return fmt.Errorf("decoding message %s failed: %w", msg.GetMessageName(), err)
 	}
 
 	return nil
@@ -109,8 +135,14 @@ func (*MsgCodec) DecodeMsg(data []byte, msg api.Message) (err error) {
 func (*MsgCodec) DecodeMsgContext(data []byte, msgType api.MessageType) (context uint32, err error) {
 	switch msgType {
 	case api.RequestMessage:
+		if len(data) < 10 {
+			return 0, fmt.Errorf("decoding message context failed: %w", ErrShortBuffer)
+		}
 		return binary.BigEndian.Uint32(data[6:10]), nil
 	case api.ReplyMessage:
+		if len(data) < 6 {
+			return 0, fmt.Errorf("decoding message context failed: %w", ErrShortBuffer)
+		}
 		return binary.BigEndian.Uint32(data[2:6]), nil
 	}
 
@@ -100,3 +100,93 @@ func TestEncodePanic(t *testing.T) {
 	}
 	t.Logf("err: %v", err)
 }
+
+type panickingMsg struct {
+	MyMsg
+}
+
+func (*panickingMsg) Unmarshal([]byte) error {
+	panic("index out of range")
+}
+
+func TestDecodePanic(t *testing.T) {
+	c := codec.DefaultCodec
+
+	err := c.DecodeMsg([]byte{0x00, 0x01, 0x02}, new(panickingMsg))
+	if err == nil {
+		t.Fatalf("expected non-nil error, got: %v", err)
+	}
+	t.Logf("err: %v", err)
+}
+
+func TestEncodeMsgTo(t *testing.T) {
+	c := codec.DefaultCodec
+	msg := &ip.IPRouteAddDel{IsAdd: true, Route: ip.IPRoute{TableID: 5}}
+
+	expData, err := c.EncodeMsg(msg, 743)
+	if err != nil {
+		t.Fatalf("expected nil error, got: %v", err)
+	}
+
+	// appends to the existing data
+	data, err := c.EncodeMsgTo([]byte{0xAA}, msg, 743)
+	if err != nil {
+		t.Fatalf("expected nil error, got: %v", err)
+	}
+	if !bytes.Equal(data, append([]byte{0xAA}, expData...)) {
+		t.Fatalf("expected data:\n% 0X, got:\n% 0X", expData, data[1:])
+	}
+
+	// reused buffer is cleared
+	buf := bytes.Repeat([]byte{0xFF}, 2*len(expData))
+	data, err = c.EncodeMsgTo(buf[:0], msg, 743)
+	if err != nil {
+		t.Fatalf("expected nil error, got: %v", err)
+	}
+	if !bytes.Equal(data, expData) {
+		t.Fatalf("expected data:\n% 0X, got:\n% 0X", expData, data)
+	}
+	if &data[0] != &buf[0] {
+		t.Fatalf("expected data encoded into the supplied buffer")
+	}
+}
+
+func TestEncodeMsgToAllocs(t *testing.T) {
+	msg := &ip.IPRouteAddDel{IsAdd: true, Route: ip.IPRoute{TableID: 5}}
+
+	buf := make([]byte, 0, 256)
+	allocs := testing.AllocsPerRun(100, func() {
+		if _, err := codec.EncodeMsgTo(buf[:0], msg, 743); err != nil {
+			t.Fatalf("expected nil error, got: %v", err)
+		}
+	})
+	if allocs > 0 {
+		t.Fatalf("expected no allocations, got %v", allocs)
+	}
+}
+
+func BenchmarkEncodeMsg(b *testing.B) {
+	msg := &ip.IPRouteAddDel{IsAdd: true, Route: ip.IPRoute{TableID: 5}}
+
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		if _, err := codec.EncodeMsg(msg, 743); err != nil {
+			b.Fatalf("expected nil error, got: %v", err)
+		}
+	}
+}
+
+func BenchmarkEncodeMsgTo(b *testing.B) {
+	msg := &ip.IPRouteAddDel{IsAdd: true, Route: ip.IPRoute{TableID: 5}}
+
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		buf := codec.GetBuffer()
+		data, err := codec.EncodeMsgTo((*buf)[:0], msg, 743)
+		if err != nil {
+			b.Fatalf("expected nil error, got: %v", err)
+		}
+		*buf = data
+		codec.PutBuffer(buf)
+	}
+}
@@ -0,0 +1,42 @@
+//  Copyright (c) 2023 Cisco and/or its affiliates.
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at:
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+// Package textcodec converts VPP API messages to and from JSON and YAML.
+//
+// The message is encoded as a self-describing document with the message name
+// and CRC, so it can be decoded without knowing its type in advance:
+//
+//	{
+//	  "name": "sw_interface_details",
+//	  "crc": "6c221fc7",
+//	  "context": 5,
+//	  "message": {
+//	    "sw_if_index": 1,
+//	    "flags": "IF_STATUS_API_FLAG_ADMIN_UP|IF_STATUS_API_FLAG_LINK_UP",
+//	    "l2_address": "de:ad:00:00:00:01",
+//	    ...
+//	  }
+//	}
+//
+// The fields use the names from the VPP API, enums are encoded as names (flags
+// joined with "|") and IP and MAC addresses in their text form. When decoding,
+// the enums are accepted as numbers as well. The names are decoded only for the
+// enum values from 0 to 1023, the negative values from -1024 to -1 and single
+// bits (flags), the names of other values must be given as numbers. The message
+// type is looked up in the registry of the imported binapi packages.
+//
+// The wire frames exchanged with VPP are converted using DecodeFrame and
+// EncodeFrame, which resolve the message IDs using the MessageResolver, e.g.
+// the connected adapter.
+package textcodec
@@ -0,0 +1,242 @@
+//  Copyright (c) 2023 Cisco and/or its affiliates.
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at:
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package textcodec
+
+import (
+	"bytes"
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sync"
+
+	"gopkg.in/yaml.v2"
+
+	"go.fd.io/govpp/api"
+	"go.fd.io/govpp/codec"
+)
+
+// Format is the text format of the messages.
+type Format string
+
+const (
+	FormatJSON Format = "json"
+	FormatYAML Format = "yaml"
+)
+
+// MessageResolver resolves the message IDs used in the wire frames,
+// e.g. the connected adapter.VppAPI.
+type MessageResolver interface {
+	GetMsgID(msgName string, msgCrc string) (uint16, error)
+}
+
+// Document is the message with its identification in the text form.
+type Document struct {
+	Name    string `json:"name" yaml:"name"`
+	CRC     string `json:"crc" yaml:"crc"`
+	ID      uint16 `json:"id,omitempty" yaml:"id,omitempty"`
+	Context uint32 `json:"context,omitempty" yaml:"context,omitempty"`
+	Message api.Message
+}
+
+// Codec converts the messages between the binary and text forms. The message
+// types are looked up in the registry of the imported binapi packages by the
+// message name and CRC.
+type Codec struct {
+	resolver MessageResolver
+
+	mu   sync.Mutex
+	byID map[uint16]api.Message
+}
+
+// NewCodec returns the codec. The resolver is required only for the wire frames,
+// it can be nil otherwise. The message IDs are assumed to not change during
+// the lifetime of the codec, so a new codec should be created after reconnecting.
+func NewCodec(resolver MessageResolver) *Codec {
+	return &Codec{resolver: resolver}
+}
+
+// Marshal returns the message in the given format.
+func (c *Codec) Marshal(format Format, msg api.Message) ([]byte, error) {
+	return c.MarshalDocument(format, &Document{Message: msg})
+}
+
+// Unmarshal returns the message decoded from the given format.
+func (c *Codec) Unmarshal(format Format, data []byte) (api.Message, error) {
+	doc, err := c.UnmarshalDocument(format, data)
+	if err != nil {
+		return nil, err
+	}
+	return doc.Message, nil
+}
+
+// MarshalDocument returns the document in the given format. The name and CRC
+// are taken from the message.
+func (c *Codec) MarshalDocument(format Format, doc *Document) ([]byte, error) {
+	if doc.Message == nil {
+		return nil, errors.New("nil message passed in")
+	}
+	fields, err := messageValue(doc.Message)
+	if err != nil {
+		return nil, fmt.Errorf("encoding message %s failed: %w", doc.Message.GetMessageName(), err)
+	}
+	out := object{
+		{Key: "name", Value: doc.Message.GetMessageName()},
+		{Key: "crc", Value: doc.Message.GetCrcString()},
+	}
+	if doc.ID != 0 {
+		out = append(out, field{Key: "id", Value: doc.ID})
+	}
+	if doc.Context != 0 {
+		out = append(out, field{Key: "context", Value: doc.Context})
+	}
+	out = append(out, field{Key: "message", Value: fields})
+
+	switch format {
+	case FormatJSON:
+		return json.Marshal(out)
+	case FormatYAML:
+		return yaml.Marshal(toYAML(out))
+	}
+	return nil, fmt.Errorf("unknown format: %q", format)
+}
+
+type rawDocument struct {
+	Name    string      `json:"name" yaml:"name"`
+	CRC     string      `json:"crc" yaml:"crc"`
+	ID      uint16      `json:"id" yaml:"id"`
+	Context uint32      `json:"context" yaml:"context"`
+	Message interface{} `json:"message" yaml:"message"`
+}
+
+// UnmarshalDocument returns the document decoded from the given format. If
+// the CRC is missing, the message is looked up only by its name.
+func (c *Codec) UnmarshalDocument(format Format, data []byte) (*Document, error) {
+	var raw rawDocument
+	switch format {
+	case FormatJSON:
+		dec := json.NewDecoder(bytes.NewReader(data))
+		dec.UseNumber()
+		if err := dec.Decode(&raw); err != nil {
+			return nil, err
+		}
+	case FormatYAML:
+		if err := yaml.Unmarshal(data, &raw); err != nil {
+			return nil, err
+		}
+	default:
+		return nil, fmt.Errorf("unknown format: %q", format)
+	}
+	if raw.Name == "" {
+		return nil, errors.New("missing message name")
+	}
+
+	msgType, err := api.LookupMessage(raw.Name, raw.CRC)
+	if err != nil {
+		return nil, err
+	}
+	msg := api.NewMessage(msgType)
+	if err := setMessageValue(msg, raw.Message); err != nil {
+		return nil, fmt.Errorf("decoding message %s failed: %w", raw.Name, err)
+	}
+	return &Document{
+		Name:    raw.Name,
+		CRC:     msgType.GetCrcString(),
+		ID:      raw.ID,
+		Context: raw.Context,
+		Message: msg,
+	}, nil
+}
+
+// DecodeFrame converts the wire frame (starting with the message ID) into
+// the given format. The message is identified by its ID using the resolver.
+func (c *Codec) DecodeFrame(format Format, frame []byte) ([]byte, error) {
+	if len(frame) < 2 {
+		return nil, fmt.Errorf("decoding frame failed: %w", codec.ErrShortBuffer)
+	}
+	msgID := binary.BigEndian.Uint16(frame)
+	msgType, err := c.messageByID(msgID)
+	if err != nil {
+		return nil, err
+	}
+	msg := api.NewMessage(msgType)
+	if err := codec.DefaultCodec.DecodeMsg(frame, msg); err != nil {
+		return nil, err
+	}
+	context, err := codec.DefaultCodec.DecodeMsgContext(frame, msg.GetMessageType())
+	if err != nil {
+		return nil, err
+	}
+	return c.MarshalDocument(format, &Document{ID: msgID, Context: context, Message: msg})
+}
+
+// EncodeFrame converts the message in the given format into the wire frame.
+// The message ID is resolved using the resolver, the ID in the document is ignored.
+func (c *Codec) EncodeFrame(format Format, data []byte) ([]byte, error) {
+	if c.resolver == nil {
+		return nil, errors.New("message resolver not set")
+	}
+	doc, err := c.UnmarshalDocument(format, data)
+	if err != nil {
+		return nil, err
+	}
+	msgID, err := c.resolver.GetMsgID(doc.Message.GetMessageName(), doc.Message.GetCrcString())
+	if err != nil {
+		return nil, err
+	}
+	frame, err := codec.DefaultCodec.EncodeMsg(doc.Message, msgID)
+	if err != nil {
+		return nil, err
+	}
+	switch doc.Message.GetMessageType() {
+	case api.RequestMessage:
+		binary.BigEndian.PutUint32(frame[6:10], doc.Context)
+	case api.ReplyMessage:
+		binary.BigEndian.PutUint32(frame[2:6], doc.Context)
+	}
+	return frame, nil
+}
+
+// messageByID returns the registered message with the ID known to the resolver.
+// The table of IDs is built on the first use.
+func (c *Codec) messageByID(msgID uint16) (api.Message, error) {
+	if c.resolver == nil {
+		return nil, errors.New("message resolver not set")
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.byID == nil {
+		c.byID = make(map[uint16]api.Message)
+		for _, msgs := range api.GetRegisteredMessages() {
+			for nameCrc := range msgs {
+				msg, err := api.LookupMessage(api.SplitNameCrc(nameCrc))
+				if err != nil {
+					continue
+				}
+				id, err := c.resolver.GetMsgID(msg.GetMessageName(), msg.GetCrcString())
+				if err != nil {
+					continue
+				}
+				c.byID[id] = msg
+			}
+		}
+	}
+	msg, ok := c.byID[msgID]
+	if !ok {
+		return nil, fmt.Errorf("unknown message ID: %d", msgID)
+	}
+	return msg, nil
+}
@@ -0,0 +1,144 @@
+//  Copyright (c) 2023 Cisco and/or its affiliates.
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at:
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package textcodec_test
+
+import (
+	"fmt"
+	"testing"
+
+	. "github.com/onsi/gomega"
+
+	"go.fd.io/govpp/api"
+	"go.fd.io/govpp/binapi/ethernet_types"
+	interfaces "go.fd.io/govpp/binapi/interface"
+	"go.fd.io/govpp/binapi/interface_types"
+	"go.fd.io/govpp/binapi/ip_types"
+	"go.fd.io/govpp/codec"
+	"go.fd.io/govpp/codec/textcodec"
+)
+
+type resolver map[string]uint16
+
+func (r resolver) GetMsgID(msgName string, msgCrc string) (uint16, error) {
+	if id, ok := r[msgName+"_"+msgCrc]; ok {
+		return id, nil
+	}
+	return 0, fmt.Errorf("unknown message %s_%s", msgName, msgCrc)
+}
+
+func testDetails() *interfaces.SwInterfaceDetails {
+	mac, _ := ethernet_types.ParseMacAddress("de:ad:00:00:00:01")
+	return &interfaces.SwInterfaceDetails{
+		SwIfIndex:     1,
+		L2Address:     mac,
+		Flags:         interface_types.IF_STATUS_API_FLAG_ADMIN_UP | interface_types.IF_STATUS_API_FLAG_LINK_UP,
+		Type:          interface_types.IF_API_TYPE_HARDWARE,
+		LinkMtu:       9000,
+		InterfaceName: "loop0",
+	}
+}
+
+func TestRoundTrip(t *testing.T) {
+	RegisterTestingT(t)
+
+	c := textcodec.NewCodec(nil)
+	addr, _ := ip_types.ParseAddressWithPrefix("10.10.0.1/24")
+	for _, msg := range []api.Message{
+		testDetails(),
+		&interfaces.SwInterfaceAddDelAddress{SwIfIndex: 1, IsAdd: true, Prefix: addr},
+		&interfaces.SwInterfaceSetFlags{SwIfIndex: 2, Flags: 0x8000},
+	} {
+		for _, format := range []textcodec.Format{textcodec.FormatJSON, textcodec.FormatYAML} {
+			data, err := c.Marshal(format, msg)
+			Expect(err).ShouldNot(HaveOccurred(), "%s %s", format, msg.GetMessageName())
+			decoded, err := c.Unmarshal(format, data)
+			Expect(err).ShouldNot(HaveOccurred(), "%s %s:\n%s", format, msg.GetMessageName(), data)
+			Expect(decoded).To(Equal(msg), "%s", format)
+		}
+	}
+}
+
+func TestMarshalText(t *testing.T) {
+	RegisterTestingT(t)
+
+	c := textcodec.NewCodec(nil)
+	data, err := c.Marshal(textcodec.FormatJSON, testDetails())
+	Expect(err).ShouldNot(HaveOccurred())
+	Expect(string(data)).To(And(
+		HavePrefix(`{"name":"sw_interface_details","crc":"6c221fc7","message":{"sw_if_index":1,`),
+		ContainSubstring(`"flags":"IF_STATUS_API_FLAG_ADMIN_UP|IF_STATUS_API_FLAG_LINK_UP"`),
+		ContainSubstring(`"type":"IF_API_TYPE_HARDWARE"`),
+		ContainSubstring(`"l2_address":"de:ad:00:00:00:01"`),
+		ContainSubstring(`"interface_name":"loop0"`),
+	))
+
+	data, err = c.Marshal(textcodec.FormatYAML, testDetails())
+	Expect(err).ShouldNot(HaveOccurred())
+	Expect(string(data)).To(HavePrefix("name: sw_interface_details\ncrc: 6c221fc7\nmessage:\n  sw_if_index: 1\n"))
+}
+
+func TestUnmarshal(t *testing.T) {
+	RegisterTestingT(t)
+
+	c := textcodec.NewCodec(nil)
+	msg, err := c.Unmarshal(textcodec.FormatYAML, []byte(`
+name: sw_interface_add_del_address
+message:
+  sw_if_index: 3
+  is_add: true
+  prefix: 2001:db8::1/64
+`))
+	Expect(err).ShouldNot(HaveOccurred())
+	addr, _ := ip_types.ParseAddressWithPrefix("2001:db8::1/64")
+	Expect(msg).To(Equal(&interfaces.SwInterfaceAddDelAddress{SwIfIndex: 3, IsAdd: true, Prefix: addr}))
+
+	// enums as numbers
+	msg, err = c.Unmarshal(textcodec.FormatJSON, []byte(`{"name":"sw_interface_set_flags","crc":"f5aec1b8","message":{"flags":3}}`))
+	Expect(err).ShouldNot(HaveOccurred())
+	Expect(msg.(*interfaces.SwInterfaceSetFlags).Flags).To(BeEquivalentTo(3))
+
+	for _, data := range []string{
+		`{"name":"unknown_message","message":{}}`,
+		`{"name":"sw_interface_set_flags","crc":"00000000","message":{}}`,
+		`{"name":"sw_interface_set_flags","message":{"flags":"UNKNOWN_FLAG"}}`,
+		`{"name":"sw_interface_set_flags","message":{"sw_if_index":"x"}}`,
+	} {
+		_, err := c.Unmarshal(textcodec.FormatJSON, []byte(data))
+		Expect(err).To(HaveOccurred(), data)
+	}
+}
+
+func TestFrames(t *testing.T) {
+	RegisterTestingT(t)
+
+	details := testDetails()
+	c := textcodec.NewCodec(resolver{
+		details.GetMessageName() + "_" + details.GetCrcString(): 201,
+	})
+	frame, err := codec.EncodeMsg(details, 201)
+	Expect(err).ShouldNot(HaveOccurred())
+	copy(frame[2:6], []byte{0, 0, 0, 5})
+
+	data, err := c.DecodeFrame(textcodec.FormatJSON, frame)
+	Expect(err).ShouldNot(HaveOccurred())
+	Expect(string(data)).To(HavePrefix(`{"name":"sw_interface_details","crc":"6c221fc7","id":201,"context":5,`))
+
+	encoded, err := c.EncodeFrame(textcodec.FormatJSON, data)
+	Expect(err).ShouldNot(HaveOccurred())
+	Expect(encoded).To(Equal(frame))
+
+	_, err = c.DecodeFrame(textcodec.FormatJSON, []byte{0, 1, 0, 0, 0, 0})
+	Expect(err).To(HaveOccurred(), "unknown message ID")
+}
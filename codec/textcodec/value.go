@@ -0,0 +1,475 @@
+//  Copyright (c) 2023 Cisco and/or its affiliates.
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at:
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package textcodec
+
+import (
+	"bytes"
+	"encoding"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+
+	"gopkg.in/yaml.v2"
+
+	"go.fd.io/govpp/api"
+)
+
+var (
+	textMarshalerType   = reflect.TypeOf((*encoding.TextMarshaler)(nil)).Elem()
+	textUnmarshalerType = reflect.TypeOf((*encoding.TextUnmarshaler)(nil)).Elem()
+	stringerType        = reflect.TypeOf((*fmt.Stringer)(nil)).Elem()
+)
+
+// object is a JSON object keeping the order of the fields.
+type object []field
+
+type field struct {
+	Key   string
+	Value interface{}
+}
+
+func (o object) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	buf.WriteByte('{')
+	for i, f := range o {
+		if i > 0 {
+			buf.WriteByte(',')
+		}
+		key, err := json.Marshal(f.Key)
+		if err != nil {
+			return nil, err
+		}
+		buf.Write(key)
+		buf.WriteByte(':')
+		value, err := json.Marshal(f.Value)
+		if err != nil {
+			return nil, err
+		}
+		buf.Write(value)
+	}
+	buf.WriteByte('}')
+	return buf.Bytes(), nil
+}
+
+// toYAML converts the objects into YAML map slices keeping the order of the fields.
+func toYAML(v interface{}) interface{} {
+	switch v := v.(type) {
+	case object:
+		m := make(yaml.MapSlice, len(v))
+		for i, f := range v {
+			m[i] = yaml.MapItem{Key: f.Key, Value: toYAML(f.Value)}
+		}
+		return m
+	case []interface{}:
+		for i := range v {
+			v[i] = toYAML(v[i])
+		}
+	}
+	return v
+}
+
+// messageValue converts the message into a tree of JSON values. The messages
+// defined at runtime, which encode themselves to JSON, are supported as well.
+func messageValue(msg api.Message) (interface{}, error) {
+	if m, ok := msg.(json.Marshaler); ok {
+		data, err := m.MarshalJSON()
+		if err != nil {
+			return nil, err
+		}
+		dec := json.NewDecoder(bytes.NewReader(data))
+		dec.UseNumber()
+		return decodeOrdered(dec)
+	}
+	return toValue(reflect.Indirect(reflect.ValueOf(msg)))
+}
+
+// setMessageValue sets the message from the tree of values decoded from JSON or YAML.
+func setMessageValue(msg api.Message, value interface{}) error {
+	if m, ok := msg.(json.Unmarshaler); ok {
+		data, err := json.Marshal(toJSON(value))
+		if err != nil {
+			return err
+		}
+		return m.UnmarshalJSON(data)
+	}
+	return fromValue(reflect.ValueOf(msg).Elem(), value)
+}
+
+// decodeOrdered decodes the JSON value keeping the order of the object fields.
+func decodeOrdered(dec *json.Decoder) (interface{}, error) {
+	tok, err := dec.Token()
+	if err != nil {
+		return nil, err
+	}
+	switch tok {
+	case json.Delim('{'):
+		obj := object{}
+		for dec.More() {
+			key, err := dec.Token()
+			if err != nil {
+				return nil, err
+			}
+			value, err := decodeOrdered(dec)
+			if err != nil {
+				return nil, err
+			}
+			obj = append(obj, field{Key: key.(string), Value: value})
+		}
+		_, err = dec.Token()
+		return obj, err
+	case json.Delim('['):
+		list := []interface{}{}
+		for dec.More() {
+			value, err := decodeOrdered(dec)
+			if err != nil {
+				return nil, err
+			}
+			list = append(list, value)
+		}
+		_, err = dec.Token()
+		return list, err
+	}
+	return tok, nil
+}
+
+// toJSON converts the maps decoded from YAML to maps with string keys.
+func toJSON(value interface{}) interface{} {
+	switch v := value.(type) {
+	case map[interface{}]interface{}:
+		m, _ := toMap(v)
+		return toJSON(m)
+	case map[string]interface{}:
+		for key, elem := range v {
+			v[key] = toJSON(elem)
+		}
+	case []interface{}:
+		for i := range v {
+			v[i] = toJSON(v[i])
+		}
+	}
+	return value
+}
+
+// fieldName returns the name of the field in VPP API, which is used as the key.
+// The fields excluded from JSON (e.g. array lengths) are skipped.
+func fieldName(f reflect.StructField) (string, bool) {
+	if f.PkgPath != "" || f.Tag.Get("json") == "-" {
+		return "", false
+	}
+	for _, opt := range strings.Split(f.Tag.Get("binapi"), ",") {
+		if strings.HasPrefix(opt, "name=") {
+			return strings.TrimPrefix(opt, "name="), true
+		}
+	}
+	if name := strings.Split(f.Tag.Get("json"), ",")[0]; name != "" {
+		return name, true
+	}
+	return f.Name, true
+}
+
+func isEnum(t reflect.Type) bool {
+	switch t.Kind() {
+	case reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return t.PkgPath() != "" && t.Implements(stringerType)
+	}
+	return false
+}
+
+func isText(t reflect.Type) bool {
+	ptr := reflect.PtrTo(t)
+	return t.Kind() != reflect.Ptr && ptr.Implements(textMarshalerType) && ptr.Implements(textUnmarshalerType)
+}
+
+// toValue converts the value into a tree of JSON values. Enums are converted
+// to their names and the types with text form (IP and MAC addresses) to text.
+func toValue(v reflect.Value) (interface{}, error) {
+	t := v.Type()
+	switch {
+	case isText(t):
+		ptr := reflect.New(t)
+		ptr.Elem().Set(v)
+		text, err := ptr.Interface().(encoding.TextMarshaler).MarshalText()
+		if err != nil {
+			return nil, err
+		}
+		return string(text), nil
+	case isEnum(t):
+		name := v.Interface().(fmt.Stringer).String()
+		if strings.HasSuffix(name, ")") {
+			// unknown value
+			break
+		}
+		return name, nil
+	}
+
+	switch t.Kind() {
+	case reflect.Struct:
+		obj := make(object, 0, t.NumField())
+		for i := 0; i < t.NumField(); i++ {
+			name, ok := fieldName(t.Field(i))
+			if !ok {
+				continue
+			}
+			value, err := toValue(v.Field(i))
+			if err != nil {
+				return nil, fmt.Errorf("%s: %w", name, err)
+			}
+			obj = append(obj, field{Key: name, Value: value})
+		}
+		return obj, nil
+	case reflect.Slice, reflect.Array:
+		if t.Kind() == reflect.Slice && t.Elem().Kind() == reflect.Uint8 {
+			return base64.StdEncoding.EncodeToString(v.Bytes()), nil
+		}
+		list := make([]interface{}, v.Len())
+		for i := range list {
+			value, err := toValue(v.Index(i))
+			if err != nil {
+				return nil, fmt.Errorf("[%d]: %w", i, err)
+			}
+			list[i] = value
+		}
+		return list, nil
+	case reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return v.Int(), nil
+	case reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return v.Uint(), nil
+	case reflect.Float32, reflect.Float64:
+		return v.Float(), nil
+	case reflect.Bool:
+		return v.Bool(), nil
+	case reflect.String:
+		return v.String(), nil
+	}
+	return nil, fmt.Errorf("unsupported type %v", t)
+}
+
+// fromValue sets v from the tree of values decoded from JSON or YAML.
+func fromValue(v reflect.Value, value interface{}) error {
+	if value == nil {
+		return nil
+	}
+	t := v.Type()
+	if text, ok := value.(string); ok {
+		switch {
+		case isText(t):
+			return v.Addr().Interface().(encoding.TextUnmarshaler).UnmarshalText([]byte(text))
+		case isEnum(t):
+			n, err := parseEnum(t, text)
+			if err != nil {
+				return err
+			}
+			if isSigned(t) {
+				v.SetInt(int64(n))
+			} else {
+				v.SetUint(n)
+			}
+			return nil
+		}
+	}
+
+	switch t.Kind() {
+	case reflect.Struct:
+		fields, ok := toMap(value)
+		if !ok {
+			return fmt.Errorf("expected object for %v, got %T", t, value)
+		}
+		for i := 0; i < t.NumField(); i++ {
+			name, ok := fieldName(t.Field(i))
+			if !ok {
+				continue
+			}
+			if value, ok := fields[name]; ok {
+				if err := fromValue(v.Field(i), value); err != nil {
+					return fmt.Errorf("%s: %w", name, err)
+				}
+			}
+		}
+		return nil
+	case reflect.Slice, reflect.Array:
+		if s, ok := value.(string); ok && t.Kind() == reflect.Slice && t.Elem().Kind() == reflect.Uint8 {
+			b, err := base64.StdEncoding.DecodeString(s)
+			if err != nil {
+				return err
+			}
+			v.SetBytes(b)
+			return nil
+		}
+		list, ok := value.([]interface{})
+		if !ok {
+			return fmt.Errorf("expected array for %v, got %T", t, value)
+		}
+		if t.Kind() == reflect.Slice {
+			if len(list) == 0 {
+				v.Set(reflect.Zero(t))
+				return nil
+			}
+			v.Set(reflect.MakeSlice(t, len(list), len(list)))
+		} else if len(list) > v.Len() {
+			return fmt.Errorf("too many elements for %v: %d", t, len(list))
+		}
+		for i, elem := range list {
+			if err := fromValue(v.Index(i), elem); err != nil {
+				return fmt.Errorf("[%d]: %w", i, err)
+			}
+		}
+		return nil
+	case reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		n, err := strconv.ParseInt(numberString(value), 0, t.Bits())
+		if err != nil {
+			return err
+		}
+		v.SetInt(n)
+		return nil
+	case reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		n, err := strconv.ParseUint(numberString(value), 0, t.Bits())
+		if err != nil {
+			return err
+		}
+		v.SetUint(n)
+		return nil
+	case reflect.Float32, reflect.Float64:
+		f, err := strconv.ParseFloat(numberString(value), t.Bits())
+		if err != nil {
+			return err
+		}
+		v.SetFloat(f)
+		return nil
+	case reflect.Bool:
+		b, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("expected bool, got %T", value)
+		}
+		v.SetBool(b)
+		return nil
+	case reflect.String:
+		s, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("expected string, got %T", value)
+		}
+		v.SetString(s)
+		return nil
+	}
+	return fmt.Errorf("unsupported type %v", t)
+}
+
+func isSigned(t reflect.Type) bool {
+	switch t.Kind() {
+	case reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return true
+	}
+	return false
+}
+
+// toMap returns the object decoded from JSON or YAML as a map.
+func toMap(value interface{}) (map[string]interface{}, bool) {
+	switch value := value.(type) {
+	case map[string]interface{}:
+		return value, true
+	case map[interface{}]interface{}:
+		m := make(map[string]interface{}, len(value))
+		for k, v := range value {
+			m[fmt.Sprint(k)] = v
+		}
+		return m, true
+	}
+	return nil, false
+}
+
+func numberString(value interface{}) string {
+	switch value := value.(type) {
+	case json.Number:
+		return value.String()
+	case string:
+		return value
+	case float64:
+		return strconv.FormatFloat(value, 'f', -1, 64)
+	}
+	return fmt.Sprint(value)
+}
+
+// enumValues caches the values of enum names for the enum types.
+var enumValues sync.Map // map[reflect.Type]map[string]uint64
+
+// parseEnum parses the enum value from its name, names of flags joined by |,
+// or a number.
+func parseEnum(t reflect.Type, text string) (uint64, error) {
+	values := enumNames(t)
+	var n uint64
+	for _, name := range strings.Split(text, "|") {
+		name = strings.TrimSpace(name)
+		if value, ok := values[name]; ok {
+			n |= value
+			continue
+		}
+		// unknown value formatted as Type(N) or a number
+		num := strings.TrimSuffix(strings.TrimPrefix(name, t.Name()+"("), ")")
+		value, err := strconv.ParseUint(num, 0, 64)
+		if err != nil {
+			return 0, fmt.Errorf("unknown value %q of enum %v", name, t)
+		}
+		n |= value
+	}
+	return n, nil
+}
+
+// enumNames returns the values of the enum names. The generated enums
+// provide only the String method, so the names are collected by formatting
+// the small values and single bits used by flags. The names of other values
+// (e.g. 1025 or -1025) are not known, such values are parsed only as numbers.
+func enumNames(t reflect.Type) map[string]uint64 {
+	if values, ok := enumValues.Load(t); ok {
+		return values.(map[string]uint64)
+	}
+	values := make(map[string]uint64)
+	add := func(n uint64) {
+		v := reflect.New(t).Elem()
+		if isSigned(t) {
+			if v.OverflowInt(int64(n)) {
+				return
+			}
+			v.SetInt(int64(n))
+		} else {
+			if v.OverflowUint(n) {
+				return
+			}
+			v.SetUint(n)
+		}
+		name := v.Interface().(fmt.Stringer).String()
+		if !strings.HasSuffix(name, ")") && !strings.Contains(name, "|") {
+			if _, ok := values[name]; !ok {
+				values[name] = n
+			}
+		}
+	}
+	for n := uint64(0); n < 1024; n++ {
+		add(n)
+	}
+	for i := 10; i < t.Bits(); i++ {
+		add(1 << uint(i))
+	}
+	if isSigned(t) {
+		for n := int64(-1); n >= -1024; n-- {
+			add(uint64(n))
+		}
+	}
+	enumValues.Store(t, values)
+	return values
+}
@@ -0,0 +1,205 @@
+//  Copyright (c) 2023 Cisco and/or its affiliates.
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at:
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package core
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/sirupsen/logrus"
+
+	"go.fd.io/govpp/api"
+)
+
+// DefaultBatchMaxInFlight is the default maximum number of batch requests
+// sent to VPP without having received their replies.
+var DefaultBatchMaxInFlight = 100
+
+// BatchItemError is an error of a single request in the batch.
+type BatchItemError struct {
+	// Index is the index of the request in the batch.
+	Index int
+	// Err is the error of the request, RequestError if the reply contained non-zero retval.
+	Err error
+}
+
+func (e *BatchItemError) Error() string {
+	return fmt.Sprintf("batch request #%d: %v", e.Index, e.Err)
+}
+
+func (e *BatchItemError) Unwrap() error {
+	return e.Err
+}
+
+// BatchError is returned by Batch if any of the requests failed.
+type BatchError struct {
+	// Errors holds errors of the failed requests ordered by the request index.
+	Errors []*BatchItemError
+}
+
+func (e *BatchError) Error() string {
+	if len(e.Errors) == 1 {
+		return e.Errors[0].Error()
+	}
+	var b strings.Builder
+	fmt.Fprintf(&b, "%d batch requests failed: ", len(e.Errors))
+	for i, err := range e.Errors {
+		if i > 0 {
+			b.WriteString("; ")
+		}
+		b.WriteString(err.Error())
+	}
+	return b.String()
+}
+
+func (e *BatchError) Unwrap() []error {
+	errs := make([]error, len(e.Errors))
+	for i, err := range e.Errors {
+		errs[i] = err
+	}
+	return errs
+}
+
+// BatchOption allows customizing a Batch call.
+type BatchOption func(*batchOptions)
+
+type batchOptions struct {
+	maxInFlight  int
+	replyTimeout time.Duration
+}
+
+// WithBatchMaxInFlight sets the maximum number of requests sent to VPP
+// without having received their replies.
+func WithBatchMaxInFlight(n int) BatchOption {
+	return func(o *batchOptions) {
+		o.maxInFlight = n
+	}
+}
+
+// WithBatchReplyTimeout sets the maximum time to wait for the next reply.
+func WithBatchReplyTimeout(timeout time.Duration) BatchOption {
+	return func(o *batchOptions) {
+		o.replyTimeout = timeout
+	}
+}
+
+// Batch sends all the requests via a single stream without waiting for each
+// reply before sending the next request, which allows bulk configuration
+// at wire speed. Replies are correlated with the requests by the request
+// context and returned in the order of the requests. If any of the requests
+// fail, BatchError with the errors of the failed requests is returned along
+// with the replies of the successful ones (replies of the failed requests are nil).
+// The number of requests waiting for reply is bounded (see WithBatchMaxInFlight).
+//
+// The requests are not passed through the interceptors of the connection.
+func (c *Connection) Batch(ctx context.Context, reqs []api.Message, opts ...BatchOption) ([]api.Message, error) {
+	if c == nil {
+		return nil, errors.New("nil connection passed in")
+	}
+	o := batchOptions{
+		maxInFlight:  DefaultBatchMaxInFlight,
+		replyTimeout: DefaultReplyTimeout,
+	}
+	for _, opt := range opts {
+		opt(&o)
+	}
+	if o.maxInFlight <= 0 {
+		o.maxInFlight = 1
+	}
+
+	// reply channel must be able to hold replies to all the requests in flight
+	stream, err := c.newStream(ctx, WithReplySize(o.maxInFlight), WithReplyTimeout(o.replyTimeout))
+	if err != nil {
+		return nil, err
+	}
+	s := stream.(*Stream)
+	defer func() { _ = s.Close() }()
+
+	var (
+		replies  = make([]api.Message, len(reqs))
+		errs     []*BatchItemError
+		inFlight = make(map[uint16]int, o.maxInFlight) // request index indexed by sequence number
+		next     int
+	)
+	for next < len(reqs) || len(inFlight) > 0 {
+		// send requests until the in-flight limit is reached
+		for next < len(reqs) && len(inFlight) < o.maxInFlight {
+			req := s.channel.newRequest(reqs[next], false)
+			if err := c.processRequest(s.channel, req); err != nil {
+				errs = append(errs, &BatchItemError{Index: next, Err: err})
+			} else {
+				inFlight[req.seqNum] = next
+			}
+			next++
+		}
+		if len(inFlight) == 0 {
+			continue
+		}
+
+		reply, err := s.recvReply()
+		if err != nil {
+			// no more replies can be received, fail all the remaining requests
+			for _, idx := range inFlight {
+				errs = append(errs, &BatchItemError{Index: idx, Err: err})
+			}
+			for ; next < len(reqs); next++ {
+				errs = append(errs, &BatchItemError{Index: next, Err: err})
+			}
+			break
+		}
+		idx, ok := inFlight[reply.seqNum]
+		if !ok {
+			log.WithFields(logrus.Fields{
+				"channel": s.channel.id,
+				"msg_id":  reply.msgID,
+				"seq_num": reply.seqNum,
+			}).Warn("Received reply to unknown batch request, ignoring the message.")
+			continue
+		}
+		delete(inFlight, reply.seqNum)
+
+		msg, err := c.decodeBatchReply(reqs[idx], reply)
+		if err != nil {
+			errs = append(errs, &BatchItemError{Index: idx, Err: err})
+			continue
+		}
+		replies[idx] = msg
+	}
+
+	if len(errs) > 0 {
+		sort.Slice(errs, func(i, j int) bool {
+			return errs[i].Index < errs[j].Index
+		})
+		return replies, &BatchError{Errors: errs}
+	}
+	return replies, nil
+}
+
+// decodeBatchReply decodes reply to the request and checks its retval.
+func (c *Connection) decodeBatchReply(req api.Message, reply *vppReply) (api.Message, error) {
+	msgType, err := c.LookupByID(c.GetMessagePath(req), reply.msgID)
+	if err != nil {
+		return nil, err
+	}
+	msg := api.NewMessage(msgType)
+	if err := c.codec.DecodeMsg(reply.data, msg); err != nil {
+		return nil, err
+	}
+	return msg, checkRequestReply(req, msg)
+}
@@ -0,0 +1,73 @@
+package core_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	. "github.com/onsi/gomega"
+
+	"go.fd.io/govpp/adapter/mock"
+	"go.fd.io/govpp/api"
+	interfaces "go.fd.io/govpp/binapi/interface"
+	"go.fd.io/govpp/binapi/interface_types"
+	"go.fd.io/govpp/core"
+)
+
+func TestBatch(t *testing.T) {
+	RegisterTestingT(t)
+
+	mockVpp := mock.NewVppAdapter()
+	conn, err := core.Connect(mockVpp)
+	Expect(err).ShouldNot(HaveOccurred())
+	defer conn.Disconnect()
+
+	var reqs []api.Message
+	for i := 0; i < 10; i++ {
+		reqs = append(reqs, &interfaces.CreateLoopback{})
+		reply := &interfaces.CreateLoopbackReply{SwIfIndex: interface_types.InterfaceIndex(i)}
+		if i == 3 || i == 7 {
+			reply.Retval = int32(api.INVALID_VALUE)
+		}
+		mockVpp.MockReply(reply)
+	}
+
+	replies, err := conn.Batch(context.Background(), reqs, core.WithBatchMaxInFlight(3))
+	Expect(err).Should(HaveOccurred())
+
+	var batchErr *core.BatchError
+	Expect(errors.As(err, &batchErr)).To(BeTrue())
+	Expect(batchErr.Errors).To(HaveLen(2))
+	Expect(batchErr.Errors[0].Index).To(Equal(3))
+	Expect(batchErr.Errors[0].Err).To(MatchError(api.INVALID_VALUE))
+	Expect(batchErr.Errors[1].Index).To(Equal(7))
+	Expect(errors.Is(err, api.INVALID_VALUE)).To(BeTrue())
+
+	Expect(replies).To(HaveLen(10))
+	for i, reply := range replies {
+		if i == 3 || i == 7 {
+			Expect(reply).To(BeNil())
+			continue
+		}
+		Expect(reply.(*interfaces.CreateLoopbackReply).SwIfIndex).To(BeEquivalentTo(i))
+	}
+}
+
+func TestBatchContextCanceled(t *testing.T) {
+	RegisterTestingT(t)
+
+	mockVpp := mock.NewVppAdapter()
+	conn, err := core.Connect(mockVpp)
+	Expect(err).ShouldNot(HaveOccurred())
+	defer conn.Disconnect()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	// no replies mocked
+	replies, err := conn.Batch(ctx, []api.Message{&interfaces.CreateLoopback{}, &interfaces.CreateLoopback{}},
+		core.WithBatchMaxInFlight(1))
+	Expect(errors.Is(err, context.Canceled)).To(BeTrue())
+	Expect(err.(*core.BatchError).Errors).To(HaveLen(2))
+	Expect(replies).To(Equal([]api.Message{nil, nil}))
+}
@@ -15,10 +15,12 @@
 package core
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"reflect"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/sirupsen/logrus"
@@ -71,21 +73,29 @@ type vppReply struct {
 type requestCtx struct {
 	ch     *Channel
 	seqNum uint16
+	msg    api.Message     // request message
+	ctx    context.Context // context that can abort waiting for the reply
+	span   Span            // span ended when the reply is received
 }
 
 // multiRequestCtx is a context for request with multiple responses
 type multiRequestCtx struct {
 	ch     *Channel
 	seqNum uint16
+	msg    api.Message     // request message
+	ctx    context.Context // context that can abort waiting for the replies
+	span   Span            // span ended when the last reply is received
 }
 
 // subscriptionCtx is a context of subscription for delivery of specific notification messages.
 type subscriptionCtx struct {
 	conn       *Connection
-	notifChan  chan api.Message   // channel where notification messages will be delivered to
-	msgID      uint16             // message ID for the subscribed event message
-	event      api.Message        // event message that this subscription is for
-	msgFactory func() api.Message // function that returns a new instance of the specific message that is expected as a notification
+	notifChan  chan api.Message       // channel where notification messages will be delivered to
+	msgID      uint16                 // message ID for the subscribed event message
+	event      api.Message            // event message that this subscription is for
+	msgFactory func() api.Message     // function that returns a new instance of the specific message that is expected as a notification
+	enableReq  api.Message            // request enabling the notifications in VPP (e.g. want_interface_events), re-sent after reconnect
+	filter     func(api.Message) bool // optional predicate, notifications not matching it are discarded
 }
 
 // Channel is the main communication interface with govpp core. It contains four Go channels, one for sending the requests
@@ -107,6 +117,8 @@ type Channel struct {
 	delayedReply        *vppReply     // reply already taken from ReplyChan, buffered for later delivery
 	replyTimeout        time.Duration // maximum time that the API waits for a reply from VPP before returning an error, can be set with SetReplyTimeout
 	receiveReplyTimeout time.Duration // maximum time that we wait for receiver to consume reply
+
+	pendingRequests sync.Map // requests waiting for reply indexed by sequence number (tracked only for metrics)
 }
 
 func (c *Connection) newChannel(reqChanBufSize, replyChanBufSize int) (*Channel, error) {
@@ -134,15 +146,39 @@ func (ch *Channel) GetID() uint16 {
 }
 
 func (ch *Channel) SendRequest(msg api.Message) api.RequestCtx {
+	return ch.SendRequestCtx(context.Background(), msg)
+}
+
+// SendRequestCtx asynchronously sends a request to VPP like SendRequest does. The returned
+// request context honours ctx: ReceiveReply returns ctx.Err() once the ctx is done.
+func (ch *Channel) SendRequestCtx(ctx context.Context, msg api.Message) api.RequestCtx {
 	req := ch.newRequest(msg, false)
-	ch.reqChan <- req
-	return &requestCtx{ch: ch, seqNum: req.seqNum}
+	ctx, span := ch.conn.startRequestSpan(ctx, "govpp.Channel.SendRequest", ch, req)
+	ch.sendRequest(ctx, req)
+	return &requestCtx{ch: ch, seqNum: req.seqNum, msg: msg, ctx: ctx, span: span}
 }
 
 func (ch *Channel) SendMultiRequest(msg api.Message) api.MultiRequestCtx {
+	return ch.SendMultiRequestCtx(context.Background(), msg)
+}
+
+// SendMultiRequestCtx asynchronously sends a multipart request to VPP like SendMultiRequest does.
+// The returned request context honours ctx: ReceiveReply returns ctx.Err() once the ctx is done,
+// which allows to abort long dumps without closing the channel.
+func (ch *Channel) SendMultiRequestCtx(ctx context.Context, msg api.Message) api.MultiRequestCtx {
 	req := ch.newRequest(msg, true)
-	ch.reqChan <- req
-	return &multiRequestCtx{ch: ch, seqNum: req.seqNum}
+	ctx, span := ch.conn.startRequestSpan(ctx, "govpp.Channel.SendMultiRequest", ch, req)
+	ch.sendRequest(ctx, req)
+	return &multiRequestCtx{ch: ch, seqNum: req.seqNum, msg: msg, ctx: ctx, span: span}
+}
+
+// sendRequest passes the request to the request channel unless the ctx is done.
+func (ch *Channel) sendRequest(ctx context.Context, req *vppRequest) {
+	select {
+	case ch.reqChan <- req:
+	case <-ctx.Done():
+		// the request is not sent, receiving the reply will return ctx.Err()
+	}
 }
 
 func (ch *Channel) nextSeqNum() uint16 {
@@ -179,6 +215,19 @@ func (ch *Channel) CheckCompatiblity(msgs ...api.Message) error {
 }
 
 func (ch *Channel) SubscribeNotification(notifChan chan api.Message, event api.Message) (api.SubscriptionCtx, error) {
+	return ch.subscribeNotification(notifChan, event, nil)
+}
+
+// SubscribeNotificationWithRequest subscribes for receiving of the specified notification messages
+// like SubscribeNotification does, and additionally remembers the request that enables the notifications
+// in VPP (e.g. WantInterfaceEvents). The request is not sent by this call, the caller is still expected
+// to send it. When the connection is re-established after VPP restart, the request is re-sent automatically
+// since VPP forgets all the registrations.
+func (ch *Channel) SubscribeNotificationWithRequest(notifChan chan api.Message, event api.Message, enableReq api.Message) (api.SubscriptionCtx, error) {
+	return ch.subscribeNotification(notifChan, event, enableReq)
+}
+
+func (ch *Channel) subscribeNotification(notifChan chan api.Message, event api.Message, enableReq api.Message) (api.SubscriptionCtx, error) {
 	msgID, err := ch.msgIdentifier.GetMessageID(event)
 	if err != nil {
 		log.WithFields(logrus.Fields{
@@ -194,6 +243,7 @@ func (ch *Channel) SubscribeNotification(notifChan chan api.Message, event api.M
 		msgID:      msgID,
 		event:      event,
 		msgFactory: getMsgFactory(event),
+		enableReq:  enableReq,
 	}
 
 	// add the subscription into map
@@ -218,14 +268,17 @@ func (req *requestCtx) ReceiveReply(msg api.Message) error {
 		return ErrInvalidRequestCtx
 	}
 
-	lastReplyReceived, err := req.ch.receiveReplyInternal(msg, req.seqNum)
-	if err != nil {
-		return err
-	} else if lastReplyReceived {
-		return errors.New("multipart reply recieved while a single reply expected")
+	lastReplyReceived, err := req.ch.receiveReplyInternal(req.ctx, msg, req.seqNum)
+	if err == nil && lastReplyReceived {
+		err = errors.New("multipart reply recieved while a single reply expected")
+	}
+	err = wrapRequestError(req.msg, err)
+	if req.span != nil {
+		endSpan(req.span, msg, err)
+		req.span = nil
 	}
 
-	return nil
+	return err
 }
 
 func (req *multiRequestCtx) ReceiveReply(msg api.Message) (lastReplyReceived bool, err error) {
@@ -233,19 +286,26 @@ func (req *multiRequestCtx) ReceiveReply(msg api.Message) (lastReplyReceived boo
 		return false, ErrInvalidRequestCtx
 	}
 
-	return req.ch.receiveReplyInternal(msg, req.seqNum)
+	lastReplyReceived, err = req.ch.receiveReplyInternal(req.ctx, msg, req.seqNum)
+	err = wrapRequestError(req.msg, err)
+	if req.span != nil && (lastReplyReceived || err != nil) {
+		endSpan(req.span, nil, err)
+		req.span = nil
+	}
+
+	return lastReplyReceived, err
 }
 
 func (sub *subscriptionCtx) Unsubscribe() error {
+	// remove the subscription from the map
+	sub.conn.subscriptionsLock.Lock()
+	defer sub.conn.subscriptionsLock.Unlock()
+
 	log.WithFields(logrus.Fields{
 		"msg_name": sub.event.GetMessageName(),
 		"msg_id":   sub.msgID,
 	}).Debug("Removing notification subscription.")
 
-	// remove the subscription from the map
-	sub.conn.subscriptionsLock.Lock()
-	defer sub.conn.subscriptionsLock.Unlock()
-
 	for i, item := range sub.conn.subscriptions[sub.msgID] {
 		if item == sub {
 			// close notification channel
@@ -256,16 +316,25 @@ func (sub *subscriptionCtx) Unsubscribe() error {
 		}
 	}
 
+	// the subscriptions were already removed by Shutdown
+	if sub.conn.isShuttingDown() {
+		return nil
+	}
 	return fmt.Errorf("subscription for %q not found", sub.event.GetMessageName())
 }
 
 const maxInt64 = 1<<63 - 1
 
 // receiveReplyInternal receives a reply from the reply channel into the provided msg structure.
-func (ch *Channel) receiveReplyInternal(msg api.Message, expSeqNum uint16) (lastReplyReceived bool, err error) {
+func (ch *Channel) receiveReplyInternal(ctx context.Context, msg api.Message, expSeqNum uint16) (lastReplyReceived bool, err error) {
 	if msg == nil {
 		return false, errors.New("nil message passed in")
 	}
+	if ctx == nil {
+		ctx = context.Background()
+	} else if ctx.Err() != nil {
+		return false, ch.abortReply(ctx, msg, expSeqNum)
+	}
 
 	var ignore bool
 
@@ -309,8 +378,46 @@ func (ch *Channel) receiveReplyInternal(msg api.Message, expSeqNum uint16) (last
 				"expSeqNum": expSeqNum,
 				"channel":   ch.id,
 			}).Debugf("timeout (%v) waiting for reply: %s", timeout, msg.GetMessageName())
-			err = fmt.Errorf("no reply received within the timeout period %s", timeout)
+			err = fmt.Errorf("%w %s", ErrReplyTimeout, timeout)
+			ch.replyTimedOut(expSeqNum)
 			return false, err
+		case <-ctx.Done():
+			return false, ch.abortReply(ctx, msg, expSeqNum)
+		}
+	}
+}
+
+// abortReply stops waiting for the reply when the context is done.
+func (ch *Channel) abortReply(ctx context.Context, msg api.Message, expSeqNum uint16) error {
+	log.WithFields(logrus.Fields{
+		"expSeqNum": expSeqNum,
+		"channel":   ch.id,
+	}).Debugf("context done while waiting for reply: %s", msg.GetMessageName())
+	ch.untrackRequest(expSeqNum)
+	ch.drainReplies(expSeqNum)
+	return ctx.Err()
+}
+
+// drainReplies drops replies to the request with the given sequence number (or older
+// requests) that are already waiting in the reply channel. The first reply to a newer
+// request is kept for later delivery.
+func (ch *Channel) drainReplies(seqNum uint16) {
+	if reply := ch.delayedReply; reply != nil {
+		if compareSeqNumbers(reply.seqNum, seqNum) == 1 {
+			// the pending reply is newer, so are the replies following it
+			return
+		}
+		ch.delayedReply = nil
+	}
+	for {
+		select {
+		case reply := <-ch.replyChan:
+			if compareSeqNumbers(reply.seqNum, seqNum) == 1 {
+				ch.delayedReply = reply
+				return
+			}
+		default:
+			return
 		}
 	}
 }
@@ -370,25 +477,55 @@ func (ch *Channel) processReply(reply *vppReply, expSeqNum uint16, msg api.Messa
 	}
 
 	// check Retval and convert it into VnetAPIError error
-	if strings.HasSuffix(msg.GetMessageName(), "_reply") {
-		// TODO: use categories for messages to avoid checking message name
-		if f := reflect.Indirect(reflect.ValueOf(msg)).FieldByName("Retval"); f.IsValid() {
-			var retval int32
-			switch f.Kind() {
-			case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
-				retval = int32(f.Int())
-			case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
-				retval = int32(f.Uint())
-			default:
-				logrus.Warnf("invalid kind (%v) for Retval field of message %v", f.Kind(), msg.GetMessageName())
-			}
-			err = api.RetvalToVPPApiError(retval)
-		}
-	}
+	err = checkReplyRetval(msg)
 
 	return
 }
 
+// checkReplyRetval checks Retval of the reply message and converts it into VPPApiError error.
+func checkReplyRetval(msg api.Message) error {
+	retval, _ := getReplyRetval(msg)
+	return api.RetvalToVPPApiError(retval)
+}
+
+// wrapRequestError converts VPPApiError returned for the request into RequestError.
+func wrapRequestError(req api.Message, err error) error {
+	var apiErr api.VPPApiError
+	if errors.As(err, &apiErr) {
+		return api.RetvalToRequestError(req, int32(apiErr))
+	}
+	return err
+}
+
+// checkRequestReply checks Retval of the reply message and converts it into
+// RequestError error for the request.
+func checkRequestReply(req, reply api.Message) error {
+	retval, _ := getReplyRetval(reply)
+	return api.RetvalToRequestError(req, retval)
+}
+
+// getReplyRetval returns Retval of the reply message, ok is false if the message has no Retval.
+func getReplyRetval(msg api.Message) (retval int32, ok bool) {
+	if !strings.HasSuffix(msg.GetMessageName(), "_reply") {
+		return 0, false
+	}
+	// TODO: use categories for messages to avoid checking message name
+	f := reflect.Indirect(reflect.ValueOf(msg)).FieldByName("Retval")
+	if !f.IsValid() {
+		return 0, false
+	}
+	switch f.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		retval = int32(f.Int())
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		retval = int32(f.Uint())
+	default:
+		logrus.Warnf("invalid kind (%v) for Retval field of message %v", f.Kind(), msg.GetMessageName())
+		return 0, false
+	}
+	return retval, true
+}
+
 func (ch *Channel) Reset() {
 	if len(ch.reqChan) > 0 || len(ch.replyChan) > 0 {
 		log.WithField("channel", ch.id).Debugf("draining channel buffers (req: %d, reply: %d)", len(ch.reqChan), len(ch.replyChan))
@@ -15,6 +15,9 @@
 package core
 
 import (
+	"context"
+	"encoding/binary"
+	"errors"
 	"testing"
 	"time"
 
@@ -98,6 +101,24 @@ func TestChannelReset(t *testing.T) {
 	}).Should(BeZero())
 }
 
+func TestDrainRepliesKeepsPendingReply(t *testing.T) {
+	ctx := setupTest(t)
+	defer ctx.teardownTest()
+
+	ch := ctx.ch.(*Channel)
+	pending := &vppReply{seqNum: 3}
+	ch.delayedReply = pending
+	ch.replyChan <- &vppReply{seqNum: 4}
+
+	ch.drainReplies(2)
+	Expect(ch.delayedReply).To(BeIdenticalTo(pending))
+	Expect(ch.replyChan).To(HaveLen(1))
+
+	ch.drainReplies(3)
+	Expect(ch.delayedReply.seqNum).To(BeEquivalentTo(4))
+	Expect(ch.replyChan).To(BeEmpty())
+}
+
 func TestRequestReplyMemifCreate(t *testing.T) {
 	ctx := setupTest(t)
 	defer ctx.teardownTest()
@@ -139,6 +160,44 @@ func TestRequestReplyMemifDelete(t *testing.T) {
 	Expect(err).ShouldNot(HaveOccurred())
 }
 
+func TestRequestReplyRetvalError(t *testing.T) {
+	ctx := setupTest(t)
+	defer ctx.teardownTest()
+
+	// mock reply
+	ctx.mockVpp.MockReply(&memif.MemifDeleteReply{Retval: int32(api.INVALID_SW_IF_INDEX)})
+
+	err := ctx.ch.SendRequest(&memif.MemifDelete{SwIfIndex: 15}).ReceiveReply(&memif.MemifDeleteReply{})
+	Expect(err).Should(HaveOccurred())
+	Expect(errors.Is(err, api.ErrNotFound)).To(BeTrue())
+	Expect(errors.Is(err, api.INVALID_SW_IF_INDEX)).To(BeTrue())
+	var apiErr api.VPPApiError
+	Expect(errors.As(err, &apiErr)).To(BeTrue())
+	Expect(apiErr).To(Equal(api.INVALID_SW_IF_INDEX))
+	var reqErr *api.RequestError
+	Expect(errors.As(err, &reqErr)).To(BeTrue())
+	Expect(reqErr.Request).To(Equal("memif_delete"))
+	Expect(reqErr.Retval).To(Equal(int32(api.INVALID_SW_IF_INDEX)))
+}
+
+func TestMultiRequestReplyRetvalError(t *testing.T) {
+	ctx := setupTest(t)
+	defer ctx.teardownTest()
+
+	// mock reply
+	ctx.mockVpp.MockReply(&memif.MemifDeleteReply{Retval: int32(api.INVALID_SW_IF_INDEX)})
+	ctx.mockVpp.MockReply(&ControlPingReply{})
+
+	_, err := ctx.ch.SendMultiRequest(&memif.MemifDelete{SwIfIndex: 15}).ReceiveReply(&memif.MemifDeleteReply{})
+	Expect(err).Should(HaveOccurred())
+	Expect(errors.Is(err, api.ErrNotFound)).To(BeTrue())
+	Expect(errors.Is(err, api.INVALID_SW_IF_INDEX)).To(BeTrue())
+	var reqErr *api.RequestError
+	Expect(errors.As(err, &reqErr)).To(BeTrue())
+	Expect(reqErr.Request).To(Equal("memif_delete"))
+	Expect(reqErr.Retval).To(Equal(int32(api.INVALID_SW_IF_INDEX)))
+}
+
 func TestRequestReplyMemifDetails(t *testing.T) {
 	ctx := setupTest(t)
 	defer ctx.teardownTest()
@@ -191,6 +250,68 @@ func TestMultiRequestReplySwInterfaceMemifDump(t *testing.T) {
 	Expect(cnt).To(BeEquivalentTo(10))
 }
 
+// sentDataAdapter keeps the data of the sent messages without copying.
+type sentDataAdapter struct {
+	*mock.VppAdapter
+	sent [][]byte
+}
+
+func (a *sentDataAdapter) SendMsg(context uint32, data []byte) error {
+	a.sent = append(a.sent, data)
+	return a.VppAdapter.SendMsg(context, data)
+}
+
+// borrowingAdapter does not retain the sent data, so the buffers are reused.
+type borrowingAdapter struct {
+	sentDataAdapter
+	sentIDs []uint16
+}
+
+func (a *borrowingAdapter) SendMsgBorrowed(context uint32, data []byte) error {
+	a.sentIDs = append(a.sentIDs, binary.BigEndian.Uint16(data))
+	return a.VppAdapter.SendMsg(context, data)
+}
+
+func TestMultiRequestSentData(t *testing.T) {
+	RegisterTestingT(t)
+
+	mockVpp := mock.NewVppAdapter()
+	retaining := &sentDataAdapter{VppAdapter: mockVpp}
+	conn, err := Connect(retaining)
+	Expect(err).ShouldNot(HaveOccurred())
+	defer conn.Disconnect()
+	ch, err := conn.NewAPIChannel()
+	Expect(err).ShouldNot(HaveOccurred())
+	defer ch.Close()
+
+	mockVpp.MockReply(&memif.MemifDetails{})
+	mockVpp.MockReply(&ControlPingReply{})
+	Expect(ch.SendMultiRequest(&memif.MemifDump{}).ReceiveReply(&memif.MemifDetails{})).Error().ShouldNot(HaveOccurred())
+
+	// the retained request is not overwritten by the control ping
+	dumpID, err := conn.GetMessageID(&memif.MemifDump{})
+	Expect(err).ShouldNot(HaveOccurred())
+	pingID, err := conn.GetMessageID(&ControlPing{})
+	Expect(err).ShouldNot(HaveOccurred())
+	Expect(retaining.sent).To(HaveLen(2))
+	Expect(binary.BigEndian.Uint16(retaining.sent[0])).To(Equal(dumpID))
+	Expect(binary.BigEndian.Uint16(retaining.sent[1])).To(Equal(pingID))
+
+	borrowing := &borrowingAdapter{sentDataAdapter: sentDataAdapter{VppAdapter: mock.NewVppAdapter()}}
+	conn2, err := Connect(borrowing)
+	Expect(err).ShouldNot(HaveOccurred())
+	defer conn2.Disconnect()
+	ch2, err := conn2.NewAPIChannel()
+	Expect(err).ShouldNot(HaveOccurred())
+	defer ch2.Close()
+
+	borrowing.MockReply(&memif.MemifDetails{})
+	borrowing.MockReply(&ControlPingReply{})
+	Expect(ch2.SendMultiRequest(&memif.MemifDump{}).ReceiveReply(&memif.MemifDetails{})).Error().ShouldNot(HaveOccurred())
+	Expect(borrowing.sent).To(BeEmpty())
+	Expect(borrowing.sentIDs).To(HaveLen(2))
+}
+
 func TestNotificationEvent(t *testing.T) {
 	ctx := setupTest(t)
 	defer ctx.teardownTest()
@@ -515,3 +636,48 @@ func TestInvalidMessageID(t *testing.T) {
 	Expect(err).Should(HaveOccurred())
 	Expect(err.Error()).To(ContainSubstring("unexpected message"))
 }
+
+func TestMultiRequestContextCancel(t *testing.T) {
+	ctx := setupTest(t)
+	defer ctx.teardownTest()
+
+	// mock multipart reply without the closing control ping reply
+	var msgs []api.Message
+	for i := 0; i < 10; i++ {
+		msgs = append(msgs, &interfaces.SwInterfaceDetails{SwIfIndex: interface_types.InterfaceIndex(i)})
+	}
+	ctx.mockVpp.MockReply(msgs...)
+	ctx.mockVpp.MockReply()
+
+	reqCtx, cancel := context.WithCancel(context.Background())
+	multiReq := ctx.ch.(*Channel).SendMultiRequestCtx(reqCtx, &interfaces.SwInterfaceDump{})
+
+	for i := 0; i < 2; i++ {
+		reply := &interfaces.SwInterfaceDetails{}
+		last, err := multiReq.ReceiveReply(reply)
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(last).To(BeFalse())
+		Expect(reply.SwIfIndex).To(BeEquivalentTo(i))
+	}
+
+	// abort the dump
+	cancel()
+	_, err := multiReq.ReceiveReply(&interfaces.SwInterfaceDetails{})
+	Expect(err).To(MatchError(context.Canceled))
+
+	// remaining replies of the aborted dump must not affect next request
+	ctx.mockVpp.MockReply(&ControlPingReply{})
+	err = ctx.ch.SendRequest(&ControlPing{}).ReceiveReply(&ControlPingReply{})
+	Expect(err).ShouldNot(HaveOccurred())
+}
+
+func TestRequestContextDeadline(t *testing.T) {
+	ctx := setupTest(t)
+	defer ctx.teardownTest()
+
+	// no reply mocked
+	reqCtx, cancel := context.WithTimeout(context.Background(), time.Millisecond)
+	defer cancel()
+	err := ctx.ch.(*Channel).SendRequestCtx(reqCtx, &ControlPing{}).ReceiveReply(&ControlPingReply{})
+	Expect(err).To(MatchError(context.DeadlineExceeded))
+}
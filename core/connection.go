@@ -15,9 +15,9 @@
 package core
 
 import (
+	"context"
 	"errors"
 	"fmt"
-	"path"
 	"reflect"
 	"sync"
 	"sync/atomic"
@@ -44,13 +44,14 @@ var (
 
 var (
 	HealthCheckProbeInterval = time.Second            // default health check probe interval
-	HealthCheckReplyTimeout  = time.Millisecond * 250 // timeout for reply to a health check probe
-	HealthCheckThreshold     = 2                      // number of failed health checks until the error is reported
+	HealthCheckReplyTimeout  = time.Millisecond * 250 // default timeout for reply to a health check probe
+	HealthCheckThreshold     = 2                      // default number of failed health checks until the error is reported
 )
 
 var (
 	DefaultReplyTimeout   = time.Duration(0) // default timeout for replies from VPP is disabled
 	WarnSlowReplyDuration = time.Second * 1  // duration of slow replies after which a warning is printed
+	ResubscribeTimeout    = time.Second * 1  // timeout for reply to a request re-enabling notifications after reconnect
 )
 
 // ConnectionState represents the current state of the connection to VPP.
@@ -96,28 +97,56 @@ type ConnectionEvent struct {
 
 	// Error holds error if any encountered.
 	Error error
+
+	// Subscriptions holds the outcome of restoring notification subscriptions
+	// after reconnect. It is empty for the initial connect.
+	Subscriptions []SubscriptionStatus
+}
+
+// SubscriptionStatus describes the outcome of restoring a single notification
+// subscription after the connection to VPP has been re-established.
+type SubscriptionStatus struct {
+	// Event holds the name of the subscribed event message.
+	Event string
+
+	// Request holds the name of the request enabling the notifications,
+	// it is empty if the subscription was registered without it.
+	Request string
+
+	// Error holds error if the subscription could not be restored.
+	Error error
 }
 
 // Connection represents a shared memory connection to VPP via vppAdapter.
 type Connection struct {
 	vppClient adapter.VppAPI // VPP binary API client
 
-	maxAttempts int           // interval for reconnect attempts
-	recInterval time.Duration // maximum number of reconnect attempts
+	maxAttempts int     // maximum number of reconnect attempts
+	backoff     Backoff // provides intervals between reconnect attempts
+
+	healthChecker            HealthChecker // sends health check probes
+	healthCheckProbeInterval time.Duration // interval between health check probes
+	healthCheckReplyTimeout  time.Duration // timeout for reply to a health check probe
+	healthCheckThreshold     int           // number of failed health checks until the error is reported
 
 	vppConnected uint32 // non-zero if the adapter is connected to VPP
+	shuttingDown uint32 // non-zero if the connection is being shut down (see Shutdown)
 
 	connChan             chan ConnectionEvent // connection status events are sent to this channel
 	healthCheckDone      chan struct{}        // used to terminate connect/health check loop
 	backgroundLoopActive uint32               // used to guard background loop from double close errors
 
+	connWatchersLock sync.RWMutex                    // lock for the connWatchers map
+	connWatchers     map[*ConnectionWatcher]struct{} // watchers of connection status events
+
 	async             bool          // connection to be operated in async mode
 	healthCheckExited chan struct{} // used to notify Disconnect() callers about healthcheck loop exit
+	connectedBefore   bool          // true if the connection has been established before (used by connect loop)
 
 	codec  MessageCodec      // message codec
 	msgIDs map[string]uint16 // map of message IDs indexed by message name + CRC
 
-	msgMapByPathLock sync.RWMutex                      // lock for the msgMapByPath map
+	msgMapByPathLock sync.RWMutex                      // lock for the message maps and the control ping messages and IDs
 	msgMapByPath     map[string]map[uint16]api.Message // map of messages indexed by message ID which are indexed by path
 
 	channelsLock sync.RWMutex        // lock for the channels map and the channel ID
@@ -127,8 +156,8 @@ type Connection struct {
 	subscriptionsLock sync.RWMutex                  // lock for the subscriptions map
 	subscriptions     map[uint16][]*subscriptionCtx // map od all notification subscriptions indexed by message ID
 
-	pingReqID   uint16 // ID if the ControlPing message
-	pingReplyID uint16 // ID of the ControlPingReply message
+	pingReqID   uint16 // ID if the ControlPing message (guarded by msgMapByPathLock)
+	pingReplyID uint16 // ID of the ControlPingReply message (guarded by msgMapByPathLock)
 
 	lastReplyLock sync.Mutex // lock for the last reply
 	lastReply     time.Time  // time of the last received reply from VPP
@@ -136,7 +165,54 @@ type Connection struct {
 	msgControlPing      api.Message
 	msgControlPingReply api.Message
 
-	apiTrace *trace // API tracer (disabled by default)
+	apiTrace *trace  // API tracer (disabled by default)
+	metrics  Metrics // metrics collector (disabled by default)
+	tracer   Tracer  // request tracer (no-op by default)
+
+	unaryInterceptors  []UnaryInterceptor  // interceptors for Invoke
+	streamInterceptors []StreamInterceptor // interceptors for NewStream
+	invoker            UnaryInvoker        // Invoke chained with interceptors
+	streamer           Streamer            // NewStream chained with interceptors
+}
+
+// ConnectionOption allows customizing a Connection.
+type ConnectionOption func(*Connection)
+
+// WithHealthChecker sets a custom health checker used to probe VPP.
+// By default, control ping is used as the probe.
+func WithHealthChecker(checker HealthChecker) ConnectionOption {
+	return func(c *Connection) {
+		c.healthChecker = checker
+	}
+}
+
+// WithHealthCheckProbeInterval sets the interval between health check probes.
+func WithHealthCheckProbeInterval(interval time.Duration) ConnectionOption {
+	return func(c *Connection) {
+		c.healthCheckProbeInterval = interval
+	}
+}
+
+// WithHealthCheckReplyTimeout sets the timeout for reply to a health check probe.
+func WithHealthCheckReplyTimeout(timeout time.Duration) ConnectionOption {
+	return func(c *Connection) {
+		c.healthCheckReplyTimeout = timeout
+	}
+}
+
+// WithHealthCheckThreshold sets the number of failed health checks until the error is reported.
+func WithHealthCheckThreshold(threshold int) ConnectionOption {
+	return func(c *Connection) {
+		c.healthCheckThreshold = threshold
+	}
+}
+
+// WithReconnectBackoff sets the strategy for intervals between reconnect attempts.
+// By default, the interval passed to AsyncConnect is used for every attempt.
+func WithReconnectBackoff(backoff Backoff) ConnectionOption {
+	return func(c *Connection) {
+		c.backoff = backoff
+	}
 }
 
 type backgroundLoopStatus int
@@ -146,7 +222,7 @@ const (
 	resume
 )
 
-func newConnection(binapi adapter.VppAPI, attempts int, interval time.Duration, async bool) *Connection {
+func newConnection(binapi adapter.VppAPI, attempts int, interval time.Duration, async bool, opts ...ConnectionOption) *Connection {
 	if attempts == 0 {
 		attempts = DefaultMaxReconnectAttempts
 	}
@@ -155,25 +231,37 @@ func newConnection(binapi adapter.VppAPI, attempts int, interval time.Duration,
 	}
 
 	c := &Connection{
-		vppClient:           binapi,
-		maxAttempts:         attempts,
-		recInterval:         interval,
-		connChan:            make(chan ConnectionEvent, NotificationChanBufSize),
-		healthCheckDone:     make(chan struct{}),
-		healthCheckExited:   make(chan struct{}),
-		async:               async,
-		codec:               codec.DefaultCodec,
-		msgIDs:              make(map[string]uint16),
-		msgMapByPath:        make(map[string]map[uint16]api.Message),
-		channels:            make(map[uint16]*Channel),
-		subscriptions:       make(map[uint16][]*subscriptionCtx),
-		msgControlPing:      msgControlPing,
-		msgControlPingReply: msgControlPingReply,
+		vppClient:                binapi,
+		maxAttempts:              attempts,
+		backoff:                  ConstantBackoff(interval),
+		healthCheckProbeInterval: HealthCheckProbeInterval,
+		healthCheckReplyTimeout:  HealthCheckReplyTimeout,
+		healthCheckThreshold:     HealthCheckThreshold,
+		connChan:                 make(chan ConnectionEvent, NotificationChanBufSize),
+		connWatchers:             make(map[*ConnectionWatcher]struct{}),
+		healthCheckDone:          make(chan struct{}),
+		healthCheckExited:        make(chan struct{}),
+		async:                    async,
+		codec:                    codec.DefaultCodec,
+		msgIDs:                   make(map[string]uint16),
+		msgMapByPath:             make(map[string]map[uint16]api.Message),
+		channels:                 make(map[uint16]*Channel),
+		subscriptions:            make(map[uint16][]*subscriptionCtx),
+		msgControlPing:           msgControlPing,
+		msgControlPingReply:      msgControlPingReply,
 		apiTrace: &trace{
 			list: make([]*api.Record, 0),
 			mux:  &sync.Mutex{},
 		},
 	}
+	c.healthChecker = HealthCheckerFunc(c.probeControlPing)
+	c.tracer = noopTracer{}
+
+	for _, opt := range opts {
+		opt(c)
+	}
+	c.invoker = chainUnaryInterceptors(c.unaryInterceptors, c.invoke)
+	c.streamer = chainStreamInterceptors(c.streamInterceptors, c.newStream)
 
 	var nextChannelID uint32
 	c.channelPool = genericpool.New[*Channel](func() *Channel {
@@ -201,9 +289,9 @@ func newConnection(binapi adapter.VppAPI, attempts int, interval time.Duration,
 // Connect connects to VPP API using specified adapter and returns a connection handle.
 // This call blocks until it is either connected, or an error occurs.
 // Only one connection attempt will be performed.
-func Connect(binapi adapter.VppAPI) (*Connection, error) {
+func Connect(binapi adapter.VppAPI, opts ...ConnectionOption) (*Connection, error) {
 	// create new connection handle
-	c := newConnection(binapi, DefaultMaxReconnectAttempts, DefaultReconnectInterval, false)
+	c := newConnection(binapi, DefaultMaxReconnectAttempts, DefaultReconnectInterval, false, opts...)
 
 	// blocking attempt to connect to VPP
 	if err := c.connectVPP(); err != nil {
@@ -217,10 +305,11 @@ func Connect(binapi adapter.VppAPI) (*Connection, error) {
 // and ConnectionState channel. This call does not block until connection is established, it
 // returns immediately. The caller is supposed to watch the returned ConnectionState channel for
 // Connected/Disconnected events. In case of disconnect, the library will asynchronously try to reconnect.
-func AsyncConnect(binapi adapter.VppAPI, attempts int, interval time.Duration) (*Connection, chan ConnectionEvent, error) {
+// The health checking and reconnecting can be customized using options.
+func AsyncConnect(binapi adapter.VppAPI, attempts int, interval time.Duration, opts ...ConnectionOption) (*Connection, chan ConnectionEvent, error) {
 
 	// create new connection handle
-	conn := newConnection(binapi, attempts, interval, true)
+	conn := newConnection(binapi, attempts, interval, true, opts...)
 
 	atomic.StoreUint32(&conn.backgroundLoopActive, 1)
 
@@ -271,6 +360,8 @@ func (c *Connection) Disconnect() {
 	if c.vppClient != nil {
 		c.disconnectVPP()
 	}
+
+	c.closeConnWatchers()
 }
 
 // disconnectVPP disconnects from VPP in case it is connected
@@ -299,6 +390,9 @@ func (c *Connection) newAPIChannel(reqChanBufSize, replyChanBufSize int) (*Chann
 	if c == nil {
 		return nil, errors.New("nil connection passed in")
 	}
+	if c.isShuttingDown() {
+		return nil, ErrShuttingDown
+	}
 
 	channel, err := c.newChannel(reqChanBufSize, replyChanBufSize)
 	if err != nil {
@@ -321,6 +415,12 @@ func (c *Connection) releaseAPIChannel(ch *Channel) {
 	c.channelsLock.Lock()
 	delete(c.channels, ch.id)
 	c.channelsLock.Unlock()
+
+	// requests not replied until now are not waited for anymore
+	ch.pendingRequests.Range(func(seqNum, _ interface{}) bool {
+		ch.pendingRequests.Delete(seqNum)
+		return true
+	})
 	go c.channelPool.Put(ch)
 }
 
@@ -350,8 +450,15 @@ func (c *Connection) connectLoop() backgroundLoopStatus {
 			log.Debugf("wait ready failed: %v", err)
 		}
 		if err := c.connectVPP(); err == nil {
+			event := ConnectionEvent{Timestamp: time.Now(), State: Connected}
+			if c.connectedBefore {
+				// VPP forgets all the registrations after restart
+				event.Subscriptions = c.restoreSubscriptions()
+			}
+			c.connectedBefore = true
+
 			// signal connected event
-			c.sendConnEvent(ConnectionEvent{Timestamp: time.Now(), State: Connected})
+			c.sendConnEvent(event)
 			return resume
 		} else if reconnectAttempts < c.maxAttempts {
 			reconnectAttempts++
@@ -366,7 +473,7 @@ func (c *Connection) connectLoop() backgroundLoopStatus {
 			// Terminate the connect loop on connection disconnect
 			log.Debug("Disconnected on request, exiting connect loop.")
 			return terminate
-		case <-time.After(c.recInterval):
+		case <-time.After(c.backoff.Next(reconnectAttempts)):
 		}
 	}
 }
@@ -382,13 +489,15 @@ func (c *Connection) healthCheckLoop() backgroundLoopStatus {
 	}
 	defer ch.Close()
 
+	ch.SetReplyTimeout(c.healthCheckReplyTimeout)
+
 	var (
 		sinceLastReply time.Duration
 		failedChecks   int
 	)
 
 	// send health check probes until an error or timeout occurs
-	probeInterval := time.NewTicker(HealthCheckProbeInterval)
+	probeInterval := time.NewTicker(c.healthCheckProbeInterval)
 	defer probeInterval.Stop()
 
 HealthCheck:
@@ -399,45 +508,32 @@ HealthCheck:
 			log.Debug("Disconnected on request, exiting health check loop.")
 			return terminate
 		case <-probeInterval.C:
-			// try draining probe replies from previous request before sending next one
-			select {
-			case <-ch.replyChan:
-				log.Debug("drained old probe reply from reply channel")
-			default:
+			t := time.Now()
+			err = c.healthChecker.Probe(ch)
+			if c.metrics != nil {
+				c.metrics.HealthCheckProbed(time.Since(t), err)
+			}
+			if errors.Is(err, ErrReplyTimeout) {
+				err = ErrProbeTimeout
 			}
 
-			// send the control ping request
-			ch.reqChan <- &vppRequest{msg: c.msgControlPing}
-
-			for {
-				// expect response within timeout period
-				select {
-				case vppReply := <-ch.replyChan:
-					err = vppReply.err
-
-				case <-time.After(HealthCheckReplyTimeout):
-					err = ErrProbeTimeout
-
-					// check if time since last reply from any other
-					// channel is less than health check reply timeout
-					c.lastReplyLock.Lock()
-					sinceLastReply = time.Since(c.lastReply)
-					c.lastReplyLock.Unlock()
+			if errors.Is(err, ErrProbeTimeout) {
+				// check if time since last reply from any other
+				// channel is less than health check reply timeout
+				c.lastReplyLock.Lock()
+				sinceLastReply = time.Since(c.lastReply)
+				c.lastReplyLock.Unlock()
 
-					if sinceLastReply < HealthCheckReplyTimeout {
-						log.Warnf("VPP health check probe timing out, but some request on other channel was received %v ago, continue waiting!", sinceLastReply)
-						continue
-					}
+				if sinceLastReply < c.healthCheckReplyTimeout {
+					log.Warnf("VPP health check probe timed out, but some request on other channel was received %v ago, continue checking!", sinceLastReply)
+					continue
 				}
-				break
-			}
 
-			if err == ErrProbeTimeout {
 				failedChecks++
-				log.Warnf("VPP health check probe timed out after %v (%d. timeout)", HealthCheckReplyTimeout, failedChecks)
-				if failedChecks > HealthCheckThreshold {
+				log.Warnf("VPP health check probe timed out after %v (%d. timeout)", c.healthCheckReplyTimeout, failedChecks)
+				if failedChecks > c.healthCheckThreshold {
 					// in case of exceeded failed check threshold, assume VPP unresponsive
-					log.Errorf("VPP does not responding, the health check exceeded threshold for timeouts (>%d)", HealthCheckThreshold)
+					log.Errorf("VPP does not responding, the health check exceeded threshold for timeouts (>%d)", c.healthCheckThreshold)
 					c.sendConnEvent(ConnectionEvent{Timestamp: time.Now(), State: NotResponding})
 					break HealthCheck
 				}
@@ -470,7 +566,7 @@ func getMsgID(name, crc string) string {
 
 func getMsgFactory(msg api.Message) func() api.Message {
 	return func() api.Message {
-		return reflect.New(reflect.TypeOf(msg).Elem()).Interface().(api.Message)
+		return api.NewMessage(msg)
 	}
 }
 
@@ -484,22 +580,30 @@ func (c *Connection) GetMessageID(msg api.Message) (uint16, error) {
 	if err != nil {
 		return 0, err
 	}
-	func() {
-		c.msgMapByPathLock.Lock()
-		defer c.msgMapByPathLock.Unlock()
+	c.msgMapByPathLock.Lock()
+	addMsgID(c.msgIDs, c.msgMapByPath, pkgPath, msg, msgID)
+	c.msgMapByPathLock.Unlock()
+	return msgID, nil
+}
 
-		if pathMsgs, pathOk := c.msgMapByPath[pkgPath]; !pathOk {
-			c.msgMapByPath[pkgPath] = make(map[uint16]api.Message)
-			c.msgMapByPath[pkgPath][msgID] = msg
-		} else if _, msgOk := pathMsgs[msgID]; !msgOk {
-			c.msgMapByPath[pkgPath][msgID] = msg
-		}
-	}()
-	if _, ok := c.msgIDs[getMsgNameWithCrc(msg)]; ok {
-		return msgID, nil
+// addMsgID adds the message with its ID into the message maps.
+func addMsgID(msgIDs map[string]uint16, msgMapByPath map[string]map[uint16]api.Message, pkgPath string, msg api.Message, msgID uint16) {
+	if pathMsgs, pathOk := msgMapByPath[pkgPath]; !pathOk {
+		msgMapByPath[pkgPath] = make(map[uint16]api.Message)
+		msgMapByPath[pkgPath][msgID] = msg
+	} else if _, msgOk := pathMsgs[msgID]; !msgOk {
+		msgMapByPath[pkgPath][msgID] = msg
 	}
-	c.msgIDs[getMsgNameWithCrc(msg)] = msgID
-	return msgID, nil
+	if _, ok := msgIDs[getMsgNameWithCrc(msg)]; !ok {
+		msgIDs[getMsgNameWithCrc(msg)] = msgID
+	}
+}
+
+// controlPing returns the control ping request and reply messages with their IDs.
+func (c *Connection) controlPing() (req api.Message, reqID uint16, reply api.Message, replyID uint16) {
+	c.msgMapByPathLock.RLock()
+	defer c.msgMapByPathLock.RUnlock()
+	return c.msgControlPing, c.pingReqID, c.msgControlPingReply, c.pingReplyID
 }
 
 // LookupByID looks up message name and crc by ID.
@@ -517,19 +621,29 @@ func (c *Connection) LookupByID(path string, msgID uint16) (api.Message, error)
 
 // GetMessagePath returns path for the given message
 func (c *Connection) GetMessagePath(msg api.Message) string {
-	return path.Dir(reflect.TypeOf(msg).Elem().PkgPath())
+	return api.GetMessagePath(msg)
 }
 
 // retrieveMessageIDs retrieves IDs for all registered messages and stores them in map
 func (c *Connection) retrieveMessageIDs() (err error) {
 	t := time.Now()
 
+	// IDs retrieved for previous connection may have changed after VPP restart,
+	// the new maps are built aside and replace the old ones at once
+	var (
+		msgIDs       = make(map[string]uint16)
+		msgMapByPath = make(map[string]map[uint16]api.Message)
+
+		pingReqID, pingReplyID uint16
+		pingReq, pingReply     = c.msgControlPing, c.msgControlPingReply
+	)
+
 	msgsByPath := api.GetRegisteredMessages()
 
 	var n int
 	for pkgPath, msgs := range msgsByPath {
 		for _, msg := range msgs {
-			msgID, err := c.GetMessageID(msg)
+			msgID, err := c.vppClient.GetMsgID(msg.GetMessageName(), msg.GetCrcString())
 			if err != nil {
 				if debugMsgIDs {
 					log.Debugf("retrieving message ID for %s.%s failed: %v",
@@ -537,14 +651,15 @@ func (c *Connection) retrieveMessageIDs() (err error) {
 				}
 				continue
 			}
+			addMsgID(msgIDs, msgMapByPath, c.GetMessagePath(msg), msg, msgID)
 			n++
 
-			if c.pingReqID == 0 && msg.GetMessageName() == c.msgControlPing.GetMessageName() {
-				c.pingReqID = msgID
-				c.msgControlPing = reflect.New(reflect.TypeOf(msg).Elem()).Interface().(api.Message)
-			} else if c.pingReplyID == 0 && msg.GetMessageName() == c.msgControlPingReply.GetMessageName() {
-				c.pingReplyID = msgID
-				c.msgControlPingReply = reflect.New(reflect.TypeOf(msg).Elem()).Interface().(api.Message)
+			if pingReqID == 0 && msg.GetMessageName() == pingReq.GetMessageName() {
+				pingReqID = msgID
+				pingReq = reflect.New(reflect.TypeOf(msg).Elem()).Interface().(api.Message)
+			} else if pingReplyID == 0 && msg.GetMessageName() == pingReply.GetMessageName() {
+				pingReplyID = msgID
+				pingReply = reflect.New(reflect.TypeOf(msg).Elem()).Interface().(api.Message)
 			}
 
 			if debugMsgIDs {
@@ -555,10 +670,105 @@ func (c *Connection) retrieveMessageIDs() (err error) {
 			Debugf("retrieved IDs for %d messages (registered %d) from path %s", n, len(msgs), pkgPath)
 	}
 
+	c.msgMapByPathLock.Lock()
+	c.msgIDs, c.msgMapByPath = msgIDs, msgMapByPath
+	c.pingReqID, c.pingReplyID = pingReqID, pingReplyID
+	c.msgControlPing, c.msgControlPingReply = pingReq, pingReply
+	c.msgMapByPathLock.Unlock()
+
 	return nil
 }
 
+// restoreSubscriptions re-resolves message IDs of all notification subscriptions, since they
+// may have changed after VPP restart, and re-sends requests enabling the notifications.
+// Subscriptions whose event message is not known to VPP anymore are dropped
+// and their notification channels are closed, so the subscribers stop waiting.
+func (c *Connection) restoreSubscriptions() []SubscriptionStatus {
+	c.subscriptionsLock.Lock()
+	var subs []*subscriptionCtx
+	for _, msgSubs := range c.subscriptions {
+		subs = append(subs, msgSubs...)
+	}
+	statuses := make([]SubscriptionStatus, len(subs))
+	c.subscriptions = make(map[uint16][]*subscriptionCtx)
+	for i, sub := range subs {
+		statuses[i].Event = sub.event.GetMessageName()
+		if sub.enableReq != nil {
+			statuses[i].Request = sub.enableReq.GetMessageName()
+		}
+		msgID, err := c.GetMessageID(sub.event)
+		if err != nil {
+			statuses[i].Error = fmt.Errorf("unable to retrieve event message ID: %w", err)
+			close(sub.notifChan)
+			continue
+		}
+		sub.msgID = msgID
+		c.subscriptions[msgID] = append(c.subscriptions[msgID], sub)
+	}
+	c.subscriptionsLock.Unlock()
+
+	// multiple subscriptions can share the same request, send it only once
+	var (
+		sentReqs []api.Message
+		sentErrs []error
+	)
+Subscriptions:
+	for i, sub := range subs {
+		if sub.enableReq == nil || statuses[i].Error != nil {
+			continue
+		}
+		for j, req := range sentReqs {
+			if reflect.DeepEqual(req, sub.enableReq) {
+				statuses[i].Error = sentErrs[j]
+				continue Subscriptions
+			}
+		}
+		ctx, cancel := context.WithTimeout(context.Background(), ResubscribeTimeout)
+		err := c.sendSubscriptionRequest(ctx, sub.enableReq)
+		cancel()
+		if err != nil {
+			err = fmt.Errorf("re-sending %s failed: %w", sub.enableReq.GetMessageName(), err)
+		}
+		sentReqs = append(sentReqs, sub.enableReq)
+		sentErrs = append(sentErrs, err)
+		statuses[i].Error = err
+	}
+
+	var failed int
+	for _, status := range statuses {
+		if status.Error != nil {
+			log.Warnf("restoring subscription for %s failed: %v", status.Event, status.Error)
+			failed++
+		}
+	}
+	log.Debugf("restored %d notification subscriptions (%d failed)", len(statuses)-failed, failed)
+
+	return statuses
+}
+
+// sendSubscriptionRequest sends a request enabling or disabling notifications and waits for its reply.
+func (c *Connection) sendSubscriptionRequest(ctx context.Context, req api.Message) error {
+	stream, err := c.openStream(ctx, WithReplyTimeout(ResubscribeTimeout))
+	if err != nil {
+		return err
+	}
+	defer func() { _ = stream.Close() }()
+
+	if err := stream.SendMsg(req); err != nil {
+		return err
+	}
+	reply, err := stream.RecvMsg()
+	if err != nil {
+		return err
+	}
+	return checkRequestReply(req, reply)
+}
+
 func (c *Connection) sendConnEvent(event ConnectionEvent) {
+	if c.metrics != nil {
+		c.metrics.ConnectionStateChanged(event.State)
+	}
+	c.notifyConnWatchers(event)
 	select {
 	case c.connChan <- event:
 	default:
@@ -572,7 +782,7 @@ func (c *Connection) Trace() api.Trace {
 }
 
 // trace records api message
-func (c *Connection) trace(msg api.Message, chId uint16, t time.Time, isReceived bool) {
+func (c *Connection) trace(msg api.Message, chId uint16, context uint32, seqNum uint16, t time.Time, isReceived bool) {
 	if atomic.LoadInt32(&c.apiTrace.isEnabled) == 0 {
 		return
 	}
@@ -581,8 +791,19 @@ func (c *Connection) trace(msg api.Message, chId uint16, t time.Time, isReceived
 		Timestamp:  t,
 		IsReceived: isReceived,
 		ChannelID:  chId,
+		Context:    context,
+		SeqNum:     seqNum,
+	}
+	if isReceived && context != 0 && msg.GetMessageType() == api.ReplyMessage {
+		// pair the reply with the request sent on the channel
+		c.channelsLock.RLock()
+		ch, ok := c.channels[chId]
+		c.channelsLock.RUnlock()
+		if ok {
+			if v, ok := ch.pendingRequests.Load(seqNum); ok {
+				entry.Latency = t.Sub(v.(*pendingRequest).sent)
+			}
+		}
 	}
-	c.apiTrace.mux.Lock()
-	c.apiTrace.list = append(c.apiTrace.list, entry)
-	c.apiTrace.mux.Unlock()
+	c.apiTrace.add(entry)
 }
@@ -15,8 +15,11 @@
 package core_test
 
 import (
+	"context"
 	"fmt"
 	"math"
+	"sync"
+	"sync/atomic"
 	"testing"
 	"time"
 
@@ -219,6 +222,388 @@ func TestAsyncConnectionDoubleDisconnect(t *testing.T) {
 	Expect(disconnectCalled).Should(BeEquivalentTo(1))
 }
 
+func TestAsyncConnectionRestoresSubscriptions(t *testing.T) {
+	ctx := setupTest(t, false)
+	defer ctx.teardownTest()
+
+	ctx.conn.Disconnect()
+
+	var (
+		droppedProbes int32 = 1
+		wantCalled    int32
+	)
+	ctx.mockVpp.MockReplyHandler(func(request mock.MessageDTO) ([]byte, uint16, bool) {
+		var reply api.Message
+		switch request.MsgName {
+		case "want_interface_events":
+			atomic.AddInt32(&wantCalled, 1)
+			reply = &interfaces.WantInterfaceEventsReply{}
+		case "sw_interface_event":
+			return request.Data, request.MsgID, true
+		default:
+			// control ping has fixed ID in the mock adapter
+			if request.MsgID != 100 || atomic.AddInt32(&droppedProbes, -1) >= 0 {
+				return nil, 0, false
+			}
+			reply = &memclnt.ControlPingReply{}
+		}
+		msgID, _ := ctx.mockVpp.GetMsgID(reply.GetMessageName(), reply.GetCrcString())
+		data, err := ctx.mockVpp.ReplyBytes(request, reply)
+		Expect(err).ShouldNot(HaveOccurred())
+		return data, msgID, true
+	})
+
+	conn, statusChan, err := core.AsyncConnect(ctx.mockVpp, core.DefaultMaxReconnectAttempts, core.DefaultReconnectInterval,
+		core.WithHealthCheckProbeInterval(10*time.Millisecond),
+		core.WithHealthCheckReplyTimeout(10*time.Millisecond),
+		core.WithHealthCheckThreshold(0),
+	)
+	ctx.conn = conn
+	Expect(err).ShouldNot(HaveOccurred())
+
+	ev := <-statusChan
+	Expect(ev.State).Should(BeEquivalentTo(core.Connected))
+	Expect(ev.Subscriptions).Should(BeEmpty())
+
+	watcher, err := conn.WatchEventWithRequest(context.Background(),
+		&interfaces.SwInterfaceEvent{}, &interfaces.WantInterfaceEvents{EnableDisable: 1})
+	Expect(err).ShouldNot(HaveOccurred())
+	defer watcher.Close()
+
+	// the first probe is dropped, which makes the connection to reconnect
+	ev = <-statusChan
+	Expect(ev.State).Should(BeEquivalentTo(core.NotResponding))
+	ev = <-statusChan
+	Expect(ev.State).Should(BeEquivalentTo(core.Connected))
+	Expect(ev.Subscriptions).Should(HaveLen(1))
+	Expect(ev.Subscriptions[0].Event).Should(Equal("sw_interface_event"))
+	Expect(ev.Subscriptions[0].Request).Should(Equal("want_interface_events"))
+	Expect(ev.Subscriptions[0].Error).ShouldNot(HaveOccurred())
+	Expect(atomic.LoadInt32(&wantCalled)).Should(BeEquivalentTo(1))
+
+	// events are still delivered to the watcher
+	msgID, err := conn.GetMessageID(&interfaces.SwInterfaceEvent{})
+	Expect(err).ShouldNot(HaveOccurred())
+	data, err := codec.DefaultCodec.EncodeMsg(&interfaces.SwInterfaceEvent{SwIfIndex: 5}, msgID)
+	Expect(err).ShouldNot(HaveOccurred())
+	Expect(ctx.mockVpp.SendMsg(0, data)).To(Succeed())
+
+	var event api.Message
+	Eventually(watcher.Events()).Should(Receive(&event))
+	Expect(event.(*interfaces.SwInterfaceEvent).SwIfIndex).To(BeEquivalentTo(5))
+}
+
+// unknownMsgAdapter fails to retrieve ID of the message after it is set unknown.
+type unknownMsgAdapter struct {
+	*mock.VppAdapter
+	unknown atomic.Value
+}
+
+func (a *unknownMsgAdapter) GetMsgID(msgName string, msgCrc string) (uint16, error) {
+	if name, _ := a.unknown.Load().(string); name == msgName {
+		return 0, fmt.Errorf("unknown message: %s", msgName)
+	}
+	return a.VppAdapter.GetMsgID(msgName, msgCrc)
+}
+
+func TestAsyncConnectionDropsUnknownSubscriptions(t *testing.T) {
+	ctx := setupTest(t, false)
+	defer ctx.teardownTest()
+
+	ctx.conn.Disconnect()
+
+	var droppedProbes int32 = 1
+	ctx.mockVpp.MockReplyHandler(func(request mock.MessageDTO) ([]byte, uint16, bool) {
+		// control ping has fixed ID in the mock adapter
+		if request.MsgID != 100 || atomic.AddInt32(&droppedProbes, -1) >= 0 {
+			return nil, 0, false
+		}
+		reply := &memclnt.ControlPingReply{}
+		msgID, _ := ctx.mockVpp.GetMsgID(reply.GetMessageName(), reply.GetCrcString())
+		data, err := ctx.mockVpp.ReplyBytes(request, reply)
+		Expect(err).ShouldNot(HaveOccurred())
+		return data, msgID, true
+	})
+
+	vppAdapter := &unknownMsgAdapter{VppAdapter: ctx.mockVpp}
+	conn, statusChan, err := core.AsyncConnect(vppAdapter, core.DefaultMaxReconnectAttempts, core.DefaultReconnectInterval,
+		core.WithHealthCheckProbeInterval(10*time.Millisecond),
+		core.WithHealthCheckReplyTimeout(10*time.Millisecond),
+		core.WithHealthCheckThreshold(0),
+	)
+	ctx.conn = conn
+	Expect(err).ShouldNot(HaveOccurred())
+
+	ev := <-statusChan
+	Expect(ev.State).Should(BeEquivalentTo(core.Connected))
+
+	watcher, err := conn.WatchEvent(context.Background(), &interfaces.SwInterfaceEvent{})
+	Expect(err).ShouldNot(HaveOccurred())
+	defer watcher.Close()
+
+	// the event is not known to VPP after reconnect
+	vppAdapter.unknown.Store("sw_interface_event")
+
+	ev = <-statusChan
+	Expect(ev.State).Should(BeEquivalentTo(core.NotResponding))
+	ev = <-statusChan
+	Expect(ev.State).Should(BeEquivalentTo(core.Connected))
+	Expect(ev.Subscriptions).Should(HaveLen(1))
+	Expect(ev.Subscriptions[0].Error).Should(HaveOccurred())
+
+	// the watcher is closed instead of waiting forever
+	Eventually(watcher.Events()).Should(BeClosed())
+}
+
+func TestAsyncConnectionCustomHealthCheck(t *testing.T) {
+	ctx := setupTest(t, false)
+	defer ctx.teardownTest()
+
+	ctx.conn.Disconnect()
+
+	var (
+		probes         int32
+		backoffAttempt int32
+	)
+	probeErr := fmt.Errorf("custom probe failed")
+	checker := core.HealthCheckerFunc(func(ch api.Channel) error {
+		if atomic.AddInt32(&probes, 1) == 1 {
+			return probeErr
+		}
+		return nil
+	})
+	backoff := backoffFunc(func(attempt int) time.Duration {
+		atomic.StoreInt32(&backoffAttempt, int32(attempt))
+		return time.Millisecond
+	})
+
+	connectCalled := 0
+	ctx.mockVpp.SetConnectCallback(func() {
+		// fail the first reconnect attempt
+		if connectCalled == 1 {
+			ctx.mockVpp.MockConnectError(fmt.Errorf("no VPP present"))
+		} else {
+			ctx.mockVpp.MockConnectError(nil)
+		}
+		connectCalled++
+	})
+
+	conn, statusChan, err := core.AsyncConnect(ctx.mockVpp, core.DefaultMaxReconnectAttempts, time.Hour,
+		core.WithHealthChecker(checker),
+		core.WithHealthCheckProbeInterval(10*time.Millisecond),
+		core.WithReconnectBackoff(backoff),
+	)
+	ctx.conn = conn
+	Expect(err).ShouldNot(HaveOccurred())
+
+	ev := <-statusChan
+	Expect(ev.State).Should(BeEquivalentTo(core.Connected))
+	ev = <-statusChan
+	Expect(ev.State).Should(BeEquivalentTo(core.Disconnected))
+	Expect(ev.Error).Should(MatchError(probeErr))
+	ev = <-statusChan
+	Expect(ev.State).Should(BeEquivalentTo(core.Connected))
+	Expect(connectCalled).Should(BeEquivalentTo(3))
+	Expect(atomic.LoadInt32(&backoffAttempt)).Should(BeEquivalentTo(1))
+}
+
+func TestConnectionWatchers(t *testing.T) {
+	ctx := setupTest(t, false)
+	defer ctx.teardownTest()
+
+	ctx.conn.Disconnect()
+
+	var probes int32
+	watching := make(chan struct{})
+	checker := core.HealthCheckerFunc(func(ch api.Channel) error {
+		// fail the first probe once the watchers are created
+		if atomic.AddInt32(&probes, 1) == 1 {
+			<-watching
+			return fmt.Errorf("probe failed")
+		}
+		return nil
+	})
+
+	conn, statusChan, err := core.AsyncConnect(ctx.mockVpp, core.DefaultMaxReconnectAttempts, time.Millisecond,
+		core.WithHealthChecker(checker),
+		core.WithHealthCheckProbeInterval(10*time.Millisecond),
+	)
+	ctx.conn = conn
+	Expect(err).ShouldNot(HaveOccurred())
+
+	Expect((<-statusChan).State).Should(BeEquivalentTo(core.Connected))
+
+	all := conn.WatchConnection(context.Background())
+	disconnected := conn.WatchConnection(context.Background(), core.Disconnected)
+	canceledCtx, cancel := context.WithCancel(context.Background())
+	canceled := conn.WatchConnection(canceledCtx)
+	cancel()
+	close(watching)
+
+	Expect((<-statusChan).State).Should(BeEquivalentTo(core.Disconnected))
+	Expect((<-statusChan).State).Should(BeEquivalentTo(core.Connected))
+	Eventually(canceled.Events()).Should(BeClosed())
+
+	// the events are queued until the watchers are closed by disconnect
+	conn.Disconnect()
+
+	var states []core.ConnectionState
+	for ev := range all.Events() {
+		states = append(states, ev.State)
+	}
+	Expect(states).To(Equal([]core.ConnectionState{core.Disconnected, core.Connected}))
+
+	states = nil
+	for ev := range disconnected.Events() {
+		states = append(states, ev.State)
+	}
+	Expect(states).To(Equal([]core.ConnectionState{core.Disconnected}))
+}
+
+func TestWatchEventFilter(t *testing.T) {
+	ctx := setupTest(t, false)
+	defer ctx.teardownTest()
+
+	ctx.mockVpp.MockReplyHandler(func(request mock.MessageDTO) ([]byte, uint16, bool) {
+		if request.MsgName == "sw_interface_event" {
+			return request.Data, request.MsgID, true
+		}
+		return nil, 0, false
+	})
+
+	watcher, err := ctx.conn.WatchEventWithOptions(context.Background(), &interfaces.SwInterfaceEvent{},
+		core.WithEventFilter(func(msg api.Message) bool {
+			return msg.(*interfaces.SwInterfaceEvent).SwIfIndex == 2
+		}))
+	Expect(err).ShouldNot(HaveOccurred())
+	defer watcher.Close()
+
+	msgID, err := ctx.conn.GetMessageID(&interfaces.SwInterfaceEvent{})
+	Expect(err).ShouldNot(HaveOccurred())
+	for _, idx := range []interface_types.InterfaceIndex{1, 2, 3, 2} {
+		data, err := codec.DefaultCodec.EncodeMsg(&interfaces.SwInterfaceEvent{SwIfIndex: idx}, msgID)
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(ctx.mockVpp.SendMsg(0, data)).To(Succeed())
+	}
+
+	for i := 0; i < 2; i++ {
+		var event api.Message
+		Eventually(watcher.Events()).Should(Receive(&event))
+		Expect(event.(*interfaces.SwInterfaceEvent).SwIfIndex).To(BeEquivalentTo(2))
+	}
+	Consistently(watcher.Events(), 50*time.Millisecond).ShouldNot(Receive())
+}
+
+func TestExponentialBackoff(t *testing.T) {
+	RegisterTestingT(t)
+
+	backoff := &core.ExponentialBackoff{
+		Initial: 100 * time.Millisecond,
+		Max:     time.Second,
+	}
+	Expect(backoff.Next(1)).To(Equal(100 * time.Millisecond))
+	Expect(backoff.Next(2)).To(Equal(200 * time.Millisecond))
+	Expect(backoff.Next(4)).To(Equal(800 * time.Millisecond))
+	Expect(backoff.Next(5)).To(Equal(time.Second))
+
+	backoff.Jitter = 0.5
+	for i := 0; i < 10; i++ {
+		Expect(backoff.Next(1)).To(BeNumerically("~", 100*time.Millisecond, 50*time.Millisecond))
+	}
+}
+
+type backoffFunc func(attempt int) time.Duration
+
+func (f backoffFunc) Next(attempt int) time.Duration {
+	return f(attempt)
+}
+
+type testMetrics struct {
+	sync.Mutex
+	sent     []string
+	received []string
+	errors   []error
+	timeouts []string
+	states   []core.ConnectionState
+}
+
+func (m *testMetrics) RequestSent(msgName string) {
+	m.Lock()
+	defer m.Unlock()
+	m.sent = append(m.sent, msgName)
+}
+
+func (m *testMetrics) RequestFailed(string, error) {}
+
+func (m *testMetrics) ReplyReceived(msgName string, _ time.Duration, err error) {
+	m.Lock()
+	defer m.Unlock()
+	m.received = append(m.received, msgName)
+	m.errors = append(m.errors, err)
+}
+
+func (m *testMetrics) ReplyTimedOut(msgName string) {
+	m.Lock()
+	defer m.Unlock()
+	m.timeouts = append(m.timeouts, msgName)
+}
+
+func (m *testMetrics) NotificationDropped(string) {}
+
+func (m *testMetrics) HealthCheckProbed(time.Duration, error) {}
+
+func (m *testMetrics) ConnectionStateChanged(state core.ConnectionState) {
+	m.Lock()
+	defer m.Unlock()
+	m.states = append(m.states, state)
+}
+
+func TestConnectionMetrics(t *testing.T) {
+	RegisterTestingT(t)
+
+	metrics := &testMetrics{}
+	mockVpp := mock.NewVppAdapter()
+	conn, err := core.Connect(mockVpp, core.WithMetrics(metrics))
+	Expect(err).ShouldNot(HaveOccurred())
+	defer conn.Disconnect()
+
+	ch, err := conn.NewAPIChannel()
+	Expect(err).ShouldNot(HaveOccurred())
+	defer ch.Close()
+	ch.SetReplyTimeout(time.Second)
+
+	// single request with error retval
+	mockVpp.MockReply(&interfaces.CreateLoopbackReply{Retval: -1})
+	err = ch.SendRequest(&interfaces.CreateLoopback{}).ReceiveReply(&interfaces.CreateLoopbackReply{})
+	Expect(err).Should(HaveOccurred())
+
+	// multipart request
+	mockVpp.MockReply(&interfaces.SwInterfaceDetails{}, &interfaces.SwInterfaceDetails{})
+	mockVpp.MockReply(&memclnt.ControlPingReply{})
+	reqCtx := ch.SendMultiRequest(&interfaces.SwInterfaceDump{})
+	for {
+		stop, err := reqCtx.ReceiveReply(&interfaces.SwInterfaceDetails{})
+		Expect(err).ShouldNot(HaveOccurred())
+		if stop {
+			break
+		}
+	}
+
+	// request without reply
+	ch.SetReplyTimeout(time.Millisecond)
+	err = ch.SendRequest(&interfaces.CreateLoopback{}).ReceiveReply(&interfaces.CreateLoopbackReply{})
+	Expect(err).Should(MatchError(core.ErrReplyTimeout))
+
+	metrics.Lock()
+	defer metrics.Unlock()
+	Expect(metrics.sent).To(Equal([]string{"create_loopback", "sw_interface_dump", "create_loopback"}))
+	Expect(metrics.received).To(Equal([]string{"create_loopback", "sw_interface_dump"}))
+	Expect(metrics.errors[0]).Should(HaveOccurred())
+	Expect(metrics.errors[1]).ShouldNot(HaveOccurred())
+	Expect(metrics.timeouts).To(Equal([]string{"create_loopback"}))
+	Expect(metrics.states).To(BeEmpty())
+}
+
 func TestCodec(t *testing.T) {
 	RegisterTestingT(t)
 
@@ -264,7 +649,7 @@ func TestCodecNegative(t *testing.T) {
 	// nil data for decoding
 	err = msgCodec.DecodeMsg(nil, &memclnt.ControlPingReply{})
 	Expect(err).Should(HaveOccurred())
-	Expect(err.Error()).To(ContainSubstring("panic"))
+	Expect(err).To(MatchError(codec.ErrShortBuffer))
 }
 
 func TestSimpleRequestsWithSequenceNumbers(t *testing.T) {
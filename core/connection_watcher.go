@@ -0,0 +1,185 @@
+//  Copyright (c) 2023 Cisco and/or its affiliates.
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at:
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package core
+
+import (
+	"context"
+	"sync"
+)
+
+// ConnectionWatcher provides access to connection state events. It can be
+// created by calling Connection.WatchConnection.
+//
+// Unlike the channel returned by AsyncConnect, events are never dropped for
+// a slow consumer, they are queued until received instead. The queue is not
+// bounded, since the connection state changes rarely.
+type ConnectionWatcher struct {
+	conn   *Connection
+	states []ConnectionState
+	events chan ConnectionEvent
+
+	mu       sync.Mutex
+	queue    []ConnectionEvent // events not yet received by the consumer
+	draining bool              // the watcher is closed once the queue is empty
+	pending  chan struct{}     // signals that the queue is not empty or draining is set
+
+	quit      chan struct{}
+	closeOnce sync.Once
+}
+
+// WatchConnection creates a new watcher for connection state events. If any
+// states are given, only events with one of them are delivered. Each watcher
+// receives all the events independently of the other watchers. The watcher
+// is closed when ctx is canceled, when Close is called or when the connection
+// is disconnected. In the last case, the events queued before the disconnect
+// are delivered before the events channel is closed.
+func (c *Connection) WatchConnection(ctx context.Context, states ...ConnectionState) *ConnectionWatcher {
+	w := &ConnectionWatcher{
+		conn:    c,
+		states:  states,
+		events:  make(chan ConnectionEvent),
+		pending: make(chan struct{}, 1),
+		quit:    make(chan struct{}),
+	}
+
+	c.connWatchersLock.Lock()
+	c.connWatchers[w] = struct{}{}
+	c.connWatchersLock.Unlock()
+
+	go w.watch(ctx)
+
+	return w
+}
+
+// Events returns a channel where connection events are sent. The channel
+// is closed when the watcher is closed.
+func (w *ConnectionWatcher) Events() <-chan ConnectionEvent {
+	return w.events
+}
+
+// Close closes the watcher along with the events channel.
+func (w *ConnectionWatcher) Close() {
+	w.closeOnce.Do(func() {
+		w.conn.connWatchersLock.Lock()
+		delete(w.conn.connWatchers, w)
+		w.conn.connWatchersLock.Unlock()
+
+		close(w.quit)
+	})
+}
+
+// push queues the event for delivery without blocking.
+func (w *ConnectionWatcher) push(event ConnectionEvent) {
+	if len(w.states) > 0 {
+		matched := false
+		for _, state := range w.states {
+			if event.State == state {
+				matched = true
+				break
+			}
+		}
+		if !matched {
+			return
+		}
+	}
+
+	w.mu.Lock()
+	w.queue = append(w.queue, event)
+	w.mu.Unlock()
+
+	w.signal()
+}
+
+// drain closes the watcher after all the queued events are delivered.
+func (w *ConnectionWatcher) drain() {
+	w.mu.Lock()
+	w.draining = true
+	w.mu.Unlock()
+
+	w.signal()
+}
+
+func (w *ConnectionWatcher) signal() {
+	select {
+	case w.pending <- struct{}{}:
+	default:
+	}
+}
+
+func (w *ConnectionWatcher) watch(ctx context.Context) {
+	defer close(w.events)
+
+	for {
+		select {
+		case <-ctx.Done():
+			w.Close()
+			return
+		case <-w.quit:
+			return
+		case <-w.pending:
+		}
+
+		w.mu.Lock()
+		queue, draining := w.queue, w.draining
+		w.queue = nil
+		w.mu.Unlock()
+
+		for _, event := range queue {
+			select {
+			case <-ctx.Done():
+				w.Close()
+				return
+			case <-w.quit:
+				return
+			case w.events <- event:
+			}
+		}
+		if draining {
+			// events queued during delivery are handled in the next iteration
+			w.mu.Lock()
+			empty := len(w.queue) == 0
+			w.mu.Unlock()
+			if empty {
+				w.Close()
+				return
+			}
+			w.signal()
+		}
+	}
+}
+
+// notifyConnWatchers queues the event for all connection watchers.
+func (c *Connection) notifyConnWatchers(event ConnectionEvent) {
+	c.connWatchersLock.RLock()
+	defer c.connWatchersLock.RUnlock()
+
+	for w := range c.connWatchers {
+		w.push(event)
+	}
+}
+
+// closeConnWatchers closes all connection watchers once they deliver the queued events.
+func (c *Connection) closeConnWatchers() {
+	c.connWatchersLock.RLock()
+	watchers := make([]*ConnectionWatcher, 0, len(c.connWatchers))
+	for w := range c.connWatchers {
+		watchers = append(watchers, w)
+	}
+	c.connWatchersLock.RUnlock()
+
+	for _, w := range watchers {
+		w.drain()
+	}
+}
@@ -0,0 +1,37 @@
+package core
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+
+	"go.fd.io/govpp/adapter/mock"
+)
+
+func TestConnectionWatcherNoDroppedEvents(t *testing.T) {
+	RegisterTestingT(t)
+
+	conn, err := Connect(mock.NewVppAdapter())
+	Expect(err).ShouldNot(HaveOccurred())
+	defer conn.Disconnect()
+
+	w := conn.WatchConnection(context.Background())
+	defer w.Close()
+
+	const numEvents = 1000
+	for i := 0; i < numEvents; i++ {
+		w.push(ConnectionEvent{State: ConnectionState(i)})
+	}
+
+	// all the events are delivered in order to the slow consumer
+	for i := 0; i < numEvents; i++ {
+		select {
+		case ev := <-w.Events():
+			Expect(ev.State).To(BeEquivalentTo(i))
+		case <-time.After(time.Second):
+			t.Fatalf("event %d not received", i)
+		}
+	}
+}
@@ -18,6 +18,9 @@
 //
 // Note that one application can open only one connection, that can serve multiple API channels.
 //
+// Disconnect closes the connection immediately. To let the requests in flight complete and disable
+// the notifications first, use Shutdown with a context limiting how long to wait for them.
+//
 // The API offers two ways of communication with govpp core: using Go channels, or using convenient function
 // wrappers over the Go channels. The latter should be sufficient for most of the use cases.
 //
@@ -68,6 +71,10 @@
 // Note that if the last reply has been already consumed, stop boolean return value is set to true.
 // Do not use the message itself if stop is true - it won't be filled with actual data.
 //
+// To be able to abort waiting for the replies (e.g. a long dump) without closing the channel,
+// use SendRequestCtx or SendMultiRequestCtx of the core Channel. Once the context is done,
+// ReceiveReply returns the context error.
+//
 // # Go Channels API
 //
 // The blocking API introduced above may be not sufficient for some management applications that strongly
@@ -103,4 +110,19 @@
 //
 // Note that the caller is responsible for creating the Go channel with preferred buffer size. If the channel's
 // buffer is full, the notifications will not be delivered into it.
+//
+// VPP forgets all the registrations for notifications after restart. To have them restored when
+// the connection is re-established by AsyncConnect, use SubscribeNotificationWithRequest (or WatchEventWithRequest)
+// with the request enabling the notifications. The outcome is reported in the Connected ConnectionEvent.
+//
+// To receive only some of the events, pass a predicate to WatchEventWithOptions using WithEventFilter.
+// Events not matching it are discarded before they are queued.
+//
+// The channel returned by AsyncConnect discards connection events if nobody reads it. Multiple consumers
+// can use WatchConnection instead, which queues the events for each of them until they are received:
+//
+//	watcher := conn.WatchConnection(ctx, core.Disconnected, core.Failed)
+//	for ev := range watcher.Events() {
+//		...
+//	}
 package core
@@ -0,0 +1,134 @@
+//  Copyright (c) 2023 Cisco and/or its affiliates.
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at:
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+//go:build go1.23
+
+package core
+
+import (
+	"context"
+	"fmt"
+	"iter"
+
+	"go.fd.io/govpp/api"
+)
+
+// Dump sends the dump request via a new stream of the connection and returns
+// an iterator over the received details messages. The control ping terminating
+// the dump is sent and handled internally. Iteration stops after the first
+// error, which is yielded with nil details. The stream is closed when the
+// iteration ends or when the loop is exited early. Canceling ctx aborts the dump.
+//
+//	for details, err := range core.Dump[interfaces.SwInterfaceDetails](ctx, conn, &interfaces.SwInterfaceDump{}) {
+//		if err != nil {
+//			// handle error!
+//		}
+//		// process details
+//	}
+func Dump[D any, PD interface {
+	*D
+	api.Message
+}](ctx context.Context, conn api.Connection, req api.Message) iter.Seq2[*D, error] {
+	return func(yield func(*D, error) bool) {
+		stream, err := conn.NewStream(ctx)
+		if err != nil {
+			yield(nil, err)
+			return
+		}
+		defer func() { _ = stream.Close() }()
+
+		if err := stream.SendMsg(req); err != nil {
+			yield(nil, err)
+			return
+		}
+		if err := stream.SendMsg(controlPingFor(req)); err != nil {
+			yield(nil, err)
+			return
+		}
+		for {
+			msg, err := stream.RecvMsg()
+			if err != nil {
+				yield(nil, err)
+				return
+			}
+			if msg.GetMessageName() == msgControlPingReply.GetMessageName() {
+				return
+			}
+			details, ok := msg.(PD)
+			if !ok {
+				yield(nil, fmt.Errorf("unexpected message %s received for %s", msg.GetMessageName(), req.GetMessageName()))
+				return
+			}
+			if !yield((*D)(details), nil) {
+				return
+			}
+		}
+	}
+}
+
+// controlPingFor returns a new control ping message from the same binapi path as the
+// given message, so that the stream can resolve the replies to both of them.
+func controlPingFor(msg api.Message) api.Message {
+	if ping, ok := api.GetRegisteredMessages()[api.GetMessagePath(msg)][getMsgNameWithCrc(msgControlPing)]; ok {
+		return getMsgFactory(ping)()
+	}
+	return getMsgFactory(msgControlPing)()
+}
+
+// DumpChannel sends the dump request as a multipart request via the channel
+// and returns an iterator over the received details messages like Dump does.
+// Canceling ctx aborts the dump if the channel is a core Channel.
+func DumpChannel[D any, PD interface {
+	*D
+	api.Message
+}](ctx context.Context, ch api.Channel, req api.Message) iter.Seq2[*D, error] {
+	return func(yield func(*D, error) bool) {
+		ctx, cancel := context.WithCancel(ctx)
+		defer cancel()
+
+		var reqCtx api.MultiRequestCtx
+		if coreCh, ok := ch.(*Channel); ok {
+			reqCtx = coreCh.SendMultiRequestCtx(ctx, req)
+		} else {
+			reqCtx = ch.SendMultiRequest(req)
+		}
+		for {
+			details := PD(new(D))
+			stop, err := reqCtx.ReceiveReply(details)
+			if err != nil {
+				yield(nil, err)
+				return
+			}
+			if stop {
+				return
+			}
+			if !yield((*D)(details), nil) {
+				return
+			}
+		}
+	}
+}
+
+// CollectDump collects all details from the dump iterator into a slice.
+// It returns the details received before the first error along with the error.
+func CollectDump[D any](seq iter.Seq2[*D, error]) ([]*D, error) {
+	var list []*D
+	for details, err := range seq {
+		if err != nil {
+			return list, err
+		}
+		list = append(list, details)
+	}
+	return list, nil
+}
@@ -0,0 +1,80 @@
+//go:build go1.23
+
+package core_test
+
+import (
+	"context"
+	"testing"
+
+	. "github.com/onsi/gomega"
+
+	"go.fd.io/govpp/adapter/mock"
+	"go.fd.io/govpp/api"
+	interfaces "go.fd.io/govpp/binapi/interface"
+	"go.fd.io/govpp/binapi/interface_types"
+	"go.fd.io/govpp/binapi/memclnt"
+	"go.fd.io/govpp/core"
+)
+
+func mockInterfaceDump(mockVpp *mock.VppAdapter, n int) {
+	var msgs []api.Message
+	for i := 0; i < n; i++ {
+		msgs = append(msgs, &interfaces.SwInterfaceDetails{SwIfIndex: interface_types.InterfaceIndex(i)})
+	}
+	mockVpp.MockReply(msgs...)
+	mockVpp.MockReply(&memclnt.ControlPingReply{})
+}
+
+func TestDump(t *testing.T) {
+	RegisterTestingT(t)
+
+	mockVpp := mock.NewVppAdapter()
+	conn, err := core.Connect(mockVpp)
+	Expect(err).ShouldNot(HaveOccurred())
+	defer conn.Disconnect()
+
+	mockInterfaceDump(mockVpp, 5)
+	var idx []interface_types.InterfaceIndex
+	for details, err := range core.Dump[interfaces.SwInterfaceDetails](context.Background(), conn, &interfaces.SwInterfaceDump{}) {
+		Expect(err).ShouldNot(HaveOccurred())
+		idx = append(idx, details.SwIfIndex)
+	}
+	Expect(idx).To(Equal([]interface_types.InterfaceIndex{0, 1, 2, 3, 4}))
+
+	// exit the loop early
+	mockInterfaceDump(mockVpp, 5)
+	n := 0
+	for range core.Dump[interfaces.SwInterfaceDetails](context.Background(), conn, &interfaces.SwInterfaceDump{}) {
+		if n++; n == 2 {
+			break
+		}
+	}
+	Expect(n).To(Equal(2))
+
+	// canceled context
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	mockInterfaceDump(mockVpp, 5)
+	list, err := core.CollectDump(core.Dump[interfaces.SwInterfaceDetails](ctx, conn, &interfaces.SwInterfaceDump{}))
+	Expect(err).To(MatchError(context.Canceled))
+	Expect(list).To(BeEmpty())
+}
+
+func TestDumpChannel(t *testing.T) {
+	RegisterTestingT(t)
+
+	mockVpp := mock.NewVppAdapter()
+	conn, err := core.Connect(mockVpp)
+	Expect(err).ShouldNot(HaveOccurred())
+	defer conn.Disconnect()
+
+	ch, err := conn.NewAPIChannel()
+	Expect(err).ShouldNot(HaveOccurred())
+	defer ch.Close()
+
+	mockInterfaceDump(mockVpp, 3)
+	list, err := core.CollectDump(core.DumpChannel[interfaces.SwInterfaceDetails](context.Background(), ch, &interfaces.SwInterfaceDump{}))
+	Expect(err).ShouldNot(HaveOccurred())
+	Expect(list).To(HaveLen(3))
+	Expect(list[2].SwIfIndex).To(BeEquivalentTo(2))
+}
@@ -0,0 +1,94 @@
+//  Copyright (c) 2023 Cisco and/or its affiliates.
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at:
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package core
+
+import (
+	"math"
+	"math/rand"
+	"time"
+
+	"go.fd.io/govpp/api"
+)
+
+// HealthChecker checks whether VPP is alive. It is used by the health check
+// loop of a connection created with AsyncConnect.
+type HealthChecker interface {
+	// Probe sends a single health check probe to VPP using the given API channel
+	// and waits for the reply. The reply timeout of the channel is set to the health
+	// check reply timeout of the connection. Probe should return ErrProbeTimeout
+	// (or ErrReplyTimeout) if the reply did not arrive in time, any other error
+	// is treated as VPP being disconnected.
+	Probe(ch api.Channel) error
+}
+
+// HealthCheckerFunc is an adapter to allow the use of ordinary functions as HealthChecker.
+type HealthCheckerFunc func(ch api.Channel) error
+
+// Probe calls f(ch).
+func (f HealthCheckerFunc) Probe(ch api.Channel) error {
+	return f(ch)
+}
+
+// probeControlPing is the default health check probe sending control ping.
+func (c *Connection) probeControlPing(ch api.Channel) error {
+	ping, _, pingReply, _ := c.controlPing()
+	return ch.SendRequest(ping).ReceiveReply(getMsgFactory(pingReply)())
+}
+
+// Backoff provides intervals between reconnect attempts.
+type Backoff interface {
+	// Next returns the duration to wait before the given reconnect attempt.
+	// The attempts are numbered from 1.
+	Next(attempt int) time.Duration
+}
+
+// ConstantBackoff is a Backoff that always waits the same interval.
+type ConstantBackoff time.Duration
+
+// Next returns the constant interval.
+func (b ConstantBackoff) Next(int) time.Duration {
+	return time.Duration(b)
+}
+
+// ExponentialBackoff is a Backoff with exponentially growing intervals and optional jitter.
+type ExponentialBackoff struct {
+	// Initial is the interval before the first attempt.
+	Initial time.Duration
+	// Max caps the interval, zero means no limit.
+	Max time.Duration
+	// Multiplier is the factor by which the interval grows with each attempt, defaults to 2.
+	Multiplier float64
+	// Jitter randomizes the interval by up to the given fraction (0-1) of its value.
+	Jitter float64
+}
+
+// Next returns the interval for the given attempt.
+func (b *ExponentialBackoff) Next(attempt int) time.Duration {
+	multiplier := b.Multiplier
+	if multiplier <= 0 {
+		multiplier = 2
+	}
+	if attempt < 1 {
+		attempt = 1
+	}
+	d := float64(b.Initial) * math.Pow(multiplier, float64(attempt-1))
+	if b.Max > 0 && d > float64(b.Max) {
+		d = float64(b.Max)
+	}
+	if b.Jitter > 0 {
+		d += d * b.Jitter * (2*rand.Float64() - 1)
+	}
+	return time.Duration(d)
+}
@@ -0,0 +1,72 @@
+//  Copyright (c) 2023 Cisco and/or its affiliates.
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at:
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package core
+
+import (
+	"context"
+
+	"go.fd.io/govpp/api"
+)
+
+// UnaryInvoker is called by UnaryInterceptor to complete the request-reply RPC.
+type UnaryInvoker func(ctx context.Context, req api.Message, reply api.Message) error
+
+// UnaryInterceptor intercepts Connection.Invoke. It can inspect or modify the request,
+// reply and error, and it is responsible for calling invoker to complete the RPC.
+type UnaryInterceptor func(ctx context.Context, req api.Message, reply api.Message, invoker UnaryInvoker) error
+
+// Streamer is called by StreamInterceptor to create the stream.
+type Streamer func(ctx context.Context, options ...api.StreamOption) (api.Stream, error)
+
+// StreamInterceptor intercepts Connection.NewStream. It is responsible for calling
+// streamer to create the stream and it can wrap the returned stream to intercept
+// SendMsg and RecvMsg calls.
+type StreamInterceptor func(ctx context.Context, streamer Streamer, options ...api.StreamOption) (api.Stream, error)
+
+// WithUnaryInterceptors adds interceptors for Invoke calls. The first interceptor
+// is the outermost one, i.e. it is called first and returns last.
+func WithUnaryInterceptors(interceptors ...UnaryInterceptor) ConnectionOption {
+	return func(c *Connection) {
+		c.unaryInterceptors = append(c.unaryInterceptors, interceptors...)
+	}
+}
+
+// WithStreamInterceptors adds interceptors for NewStream calls. The first interceptor
+// is the outermost one, i.e. it is called first and returns last.
+func WithStreamInterceptors(interceptors ...StreamInterceptor) ConnectionOption {
+	return func(c *Connection) {
+		c.streamInterceptors = append(c.streamInterceptors, interceptors...)
+	}
+}
+
+func chainUnaryInterceptors(interceptors []UnaryInterceptor, invoker UnaryInvoker) UnaryInvoker {
+	for i := len(interceptors) - 1; i >= 0; i-- {
+		interceptor, next := interceptors[i], invoker
+		invoker = func(ctx context.Context, req api.Message, reply api.Message) error {
+			return interceptor(ctx, req, reply, next)
+		}
+	}
+	return invoker
+}
+
+func chainStreamInterceptors(interceptors []StreamInterceptor, streamer Streamer) Streamer {
+	for i := len(interceptors) - 1; i >= 0; i-- {
+		interceptor, next := interceptors[i], streamer
+		streamer = func(ctx context.Context, options ...api.StreamOption) (api.Stream, error) {
+			return interceptor(ctx, next, options...)
+		}
+	}
+	return streamer
+}
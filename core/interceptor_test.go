@@ -0,0 +1,115 @@
+package core_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	. "github.com/onsi/gomega"
+
+	"go.fd.io/govpp/adapter/mock"
+	"go.fd.io/govpp/api"
+	interfaces "go.fd.io/govpp/binapi/interface"
+	"go.fd.io/govpp/binapi/interface_types"
+	"go.fd.io/govpp/binapi/memclnt"
+	"go.fd.io/govpp/core"
+)
+
+func TestUnaryInterceptors(t *testing.T) {
+	RegisterTestingT(t)
+
+	var calls []string
+	interceptor := func(name string) core.UnaryInterceptor {
+		return func(ctx context.Context, req, reply api.Message, invoker core.UnaryInvoker) error {
+			calls = append(calls, name+":"+req.GetMessageName())
+			err := invoker(ctx, req, reply)
+			calls = append(calls, name+":"+reply.GetMessageName())
+			return err
+		}
+	}
+
+	mockVpp := mock.NewVppAdapter()
+	conn, err := core.Connect(mockVpp, core.WithUnaryInterceptors(interceptor("first"), interceptor("second")))
+	Expect(err).ShouldNot(HaveOccurred())
+	defer conn.Disconnect()
+
+	// generated RPC client passes through the interceptors
+	mockVpp.MockReply(&interfaces.CreateLoopbackReply{SwIfIndex: 3})
+	reply, err := interfaces.NewServiceClient(conn).CreateLoopback(context.Background(), &interfaces.CreateLoopback{})
+	Expect(err).ShouldNot(HaveOccurred())
+	Expect(reply.SwIfIndex).To(BeEquivalentTo(3))
+	Expect(calls).To(Equal([]string{
+		"first:create_loopback",
+		"second:create_loopback",
+		"second:create_loopback_reply",
+		"first:create_loopback_reply",
+	}))
+}
+
+func TestUnaryInterceptorShortCircuit(t *testing.T) {
+	RegisterTestingT(t)
+
+	errDenied := errors.New("denied")
+	mockVpp := mock.NewVppAdapter()
+	conn, err := core.Connect(mockVpp, core.WithUnaryInterceptors(
+		func(ctx context.Context, req, reply api.Message, invoker core.UnaryInvoker) error {
+			return errDenied
+		}))
+	Expect(err).ShouldNot(HaveOccurred())
+	defer conn.Disconnect()
+
+	err = conn.Invoke(context.Background(), &interfaces.CreateLoopback{}, &interfaces.CreateLoopbackReply{})
+	Expect(err).To(MatchError(errDenied))
+}
+
+type countingStream struct {
+	api.Stream
+	sent, received *int
+}
+
+func (s *countingStream) SendMsg(msg api.Message) error {
+	*s.sent++
+	return s.Stream.SendMsg(msg)
+}
+
+func (s *countingStream) RecvMsg() (api.Message, error) {
+	*s.received++
+	return s.Stream.RecvMsg()
+}
+
+func TestStreamInterceptors(t *testing.T) {
+	RegisterTestingT(t)
+
+	var sent, received int
+	mockVpp := mock.NewVppAdapter()
+	conn, err := core.Connect(mockVpp, core.WithStreamInterceptors(
+		func(ctx context.Context, streamer core.Streamer, options ...api.StreamOption) (api.Stream, error) {
+			stream, err := streamer(ctx, options...)
+			if err != nil {
+				return nil, err
+			}
+			return &countingStream{Stream: stream, sent: &sent, received: &received}, nil
+		}))
+	Expect(err).ShouldNot(HaveOccurred())
+	defer conn.Disconnect()
+
+	// generated RPC client for dump passes through the interceptors
+	mockVpp.MockReply(
+		&interfaces.SwInterfaceDetails{SwIfIndex: 1},
+		&interfaces.SwInterfaceDetails{SwIfIndex: 2},
+	)
+	mockVpp.MockReply(&memclnt.ControlPingReply{})
+	client, err := interfaces.NewServiceClient(conn).SwInterfaceDump(context.Background(), &interfaces.SwInterfaceDump{})
+	Expect(err).ShouldNot(HaveOccurred())
+	var ifaces []interface_types.InterfaceIndex
+	for {
+		details, err := client.Recv()
+		if err != nil {
+			break
+		}
+		ifaces = append(ifaces, details.SwIfIndex)
+	}
+	Expect(ifaces).To(Equal([]interface_types.InterfaceIndex{1, 2}))
+	Expect(sent).To(Equal(2))
+	Expect(received).To(Equal(3))
+}
@@ -0,0 +1,84 @@
+//  Copyright (c) 2023 Cisco and/or its affiliates.
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at:
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package core
+
+import (
+	"time"
+)
+
+// Metrics collects metrics about the behaviour of a connection. The methods
+// are called synchronously from the connection internals, so they must be
+// safe for concurrent use and should not block.
+type Metrics interface {
+	// RequestSent is called after a request message has been sent to VPP.
+	RequestSent(msgName string)
+
+	// RequestFailed is called when a request message could not be sent to VPP.
+	RequestFailed(msgName string, err error)
+
+	// ReplyReceived is called when the reply (or the last reply of a multipart
+	// reply) to a request has been received from VPP. The latency is the time
+	// since the request was sent and err holds VPPApiError if the reply
+	// contained non-zero retval.
+	ReplyReceived(msgName string, latency time.Duration, err error)
+
+	// ReplyTimedOut is called when the reply to a request has not been
+	// received within the reply timeout.
+	ReplyTimedOut(msgName string)
+
+	// NotificationDropped is called when a notification message could not be
+	// delivered to a subscriber.
+	NotificationDropped(msgName string)
+
+	// HealthCheckProbed is called after each health check probe.
+	HealthCheckProbed(duration time.Duration, err error)
+
+	// ConnectionStateChanged is called when a connection event is emitted.
+	ConnectionStateChanged(state ConnectionState)
+}
+
+// WithMetrics sets metrics collector for the connection.
+func WithMetrics(metrics Metrics) ConnectionOption {
+	return func(c *Connection) {
+		c.metrics = metrics
+	}
+}
+
+// pendingRequest is a request waiting for reply, tracked for metrics and graceful shutdown.
+type pendingRequest struct {
+	msgName string
+	sent    time.Time
+}
+
+// trackRequest stores the request sent on the channel to measure its reply latency.
+func (ch *Channel) trackRequest(seqNum uint16, msgName string, sent time.Time) {
+	ch.pendingRequests.Store(seqNum, &pendingRequest{msgName: msgName, sent: sent})
+}
+
+// untrackRequest removes and returns the tracked request with the given sequence number.
+func (ch *Channel) untrackRequest(seqNum uint16) (*pendingRequest, bool) {
+	v, ok := ch.pendingRequests.LoadAndDelete(seqNum)
+	if !ok {
+		return nil, false
+	}
+	return v.(*pendingRequest), true
+}
+
+// replyTimedOut reports reply timeout of the request with the given sequence number.
+func (ch *Channel) replyTimedOut(seqNum uint16) {
+	if req, ok := ch.untrackRequest(seqNum); ok && ch.conn.metrics != nil {
+		ch.conn.metrics.ReplyTimedOut(req.msgName)
+	}
+}
@@ -17,13 +17,14 @@ package core
 import (
 	"errors"
 	"fmt"
-	"reflect"
 	"sync/atomic"
 	"time"
 
 	logger "github.com/sirupsen/logrus"
 
+	"go.fd.io/govpp/adapter"
 	"go.fd.io/govpp/api"
+	"go.fd.io/govpp/codec"
 )
 
 var ReplyChannelTimeout = time.Millisecond * 100
@@ -31,6 +32,7 @@ var ReplyChannelTimeout = time.Millisecond * 100
 var (
 	ErrNotConnected = errors.New("not connected to VPP, ignoring the request")
 	ErrProbeTimeout = errors.New("probe reply not received within timeout period")
+	ErrReplyTimeout = errors.New("no reply received within the timeout period")
 )
 
 // watchRequests watches for requests on the request API channel and forwards them as messages to VPP.
@@ -53,7 +55,15 @@ func (c *Connection) watchRequests(ch *Channel) {
 }
 
 // processRequest processes a single request received on the request channel.
-func (c *Connection) processRequest(ch *Channel, req *vppRequest) error {
+func (c *Connection) processRequest(ch *Channel, req *vppRequest) (err error) {
+	if c.metrics != nil {
+		defer func() {
+			if err != nil {
+				c.metrics.RequestFailed(req.msg.GetMessageName(), err)
+			}
+		}()
+	}
+
 	// check whether we are connected to VPP
 	if atomic.LoadUint32(&c.vppConnected) == 0 {
 		err := ErrNotConnected
@@ -80,8 +90,16 @@ func (c *Connection) processRequest(ch *Channel, req *vppRequest) error {
 		return err
 	}
 
-	// encode the message into binary
-	data, err := c.codec.EncodeMsg(req.msg, msgID)
+	// encode the message into binary, the pooled buffer is used only
+	// if the adapter does not retain the sent data
+	send := c.vppClient.SendMsg
+	var buf *[]byte
+	if sender, ok := c.vppClient.(adapter.BorrowingMsgSender); ok {
+		send = sender.SendMsgBorrowed
+		buf = codec.GetBuffer()
+		defer codec.PutBuffer(buf)
+	}
+	data, err := c.encodeMsg(buf, req.msg, msgID)
 	if err != nil {
 		log.WithFields(logger.Fields{
 			"channel":  ch.id,
@@ -111,8 +129,11 @@ func (c *Connection) processRequest(ch *Channel, req *vppRequest) error {
 
 	// send the request to VPP
 	t := time.Now()
-	err = c.vppClient.SendMsg(context, data)
+	// track the request before sending, the reply may come before SendMsg returns
+	ch.trackRequest(req.seqNum, req.msg.GetMessageName(), t)
+	err = send(context, data)
 	if err != nil {
+		ch.untrackRequest(req.seqNum)
 		log.WithFields(logger.Fields{
 			"channel":  ch.id,
 			"msg_id":   msgID,
@@ -126,38 +147,64 @@ func (c *Connection) processRequest(ch *Channel, req *vppRequest) error {
 		}).Warnf("Unable to send message")
 		return err
 	}
-	c.trace(req.msg, ch.id, t, false)
+	c.trace(req.msg, ch.id, context, req.seqNum, t, false)
+	if c.metrics != nil {
+		c.metrics.RequestSent(req.msg.GetMessageName())
+	}
 
 	if req.multi {
 		// send a control ping to determine end of the multipart response
-		pingData, _ := c.codec.EncodeMsg(c.msgControlPing, c.pingReqID)
+		ping, pingID, _, _ := c.controlPing()
+		if buf != nil {
+			*buf = data // the request was sent, the buffer can be reused
+		}
+		pingData, _ := c.encodeMsg(buf, ping, pingID)
 
 		if log.Level >= logger.DebugLevel {
 			log.WithFields(logger.Fields{
 				"channel":  ch.id,
-				"msg_id":   c.pingReqID,
-				"msg_name": c.msgControlPing.GetMessageName(),
-				"msg_crc":  c.msgControlPing.GetCrcString(),
+				"msg_id":   pingID,
+				"msg_name": ping.GetMessageName(),
+				"msg_crc":  ping.GetCrcString(),
 				"seq_num":  req.seqNum,
 				"context":  context,
 				"data_len": len(pingData),
-			}).Debugf(" -> SEND MSG: %T", c.msgControlPing)
+			}).Debugf(" -> SEND MSG: %T", ping)
 		}
 
 		t = time.Now()
-		if err := c.vppClient.SendMsg(context, pingData); err != nil {
+		if err := send(context, pingData); err != nil {
 			log.WithFields(logger.Fields{
 				"context": context,
 				"seq_num": req.seqNum,
 				"error":   err,
 			}).Warnf("unable to send control ping")
 		}
-		c.trace(c.msgControlPing, ch.id, t, false)
+		c.trace(ping, ch.id, context, req.seqNum, t, false)
+		data = pingData
+	}
+	if buf != nil {
+		*buf = data // keep the grown buffer in the pool
 	}
 
 	return nil
 }
 
+// bufferEncoder is implemented by the message codecs able to encode
+// the messages into a supplied buffer.
+type bufferEncoder interface {
+	EncodeMsgTo(buf []byte, msg api.Message, msgID uint16) ([]byte, error)
+}
+
+// encodeMsg encodes the message into the pooled buffer if it is given and the
+// codec supports it, otherwise the encoded message is newly allocated.
+func (c *Connection) encodeMsg(buf *[]byte, msg api.Message, msgID uint16) ([]byte, error) {
+	if encoder, ok := c.codec.(bufferEncoder); ok && buf != nil {
+		return encoder.EncodeMsgTo((*buf)[:0], msg, msgID)
+	}
+	return c.codec.EncodeMsg(msg, msgID)
+}
+
 // msgCallback is called whenever any binary API message comes from VPP.
 func (c *Connection) msgCallback(msgID uint16, data []byte) {
 	if c == nil {
@@ -187,12 +234,12 @@ func (c *Connection) msgCallback(msgID uint16, data []byte) {
 	chanID, isMulti, seqNum := unpackRequestContext(context)
 
 	// decode and trace the message
-	msg = reflect.New(reflect.TypeOf(msg).Elem()).Interface().(api.Message)
+	msg = api.NewMessage(msg)
 	if err = c.codec.DecodeMsg(data, msg); err != nil {
 		log.WithField("msg", msg).Warnf("Unable to decode message: %v", err)
 		return
 	}
-	c.trace(msg, chanID, time.Now(), true)
+	c.trace(msg, chanID, context, seqNum, time.Now(), true)
 
 	if log.Level == logger.DebugLevel { // for performance reasons - logrus does some processing even if debugs are disabled
 		log.WithFields(logger.Fields{
@@ -226,7 +273,17 @@ func (c *Connection) msgCallback(msgID uint16, data []byte) {
 
 	// if this is a control ping reply to a multipart request,
 	// treat this as a last part of the reply
-	lastReplyReceived := isMulti && msgID == c.pingReplyID
+	var lastReplyReceived bool
+	if isMulti {
+		_, _, _, pingReplyID := c.controlPing()
+		lastReplyReceived = msgID == pingReplyID
+	}
+
+	if !isMulti || lastReplyReceived {
+		if req, ok := ch.untrackRequest(seqNum); ok && c.metrics != nil {
+			c.metrics.ReplyReceived(req.msgName, time.Since(req.sent), checkReplyRetval(msg))
+		}
+	}
 
 	// send the data to the channel, it needs to be copied,
 	// because it will be freed after this function returns
@@ -310,6 +367,11 @@ func (c *Connection) sendNotifications(msgID uint16, data []byte) {
 			}).Warnf("Unable to decode the notification message")
 			continue
 		}
+		matched = true
+
+		if sub.filter != nil && !sub.filter(event) {
+			continue
+		}
 
 		// send the message into the go channel of the subscription
 		select {
@@ -322,9 +384,10 @@ func (c *Connection) sendNotifications(msgID uint16, data []byte) {
 				"msg_id":   msgID,
 				"msg_size": len(data),
 			}).Warn("Unable to deliver the notification, reciever end not ready.")
+			if c.metrics != nil {
+				c.metrics.NotificationDropped(sub.event.GetMessageName())
+			}
 		}
-
-		matched = true
 	}
 
 	if !matched {
@@ -0,0 +1,168 @@
+//  Copyright (c) 2023 Cisco and/or its affiliates.
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at:
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package core
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+	"sync/atomic"
+	"time"
+
+	"go.fd.io/govpp/api"
+)
+
+// ErrShuttingDown is returned when a new channel or stream is requested
+// from a connection which is being shut down.
+var ErrShuttingDown = errors.New("connection is shutting down")
+
+// shutdownPollInterval is the interval of checking for the requests in flight during shutdown.
+const shutdownPollInterval = 10 * time.Millisecond
+
+// Shutdown gracefully disconnects from VPP. It stops accepting new channels
+// and streams (ErrShuttingDown is returned instead), waits until replies
+// to all the requests in flight (including multipart dumps) are received,
+// disables the notifications enabled by the requests passed to
+// SubscribeNotificationWithRequest or WatchEventWithRequest, removes all
+// the subscriptions closing their notification channels and finally
+// disconnects from VPP.
+//
+// If ctx is done before the requests in flight complete, the notifications
+// are not disabled and the connection is disconnected immediately with
+// ctx.Err() returned. The existing channels and streams can still be used
+// to send requests until ctx is done, but they may never be replied.
+func (c *Connection) Shutdown(ctx context.Context) error {
+	if c == nil {
+		return errors.New("nil connection passed in")
+	}
+	if !atomic.CompareAndSwapUint32(&c.shuttingDown, 0, 1) {
+		c.Disconnect()
+		return nil
+	}
+	defer c.Disconnect()
+
+	if err := c.waitForPendingRequests(ctx); err != nil {
+		log.Warnf("shutdown: %d requests still in flight: %v", c.pendingRequestsCount(), err)
+		return err
+	}
+	return c.disableSubscriptions(ctx)
+}
+
+func (c *Connection) isShuttingDown() bool {
+	return atomic.LoadUint32(&c.shuttingDown) == 1
+}
+
+// waitForPendingRequests waits until there are no requests waiting for reply or ctx is done.
+func (c *Connection) waitForPendingRequests(ctx context.Context) error {
+	ticker := time.NewTicker(shutdownPollInterval)
+	defer ticker.Stop()
+
+	for c.pendingRequestsCount() > 0 {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+	}
+	return nil
+}
+
+// pendingRequestsCount returns the number of requests queued or waiting for reply on all channels.
+func (c *Connection) pendingRequestsCount() int {
+	c.channelsLock.RLock()
+	defer c.channelsLock.RUnlock()
+
+	var n int
+	for _, ch := range c.channels {
+		n += len(ch.reqChan)
+		ch.pendingRequests.Range(func(_, _ interface{}) bool {
+			n++
+			return true
+		})
+	}
+	return n
+}
+
+// disableSubscriptions removes all the subscriptions, closes their notification
+// channels and sends requests disabling their notifications.
+func (c *Connection) disableSubscriptions(ctx context.Context) error {
+	c.subscriptionsLock.Lock()
+	subs := c.subscriptions
+	c.subscriptions = make(map[uint16][]*subscriptionCtx)
+	for _, msgSubs := range subs {
+		for _, sub := range msgSubs {
+			close(sub.notifChan)
+		}
+	}
+	c.subscriptionsLock.Unlock()
+
+	if atomic.LoadUint32(&c.vppConnected) == 0 {
+		return nil
+	}
+
+	// multiple subscriptions can share the same request, send it only once
+	var (
+		sentReqs []api.Message
+		errs     []error
+	)
+	for _, msgSubs := range subs {
+	Subscriptions:
+		for _, sub := range msgSubs {
+			if sub.enableReq == nil {
+				continue
+			}
+			req, ok := disableRequest(sub.enableReq)
+			if !ok {
+				log.Debugf("shutdown: unable to derive disable request from %s", sub.enableReq.GetMessageName())
+				continue
+			}
+			for _, sent := range sentReqs {
+				if reflect.DeepEqual(sent, req) {
+					continue Subscriptions
+				}
+			}
+			sentReqs = append(sentReqs, req)
+			if err := c.sendSubscriptionRequest(ctx, req); err != nil {
+				log.Warnf("shutdown: disabling notifications for %s failed: %v", sub.event.GetMessageName(), err)
+				errs = append(errs, fmt.Errorf("sending %s failed: %w", req.GetMessageName(), err))
+			}
+		}
+	}
+	log.Debugf("shutdown: disabled notifications with %d requests (%d failed)", len(sentReqs), len(errs))
+
+	if len(errs) > 0 {
+		return fmt.Errorf("%d of %d requests disabling notifications failed: %w", len(errs), len(sentReqs), errs[0])
+	}
+	return nil
+}
+
+// disableRequest returns a copy of the request enabling notifications with
+// its enable flag (the EnableDisable or Enable field) cleared.
+func disableRequest(enableReq api.Message) (api.Message, bool) {
+	v := reflect.ValueOf(enableReq)
+	if v.Kind() != reflect.Ptr || v.Elem().Kind() != reflect.Struct {
+		return nil, false
+	}
+	req := reflect.New(v.Elem().Type())
+	req.Elem().Set(v.Elem())
+	for _, name := range []string{"EnableDisable", "Enable"} {
+		if field := req.Elem().FieldByName(name); field.IsValid() && field.CanSet() {
+			field.Set(reflect.Zero(field.Type()))
+			return req.Interface().(api.Message), true
+		}
+	}
+	return nil, false
+}
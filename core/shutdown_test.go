@@ -0,0 +1,157 @@
+package core_test
+
+import (
+	"context"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+
+	"go.fd.io/govpp/adapter/mock"
+	"go.fd.io/govpp/api"
+	interfaces "go.fd.io/govpp/binapi/interface"
+	"go.fd.io/govpp/binapi/memclnt"
+	"go.fd.io/govpp/codec"
+	"go.fd.io/govpp/core"
+)
+
+// mockShutdownHandler replies to control pings once release is closed and
+// records the EnableDisable flag of want_interface_events requests. It does
+// not use gomega, because it may run after the test has finished.
+func mockShutdownHandler(mockVpp *mock.VppAdapter, release chan struct{}, wantEnable *int32) {
+	mockVpp.MockReplyHandler(func(request mock.MessageDTO) ([]byte, uint16, bool) {
+		var reply api.Message
+		switch request.MsgName {
+		case "want_interface_events":
+			var req interfaces.WantInterfaceEvents
+			if err := codec.DefaultCodec.DecodeMsg(request.Data, &req); err != nil || req.PID != 10 {
+				return nil, 0, false
+			}
+			atomic.StoreInt32(wantEnable, int32(req.EnableDisable))
+			reply = &interfaces.WantInterfaceEventsReply{}
+		default:
+			// control ping has fixed ID in the mock adapter
+			if request.MsgID != 100 {
+				return nil, 0, false
+			}
+			<-release
+			reply = &memclnt.ControlPingReply{}
+		}
+		msgID, _ := mockVpp.GetMsgID(reply.GetMessageName(), reply.GetCrcString())
+		data, err := mockVpp.ReplyBytes(request, reply)
+		if err != nil {
+			return nil, 0, false
+		}
+		return data, msgID, true
+	})
+}
+
+func TestShutdown(t *testing.T) {
+	RegisterTestingT(t)
+
+	mockVpp := mock.NewVppAdapter()
+	release := make(chan struct{})
+	wantEnable := int32(-1)
+	mockShutdownHandler(mockVpp, release, &wantEnable)
+
+	conn, err := core.Connect(mockVpp)
+	Expect(err).ShouldNot(HaveOccurred())
+	defer conn.Disconnect()
+
+	watcher, err := conn.WatchEventWithRequest(context.Background(),
+		&interfaces.SwInterfaceEvent{}, &interfaces.WantInterfaceEvents{EnableDisable: 1, PID: 10})
+	Expect(err).ShouldNot(HaveOccurred())
+	defer watcher.Close()
+
+	ch, err := conn.NewAPIChannel()
+	Expect(err).ShouldNot(HaveOccurred())
+	defer ch.Close()
+	reqCtx := ch.SendRequest(&memclnt.ControlPing{})
+
+	done := make(chan error, 1)
+	go func() {
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		done <- conn.Shutdown(ctx)
+	}()
+
+	// no new channels or streams are accepted
+	Eventually(func() error {
+		_, err := conn.NewAPIChannel()
+		return err
+	}).Should(MatchError(core.ErrShuttingDown))
+	_, err = conn.NewStream(context.Background())
+	Expect(err).To(MatchError(core.ErrShuttingDown))
+
+	// shutdown waits for the reply
+	Consistently(done, 50*time.Millisecond).ShouldNot(Receive())
+	close(release)
+	Expect(reqCtx.ReceiveReply(&memclnt.ControlPingReply{})).To(Succeed())
+
+	Eventually(done).Should(Receive(BeNil()))
+	Expect(atomic.LoadInt32(&wantEnable)).To(BeEquivalentTo(0))
+}
+
+func TestShutdownDeadline(t *testing.T) {
+	RegisterTestingT(t)
+
+	mockVpp := mock.NewVppAdapter()
+	release := make(chan struct{})
+	wantEnable := int32(-1)
+	mockShutdownHandler(mockVpp, release, &wantEnable)
+	defer close(release)
+
+	conn, err := core.Connect(mockVpp)
+	Expect(err).ShouldNot(HaveOccurred())
+	defer conn.Disconnect()
+
+	watcher, err := conn.WatchEventWithRequest(context.Background(),
+		&interfaces.SwInterfaceEvent{}, &interfaces.WantInterfaceEvents{EnableDisable: 1, PID: 10})
+	Expect(err).ShouldNot(HaveOccurred())
+	defer watcher.Close()
+
+	ch, err := conn.NewAPIChannel()
+	Expect(err).ShouldNot(HaveOccurred())
+	defer ch.Close()
+	ch.SendRequest(&memclnt.ControlPing{})
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	Expect(conn.Shutdown(ctx)).To(MatchError(context.DeadlineExceeded))
+
+	// notifications are not disabled if the requests in flight did not complete
+	Expect(atomic.LoadInt32(&wantEnable)).To(BeEquivalentTo(-1))
+}
+
+func TestShutdownClosesSubscriptions(t *testing.T) {
+	RegisterTestingT(t)
+
+	mockVpp := mock.NewVppAdapter()
+	release := make(chan struct{})
+	close(release)
+	wantEnable := int32(-1)
+	mockShutdownHandler(mockVpp, release, &wantEnable)
+
+	conn, err := core.Connect(mockVpp)
+	Expect(err).ShouldNot(HaveOccurred())
+	defer conn.Disconnect()
+
+	ch, err := conn.NewAPIChannel()
+	Expect(err).ShouldNot(HaveOccurred())
+	defer ch.Close()
+	notifChan := make(chan api.Message, 1)
+	sub, err := ch.SubscribeNotification(notifChan, &interfaces.SwInterfaceEvent{})
+	Expect(err).ShouldNot(HaveOccurred())
+
+	watcher, err := conn.WatchEventWithRequest(context.Background(),
+		&interfaces.SwInterfaceEvent{}, &interfaces.WantInterfaceEvents{EnableDisable: 1, PID: 10})
+	Expect(err).ShouldNot(HaveOccurred())
+	defer watcher.Close()
+
+	Expect(conn.Shutdown(context.Background())).To(Succeed())
+
+	Expect(notifChan).To(BeClosed())
+	Eventually(watcher.Events()).Should(BeClosed())
+	Expect(sub.Unsubscribe()).To(Succeed())
+}
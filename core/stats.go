@@ -66,7 +66,6 @@ const (
 	InterfaceStats_TxMulticast   = InterfaceStatsPrefix + "tx-multicast"
 	InterfaceStats_TxBroadcast   = InterfaceStatsPrefix + "tx-broadcast"
 
-	// TODO: network stats
 	NetworkStatsPrefix     = "/net/"
 	NetworkStats_RouteTo   = NetworkStatsPrefix + "route/to"
 	NetworkStats_RouteVia  = NetworkStatsPrefix + "route/via"
@@ -90,6 +89,7 @@ type StatsConnection struct {
 	sysStatsData   *adapter.StatDir
 	bufStatsData   *adapter.StatDir
 	memStatsData   *adapter.StatDir
+	netStatsData   *adapter.StatDir
 }
 
 func newStatsConnection(stats adapter.StatsAPI, attempts int, interval time.Duration) *StatsConnection {
@@ -601,6 +601,72 @@ func (c *StatsConnection) GetMemoryStats(memStats *api.MemoryStats) (err error)
 	return nil
 }
 
+// GetNetworkStats retrieves VPP per FIB entry stats.
+func (c *StatsConnection) GetNetworkStats(netStats *api.NetworkStats) (err error) {
+	if err := c.updateStats(&c.netStatsData, NetworkStatsPrefix); err != nil {
+		return err
+	}
+
+	combined := func(stat adapter.StatEntry) []api.CombinedCounter {
+		s, ok := stat.Data.(adapter.CombinedCounterStat)
+		if !ok || len(s) == 0 {
+			return nil
+		}
+		counters := make([]api.CombinedCounter, len(s[0]))
+		for i := range counters {
+			val := adapter.ReduceCombinedCounterStatIndex(s, i)
+			counters[i] = api.CombinedCounter{Packets: val[0], Bytes: val[1]}
+		}
+		return counters
+	}
+	indexed := func(counters []api.CombinedCounter) []api.NetworkCounter {
+		list := make([]api.NetworkCounter, len(counters))
+		for i, counter := range counters {
+			list[i] = api.NetworkCounter{StatsIndex: uint32(i), CombinedCounter: counter}
+		}
+		return list
+	}
+
+	var routesTo, routesVia []api.CombinedCounter
+	for _, stat := range c.netStatsData.Entries {
+		switch string(stat.Name) {
+		case NetworkStats_RouteTo:
+			routesTo = combined(stat)
+		case NetworkStats_RouteVia:
+			routesVia = combined(stat)
+		case NetworkStats_MRoute:
+			netStats.MRoutes = indexed(combined(stat))
+		case NetworkStats_Adjacency:
+			netStats.Adjacencies = indexed(combined(stat))
+		case NetworkStats_Punt:
+			netStats.Punts = indexed(combined(stat))
+		}
+	}
+
+	numRoutes := len(routesTo)
+	if len(routesVia) > numRoutes {
+		numRoutes = len(routesVia)
+	}
+	if len(netStats.Routes) != numRoutes {
+		netStats.Routes = make([]api.RouteCounters, numRoutes)
+		for i := range netStats.Routes {
+			netStats.Routes[i].StatsIndex = uint32(i)
+		}
+	}
+	for i := range netStats.Routes {
+		route := &netStats.Routes[i]
+		route.To, route.Via = api.CombinedCounter{}, api.CombinedCounter{}
+		if i < len(routesTo) {
+			route.To = routesTo[i]
+		}
+		if i < len(routesVia) {
+			route.Via = routesVia[i]
+		}
+	}
+
+	return nil
+}
+
 func (c *StatsConnection) sendStatsConnEvent(event ConnectionEvent) {
 	select {
 	case c.connChan <- event:
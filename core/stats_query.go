@@ -0,0 +1,156 @@
+//  Copyright (c) 2023 Cisco and/or its affiliates.
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at:
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package core
+
+import (
+	"go.fd.io/govpp/adapter"
+	"go.fd.io/govpp/api"
+)
+
+// StatsQuery retrieves the stats matching the patterns. The stat dir is
+// prepared by the first run and updated by the next runs, so the query should
+// be kept and reused for the periodic retrieval. It is not safe for
+// concurrent use.
+type StatsQuery struct {
+	conn     *StatsConnection
+	patterns []string
+	dir      *adapter.StatDir
+
+	targets      map[uint32]string // names of the symlink targets by the entry index
+	targetsEpoch int64
+}
+
+// NewStatsQuery returns the query of the stats matching any of the patterns
+// (regular expressions, as accepted by adapter.StatsAPI PrepareDir). All
+// stats are retrieved if no pattern is given.
+func (c *StatsConnection) NewStatsQuery(patterns ...string) *StatsQuery {
+	return &StatsQuery{
+		conn:     c,
+		patterns: patterns,
+	}
+}
+
+// Run retrieves the stats and returns their values summed for all threads.
+// The symlinks are resolved to the stats they point to.
+func (q *StatsQuery) Run() ([]api.StatValue, error) {
+	if err := q.conn.updateStats(&q.dir, q.patterns...); err != nil {
+		return nil, err
+	}
+
+	values := make([]api.StatValue, 0, len(q.dir.Entries))
+	var symlinks []adapter.StatEntry
+	for _, entry := range q.dir.Entries {
+		value, ok := statValue(entry)
+		if !ok {
+			continue
+		}
+		if entry.Symlink {
+			symlinks = append(symlinks, entry)
+		}
+		values = append(values, value)
+	}
+
+	if len(symlinks) > 0 {
+		if err := q.resolveTargets(symlinks); err != nil {
+			return nil, err
+		}
+		for i, link := 0, 0; i < len(values); i++ {
+			if !values[i].Symlink {
+				continue
+			}
+			values[i].Target = q.targets[symlinks[link].SymlinkTarget]
+			values[i].TargetIndex = symlinks[link].SymlinkItem
+			link++
+		}
+	}
+	return values, nil
+}
+
+// resolveTargets retrieves the names of the stat entries the symlinks point
+// to. The names are kept until the stat directory changes.
+func (q *StatsQuery) resolveTargets(symlinks []adapter.StatEntry) error {
+	if q.targets == nil || q.targetsEpoch != q.dir.Epoch {
+		q.targets = make(map[uint32]string)
+		q.targetsEpoch = q.dir.Epoch
+	}
+	var missing []uint32
+	for _, link := range symlinks {
+		if _, ok := q.targets[link.SymlinkTarget]; !ok {
+			missing = append(missing, link.SymlinkTarget)
+		}
+	}
+	if len(missing) == 0 {
+		return nil
+	}
+	dir, err := q.conn.statsClient.PrepareDirOnIndex(missing...)
+	if err != nil {
+		return err
+	}
+	for _, entry := range dir.Entries {
+		q.targets[entry.Index] = string(entry.Name)
+	}
+	return nil
+}
+
+// statValue converts the stat entry into the value summed for all threads.
+// The empty and unknown stats are skipped.
+func statValue(entry adapter.StatEntry) (api.StatValue, bool) {
+	value := api.StatValue{
+		Name:    string(entry.Name),
+		Symlink: entry.Symlink,
+	}
+	switch stat := entry.Data.(type) {
+	case adapter.ScalarStat:
+		value.Kind = api.ScalarStatKind
+		value.Scalar = float64(stat)
+	case adapter.ErrorStat:
+		value.Kind = api.CounterStatKind
+		var sum uint64
+		for _, v := range stat {
+			sum += uint64(v)
+		}
+		value.Counters = []uint64{sum}
+	case adapter.SimpleCounterStat:
+		value.Kind = api.CounterStatKind
+		for _, thread := range stat {
+			if len(thread) > len(value.Counters) {
+				value.Counters = append(value.Counters, make([]uint64, len(thread)-len(value.Counters))...)
+			}
+			for i, v := range thread {
+				value.Counters[i] += uint64(v)
+			}
+		}
+	case adapter.CombinedCounterStat:
+		value.Kind = api.CombinedStatKind
+		for _, thread := range stat {
+			if len(thread) > len(value.Combined) {
+				value.Combined = append(value.Combined, make([]api.CombinedCounter, len(thread)-len(value.Combined))...)
+			}
+			for i, v := range thread {
+				value.Combined[i].Packets += v.Packets()
+				value.Combined[i].Bytes += v.Bytes()
+			}
+		}
+	case adapter.NameStat:
+		value.Kind = api.NameStatKind
+		value.Names = make([]string, len(stat))
+		for i, name := range stat {
+			value.Names[i] = name.String()
+		}
+	default:
+		return value, false
+	}
+	return value, true
+}
@@ -0,0 +1,57 @@
+//  Copyright (c) 2023 Cisco and/or its affiliates.
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at:
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package core
+
+import (
+	"context"
+	"fmt"
+
+	"go.fd.io/govpp/api"
+)
+
+// RouteResolver returns the routes identified by their stats index, e.g. the
+// routes of all IP tables dumped by statsroutes.Resolver using the binary API.
+type RouteResolver func(ctx context.Context) ([]api.RouteInfo, error)
+
+// GetNetworkStatsWithRoutes retrieves VPP per FIB entry stats like GetNetworkStats
+// and sets the table ID and prefix of the routes returned by the resolver.
+func (c *StatsConnection) GetNetworkStatsWithRoutes(ctx context.Context, resolve RouteResolver, netStats *api.NetworkStats) error {
+	if err := c.GetNetworkStats(netStats); err != nil {
+		return err
+	}
+	routes, err := resolve(ctx)
+	if err != nil {
+		return fmt.Errorf("resolving routes failed: %w", err)
+	}
+	resolveRoutes(netStats, routes)
+	return nil
+}
+
+// resolveRoutes sets the table ID and prefix of the route counters
+// by the stats index of the routes.
+func resolveRoutes(netStats *api.NetworkStats, routes []api.RouteInfo) {
+	for i := range netStats.Routes {
+		netStats.Routes[i].TableID = 0
+		netStats.Routes[i].Prefix = ""
+	}
+	for _, route := range routes {
+		if int(route.StatsIndex) >= len(netStats.Routes) {
+			continue
+		}
+		counters := &netStats.Routes[route.StatsIndex]
+		counters.TableID = route.TableID
+		counters.Prefix = route.Prefix
+	}
+}
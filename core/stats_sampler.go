@@ -0,0 +1,305 @@
+//  Copyright (c) 2023 Cisco and/or its affiliates.
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at:
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package core
+
+import (
+	"sync"
+	"time"
+
+	"go.fd.io/govpp/api"
+)
+
+// DefaultStatsSampleInterval is the default interval of sampling the stats by StatsSampler.
+var DefaultStatsSampleInterval = time.Second
+
+// StatsSamplerOption allows customizing a StatsSampler.
+type StatsSamplerOption func(*StatsSampler)
+
+// WithSampleInterval sets the interval of sampling the stats. Zero interval
+// disables the periodic sampling, the stats are then sampled only by Sample.
+func WithSampleInterval(interval time.Duration) StatsSamplerOption {
+	return func(s *StatsSampler) {
+		s.interval = interval
+	}
+}
+
+// StatsSampler periodically retrieves the interface, node and error stats and
+// computes per-second rates of their counters from the consecutive samples.
+//
+// The rates are not computed when the counters were cleared since the previous
+// sample (the /sys/last_stats_clear changed), for counters that decreased and
+// for interfaces or nodes whose index was reused for another name.
+type StatsSampler struct {
+	stats    api.StatsProvider
+	interval time.Duration
+	now      func() time.Time
+
+	sampleMu sync.Mutex // serializes sampling
+	prev     *statsSample
+
+	mu       sync.Mutex
+	last     *api.StatsRates
+	watchers map[chan *api.StatsRates]struct{}
+	closed   bool
+
+	quit chan struct{}
+	wg   sync.WaitGroup
+}
+
+type statsSample struct {
+	time       time.Time
+	lastClear  uint64
+	interfaces api.InterfaceStats
+	nodes      api.NodeStats
+	errors     api.ErrorStats
+}
+
+// NewStatsSampler returns the sampler of the stats retrieved using the connection.
+// The sampling starts immediately and runs until Close is called.
+func (c *StatsConnection) NewStatsSampler(opts ...StatsSamplerOption) *StatsSampler {
+	return newStatsSampler(c, opts...)
+}
+
+func newStatsSampler(stats api.StatsProvider, opts ...StatsSamplerOption) *StatsSampler {
+	s := &StatsSampler{
+		stats:    stats,
+		interval: DefaultStatsSampleInterval,
+		now:      time.Now,
+		watchers: make(map[chan *api.StatsRates]struct{}),
+		quit:     make(chan struct{}),
+	}
+	for _, opt := range opts {
+		opt(s)
+	}
+	if s.interval > 0 {
+		s.wg.Add(1)
+		go s.sampleLoop()
+	}
+	return s
+}
+
+func (s *StatsSampler) sampleLoop() {
+	defer s.wg.Done()
+
+	ticker := time.NewTicker(s.interval)
+	defer ticker.Stop()
+
+	if _, err := s.Sample(); err != nil {
+		log.Debugf("sampling stats failed: %v", err)
+	}
+	for {
+		select {
+		case <-ticker.C:
+			if _, err := s.Sample(); err != nil {
+				log.Debugf("sampling stats failed: %v", err)
+			}
+		case <-s.quit:
+			return
+		}
+	}
+}
+
+// Sample retrieves the stats and returns the rates computed since the
+// previous sample. The first sample only records the counters and returns nil.
+// The rates are sent to the watchers and kept as the last snapshot.
+func (s *StatsSampler) Sample() (*api.StatsRates, error) {
+	s.sampleMu.Lock()
+	defer s.sampleMu.Unlock()
+
+	var sysStats api.SystemStats
+	if err := s.stats.GetSystemStats(&sysStats); err != nil {
+		return nil, err
+	}
+	cur := &statsSample{lastClear: sysStats.LastStatsClear}
+	if err := s.stats.GetInterfaceStats(&cur.interfaces); err != nil {
+		return nil, err
+	}
+	if err := s.stats.GetNodeStats(&cur.nodes); err != nil {
+		return nil, err
+	}
+	if err := s.stats.GetErrorStats(&cur.errors); err != nil {
+		return nil, err
+	}
+	cur.time = s.now()
+
+	prev := s.prev
+	s.prev = cur
+	if prev == nil {
+		return nil, nil
+	}
+	rates := computeStatsRates(prev, cur)
+	s.publish(rates)
+	return rates, nil
+}
+
+// Snapshot returns the rates computed from the last two samples or nil if
+// there were no such samples yet.
+func (s *StatsSampler) Snapshot() *api.StatsRates {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.last
+}
+
+// Watch returns the channel receiving the rates computed from each sample.
+// Updates are dropped if the receiver is not ready. The channel is closed
+// when the cancel func is called or the sampler is closed.
+func (s *StatsSampler) Watch() (updates <-chan *api.StatsRates, cancel func()) {
+	ch := make(chan *api.StatsRates, 1)
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.closed {
+		close(ch)
+		return ch, func() {}
+	}
+	s.watchers[ch] = struct{}{}
+	return ch, func() {
+		s.mu.Lock()
+		defer s.mu.Unlock()
+		if _, ok := s.watchers[ch]; ok {
+			delete(s.watchers, ch)
+			close(ch)
+		}
+	}
+}
+
+// Close stops the sampling and closes the watch channels.
+func (s *StatsSampler) Close() {
+	s.mu.Lock()
+	if s.closed {
+		s.mu.Unlock()
+		return
+	}
+	s.closed = true
+	for ch := range s.watchers {
+		delete(s.watchers, ch)
+		close(ch)
+	}
+	s.mu.Unlock()
+
+	close(s.quit)
+	s.wg.Wait()
+}
+
+func (s *StatsSampler) publish(rates *api.StatsRates) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.closed {
+		return
+	}
+	s.last = rates
+	for ch := range s.watchers {
+		select {
+		case ch <- rates:
+		default:
+			log.Debugf("stats rates update dropped, watcher not ready")
+		}
+	}
+}
+
+func computeStatsRates(prev, cur *statsSample) *api.StatsRates {
+	rates := &api.StatsRates{
+		Timestamp: cur.time,
+		Interval:  cur.time.Sub(prev.time),
+	}
+	if cur.lastClear != prev.lastClear {
+		rates.Reset = true
+		return rates
+	}
+	secs := rates.Interval.Seconds()
+	if secs <= 0 {
+		return rates
+	}
+
+	prevIfaces := prev.interfaces.Interfaces
+	for _, iface := range cur.interfaces.Interfaces {
+		if int(iface.InterfaceIndex) >= len(prevIfaces) {
+			continue
+		}
+		p := prevIfaces[iface.InterfaceIndex]
+		if p.InterfaceName != iface.InterfaceName {
+			// interface deleted or index reused
+			continue
+		}
+		rates.Interfaces = append(rates.Interfaces, api.InterfaceRates{
+			InterfaceIndex: iface.InterfaceIndex,
+			InterfaceName:  iface.InterfaceName,
+			RxPps:          counterRate(p.Rx.Packets, iface.Rx.Packets, secs),
+			RxBps:          counterRate(p.Rx.Bytes, iface.Rx.Bytes, secs) * 8,
+			TxPps:          counterRate(p.Tx.Packets, iface.Tx.Packets, secs),
+			TxBps:          counterRate(p.Tx.Bytes, iface.Tx.Bytes, secs) * 8,
+			RxErrors:       counterRate(p.RxErrors, iface.RxErrors, secs),
+			TxErrors:       counterRate(p.TxErrors, iface.TxErrors, secs),
+			Drops:          counterRate(p.Drops, iface.Drops, secs),
+			RxNoBuf:        counterRate(p.RxNoBuf, iface.RxNoBuf, secs),
+			RxMiss:         counterRate(p.RxMiss, iface.RxMiss, secs),
+		})
+	}
+
+	prevNodes := prev.nodes.Nodes
+	for _, node := range cur.nodes.Nodes {
+		if int(node.NodeIndex) >= len(prevNodes) {
+			continue
+		}
+		p := prevNodes[node.NodeIndex]
+		if p.NodeName != node.NodeName {
+			continue
+		}
+		r := api.NodeRates{
+			NodeIndex: node.NodeIndex,
+			NodeName:  node.NodeName,
+			Clocks:    counterRate(p.Clocks, node.Clocks, secs),
+			Vectors:   counterRate(p.Vectors, node.Vectors, secs),
+			Calls:     counterRate(p.Calls, node.Calls, secs),
+			Suspends:  counterRate(p.Suspends, node.Suspends, secs),
+		}
+		if r.Calls > 0 {
+			r.VectorsPerCall = r.Vectors / r.Calls
+		}
+		rates.Nodes = append(rates.Nodes, r)
+	}
+
+	prevErrors := make(map[string]uint64, len(prev.errors.Errors))
+	for _, e := range prev.errors.Errors {
+		prevErrors[e.CounterName] = sumValues(e.Values)
+	}
+	for _, e := range cur.errors.Errors {
+		p, ok := prevErrors[e.CounterName]
+		if !ok {
+			continue
+		}
+		rates.Errors = append(rates.Errors, api.ErrorRate{
+			CounterName: e.CounterName,
+			Rate:        counterRate(p, sumValues(e.Values), secs),
+		})
+	}
+
+	return rates
+}
+
+// counterRate returns the per-second rate of the counter, zero if the counter
+// decreased (it was reset).
+func counterRate(prev, cur uint64, secs float64) float64 {
+	if cur < prev {
+		return 0
+	}
+	return float64(cur-prev) / secs
+}
+
+func sumValues(values []uint64) (sum uint64) {
+	for _, v := range values {
+		sum += v
+	}
+	return sum
+}
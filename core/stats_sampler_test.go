@@ -0,0 +1,160 @@
+package core
+
+import (
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+
+	"go.fd.io/govpp/api"
+)
+
+// testStatsProvider returns the stats set by the test.
+type testStatsProvider struct {
+	sys    api.SystemStats
+	ifaces []api.InterfaceCounters
+	nodes  []api.NodeCounters
+	errors []api.ErrorCounter
+}
+
+func (p *testStatsProvider) GetSystemStats(s *api.SystemStats) error {
+	*s = p.sys
+	return nil
+}
+
+func (p *testStatsProvider) GetNodeStats(s *api.NodeStats) error {
+	s.Nodes = append([]api.NodeCounters(nil), p.nodes...)
+	return nil
+}
+
+func (p *testStatsProvider) GetInterfaceStats(s *api.InterfaceStats) error {
+	s.Interfaces = append([]api.InterfaceCounters(nil), p.ifaces...)
+	return nil
+}
+
+func (p *testStatsProvider) GetErrorStats(s *api.ErrorStats) error {
+	s.Errors = append([]api.ErrorCounter(nil), p.errors...)
+	return nil
+}
+
+func (p *testStatsProvider) GetBufferStats(*api.BufferStats) error   { return nil }
+func (p *testStatsProvider) GetMemoryStats(*api.MemoryStats) error   { return nil }
+func (p *testStatsProvider) GetNetworkStats(*api.NetworkStats) error { return nil }
+
+func newTestSampler(p *testStatsProvider) (*StatsSampler, *time.Time) {
+	now := time.Unix(1000, 0)
+	s := newStatsSampler(p, WithSampleInterval(0))
+	s.now = func() time.Time { return now }
+	return s, &now
+}
+
+func TestStatsSamplerRates(t *testing.T) {
+	RegisterTestingT(t)
+
+	p := &testStatsProvider{
+		ifaces: []api.InterfaceCounters{
+			{InterfaceIndex: 0, InterfaceName: "local0"},
+			{InterfaceIndex: 1, InterfaceName: "loop0", Rx: api.InterfaceCounterCombined{Packets: 100, Bytes: 1000}},
+		},
+		nodes: []api.NodeCounters{
+			{NodeIndex: 0, NodeName: "ip4-input", Calls: 10, Vectors: 100},
+		},
+		errors: []api.ErrorCounter{
+			{CounterName: "/err/ip4-input/drop", Values: []uint64{1, 2}},
+		},
+	}
+	s, now := newTestSampler(p)
+	defer s.Close()
+	updates, cancel := s.Watch()
+	defer cancel()
+
+	rates, err := s.Sample()
+	Expect(err).ShouldNot(HaveOccurred())
+	Expect(rates).To(BeNil())
+	Expect(s.Snapshot()).To(BeNil())
+
+	*now = now.Add(2 * time.Second)
+	p.ifaces[1].Rx = api.InterfaceCounterCombined{Packets: 300, Bytes: 3000}
+	p.ifaces[1].Drops = 4
+	p.nodes[0].Calls, p.nodes[0].Vectors = 20, 300
+	p.errors = []api.ErrorCounter{{CounterName: "/err/ip4-input/drop", Values: []uint64{5, 6}}}
+
+	rates, err = s.Sample()
+	Expect(err).ShouldNot(HaveOccurred())
+	Expect(rates.Interval).To(Equal(2 * time.Second))
+	Expect(rates.Reset).To(BeFalse())
+	Expect(rates.Interfaces).To(HaveLen(2))
+	Expect(rates.Interfaces[1]).To(Equal(api.InterfaceRates{
+		InterfaceIndex: 1,
+		InterfaceName:  "loop0",
+		RxPps:          100,
+		RxBps:          8000,
+		Drops:          2,
+	}))
+	Expect(rates.Nodes).To(Equal([]api.NodeRates{{
+		NodeName:       "ip4-input",
+		Calls:          5,
+		Vectors:        100,
+		VectorsPerCall: 20,
+	}}))
+	Expect(rates.Errors).To(Equal([]api.ErrorRate{{CounterName: "/err/ip4-input/drop", Rate: 4}}))
+
+	Expect(s.Snapshot()).To(Equal(rates))
+	Expect(updates).To(Receive(Equal(rates)))
+}
+
+func TestStatsSamplerReset(t *testing.T) {
+	RegisterTestingT(t)
+
+	p := &testStatsProvider{
+		sys: api.SystemStats{LastStatsClear: 1},
+		ifaces: []api.InterfaceCounters{
+			{InterfaceIndex: 0, InterfaceName: "loop0", Rx: api.InterfaceCounterCombined{Packets: 100}},
+			{InterfaceIndex: 1, InterfaceName: "loop1", Tx: api.InterfaceCounterCombined{Packets: 100}},
+		},
+	}
+	s, now := newTestSampler(p)
+	defer s.Close()
+
+	_, err := s.Sample()
+	Expect(err).ShouldNot(HaveOccurred())
+
+	// counters cleared
+	*now = now.Add(time.Second)
+	p.sys.LastStatsClear = 2
+	p.ifaces[0].Rx.Packets = 10
+	rates, err := s.Sample()
+	Expect(err).ShouldNot(HaveOccurred())
+	Expect(rates.Reset).To(BeTrue())
+	Expect(rates.Interfaces).To(BeEmpty())
+
+	// interface index reused and counter decreased
+	*now = now.Add(time.Second)
+	p.ifaces[1].InterfaceName = "memif0/0"
+	p.ifaces[0].Rx.Packets = 5
+	rates, err = s.Sample()
+	Expect(err).ShouldNot(HaveOccurred())
+	Expect(rates.Reset).To(BeFalse())
+	Expect(rates.Interfaces).To(Equal([]api.InterfaceRates{{InterfaceName: "loop0"}}))
+
+	*now = now.Add(time.Second)
+	p.ifaces[1].Tx.Packets = 150
+	rates, err = s.Sample()
+	Expect(err).ShouldNot(HaveOccurred())
+	Expect(rates.Interfaces).To(HaveLen(2))
+	Expect(rates.Interfaces[1].TxPps).To(BeEquivalentTo(50))
+}
+
+func TestStatsSamplerClose(t *testing.T) {
+	RegisterTestingT(t)
+
+	s := newStatsSampler(&testStatsProvider{}, WithSampleInterval(time.Millisecond))
+	updates, _ := s.Watch()
+	Eventually(updates).Should(Receive(Not(BeNil())))
+	s.Close()
+	Eventually(updates).Should(BeClosed())
+
+	updates, cancel := s.Watch()
+	Expect(updates).To(BeClosed())
+	cancel()
+}
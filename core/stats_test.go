@@ -0,0 +1,89 @@
+package core_test
+
+import (
+	"context"
+	"testing"
+
+	. "github.com/onsi/gomega"
+
+	"go.fd.io/govpp/adapter/statsfile"
+	"go.fd.io/govpp/api"
+	"go.fd.io/govpp/core"
+)
+
+// newSnapshotStatsConnection connects to the stats of testdata/stats.snapshot,
+// which is generated by testdata/gen_snapshot.go.
+func newSnapshotStatsConnection() (*core.StatsConnection, error) {
+	snapshot, err := statsfile.LoadFile("testdata/stats.snapshot")
+	if err != nil {
+		return nil, err
+	}
+	return core.ConnectStats(statsfile.NewStatsAdapter(snapshot))
+}
+
+func TestGetNetworkStats(t *testing.T) {
+	RegisterTestingT(t)
+
+	statsConn, err := newSnapshotStatsConnection()
+	Expect(err).ShouldNot(HaveOccurred())
+	defer statsConn.Disconnect()
+
+	stats := new(api.NetworkStats)
+	Expect(statsConn.GetNetworkStats(stats)).To(Succeed())
+	Expect(stats.Routes).To(Equal([]api.RouteCounters{
+		{StatsIndex: 0},
+		{StatsIndex: 1, To: api.CombinedCounter{Packets: 15, Bytes: 1500}, Via: api.CombinedCounter{Packets: 2, Bytes: 200}},
+		{StatsIndex: 2, To: api.CombinedCounter{Packets: 1, Bytes: 100}},
+	}))
+	Expect(stats.Adjacencies).To(Equal([]api.NetworkCounter{
+		{StatsIndex: 0, CombinedCounter: api.CombinedCounter{Packets: 3, Bytes: 300}},
+	}))
+	Expect(stats.MRoutes).To(BeEmpty())
+}
+
+func TestGetNetworkStatsWithRoutes(t *testing.T) {
+	RegisterTestingT(t)
+
+	statsConn, err := newSnapshotStatsConnection()
+	Expect(err).ShouldNot(HaveOccurred())
+	defer statsConn.Disconnect()
+
+	resolver := func(ctx context.Context) ([]api.RouteInfo, error) {
+		return []api.RouteInfo{
+			{TableID: 0, StatsIndex: 1, Prefix: "10.0.0.0/24"},
+			{TableID: 0, StatsIndex: 2, Prefix: "2001:db8::/64"},
+			{TableID: 1, StatsIndex: 10, Prefix: "10.1.0.0/24"}, // not in stats
+		}, nil
+	}
+
+	stats := new(api.NetworkStats)
+	Expect(statsConn.GetNetworkStatsWithRoutes(context.Background(), resolver, stats)).To(Succeed())
+	Expect(stats.Routes).To(HaveLen(3))
+	Expect(stats.Routes[0].Prefix).To(BeEmpty())
+	Expect(stats.Routes[1].Prefix).To(Equal("10.0.0.0/24"))
+	Expect(stats.Routes[1].To.Packets).To(BeEquivalentTo(15))
+	Expect(stats.Routes[2].Prefix).To(Equal("2001:db8::/64"))
+}
+
+func TestStatsQuery(t *testing.T) {
+	RegisterTestingT(t)
+
+	statsConn, err := newSnapshotStatsConnection()
+	Expect(err).ShouldNot(HaveOccurred())
+	defer statsConn.Disconnect()
+
+	query := statsConn.NewStatsQuery("/if/", "/interfaces/", "/err/", "/sys/vector_rate")
+	for i := 0; i < 2; i++ {
+		values, err := query.Run()
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(values).To(Equal([]api.StatValue{
+			{Name: core.SystemStats_VectorRate, Kind: api.ScalarStatKind, Scalar: 12.5},
+			{Name: core.InterfaceStats_Names, Kind: api.NameStatKind, Names: []string{"local0", "memif0/0"}},
+			{Name: core.InterfaceStats_Drops, Kind: api.CounterStatKind, Counters: []uint64{4, 6}},
+			{Name: core.InterfaceStats_Rx, Kind: api.CombinedStatKind, Combined: []api.CombinedCounter{{}, {Packets: 6, Bytes: 600}}},
+			{Name: "/interfaces/memif0_0/rx", Kind: api.CombinedStatKind, Combined: []api.CombinedCounter{{Packets: 6, Bytes: 600}},
+				Symlink: true, Target: core.InterfaceStats_Rx, TargetIndex: 1},
+			{Name: "/err/ip4-input/no error", Kind: api.CounterStatKind, Counters: []uint64{10}},
+		}))
+	}
+}
@@ -0,0 +1,80 @@
+//  Copyright (c) 2023 Cisco and/or its affiliates.
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at:
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+// Package statsroutes resolves the routes of the per FIB entry stats using
+// the ip_route_dump of the VPP binary API. It is kept separate from the core
+// package, so the core does not depend on the generated bindings.
+//
+//	netStats := new(api.NetworkStats)
+//	err := statsConn.GetNetworkStatsWithRoutes(ctx, statsroutes.Resolver(conn), netStats)
+package statsroutes
+
+import (
+	"context"
+	"io"
+
+	"go.fd.io/govpp/api"
+	"go.fd.io/govpp/binapi/ip"
+	"go.fd.io/govpp/core"
+)
+
+// Resolver returns the route resolver dumping the routes of all IP tables
+// via the binary API connection.
+func Resolver(conn api.Connection) core.RouteResolver {
+	return func(ctx context.Context) ([]api.RouteInfo, error) {
+		return DumpRoutes(ctx, conn)
+	}
+}
+
+// DumpRoutes returns the routes of all IP tables.
+func DumpRoutes(ctx context.Context, conn api.Connection) ([]api.RouteInfo, error) {
+	client := ip.NewServiceClient(conn)
+
+	tableStream, err := client.IPTableDump(ctx, &ip.IPTableDump{})
+	if err != nil {
+		return nil, err
+	}
+	var tables []ip.IPTable
+	for {
+		details, err := tableStream.Recv()
+		if err == io.EOF {
+			break
+		} else if err != nil {
+			return nil, err
+		}
+		tables = append(tables, details.Table)
+	}
+
+	var routes []api.RouteInfo
+	for _, table := range tables {
+		routeStream, err := client.IPRouteDump(ctx, &ip.IPRouteDump{Table: table})
+		if err != nil {
+			return nil, err
+		}
+		for {
+			details, err := routeStream.Recv()
+			if err == io.EOF {
+				break
+			} else if err != nil {
+				return nil, err
+			}
+			routes = append(routes, api.RouteInfo{
+				StatsIndex: details.Route.StatsIndex,
+				TableID:    details.Route.TableID,
+				Prefix:     details.Route.Prefix.String(),
+			})
+		}
+	}
+	return routes, nil
+}
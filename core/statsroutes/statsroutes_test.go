@@ -0,0 +1,58 @@
+//  Copyright (c) 2023 Cisco and/or its affiliates.
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at:
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package statsroutes_test
+
+import (
+	"context"
+	"testing"
+
+	. "github.com/onsi/gomega"
+
+	"go.fd.io/govpp/adapter/mock"
+	"go.fd.io/govpp/api"
+	"go.fd.io/govpp/binapi/ip"
+	"go.fd.io/govpp/binapi/ip_types"
+	"go.fd.io/govpp/binapi/memclnt"
+	"go.fd.io/govpp/core"
+	"go.fd.io/govpp/core/statsroutes"
+)
+
+func TestDumpRoutes(t *testing.T) {
+	RegisterTestingT(t)
+
+	mockVpp := mock.NewVppAdapter()
+	conn, err := core.Connect(mockVpp)
+	Expect(err).ShouldNot(HaveOccurred())
+	defer conn.Disconnect()
+
+	prefix1, _ := ip_types.ParsePrefix("10.0.0.0/24")
+	prefix2, _ := ip_types.ParsePrefix("2001:db8::/64")
+	mockVpp.MockReply(
+		&ip.IPTableDetails{Table: ip.IPTable{TableID: 0}},
+		&ip.IPTableDetails{Table: ip.IPTable{TableID: 0, IsIP6: true}},
+	)
+	mockVpp.MockReply(&memclnt.ControlPingReply{})
+	mockVpp.MockReply(&ip.IPRouteDetails{Route: ip.IPRoute{TableID: 0, StatsIndex: 1, Prefix: prefix1}})
+	mockVpp.MockReply(&memclnt.ControlPingReply{})
+	mockVpp.MockReply(&ip.IPRouteDetails{Route: ip.IPRoute{TableID: 0, StatsIndex: 2, Prefix: prefix2}})
+	mockVpp.MockReply(&memclnt.ControlPingReply{})
+
+	routes, err := statsroutes.Resolver(conn)(context.Background())
+	Expect(err).ShouldNot(HaveOccurred())
+	Expect(routes).To(Equal([]api.RouteInfo{
+		{StatsIndex: 1, TableID: 0, Prefix: "10.0.0.0/24"},
+		{StatsIndex: 2, TableID: 0, Prefix: "2001:db8::/64"},
+	}))
+}
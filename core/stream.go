@@ -18,7 +18,6 @@ import (
 	"context"
 	"errors"
 	"fmt"
-	"reflect"
 	"sync"
 	"time"
 
@@ -44,6 +43,19 @@ func (c *Connection) NewStream(ctx context.Context, options ...api.StreamOption)
 	if c == nil {
 		return nil, errors.New("nil connection passed in")
 	}
+	return c.streamer(ctx, options...)
+}
+
+// newStream creates a new stream bypassing the stream interceptors.
+func (c *Connection) newStream(ctx context.Context, options ...api.StreamOption) (api.Stream, error) {
+	if c.isShuttingDown() {
+		return nil, ErrShuttingDown
+	}
+	return c.openStream(ctx, options...)
+}
+
+// openStream creates a new stream even if the connection is being shut down.
+func (c *Connection) openStream(ctx context.Context, options ...api.StreamOption) (api.Stream, error) {
 	s := &Stream{
 		conn: c,
 		ctx:  ctx,
@@ -72,7 +84,20 @@ func (c *Connection) NewStream(ctx context.Context, options ...api.StreamOption)
 }
 
 func (c *Connection) Invoke(ctx context.Context, req api.Message, reply api.Message) error {
-	stream, err := c.NewStream(ctx)
+	if c == nil {
+		return errors.New("nil connection passed in")
+	}
+	return c.invoker(ctx, req, reply)
+}
+
+// invoke performs the request-reply RPC bypassing the unary interceptors.
+func (c *Connection) invoke(ctx context.Context, req api.Message, reply api.Message) (err error) {
+	ctx, span := c.tracer.StartSpan(ctx, "govpp.Invoke")
+	span.SetAttribute(SpanAttrMsgName, req.GetMessageName())
+	span.SetAttribute(SpanAttrMsgCrc, req.GetCrcString())
+	defer func() { endSpan(span, reply, err) }()
+
+	stream, err := c.newStream(ctx)
 	if err != nil {
 		return err
 	}
@@ -122,7 +147,11 @@ func (w *watcher) watch() {
 		select {
 		case <-w.ctx.Done():
 			return
-		case e := <-w.sub.notifChan:
+		case e, ok := <-w.sub.notifChan:
+			if !ok {
+				// subscription dropped
+				return
+			}
 			// send to events
 			select {
 			case <-w.ctx.Done():
@@ -135,6 +164,38 @@ func (w *watcher) watch() {
 }
 
 func (c *Connection) WatchEvent(ctx context.Context, event api.Message) (api.Watcher, error) {
+	return c.watchEvent(ctx, event, nil)
+}
+
+// WatchEventWithOptions creates a new watcher like WatchEvent does, customized by the options.
+func (c *Connection) WatchEventWithOptions(ctx context.Context, event api.Message, options ...WatchOption) (api.Watcher, error) {
+	return c.watchEvent(ctx, event, nil, options...)
+}
+
+// WatchEventWithRequest creates a new watcher like WatchEvent does, and additionally remembers
+// the request that enables the events in VPP (e.g. WantInterfaceEvents). The request is not sent
+// by this call, but it is re-sent automatically when the connection is re-established after VPP restart.
+func (c *Connection) WatchEventWithRequest(ctx context.Context, event api.Message, enableReq api.Message, options ...WatchOption) (api.Watcher, error) {
+	return c.watchEvent(ctx, event, enableReq, options...)
+}
+
+// WatchOption allows customizing a watcher created by the Connection.
+type WatchOption func(*watcher)
+
+// WithEventFilter sets a predicate for the watched events. Only the events
+// for which the filter returns true are delivered to the watcher, the rest
+// are discarded before they are queued.
+//
+//	conn.WatchEventWithOptions(ctx, &interfaces.SwInterfaceEvent{}, core.WithEventFilter(func(msg api.Message) bool {
+//		return msg.(*interfaces.SwInterfaceEvent).SwIfIndex == ifIdx
+//	}))
+func WithEventFilter(filter func(api.Message) bool) WatchOption {
+	return func(w *watcher) {
+		w.sub.filter = filter
+	}
+}
+
+func (c *Connection) watchEvent(ctx context.Context, event api.Message, enableReq api.Message, options ...WatchOption) (api.Watcher, error) {
 	msgID, err := c.GetMessageID(event)
 	if err != nil {
 		log.WithFields(logrus.Fields{
@@ -160,6 +221,10 @@ func (c *Connection) WatchEvent(ctx context.Context, event api.Message) (api.Wat
 		msgID:      msgID,
 		event:      event,
 		msgFactory: getMsgFactory(event),
+		enableReq:  enableReq,
+	}
+	for _, option := range options {
+		option(w)
 	}
 
 	go w.watch()
@@ -191,7 +256,10 @@ func (s *Stream) SendMsg(msg api.Message) error {
 		return errors.New("stream closed")
 	}
 	req := s.channel.newRequest(msg, false)
-	if err := s.conn.processRequest(s.channel, req); err != nil {
+	_, span := s.conn.startRequestSpan(s.ctx, "govpp.Stream.SendMsg", s.channel, req)
+	err := s.conn.processRequest(s.channel, req)
+	endSpan(span, nil, err)
+	if err != nil {
 		return err
 	}
 	s.Lock()
@@ -200,21 +268,30 @@ func (s *Stream) SendMsg(msg api.Message) error {
 	return nil
 }
 
-func (s *Stream) RecvMsg() (api.Message, error) {
+func (s *Stream) RecvMsg() (msg api.Message, err error) {
+	if s.conn == nil {
+		return nil, errors.New("stream closed")
+	}
+	_, span := s.conn.tracer.StartSpan(s.ctx, "govpp.Stream.RecvMsg")
+	span.SetAttribute(SpanAttrChannel, s.channel.id)
+	defer func() { endSpan(span, msg, err) }()
+
 	reply, err := s.recvReply()
 	if err != nil {
 		return nil, err
 	}
+	span.SetAttribute(SpanAttrSeqNum, reply.seqNum)
 	// resolve message type
 	s.Lock()
 	path := s.pkgPath
 	s.Unlock()
-	msg, err := s.channel.msgIdentifier.LookupByID(path, reply.msgID)
+	msgType, err := s.channel.msgIdentifier.LookupByID(path, reply.msgID)
 	if err != nil {
 		return nil, err
 	}
+	span.SetAttribute(SpanAttrMsgName, msgType.GetMessageName())
 	// allocate message instance
-	msg = reflect.New(reflect.TypeOf(msg).Elem()).Interface().(api.Message)
+	msg = api.NewMessage(msgType)
 	// decode message data
 	if err := s.channel.msgCodec.DecodeMsg(reply.data, msg); err != nil {
 		return nil, err
@@ -244,6 +321,10 @@ func (s *Stream) recvReply() (*vppReply, error) {
 	if s.conn == nil {
 		return nil, errors.New("stream closed")
 	}
+	if err := s.ctx.Err(); err != nil {
+		// select below would choose randomly if the replies are already queued
+		return nil, err
+	}
 	timeout := s.replyTimeout
 	if timeout <= 0 {
 		timeout = maxInt64
@@ -262,7 +343,8 @@ func (s *Stream) recvReply() (*vppReply, error) {
 		}
 		return reply, nil
 	case <-timeoutTimer.C:
-		err := fmt.Errorf("no reply received within the timeout period %s", timeout)
+		err := fmt.Errorf("%w %s", ErrReplyTimeout, timeout)
+		s.channel.replyTimedOut(s.channel.lastSeqNum)
 		return nil, err
 	case <-s.ctx.Done():
 		return nil, s.ctx.Err()
@@ -0,0 +1,100 @@
+//  Copyright (c) 2023 Cisco and/or its affiliates.
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at:
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+//go:build ignore
+
+// This program generates stats.snapshot used by the stats tests, the stats
+// are laid out as in the stat segment of VPP with two threads. Run it from
+// the core directory:
+//
+//	go run testdata/gen_snapshot.go
+package main
+
+import (
+	"log"
+	"time"
+
+	"go.fd.io/govpp/adapter"
+	"go.fd.io/govpp/adapter/statsfile"
+	"go.fd.io/govpp/core"
+)
+
+func main() {
+	snapshot := &statsfile.Snapshot{
+		Timestamp: time.Unix(1700000000, 0),
+		Epoch:     1,
+		Entries: []adapter.StatEntry{
+			{
+				StatIdentifier: adapter.StatIdentifier{Index: 1, Name: []byte(core.SystemStats_VectorRate)},
+				Type:           adapter.ScalarIndex,
+				Data:           adapter.ScalarStat(12.5),
+			},
+			{
+				StatIdentifier: adapter.StatIdentifier{Index: 2, Name: []byte(core.InterfaceStats_Names)},
+				Type:           adapter.NameVector,
+				Data:           adapter.NameStat{adapter.Name("local0"), adapter.Name("memif0/0")},
+			},
+			{
+				StatIdentifier: adapter.StatIdentifier{Index: 3, Name: []byte(core.InterfaceStats_Drops)},
+				Type:           adapter.SimpleCounterVector,
+				Data:           adapter.SimpleCounterStat{{1, 2}, {3, 4}},
+			},
+			{
+				StatIdentifier: adapter.StatIdentifier{Index: 4, Name: []byte(core.InterfaceStats_Rx)},
+				Type:           adapter.CombinedCounterVector,
+				Data:           adapter.CombinedCounterStat{{{0, 0}, {5, 500}}, {{0, 0}, {1, 100}}},
+			},
+			{
+				// VPP replaces "/" in the interface names of the symlinks by "_"
+				StatIdentifier: adapter.StatIdentifier{Index: 5, Name: []byte("/interfaces/memif0_0/rx")},
+				Type:           adapter.CombinedCounterVector,
+				Data:           adapter.CombinedCounterStat{{{5, 500}}, {{1, 100}}},
+				Symlink:        true,
+				SymlinkTarget:  4,
+				SymlinkItem:    1,
+			},
+			{
+				StatIdentifier: adapter.StatIdentifier{Index: 6, Name: []byte("/err/ip4-input/no error")},
+				Type:           adapter.ErrorIndex,
+				Data:           adapter.ErrorStat{7, 3},
+			},
+			{
+				StatIdentifier: adapter.StatIdentifier{Index: 7, Name: []byte(core.NetworkStats_RouteTo)},
+				Type:           adapter.CombinedCounterVector,
+				Data: adapter.CombinedCounterStat{
+					{{0, 0}, {10, 1000}, {1, 100}},
+					{{0, 0}, {5, 500}, {0, 0}},
+				},
+			},
+			{
+				StatIdentifier: adapter.StatIdentifier{Index: 8, Name: []byte(core.NetworkStats_RouteVia)},
+				Type:           adapter.CombinedCounterVector,
+				Data:           adapter.CombinedCounterStat{{{0, 0}, {2, 200}, {0, 0}}},
+			},
+			{
+				StatIdentifier: adapter.StatIdentifier{Index: 9, Name: []byte(core.NetworkStats_Adjacency)},
+				Type:           adapter.CombinedCounterVector,
+				Data:           adapter.CombinedCounterStat{{{3, 300}}},
+			},
+			{
+				StatIdentifier: adapter.StatIdentifier{Index: 10, Name: []byte("/empty")},
+				Type:           adapter.Empty,
+				Data:           adapter.EmptyStat(""),
+			},
+		},
+	}
+	if err := statsfile.SaveFile("testdata/stats.snapshot", snapshot); err != nil {
+		log.Fatal(err)
+	}
+}
@@ -27,6 +27,36 @@ type trace struct {
 	mux  *sync.Mutex
 
 	isEnabled int32
+
+	capacity int           // maximum number of kept records, unlimited if zero
+	next     int           // index of the oldest record in the full ring buffer
+	filters  []TraceFilter // all of them must match for the record to be traced
+	sinks    []TraceSink   // receive the traced records as they are captured
+}
+
+// WithTraceCapacity limits the number of records kept by the API trace. Once the capacity
+// is reached, the oldest records are overwritten by the new ones. By default, the number
+// of records is not limited.
+func WithTraceCapacity(capacity int) ConnectionOption {
+	return func(c *Connection) {
+		c.apiTrace.capacity = capacity
+	}
+}
+
+// WithTraceFilters adds filters for the API trace. Only the messages matching all
+// the filters are traced.
+func WithTraceFilters(filters ...TraceFilter) ConnectionOption {
+	return func(c *Connection) {
+		c.apiTrace.filters = append(c.apiTrace.filters, filters...)
+	}
+}
+
+// WithTraceSinks adds sinks receiving the traced messages as they are captured
+// while the API trace is enabled.
+func WithTraceSinks(sinks ...TraceSink) ConnectionOption {
+	return func(c *Connection) {
+		c.apiTrace.sinks = append(c.apiTrace.sinks, sinks...)
+	}
 }
 
 func (c *trace) Enable(enable bool) {
@@ -64,5 +94,26 @@ func (c *trace) GetRecordsForChannel(chId uint16) (list []*api.Record) {
 func (c *trace) Clear() {
 	c.mux.Lock()
 	c.list = make([]*api.Record, 0)
+	c.next = 0
 	c.mux.Unlock()
 }
+
+// add stores the record if it matches the filters and passes it to the sinks.
+func (c *trace) add(record *api.Record) {
+	for _, filter := range c.filters {
+		if !filter(record) {
+			return
+		}
+	}
+	c.mux.Lock()
+	if c.capacity > 0 && len(c.list) >= c.capacity {
+		c.list[c.next] = record
+		c.next = (c.next + 1) % c.capacity
+	} else {
+		c.list = append(c.list, record)
+	}
+	c.mux.Unlock()
+	for _, sink := range c.sinks {
+		sink.WriteRecord(record)
+	}
+}
@@ -0,0 +1,139 @@
+//  Copyright (c) 2023 Cisco and/or its affiliates.
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at:
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package core
+
+import (
+	"fmt"
+	"io"
+	"sync"
+	"time"
+
+	"github.com/sirupsen/logrus"
+
+	"go.fd.io/govpp/api"
+)
+
+// TraceFilter reports whether the record should be traced.
+type TraceFilter func(record *api.Record) bool
+
+// TraceMessageFilter returns a filter matching the messages with any of the given names.
+func TraceMessageFilter(names ...string) TraceFilter {
+	set := make(map[string]struct{}, len(names))
+	for _, name := range names {
+		set[name] = struct{}{}
+	}
+	return func(record *api.Record) bool {
+		_, ok := set[record.Message.GetMessageName()]
+		return ok
+	}
+}
+
+// TraceChannelFilter returns a filter matching the messages of any of the given channels.
+func TraceChannelFilter(ids ...uint16) TraceFilter {
+	return func(record *api.Record) bool {
+		for _, id := range ids {
+			if record.ChannelID == id {
+				return true
+			}
+		}
+		return false
+	}
+}
+
+// TraceDirectionFilter returns a filter matching either the received or the sent messages.
+func TraceDirectionFilter(received bool) TraceFilter {
+	return func(record *api.Record) bool {
+		return record.IsReceived == received
+	}
+}
+
+// TraceSink receives the traced records as they are captured. WriteRecord is
+// called synchronously from the connection internals, so it must be safe
+// for concurrent use and should not block.
+type TraceSink interface {
+	WriteRecord(record *api.Record)
+}
+
+// TraceSinkFunc is a function adapter for TraceSink.
+type TraceSinkFunc func(record *api.Record)
+
+func (f TraceSinkFunc) WriteRecord(record *api.Record) {
+	f(record)
+}
+
+// NewTraceWriterSink returns a sink writing each record as a line of text into w
+// (e.g. a file). Write errors are logged and otherwise ignored.
+func NewTraceWriterSink(w io.Writer) TraceSink {
+	return &traceWriterSink{w: w}
+}
+
+type traceWriterSink struct {
+	mu sync.Mutex
+	w  io.Writer
+}
+
+func (s *traceWriterSink) WriteRecord(record *api.Record) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if _, err := fmt.Fprintln(s.w, formatTraceRecord(record)); err != nil {
+		log.Debugf("writing API trace record failed: %v", err)
+	}
+}
+
+// NewTraceChanSink returns a sink sending the records into ch. The records
+// are dropped if ch is not ready to receive them.
+func NewTraceChanSink(ch chan<- *api.Record) TraceSink {
+	return TraceSinkFunc(func(record *api.Record) {
+		select {
+		case ch <- record:
+		default:
+			log.Debugf("API trace channel is full, dropping record of %s", record.Message.GetMessageName())
+		}
+	})
+}
+
+// NewTraceLogSink returns a sink logging the records with the logger at the given level.
+func NewTraceLogSink(logger logrus.FieldLogger, level logrus.Level) TraceSink {
+	return TraceSinkFunc(func(record *api.Record) {
+		fields := logrus.Fields{
+			"msg_name": record.Message.GetMessageName(),
+			"channel":  record.ChannelID,
+			"context":  record.Context,
+			"seq_num":  record.SeqNum,
+		}
+		if record.IsReceived && record.Latency > 0 {
+			fields["latency"] = record.Latency
+		}
+		logger.WithFields(fields).Logf(level, "%s %+v", traceDirection(record), record.Message)
+	})
+}
+
+func traceDirection(record *api.Record) string {
+	if record.IsReceived {
+		return "<--"
+	}
+	return "-->"
+}
+
+// formatTraceRecord formats the record as a single line of text.
+func formatTraceRecord(record *api.Record) string {
+	line := fmt.Sprintf("%s %s ch=%d ctx=%#x seq=%d %s",
+		record.Timestamp.Format(time.RFC3339Nano), traceDirection(record),
+		record.ChannelID, record.Context, record.SeqNum, record.Message.GetMessageName())
+	if record.IsReceived && record.Latency > 0 {
+		line += fmt.Sprintf(" latency=%s", record.Latency)
+	}
+	return line + fmt.Sprintf(" %+v", record.Message)
+}
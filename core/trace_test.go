@@ -1,13 +1,16 @@
 package core_test
 
 import (
+	"bytes"
 	"strings"
 	"testing"
 
 	. "github.com/onsi/gomega"
 
+	"go.fd.io/govpp/adapter/mock"
 	"go.fd.io/govpp/api"
 	interfaces "go.fd.io/govpp/binapi/interface"
+	"go.fd.io/govpp/binapi/interface_types"
 	"go.fd.io/govpp/binapi/ip"
 	"go.fd.io/govpp/binapi/l2"
 	"go.fd.io/govpp/binapi/memclnt"
@@ -267,3 +270,95 @@ func TestTraceClear(t *testing.T) {
 	Expect(traced).To(BeNil())
 	Expect(traced).To(BeEmpty())
 }
+
+func TestTraceCapacity(t *testing.T) {
+	RegisterTestingT(t)
+
+	mockVpp := mock.NewVppAdapter()
+	conn, err := core.Connect(mockVpp, core.WithTraceCapacity(3))
+	Expect(err).ShouldNot(HaveOccurred())
+	defer conn.Disconnect()
+	conn.Trace().Enable(true)
+
+	ch, err := conn.NewAPIChannel()
+	Expect(err).ShouldNot(HaveOccurred())
+	defer ch.Close()
+
+	for i := 0; i < 3; i++ {
+		mockVpp.MockReply(&interfaces.CreateLoopbackReply{SwIfIndex: interface_types.InterfaceIndex(i)})
+		err := ch.SendRequest(&interfaces.CreateLoopback{}).ReceiveReply(&interfaces.CreateLoopbackReply{})
+		Expect(err).ShouldNot(HaveOccurred())
+	}
+
+	// only the last three captured records are kept (the mock adapter
+	// replies synchronously, so each reply is captured before its request)
+	traced := conn.Trace().GetRecords()
+	Expect(traced).To(HaveLen(3))
+	var replies []api.Message
+	for _, record := range traced {
+		if record.IsReceived {
+			replies = append(replies, record.Message)
+		}
+	}
+	Expect(replies).To(Equal([]api.Message{&interfaces.CreateLoopbackReply{SwIfIndex: 2}}))
+
+	conn.Trace().Clear()
+	Expect(conn.Trace().GetRecords()).To(BeEmpty())
+}
+
+func TestTraceFiltersAndSinks(t *testing.T) {
+	RegisterTestingT(t)
+
+	var (
+		buf     bytes.Buffer
+		records = make(chan *api.Record, 10)
+	)
+	mockVpp := mock.NewVppAdapter()
+	conn, err := core.Connect(mockVpp,
+		core.WithTraceFilters(core.TraceMessageFilter("create_loopback", "create_loopback_reply")),
+		core.WithTraceSinks(core.NewTraceWriterSink(&buf), core.NewTraceChanSink(records)),
+	)
+	Expect(err).ShouldNot(HaveOccurred())
+	defer conn.Disconnect()
+	conn.Trace().Enable(true)
+
+	ch, err := conn.NewAPIChannel()
+	Expect(err).ShouldNot(HaveOccurred())
+	defer ch.Close()
+	chID := ch.(*core.Channel).GetID()
+
+	mockVpp.MockReply(&interfaces.CreateLoopbackReply{})
+	Expect(ch.SendRequest(&interfaces.CreateLoopback{}).ReceiveReply(&interfaces.CreateLoopbackReply{})).To(Succeed())
+	mockVpp.MockReply(&memclnt.ControlPingReply{})
+	Expect(ch.SendRequest(&memclnt.ControlPing{}).ReceiveReply(&memclnt.ControlPingReply{})).To(Succeed())
+
+	// control ping is filtered out
+	Expect(conn.Trace().GetRecords()).To(HaveLen(2))
+	Expect(records).To(HaveLen(2))
+
+	// the records are streamed in the order they are captured, which is
+	// reply first for the mock adapter replying synchronously
+	var reply, request *api.Record
+	Expect(records).To(Receive(&reply))
+	Expect(records).To(Receive(&request))
+	Expect(request.IsReceived).To(BeFalse())
+	Expect(reply.IsReceived).To(BeTrue())
+	Expect(reply.Message.GetMessageName()).To(Equal("create_loopback_reply"))
+	Expect(reply.ChannelID).To(Equal(chID))
+	Expect(reply.SeqNum).To(Equal(request.SeqNum))
+	Expect(reply.Context).To(Equal(request.Context))
+	Expect(reply.Context).ToNot(BeZero())
+	Expect(reply.Latency).To(BeNumerically(">", 0))
+
+	lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+	Expect(lines).To(HaveLen(2))
+	Expect(lines[0]).To(ContainSubstring("<-- ch=%d", chID))
+	Expect(lines[0]).To(ContainSubstring("create_loopback_reply latency="))
+	Expect(lines[1]).To(ContainSubstring("--> ch=%d", chID))
+
+	// direction and channel filters
+	Expect(core.TraceDirectionFilter(true)(reply)).To(BeTrue())
+	Expect(core.TraceDirectionFilter(true)(request)).To(BeFalse())
+	Expect(core.TraceChannelFilter(chID)(request)).To(BeTrue())
+	Expect(core.TraceChannelFilter(chID + 1)(request)).To(BeFalse())
+}
@@ -0,0 +1,107 @@
+//  Copyright (c) 2023 Cisco and/or its affiliates.
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at:
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package core
+
+import (
+	"context"
+	"errors"
+
+	"go.fd.io/govpp/api"
+)
+
+// Span attribute keys set by the core.
+const (
+	SpanAttrMsgName  = "vpp.msg.name"  // name of the request message
+	SpanAttrMsgCrc   = "vpp.msg.crc"   // CRC of the request message
+	SpanAttrChannel  = "vpp.channel"   // ID of the channel used to send the request
+	SpanAttrContext  = "vpp.context"   // context of the request sent to VPP
+	SpanAttrSeqNum   = "vpp.seq_num"   // sequence number of the request
+	SpanAttrRetval   = "vpp.retval"    // retval of the reply message
+	SpanAttrApiError = "vpp.api_error" // VPPApiError derived from retval
+)
+
+// Tracer creates spans for the requests sent to VPP. It allows plugging
+// a distributed tracing library (e.g. OpenTelemetry) into the core without
+// depending on it.
+type Tracer interface {
+	// StartSpan starts a new span with the given name as a child of the span
+	// carried by ctx (if any) and returns the context carrying the new span.
+	StartSpan(ctx context.Context, name string) (context.Context, Span)
+}
+
+// Span represents a single traced operation.
+type Span interface {
+	// SetAttribute sets an attribute of the span.
+	SetAttribute(key string, value interface{})
+
+	// RecordError records the error and marks the span as failed.
+	RecordError(err error)
+
+	// End completes the span.
+	End()
+}
+
+// WithTracer sets tracer for the connection. By default, no spans are created.
+func WithTracer(tracer Tracer) ConnectionOption {
+	return func(c *Connection) {
+		c.tracer = tracer
+	}
+}
+
+type noopTracer struct{}
+
+func (noopTracer) StartSpan(ctx context.Context, _ string) (context.Context, Span) {
+	return ctx, noopSpan{}
+}
+
+type noopSpan struct{}
+
+func (noopSpan) SetAttribute(string, interface{}) {}
+func (noopSpan) RecordError(error)                {}
+func (noopSpan) End()                             {}
+
+// startRequestSpan starts a span for the request sent via the channel.
+func (c *Connection) startRequestSpan(ctx context.Context, name string, ch *Channel, req *vppRequest) (context.Context, Span) {
+	ctx, span := c.tracer.StartSpan(ctx, name)
+	span.SetAttribute(SpanAttrMsgName, req.msg.GetMessageName())
+	span.SetAttribute(SpanAttrMsgCrc, req.msg.GetCrcString())
+	span.SetAttribute(SpanAttrChannel, ch.id)
+	span.SetAttribute(SpanAttrContext, packRequestContext(ch.id, req.multi, req.seqNum))
+	span.SetAttribute(SpanAttrSeqNum, req.seqNum)
+	return ctx, span
+}
+
+// endSpan records the reply and error into the span and ends it. The reply
+// is only inspected if it has been decoded (err is nil or VPPApiError).
+func endSpan(span Span, reply api.Message, err error) {
+	var apiErr api.VPPApiError
+	isApiErr := errors.As(err, &apiErr)
+	if reply != nil && (err == nil || isApiErr) {
+		if retval, ok := getReplyRetval(reply); ok {
+			span.SetAttribute(SpanAttrRetval, retval)
+			if err == nil {
+				err = api.RetvalToVPPApiError(retval)
+				isApiErr = errors.As(err, &apiErr)
+			}
+		}
+	}
+	if err != nil {
+		if isApiErr {
+			span.SetAttribute(SpanAttrApiError, apiErr.Error())
+		}
+		span.RecordError(err)
+	}
+	span.End()
+}
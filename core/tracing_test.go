@@ -0,0 +1,98 @@
+package core
+
+import (
+	"context"
+	"sync"
+	"testing"
+
+	. "github.com/onsi/gomega"
+
+	"go.fd.io/govpp/adapter/mock"
+	"go.fd.io/govpp/api"
+	interfaces "go.fd.io/govpp/binapi/interface"
+)
+
+type testSpan struct {
+	name   string
+	parent *testSpan
+	attrs  map[string]interface{}
+	err    error
+	ended  bool
+}
+
+func (s *testSpan) SetAttribute(key string, value interface{}) { s.attrs[key] = value }
+func (s *testSpan) RecordError(err error)                      { s.err = err }
+func (s *testSpan) End()                                       { s.ended = true }
+
+type testSpanKey struct{}
+
+type testTracer struct {
+	sync.Mutex
+	spans []*testSpan
+}
+
+func (t *testTracer) StartSpan(ctx context.Context, name string) (context.Context, Span) {
+	t.Lock()
+	defer t.Unlock()
+	parent, _ := ctx.Value(testSpanKey{}).(*testSpan)
+	span := &testSpan{name: name, parent: parent, attrs: map[string]interface{}{}}
+	t.spans = append(t.spans, span)
+	return context.WithValue(ctx, testSpanKey{}, span), span
+}
+
+func TestTracingInvoke(t *testing.T) {
+	RegisterTestingT(t)
+
+	tracer := &testTracer{}
+	mockVpp := mock.NewVppAdapter()
+	conn, err := Connect(mockVpp, WithTracer(tracer))
+	Expect(err).ShouldNot(HaveOccurred())
+	defer conn.Disconnect()
+
+	mockVpp.MockReply(&interfaces.CreateLoopbackReply{Retval: int32(api.INVALID_VALUE)})
+	reply := &interfaces.CreateLoopbackReply{}
+	err = conn.Invoke(context.Background(), &interfaces.CreateLoopback{}, reply)
+	Expect(err).ShouldNot(HaveOccurred())
+
+	Expect(tracer.spans).To(HaveLen(2))
+	invokeSpan, sendSpan := tracer.spans[0], tracer.spans[1]
+	Expect(invokeSpan.name).To(Equal("govpp.Invoke"))
+	Expect(invokeSpan.ended).To(BeTrue())
+	Expect(invokeSpan.attrs).To(HaveKeyWithValue(SpanAttrMsgName, "create_loopback"))
+	Expect(invokeSpan.attrs).To(HaveKeyWithValue(SpanAttrRetval, int32(api.INVALID_VALUE)))
+	Expect(invokeSpan.attrs).To(HaveKey(SpanAttrApiError))
+	Expect(invokeSpan.err).To(MatchError(api.INVALID_VALUE))
+
+	Expect(sendSpan.name).To(Equal("govpp.Stream.SendMsg"))
+	Expect(sendSpan.parent).To(Equal(invokeSpan))
+	Expect(sendSpan.ended).To(BeTrue())
+	Expect(sendSpan.attrs).To(HaveKey(SpanAttrChannel))
+	Expect(sendSpan.attrs).To(HaveKeyWithValue(SpanAttrSeqNum, uint16(1)))
+	Expect(sendSpan.err).ToNot(HaveOccurred())
+}
+
+func TestTracingChannelRequest(t *testing.T) {
+	RegisterTestingT(t)
+
+	tracer := &testTracer{}
+	mockVpp := mock.NewVppAdapter()
+	conn, err := Connect(mockVpp, WithTracer(tracer))
+	Expect(err).ShouldNot(HaveOccurred())
+	defer conn.Disconnect()
+
+	ch, err := conn.NewAPIChannel()
+	Expect(err).ShouldNot(HaveOccurred())
+	defer ch.Close()
+
+	mockVpp.MockReply(&interfaces.CreateLoopbackReply{})
+	err = ch.SendRequest(&interfaces.CreateLoopback{}).ReceiveReply(&interfaces.CreateLoopbackReply{})
+	Expect(err).ShouldNot(HaveOccurred())
+
+	Expect(tracer.spans).To(HaveLen(1))
+	span := tracer.spans[0]
+	Expect(span.name).To(Equal("govpp.Channel.SendRequest"))
+	Expect(span.ended).To(BeTrue())
+	Expect(span.attrs).To(HaveKeyWithValue(SpanAttrMsgCrc, (&interfaces.CreateLoopback{}).GetCrcString()))
+	Expect(span.attrs).To(HaveKeyWithValue(SpanAttrRetval, int32(0)))
+	Expect(span.err).ToNot(HaveOccurred())
+}
@@ -25,6 +25,7 @@ import (
 
 	"go.fd.io/govpp/adapter"
 	"go.fd.io/govpp/adapter/statsclient"
+	"go.fd.io/govpp/adapter/statsfile"
 	"go.fd.io/govpp/api"
 	"go.fd.io/govpp/core"
 )
@@ -41,11 +42,13 @@ var (
 	dumpAll     = flag.Bool("all", false, "Dump all stats including ones with zero values")
 	pollPeriod  = flag.Duration("period", time.Second*5, "Polling interval period")
 	async       = flag.Bool("async", false, "Use asynchronous connection")
+	snapshot    = flag.String("snapshot", "", "Read stats from the snapshot file instead of VPP")
+	output      = flag.String("output", "stats.snapshot", "Path to the snapshot file written by the save command")
 )
 
 func init() {
 	flag.Usage = func() {
-		fmt.Fprintf(os.Stderr, "%s: usage [ls|dump|poll|errors|interfaces|nodes|system|buffers|memory|epoch] <patterns/index>...\n", os.Args[0])
+		fmt.Fprintf(os.Stderr, "%s: usage [ls|dump|poll|errors|interfaces|nodes|system|buffers|memory|network|epoch|save] <patterns/index>...\n", os.Args[0])
 		flag.PrintDefaults()
 		os.Exit(1)
 	}
@@ -68,12 +71,22 @@ func main() {
 	}
 
 	var (
-		client *statsclient.StatsClient
+		client adapter.StatsAPI
 		c      *core.StatsConnection
 		err    error
 	)
 
-	if *async {
+	if *snapshot != "" {
+		s, err := statsfile.LoadFile(*snapshot)
+		if err != nil {
+			log.Fatalln("Loading snapshot failed:", err)
+		}
+		client = statsfile.NewStatsAdapter(s)
+		c, err = core.ConnectStats(client)
+		if err != nil {
+			log.Fatalln("Connecting failed:", err)
+		}
+	} else if *async {
 		var statsChan chan core.ConnectionEvent
 		client = statsclient.NewStatsClient(*statsSocket, statsclient.SetSocketRetryPeriod(1*time.Second),
 			statsclient.SetSocketRetryTimeout(10*time.Second))
@@ -171,6 +184,21 @@ func main() {
 		}
 		fmt.Printf("Memory stats: %+v\n", stats)
 
+	case "network":
+		stats := new(api.NetworkStats)
+		if err := c.GetNetworkStats(stats); err != nil {
+			log.Fatalln("getting network stats failed:", err)
+		}
+		n := 0
+		for _, route := range stats.Routes {
+			if skipZeros && route.To.Packets == 0 && route.Via.Packets == 0 {
+				continue
+			}
+			fmt.Printf(" - route %d: to %+v via %+v\n", route.StatsIndex, route.To, route.Via)
+			n++
+		}
+		fmt.Printf("Listed %d (%d) route counters\n", n, len(stats.Routes))
+
 	case "dump":
 		fmt.Printf("Dumping stats.. %s\n", strings.Join(patterns, " "))
 
@@ -191,6 +219,11 @@ func main() {
 
 		getEpoch(client)
 
+	case "save":
+		fmt.Printf("Saving stats snapshot.. %s\n", strings.Join(patterns, " "))
+
+		saveSnapshot(client, patterns, *output)
+
 	default:
 		fmt.Printf("invalid command: %q\n", cmd)
 	}
@@ -221,6 +254,17 @@ func listStats(client adapter.StatsAPI, patterns []string, indexes []uint32) {
 	fmt.Printf("Listed %d stats\n", len(list))
 }
 
+func saveSnapshot(client adapter.StatsAPI, patterns []string, path string) {
+	s, err := statsfile.Capture(client, patterns...)
+	if err != nil {
+		log.Fatalln("capturing stats failed:", err)
+	}
+	if err := statsfile.SaveFile(path, s); err != nil {
+		log.Fatalln("saving snapshot failed:", err)
+	}
+	fmt.Printf("Saved %d stats to %s\n", len(s.Entries), path)
+}
+
 func getEpoch(client adapter.StatsAPI) {
 	dir, err := client.PrepareDir()
 	if err != nil {
@@ -0,0 +1,74 @@
+//  Copyright (c) 2023 Cisco and/or its affiliates.
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at:
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+// The vpp-stats-exporter exposes the stats from the VPP stats segment as
+// Prometheus metrics on HTTP endpoint.
+package main
+
+import (
+	"log"
+	"net/http"
+
+	prom "github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+	"github.com/spf13/pflag"
+
+	"go.fd.io/govpp/adapter/statsclient"
+	"go.fd.io/govpp/metrics/prometheus"
+)
+
+var (
+	statsSocket = pflag.String("stats-socket", statsclient.DefaultSocketName, "Path to VPP stats socket.")
+	listenAddr  = pflag.String("listen", ":9482", "Address on which the metrics are served.")
+	metricsPath = pflag.String("path", "/metrics", "Path under which the metrics are served.")
+	include     = pflag.StringSlice("include", nil, "Regular expressions of the stat names to export (all by default).")
+	exclude     = pflag.StringSlice("exclude", nil, "Regular expressions of the stat names excluded from export.")
+	perThread   = pflag.Bool("per-thread", false, "Export counters per VPP thread instead of summed for all threads.")
+	labels      = pflag.StringToString("label", nil, "Constant labels added to all metrics (e.g. --label vpp=node1).")
+)
+
+func main() {
+	pflag.Parse()
+
+	client := statsclient.NewStatsClient(*statsSocket)
+	if err := client.Connect(); err != nil {
+		log.Fatalln("connecting to stats failed:", err)
+	}
+	defer func() {
+		if err := client.Disconnect(); err != nil {
+			log.Println("disconnecting stats failed:", err)
+		}
+	}()
+
+	collector, err := prometheus.NewStatsCollector(client,
+		prometheus.WithStatsInclude(*include...),
+		prometheus.WithStatsExclude(*exclude...),
+		prometheus.WithPerThread(*perThread),
+		prometheus.WithStatsConstLabels(*labels),
+	)
+	if err != nil {
+		log.Fatalln(err)
+	}
+	registry := prom.NewRegistry()
+	registry.MustRegister(collector)
+
+	http.Handle(*metricsPath, promhttp.HandlerFor(registry, promhttp.HandlerOpts{
+		ErrorLog:          log.Default(),
+		EnableOpenMetrics: true,
+	}))
+	log.Printf("serving metrics on %s%s", *listenAddr, *metricsPath)
+	if err := http.ListenAndServe(*listenAddr, nil); err != nil {
+		log.Fatalln(err)
+	}
+}
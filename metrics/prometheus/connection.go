@@ -0,0 +1,194 @@
+//  Copyright (c) 2023 Cisco and/or its affiliates.
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at:
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package prometheus
+
+import (
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+
+	"go.fd.io/govpp/core"
+)
+
+const (
+	namespace = "govpp"
+
+	labelMessage = "message"
+	labelState   = "state"
+)
+
+// DefaultLatencyBuckets are the default histogram buckets (in seconds) for reply latency.
+var DefaultLatencyBuckets = []float64{.0001, .00025, .0005, .001, .0025, .005, .01, .025, .05, .1, .25, .5, 1}
+
+// ConnectionMetrics implements core.Metrics and exposes the collected values
+// as Prometheus metrics. It is a prometheus.Collector, which needs to be
+// registered to a registry.
+//
+// To distinguish metrics from multiple connections (e.g. for multiple VPP
+// instances), use different constant labels for each of them:
+//
+//	metrics := prometheus.NewConnectionMetrics(prom.Labels{"vpp": "node1"})
+//	prom.MustRegister(metrics)
+//	conn, err := core.Connect(adapter, core.WithMetrics(metrics))
+type ConnectionMetrics struct {
+	requests             *prometheus.CounterVec
+	requestFailures      *prometheus.CounterVec
+	replyLatency         *prometheus.HistogramVec
+	replyErrors          *prometheus.CounterVec
+	replyTimeouts        *prometheus.CounterVec
+	droppedNotifications *prometheus.CounterVec
+	healthCheckLatency   prometheus.Histogram
+	healthCheckFailures  prometheus.Counter
+	connectionEvents     *prometheus.CounterVec
+	connected            prometheus.Gauge
+}
+
+var _ core.Metrics = (*ConnectionMetrics)(nil)
+
+// NewConnectionMetrics returns new ConnectionMetrics with the given constant labels.
+func NewConnectionMetrics(constLabels prometheus.Labels) *ConnectionMetrics {
+	return &ConnectionMetrics{
+		requests: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace:   namespace,
+			Name:        "requests_total",
+			Help:        "Number of requests sent to VPP.",
+			ConstLabels: constLabels,
+		}, []string{labelMessage}),
+		requestFailures: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace:   namespace,
+			Name:        "request_failures_total",
+			Help:        "Number of requests that could not be sent to VPP.",
+			ConstLabels: constLabels,
+		}, []string{labelMessage}),
+		replyLatency: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace:   namespace,
+			Name:        "reply_latency_seconds",
+			Help:        "Time between sending a request and receiving its reply.",
+			ConstLabels: constLabels,
+			Buckets:     DefaultLatencyBuckets,
+		}, []string{labelMessage}),
+		replyErrors: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace:   namespace,
+			Name:        "reply_errors_total",
+			Help:        "Number of replies with non-zero retval.",
+			ConstLabels: constLabels,
+		}, []string{labelMessage}),
+		replyTimeouts: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace:   namespace,
+			Name:        "reply_timeouts_total",
+			Help:        "Number of requests without reply received within the timeout.",
+			ConstLabels: constLabels,
+		}, []string{labelMessage}),
+		droppedNotifications: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace:   namespace,
+			Name:        "dropped_notifications_total",
+			Help:        "Number of notifications not delivered to a subscriber.",
+			ConstLabels: constLabels,
+		}, []string{labelMessage}),
+		healthCheckLatency: prometheus.NewHistogram(prometheus.HistogramOpts{
+			Namespace:   namespace,
+			Name:        "health_check_latency_seconds",
+			Help:        "Duration of health check probes.",
+			ConstLabels: constLabels,
+			Buckets:     DefaultLatencyBuckets,
+		}),
+		healthCheckFailures: prometheus.NewCounter(prometheus.CounterOpts{
+			Namespace:   namespace,
+			Name:        "health_check_failures_total",
+			Help:        "Number of failed health check probes.",
+			ConstLabels: constLabels,
+		}),
+		connectionEvents: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace:   namespace,
+			Name:        "connection_events_total",
+			Help:        "Number of connection events by the new connection state.",
+			ConstLabels: constLabels,
+		}, []string{labelState}),
+		connected: prometheus.NewGauge(prometheus.GaugeOpts{
+			Namespace:   namespace,
+			Name:        "connected",
+			Help:        "Whether the connection to VPP is established (1) or not (0).",
+			ConstLabels: constLabels,
+		}),
+	}
+}
+
+func (m *ConnectionMetrics) collectors() []prometheus.Collector {
+	return []prometheus.Collector{
+		m.requests,
+		m.requestFailures,
+		m.replyLatency,
+		m.replyErrors,
+		m.replyTimeouts,
+		m.droppedNotifications,
+		m.healthCheckLatency,
+		m.healthCheckFailures,
+		m.connectionEvents,
+		m.connected,
+	}
+}
+
+// Describe implements prometheus.Collector.
+func (m *ConnectionMetrics) Describe(ch chan<- *prometheus.Desc) {
+	for _, c := range m.collectors() {
+		c.Describe(ch)
+	}
+}
+
+// Collect implements prometheus.Collector.
+func (m *ConnectionMetrics) Collect(ch chan<- prometheus.Metric) {
+	for _, c := range m.collectors() {
+		c.Collect(ch)
+	}
+}
+
+func (m *ConnectionMetrics) RequestSent(msgName string) {
+	m.requests.WithLabelValues(msgName).Inc()
+}
+
+func (m *ConnectionMetrics) RequestFailed(msgName string, _ error) {
+	m.requestFailures.WithLabelValues(msgName).Inc()
+}
+
+func (m *ConnectionMetrics) ReplyReceived(msgName string, latency time.Duration, err error) {
+	m.replyLatency.WithLabelValues(msgName).Observe(latency.Seconds())
+	if err != nil {
+		m.replyErrors.WithLabelValues(msgName).Inc()
+	}
+}
+
+func (m *ConnectionMetrics) ReplyTimedOut(msgName string) {
+	m.replyTimeouts.WithLabelValues(msgName).Inc()
+}
+
+func (m *ConnectionMetrics) NotificationDropped(msgName string) {
+	m.droppedNotifications.WithLabelValues(msgName).Inc()
+}
+
+func (m *ConnectionMetrics) HealthCheckProbed(duration time.Duration, err error) {
+	m.healthCheckLatency.Observe(duration.Seconds())
+	if err != nil {
+		m.healthCheckFailures.Inc()
+	}
+}
+
+func (m *ConnectionMetrics) ConnectionStateChanged(state core.ConnectionState) {
+	m.connectionEvents.WithLabelValues(state.String()).Inc()
+	if state == core.Connected {
+		m.connected.Set(1)
+	} else {
+		m.connected.Set(0)
+	}
+}
@@ -0,0 +1,7 @@
+// Package prometheus provides collectors exposing GoVPP connection metrics
+// and the stats from the VPP stats segment to Prometheus.
+//
+// The package is a separate Go module, so the GoVPP module does not depend
+// on the Prometheus client library. The vpp-stats-exporter command serving
+// the stats is in the cmd directory of this module.
+package prometheus
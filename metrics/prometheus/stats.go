@@ -0,0 +1,438 @@
+//  Copyright (c) 2023 Cisco and/or its affiliates.
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at:
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package prometheus
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/prometheus/client_golang/prometheus"
+
+	"go.fd.io/govpp/adapter"
+)
+
+const (
+	statsNamespace = "vpp"
+
+	labelInterface = "interface"
+	labelNode      = "node"
+	labelError     = "error"
+	labelThread    = "thread"
+	labelIndex     = "index"
+	labelName      = "name"
+
+	interfaceNames = "/if/names"
+	nodeNames      = "/sys/node/names"
+)
+
+// StatsCollectorOption allows customizing a StatsCollector.
+type StatsCollectorOption func(*StatsCollector)
+
+// WithStatsInclude sets the regular expressions of the stat names to export.
+// All stats are exported by default.
+func WithStatsInclude(patterns ...string) StatsCollectorOption {
+	return func(c *StatsCollector) {
+		c.include = append(c.include, patterns...)
+	}
+}
+
+// WithStatsExclude sets the regular expressions of the stat names excluded from export.
+func WithStatsExclude(patterns ...string) StatsCollectorOption {
+	return func(c *StatsCollector) {
+		c.exclude = append(c.exclude, patterns...)
+	}
+}
+
+// WithPerThread exports counters per VPP thread with the thread label,
+// instead of the values summed for all threads.
+func WithPerThread(perThread bool) StatsCollectorOption {
+	return func(c *StatsCollector) {
+		c.perThread = perThread
+	}
+}
+
+// WithStatsConstLabels sets the constant labels added to all exported metrics.
+func WithStatsConstLabels(labels prometheus.Labels) StatsCollectorOption {
+	return func(c *StatsCollector) {
+		c.constLabels = labels
+	}
+}
+
+// StatsCollector exports the stats from the VPP stats segment as Prometheus
+// metrics. The stat names are converted to metric names, e.g. /if/rx is
+// exported as vpp_if_rx_packets_total and vpp_if_rx_bytes_total:
+//
+//   - ScalarStat is exported as gauge
+//   - ErrorStat and counters under /err/ are exported as vpp_errors_total
+//     with the node and error labels
+//   - SimpleCounterStat and CombinedCounterStat are exported as counters
+//     (gauges under /sys/ and /mem/) with the interface label for /if/ stats,
+//     the node label for /sys/node/ stats and the index label otherwise
+//   - symlinks (e.g. /interfaces/<name>/rx) are exported with the interface
+//     or node label from their name
+//
+// The set of exported metrics changes with the stats segment, so the collector
+// is unchecked, i.e. it describes no metrics in advance.
+type StatsCollector struct {
+	stats       adapter.StatsAPI
+	include     []string
+	exclude     []string
+	perThread   bool
+	constLabels prometheus.Labels
+
+	includeRe []*regexp.Regexp
+	excludeRe []*regexp.Regexp
+
+	mu  sync.Mutex
+	dir *adapter.StatDir
+}
+
+// NewStatsCollector returns the collector of the stats retrieved from
+// the connected stats client. It fails if the include or exclude patterns
+// are not valid regular expressions.
+func NewStatsCollector(stats adapter.StatsAPI, opts ...StatsCollectorOption) (*StatsCollector, error) {
+	c := &StatsCollector{stats: stats}
+	for _, opt := range opts {
+		opt(c)
+	}
+	var err error
+	if c.includeRe, err = compilePatterns(c.include); err != nil {
+		return nil, err
+	}
+	if c.excludeRe, err = compilePatterns(c.exclude); err != nil {
+		return nil, err
+	}
+	return c, nil
+}
+
+func compilePatterns(patterns []string) ([]*regexp.Regexp, error) {
+	res := make([]*regexp.Regexp, len(patterns))
+	for i, pattern := range patterns {
+		re, err := regexp.Compile(pattern)
+		if err != nil {
+			return nil, fmt.Errorf("invalid stats pattern %q: %w", pattern, err)
+		}
+		res[i] = re
+	}
+	return res, nil
+}
+
+// Describe implements prometheus.Collector.
+func (c *StatsCollector) Describe(chan<- *prometheus.Desc) {}
+
+// Collect implements prometheus.Collector.
+func (c *StatsCollector) Collect(ch chan<- prometheus.Metric) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if err := c.updateDir(); err != nil {
+		desc := prometheus.NewDesc(statsNamespace+"_stats_error", "Error of reading VPP stats.", nil, c.constLabels)
+		ch <- prometheus.NewInvalidMetric(desc, err)
+		return
+	}
+
+	names := map[string][]string{}
+	for _, entry := range c.dir.Entries {
+		name := string(entry.Name)
+		if name != interfaceNames && name != nodeNames {
+			continue
+		}
+		if stat, ok := entry.Data.(adapter.NameStat); ok {
+			values := make([]string, len(stat))
+			for i, n := range stat {
+				values[i] = n.String()
+			}
+			names[name] = values
+		}
+	}
+
+	for _, entry := range c.dir.Entries {
+		name := string(entry.Name)
+		if !c.matches(name) {
+			continue
+		}
+		c.collectEntry(ch, name, entry, names)
+	}
+}
+
+// updateDir prepares the stat dir or updates the prepared one.
+func (c *StatsCollector) updateDir() error {
+	if c.dir != nil {
+		err := c.stats.UpdateDir(c.dir)
+		if err == nil {
+			return nil
+		}
+		if !errors.Is(err, adapter.ErrStatsDirStale) {
+			return err
+		}
+		c.dir = nil
+	}
+	var patterns []string
+	if len(c.include) > 0 {
+		patterns = append(patterns, c.include...)
+		patterns = append(patterns, "^"+interfaceNames+"$", "^"+nodeNames+"$")
+	}
+	dir, err := c.stats.PrepareDir(patterns...)
+	if err != nil {
+		return err
+	}
+	c.dir = dir
+	return nil
+}
+
+func (c *StatsCollector) matches(name string) bool {
+	if len(c.includeRe) > 0 {
+		var included bool
+		for _, re := range c.includeRe {
+			if re.MatchString(name) {
+				included = true
+				break
+			}
+		}
+		if !included {
+			return false
+		}
+	}
+	for _, re := range c.excludeRe {
+		if re.MatchString(name) {
+			return false
+		}
+	}
+	return true
+}
+
+// metric is the exported metric with its labels, the label values
+// are passed when the value is added.
+type metric struct {
+	c         *StatsCollector
+	ch        chan<- prometheus.Metric
+	desc      *prometheus.Desc
+	valueType prometheus.ValueType
+}
+
+// newMetric returns the metric with the given labels. The thread label is
+// added to the metrics of per-thread values, if exported per thread.
+func (c *StatsCollector) newMetric(ch chan<- prometheus.Metric, name, help string, valueType prometheus.ValueType, threads bool, labels ...string) *metric {
+	if threads && c.perThread {
+		labels = append(labels, labelThread)
+	}
+	return &metric{
+		c:         c,
+		ch:        ch,
+		desc:      prometheus.NewDesc(name, help, labels, c.constLabels),
+		valueType: valueType,
+	}
+}
+
+func (m *metric) add(value float64, labels ...string) {
+	m.ch <- prometheus.MustNewConstMetric(m.desc, m.valueType, value, labels...)
+}
+
+// addThreads adds the value per thread or summed for all threads.
+func (m *metric) addThreads(values []float64, labels ...string) {
+	if !m.c.perThread {
+		var sum float64
+		for _, v := range values {
+			sum += v
+		}
+		m.add(sum, labels...)
+		return
+	}
+	for thread, v := range values {
+		m.add(v, append(labels, strconv.Itoa(thread))...)
+	}
+}
+
+func (c *StatsCollector) collectEntry(ch chan<- prometheus.Metric, name string, entry adapter.StatEntry, names map[string][]string) {
+	help := fmt.Sprintf("VPP stat %s.", name)
+
+	if strings.HasPrefix(name, "/err/") {
+		node, errName := splitErrorName(name)
+		m := c.newMetric(ch, statsNamespace+"_errors_total", "VPP error counters.", prometheus.CounterValue, true, labelNode, labelError)
+		switch stat := entry.Data.(type) {
+		case adapter.ErrorStat:
+			values := make([]float64, len(stat))
+			for i, v := range stat {
+				values[i] = float64(v)
+			}
+			m.addThreads(values, node, errName)
+		case adapter.SimpleCounterStat:
+			values := make([]float64, len(stat))
+			for i, thread := range stat {
+				for _, v := range thread {
+					values[i] += float64(v)
+				}
+			}
+			m.addThreads(values, node, errName)
+		}
+		return
+	}
+
+	metricName := statsNamespace + "_" + sanitizeName(name)
+	valueType := counterValueType(name)
+	suffix := ""
+	if valueType == prometheus.CounterValue {
+		suffix = "_total"
+	}
+
+	if entry.Symlink {
+		label, object, metricName := symlinkLabel(name)
+		// the help must be the same for all objects
+		help := fmt.Sprintf("VPP stat %s.", strings.Replace(name, "/"+object+"/", "/<"+label+">/", 1))
+		switch stat := entry.Data.(type) {
+		case adapter.SimpleCounterStat:
+			m := c.newMetric(ch, metricName+suffix, help, valueType, true, label)
+			m.addThreads(simpleValues(stat, 0), object)
+		case adapter.CombinedCounterStat:
+			packets := c.newMetric(ch, metricName+"_packets"+suffix, help, valueType, true, label)
+			bytes := c.newMetric(ch, metricName+"_bytes"+suffix, help, valueType, true, label)
+			pkts, bts := combinedValues(stat, 0)
+			packets.addThreads(pkts, object)
+			bytes.addThreads(bts, object)
+		}
+		return
+	}
+
+	switch stat := entry.Data.(type) {
+	case adapter.ScalarStat:
+		m := c.newMetric(ch, metricName, help, prometheus.GaugeValue, false)
+		m.add(float64(stat))
+	case adapter.ErrorStat:
+		values := make([]float64, len(stat))
+		for i, v := range stat {
+			values[i] = float64(v)
+		}
+		m := c.newMetric(ch, metricName+suffix, help, valueType, true)
+		m.addThreads(values)
+	case adapter.SimpleCounterStat:
+		label, objects := indexLabel(name, names)
+		m := c.newMetric(ch, metricName+suffix, help, valueType, true, label)
+		for i := 0; i < vectorLen(len(stat), func(t int) int { return len(stat[t]) }); i++ {
+			m.addThreads(simpleValues(stat, i), objectName(objects, i))
+		}
+	case adapter.CombinedCounterStat:
+		label, objects := indexLabel(name, names)
+		packets := c.newMetric(ch, metricName+"_packets"+suffix, help, valueType, true, label)
+		bytes := c.newMetric(ch, metricName+"_bytes"+suffix, help, valueType, true, label)
+		for i := 0; i < vectorLen(len(stat), func(t int) int { return len(stat[t]) }); i++ {
+			pkts, bts := combinedValues(stat, i)
+			packets.addThreads(pkts, objectName(objects, i))
+			bytes.addThreads(bts, objectName(objects, i))
+		}
+	}
+}
+
+// vectorLen returns the maximum length of the per-thread vectors.
+func vectorLen(threads int, length func(int) int) (n int) {
+	for t := 0; t < threads; t++ {
+		if l := length(t); l > n {
+			n = l
+		}
+	}
+	return n
+}
+
+func simpleValues(stat adapter.SimpleCounterStat, index int) []float64 {
+	values := make([]float64, len(stat))
+	for t, thread := range stat {
+		if index < len(thread) {
+			values[t] = float64(thread[index])
+		}
+	}
+	return values
+}
+
+func combinedValues(stat adapter.CombinedCounterStat, index int) (packets, bytes []float64) {
+	packets = make([]float64, len(stat))
+	bytes = make([]float64, len(stat))
+	for t, thread := range stat {
+		if index < len(thread) {
+			packets[t] = float64(thread[index].Packets())
+			bytes[t] = float64(thread[index].Bytes())
+		}
+	}
+	return packets, bytes
+}
+
+// indexLabel returns the label and its values for the indexes of the counter vector.
+func indexLabel(name string, names map[string][]string) (string, []string) {
+	switch {
+	case strings.HasPrefix(name, "/if/"):
+		return labelInterface, names[interfaceNames]
+	case strings.HasPrefix(name, "/sys/node/"):
+		return labelNode, names[nodeNames]
+	}
+	return labelIndex, nil
+}
+
+// objectName returns the name of the object on the index or the index itself
+// if the name is unknown.
+func objectName(names []string, index int) string {
+	if index < len(names) && names[index] != "" {
+		return names[index]
+	}
+	return strconv.Itoa(index)
+}
+
+// symlinkLabel returns the label, its value and the metric name for
+// the symlink name, e.g. /interfaces/<name>/rx.
+func symlinkLabel(name string) (label, object, metricName string) {
+	parts := strings.Split(strings.TrimPrefix(name, "/"), "/")
+	if len(parts) < 3 {
+		return labelName, "", statsNamespace + "_" + sanitizeName(name)
+	}
+	dir, counter := parts[0], parts[len(parts)-1]
+	object = strings.Join(parts[1:len(parts)-1], "/")
+	switch dir {
+	case "interfaces":
+		label = labelInterface
+	case "nodes":
+		label = labelNode
+	default:
+		label = labelName
+	}
+	return label, object, statsNamespace + "_" + sanitizeName(dir+"/"+counter)
+}
+
+// splitErrorName returns the node and error name from /err/<node>/<error>.
+func splitErrorName(name string) (node, errName string) {
+	name = strings.TrimPrefix(name, "/err/")
+	if i := strings.Index(name, "/"); i >= 0 {
+		return name[:i], name[i+1:]
+	}
+	return "", name
+}
+
+// counterValueType returns the value type of counter vectors, the counters
+// under /sys/ (except nodes) and /mem/ are gauges.
+func counterValueType(name string) prometheus.ValueType {
+	if strings.HasPrefix(name, "/mem/") ||
+		strings.HasPrefix(name, "/sys/") && !strings.HasPrefix(name, "/sys/node/") {
+		return prometheus.GaugeValue
+	}
+	return prometheus.CounterValue
+}
+
+var invalidMetricChars = regexp.MustCompile(`[^a-zA-Z0-9_]+`)
+
+// sanitizeName converts the stat name to valid metric name.
+func sanitizeName(name string) string {
+	name = invalidMetricChars.ReplaceAllString(strings.Trim(name, "/"), "_")
+	return strings.Trim(strings.ToLower(name), "_")
+}
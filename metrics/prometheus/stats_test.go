@@ -0,0 +1,98 @@
+//  Copyright (c) 2023 Cisco and/or its affiliates.
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at:
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package prometheus_test
+
+import (
+	"strings"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	prom "github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+
+	"go.fd.io/govpp/adapter"
+	"go.fd.io/govpp/adapter/mock"
+	"go.fd.io/govpp/adapter/statsfile"
+	"go.fd.io/govpp/metrics/prometheus"
+)
+
+// newTestStatsAdapter returns the adapter serving the stats of
+// testdata/stats.snapshot, which is generated by testdata/gen_snapshot.go.
+func newTestStatsAdapter() adapter.StatsAPI {
+	snapshot, err := statsfile.LoadFile("testdata/stats.snapshot")
+	Expect(err).ShouldNot(HaveOccurred())
+	return statsfile.NewStatsAdapter(snapshot)
+}
+
+func TestStatsCollector(t *testing.T) {
+	RegisterTestingT(t)
+
+	collector, err := prometheus.NewStatsCollector(newTestStatsAdapter(), prometheus.WithStatsExclude("^/net/"))
+	Expect(err).ShouldNot(HaveOccurred())
+
+	Expect(testutil.CollectAndCompare(collector, strings.NewReader(`
+# HELP vpp_errors_total VPP error counters.
+# TYPE vpp_errors_total counter
+vpp_errors_total{error="ip4 ttl expired",node="ip4-input"} 8
+# HELP vpp_if_drops_total VPP stat /if/drops.
+# TYPE vpp_if_drops_total counter
+vpp_if_drops_total{interface="local0"} 1
+vpp_if_drops_total{interface="loop0"} 5
+# HELP vpp_if_rx_bytes_total VPP stat /if/rx.
+# TYPE vpp_if_rx_bytes_total counter
+vpp_if_rx_bytes_total{interface="local0"} 0
+vpp_if_rx_bytes_total{interface="loop0"} 1500
+# HELP vpp_if_rx_packets_total VPP stat /if/rx.
+# TYPE vpp_if_rx_packets_total counter
+vpp_if_rx_packets_total{interface="local0"} 0
+vpp_if_rx_packets_total{interface="loop0"} 15
+# HELP vpp_interfaces_drops_total VPP stat /interfaces/<interface>/drops.
+# TYPE vpp_interfaces_drops_total counter
+vpp_interfaces_drops_total{interface="loop0"} 7
+# HELP vpp_sys_vector_rate VPP stat /sys/vector_rate.
+# TYPE vpp_sys_vector_rate gauge
+vpp_sys_vector_rate 2.5
+`))).To(Succeed())
+}
+
+func TestStatsCollectorPerThread(t *testing.T) {
+	RegisterTestingT(t)
+
+	collector, err := prometheus.NewStatsCollector(newTestStatsAdapter(),
+		prometheus.WithStatsInclude("^/if/rx$", "^/net/"),
+		prometheus.WithPerThread(true),
+		prometheus.WithStatsConstLabels(prom.Labels{"vpp": "node1"}),
+	)
+	Expect(err).ShouldNot(HaveOccurred())
+
+	Expect(testutil.CollectAndCompare(collector, strings.NewReader(`
+# HELP vpp_if_rx_packets_total VPP stat /if/rx.
+# TYPE vpp_if_rx_packets_total counter
+vpp_if_rx_packets_total{interface="local0",thread="0",vpp="node1"} 0
+vpp_if_rx_packets_total{interface="local0",thread="1",vpp="node1"} 0
+vpp_if_rx_packets_total{interface="loop0",thread="0",vpp="node1"} 10
+vpp_if_rx_packets_total{interface="loop0",thread="1",vpp="node1"} 5
+# HELP vpp_net_route_to_packets_total VPP stat /net/route/to.
+# TYPE vpp_net_route_to_packets_total counter
+vpp_net_route_to_packets_total{index="0",thread="0",vpp="node1"} 1
+`), "vpp_if_rx_packets_total", "vpp_net_route_to_packets_total")).To(Succeed())
+}
+
+func TestStatsCollectorInvalidPattern(t *testing.T) {
+	RegisterTestingT(t)
+
+	_, err := prometheus.NewStatsCollector(mock.NewStatsAdapter(), prometheus.WithStatsExclude("("))
+	Expect(err).To(HaveOccurred())
+}
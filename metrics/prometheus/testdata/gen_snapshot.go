@@ -0,0 +1,65 @@
+//  Copyright (c) 2023 Cisco and/or its affiliates.
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at:
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+//go:build ignore
+
+// This program generates stats.snapshot used by the stats collector tests,
+// the stats are laid out as in the stat segment of VPP with two threads.
+// Run it from the metrics/prometheus directory:
+//
+//	go run testdata/gen_snapshot.go
+package main
+
+import (
+	"log"
+	"time"
+
+	"go.fd.io/govpp/adapter"
+	"go.fd.io/govpp/adapter/statsfile"
+)
+
+func statEntry(index uint32, name string, data adapter.Stat) adapter.StatEntry {
+	return adapter.StatEntry{
+		StatIdentifier: adapter.StatIdentifier{Index: index, Name: []byte(name)},
+		Type:           data.Type(),
+		Data:           data,
+	}
+}
+
+func main() {
+	symlink := statEntry(7, "/interfaces/loop0/drops", adapter.SimpleCounterStat{{3}, {4}})
+	symlink.Symlink = true
+	symlink.SymlinkTarget = 4
+	symlink.SymlinkItem = 1
+
+	snapshot := &statsfile.Snapshot{
+		Timestamp: time.Unix(1700000000, 0),
+		Epoch:     1,
+		Entries: []adapter.StatEntry{
+			statEntry(1, "/sys/vector_rate", adapter.ScalarStat(2.5)),
+			statEntry(2, "/if/names", adapter.NameStat{adapter.Name("local0"), adapter.Name("loop0")}),
+			statEntry(3, "/if/rx", adapter.CombinedCounterStat{
+				{{0, 0}, {10, 1000}},
+				{{0, 0}, {5, 500}},
+			}),
+			statEntry(4, "/if/drops", adapter.SimpleCounterStat{{1, 2}, {0, 3}}),
+			statEntry(5, "/err/ip4-input/ip4 ttl expired", adapter.ErrorStat{7, 1}),
+			statEntry(6, "/net/route/to", adapter.CombinedCounterStat{{{1, 64}}}),
+			symlink,
+		},
+	}
+	if err := statsfile.SaveFile("testdata/stats.snapshot", snapshot); err != nil {
+		log.Fatal(err)
+	}
+}
@@ -128,6 +128,16 @@ func (s *StatsClient) GetMemoryStats(memStats *api.MemoryStats) error {
 	return nil
 }
 
+func (s *StatsClient) GetNetworkStats(netStats *api.NetworkStats) error {
+	req := StatsRequest{StatsType: "network"}
+	resp := StatsResponse{NetStats: new(api.NetworkStats)}
+	if err := s.rpc.Call("StatsRPC.GetStats", req, &resp); err != nil {
+		return err
+	}
+	*netStats = *resp.NetStats
+	return nil
+}
+
 type BinapiClient struct {
 	rpc     *rpc.Client
 	timeout time.Duration
@@ -57,6 +57,7 @@ type StatsResponse struct {
 	ErrStats   *api.ErrorStats
 	BufStats   *api.BufferStats
 	MemStats   *api.MemoryStats
+	NetStats   *api.NetworkStats
 }
 
 // StatsRPC is a RPC server for proxying client request to api.StatsProvider.
@@ -205,6 +206,9 @@ func (s *StatsRPC) GetStats(req StatsRequest, resp *StatsResponse) error {
 	case "memory":
 		resp.MemStats = new(api.MemoryStats)
 		return s.statsConn.GetMemoryStats(resp.MemStats)
+	case "network":
+		resp.NetStats = new(api.NetworkStats)
+		return s.statsConn.GetNetworkStats(resp.NetStats)
 	default:
 		return fmt.Errorf("unknown stats type: %s", req.StatsType)
 	}